@@ -166,12 +166,116 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Schema
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Schema)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Schema)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Schema)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Schema)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*NameAccessGrant
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NameAccessGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NameAccessGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(NameAccessGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(NameAccessGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState             protoreflect.MessageDescriptor
-	fd_GenesisState_params      protoreflect.FieldDescriptor
-	fd_GenesisState_records     protoreflect.FieldDescriptor
-	fd_GenesisState_authorities protoreflect.FieldDescriptor
-	fd_GenesisState_names       protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_records            protoreflect.FieldDescriptor
+	fd_GenesisState_authorities        protoreflect.FieldDescriptor
+	fd_GenesisState_names              protoreflect.FieldDescriptor
+	fd_GenesisState_schemas            protoreflect.FieldDescriptor
+	fd_GenesisState_name_access_grants protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -181,6 +285,8 @@ func init() {
 	fd_GenesisState_records = md_GenesisState.Fields().ByName("records")
 	fd_GenesisState_authorities = md_GenesisState.Fields().ByName("authorities")
 	fd_GenesisState_names = md_GenesisState.Fields().ByName("names")
+	fd_GenesisState_schemas = md_GenesisState.Fields().ByName("schemas")
+	fd_GenesisState_name_access_grants = md_GenesisState.Fields().ByName("name_access_grants")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -272,6 +378,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.Schemas) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Schemas})
+		if !f(fd_GenesisState_schemas, value) {
+			return
+		}
+	}
+	if len(x.NameAccessGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.NameAccessGrants})
+		if !f(fd_GenesisState_name_access_grants, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -295,6 +413,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Authorities) != 0
 	case "cerc.registry.v1.GenesisState.names":
 		return len(x.Names) != 0
+	case "cerc.registry.v1.GenesisState.schemas":
+		return len(x.Schemas) != 0
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		return len(x.NameAccessGrants) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -319,6 +441,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Authorities = nil
 	case "cerc.registry.v1.GenesisState.names":
 		x.Names = nil
+	case "cerc.registry.v1.GenesisState.schemas":
+		x.Schemas = nil
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		x.NameAccessGrants = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -356,6 +482,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_4_list{list: &x.Names}
 		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.GenesisState.schemas":
+		if len(x.Schemas) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Schemas}
+		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		if len(x.NameAccessGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.NameAccessGrants}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -390,6 +528,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_4_list)
 		x.Names = *clv.list
+	case "cerc.registry.v1.GenesisState.schemas":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Schemas = *clv.list
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.NameAccessGrants = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -433,6 +579,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.Names}
 		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.GenesisState.schemas":
+		if x.Schemas == nil {
+			x.Schemas = []*Schema{}
+		}
+		value := &_GenesisState_5_list{list: &x.Schemas}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		if x.NameAccessGrants == nil {
+			x.NameAccessGrants = []*NameAccessGrant{}
+		}
+		value := &_GenesisState_6_list{list: &x.NameAccessGrants}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -458,6 +616,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cerc.registry.v1.GenesisState.names":
 		list := []*NameEntry{}
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "cerc.registry.v1.GenesisState.schemas":
+		list := []*Schema{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cerc.registry.v1.GenesisState.name_access_grants":
+		list := []*NameAccessGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.GenesisState"))
@@ -549,6 +713,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Schemas) > 0 {
+			for _, e := range x.Schemas {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NameAccessGrants) > 0 {
+			for _, e := range x.NameAccessGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -578,6 +754,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NameAccessGrants) > 0 {
+			for iNdEx := len(x.NameAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NameAccessGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Schemas) > 0 {
+			for iNdEx := len(x.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Schemas[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if len(x.Names) > 0 {
 			for iNdEx := len(x.Names) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Names[iNdEx])
@@ -827,6 +1035,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Schemas = append(x.Schemas, &Schema{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schemas[len(x.Schemas)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NameAccessGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NameAccessGrants = append(x.NameAccessGrants, &NameAccessGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NameAccessGrants[len(x.NameAccessGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -889,6 +1165,10 @@ type GenesisState struct {
 	Authorities []*AuthorityEntry `protobuf:"bytes,3,rep,name=authorities,proto3" json:"authorities,omitempty"`
 	// names
 	Names []*NameEntry `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	// schemas
+	Schemas []*Schema `protobuf:"bytes,5,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	// name access grants
+	NameAccessGrants []*NameAccessGrant `protobuf:"bytes,6,rep,name=name_access_grants,json=nameAccessGrants,proto3" json:"name_access_grants,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -939,6 +1219,20 @@ func (x *GenesisState) GetNames() []*NameEntry {
 	return nil
 }
 
+func (x *GenesisState) GetSchemas() []*Schema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNameAccessGrants() []*NameAccessGrant {
+	if x != nil {
+		return x.NameAccessGrants
+	}
+	return nil
+}
+
 var File_cerc_registry_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_cerc_registry_v1_genesis_proto_rawDesc = []byte{
@@ -948,7 +1242,7 @@ var file_cerc_registry_v1_genesis_proto_rawDesc = []byte{
 	0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72,
 	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x02, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x04, 0x0a, 0x0c, 0x47, 0x65,
 	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x70, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65, 0x72,
 	0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
@@ -971,20 +1265,34 @@ var file_cerc_registry_v1_genesis_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x21, 0xc8, 0xde,
 	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52,
-	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0xc3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x3b, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x64, 0x62, 0x2e, 0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63,
-	0x2d, 0x69, 0x6f, 0x2f, 0x6c, 0x61, 0x63, 0x6f, 0x6e, 0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76,
-	0x31, 0x3b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
-	0x52, 0x58, 0xaa, 0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x22, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x12, 0x8c, 0x01, 0x0a, 0x12, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x42, 0x3b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x33, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x10,
+	0x6e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x42, 0xc3, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x2e, 0x76,
+	0x64, 0x62, 0x2e, 0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2d, 0x69, 0x6f, 0x2f, 0x6c, 0x61,
+	0x63, 0x6f, 0x6e, 0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x10, 0x43,
+	0x65, 0x72, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1001,22 +1309,26 @@ func file_cerc_registry_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cerc_registry_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cerc_registry_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),   // 0: cerc.registry.v1.GenesisState
-	(*Params)(nil),         // 1: cerc.registry.v1.Params
-	(*Record)(nil),         // 2: cerc.registry.v1.Record
-	(*AuthorityEntry)(nil), // 3: cerc.registry.v1.AuthorityEntry
-	(*NameEntry)(nil),      // 4: cerc.registry.v1.NameEntry
+	(*GenesisState)(nil),    // 0: cerc.registry.v1.GenesisState
+	(*Params)(nil),          // 1: cerc.registry.v1.Params
+	(*Record)(nil),          // 2: cerc.registry.v1.Record
+	(*AuthorityEntry)(nil),  // 3: cerc.registry.v1.AuthorityEntry
+	(*NameEntry)(nil),       // 4: cerc.registry.v1.NameEntry
+	(*Schema)(nil),          // 5: cerc.registry.v1.Schema
+	(*NameAccessGrant)(nil), // 6: cerc.registry.v1.NameAccessGrant
 }
 var file_cerc_registry_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: cerc.registry.v1.GenesisState.params:type_name -> cerc.registry.v1.Params
 	2, // 1: cerc.registry.v1.GenesisState.records:type_name -> cerc.registry.v1.Record
 	3, // 2: cerc.registry.v1.GenesisState.authorities:type_name -> cerc.registry.v1.AuthorityEntry
 	4, // 3: cerc.registry.v1.GenesisState.names:type_name -> cerc.registry.v1.NameEntry
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: cerc.registry.v1.GenesisState.schemas:type_name -> cerc.registry.v1.Schema
+	6, // 5: cerc.registry.v1.GenesisState.name_access_grants:type_name -> cerc.registry.v1.NameAccessGrant
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_cerc_registry_v1_genesis_proto_init() }
@@ -22,13 +22,21 @@ const (
 	Query_Params_FullMethodName                   = "/cerc.registry.v1.Query/Params"
 	Query_Records_FullMethodName                  = "/cerc.registry.v1.Query/Records"
 	Query_GetRecord_FullMethodName                = "/cerc.registry.v1.Query/GetRecord"
+	Query_GetRecordHistory_FullMethodName         = "/cerc.registry.v1.Query/GetRecordHistory"
+	Query_GetRecordsByOwner_FullMethodName        = "/cerc.registry.v1.Query/GetRecordsByOwner"
+	Query_GetReferencingRecords_FullMethodName    = "/cerc.registry.v1.Query/GetReferencingRecords"
+	Query_QuoteRecordRent_FullMethodName          = "/cerc.registry.v1.Query/QuoteRecordRent"
 	Query_GetRecordsByBondId_FullMethodName       = "/cerc.registry.v1.Query/GetRecordsByBondId"
 	Query_NameRecords_FullMethodName              = "/cerc.registry.v1.Query/NameRecords"
+	Query_NamesByPrefix_FullMethodName            = "/cerc.registry.v1.Query/NamesByPrefix"
 	Query_Whois_FullMethodName                    = "/cerc.registry.v1.Query/Whois"
+	Query_NameAccessGrants_FullMethodName         = "/cerc.registry.v1.Query/NameAccessGrants"
 	Query_LookupLrn_FullMethodName                = "/cerc.registry.v1.Query/LookupLrn"
 	Query_ResolveLrn_FullMethodName               = "/cerc.registry.v1.Query/ResolveLrn"
 	Query_GetRegistryModuleBalance_FullMethodName = "/cerc.registry.v1.Query/GetRegistryModuleBalance"
 	Query_Authorities_FullMethodName              = "/cerc.registry.v1.Query/Authorities"
+	Query_Schemas_FullMethodName                  = "/cerc.registry.v1.Query/Schemas"
+	Query_GetSchema_FullMethodName                = "/cerc.registry.v1.Query/GetSchema"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,12 +49,24 @@ type QueryClient interface {
 	Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error)
 	// Get record by id
 	GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error)
+	// Get all versions of a record, latest first
+	GetRecordHistory(ctx context.Context, in *QueryGetRecordHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecordHistoryResponse, error)
+	// Get records by owner address
+	GetRecordsByOwner(ctx context.Context, in *QueryGetRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryGetRecordsByOwnerResponse, error)
+	// Get records with a link to a record
+	GetReferencingRecords(ctx context.Context, in *QueryGetReferencingRecordsRequest, opts ...grpc.CallOption) (*QueryGetReferencingRecordsResponse, error)
+	// Get the rent of a new or existing record
+	QuoteRecordRent(ctx context.Context, in *QueryQuoteRecordRentRequest, opts ...grpc.CallOption) (*QueryQuoteRecordRentResponse, error)
 	// Get records by bond id
 	GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error)
 	// NameRecords queries all name records
 	NameRecords(ctx context.Context, in *QueryNameRecordsRequest, opts ...grpc.CallOption) (*QueryNameRecordsResponse, error)
+	// NamesByPrefix queries the name records under an LRN prefix
+	NamesByPrefix(ctx context.Context, in *QueryNamesByPrefixRequest, opts ...grpc.CallOption) (*QueryNamesByPrefixResponse, error)
 	// Whois method retrieve the name authority info
 	Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error)
+	// NameAccessGrants queries the name access grants of a name authority
+	NameAccessGrants(ctx context.Context, in *QueryNameAccessGrantsRequest, opts ...grpc.CallOption) (*QueryNameAccessGrantsResponse, error)
 	// LookupLrn
 	LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error)
 	// ResolveLrn
@@ -55,6 +75,10 @@ type QueryClient interface {
 	GetRegistryModuleBalance(ctx context.Context, in *QueryGetRegistryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryGetRegistryModuleBalanceResponse, error)
 	// Authorities queries all authorities
 	Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error)
+	// Schemas queries all record type schemas
+	Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error)
+	// Get schema by record type
+	GetSchema(ctx context.Context, in *QueryGetSchemaRequest, opts ...grpc.CallOption) (*QueryGetSchemaResponse, error)
 }
 
 type queryClient struct {
@@ -92,6 +116,42 @@ func (c *queryClient) GetRecord(ctx context.Context, in *QueryGetRecordRequest,
 	return out, nil
 }
 
+func (c *queryClient) GetRecordHistory(ctx context.Context, in *QueryGetRecordHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecordHistoryResponse, error) {
+	out := new(QueryGetRecordHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRecordsByOwner(ctx context.Context, in *QueryGetRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryGetRecordsByOwnerResponse, error) {
+	out := new(QueryGetRecordsByOwnerResponse)
+	err := c.cc.Invoke(ctx, Query_GetRecordsByOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetReferencingRecords(ctx context.Context, in *QueryGetReferencingRecordsRequest, opts ...grpc.CallOption) (*QueryGetReferencingRecordsResponse, error) {
+	out := new(QueryGetReferencingRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_GetReferencingRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteRecordRent(ctx context.Context, in *QueryQuoteRecordRentRequest, opts ...grpc.CallOption) (*QueryQuoteRecordRentResponse, error) {
+	out := new(QueryQuoteRecordRentResponse)
+	err := c.cc.Invoke(ctx, Query_QuoteRecordRent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error) {
 	out := new(QueryGetRecordsByBondIdResponse)
 	err := c.cc.Invoke(ctx, Query_GetRecordsByBondId_FullMethodName, in, out, opts...)
@@ -110,6 +170,15 @@ func (c *queryClient) NameRecords(ctx context.Context, in *QueryNameRecordsReque
 	return out, nil
 }
 
+func (c *queryClient) NamesByPrefix(ctx context.Context, in *QueryNamesByPrefixRequest, opts ...grpc.CallOption) (*QueryNamesByPrefixResponse, error) {
+	out := new(QueryNamesByPrefixResponse)
+	err := c.cc.Invoke(ctx, Query_NamesByPrefix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error) {
 	out := new(QueryWhoisResponse)
 	err := c.cc.Invoke(ctx, Query_Whois_FullMethodName, in, out, opts...)
@@ -119,6 +188,15 @@ func (c *queryClient) Whois(ctx context.Context, in *QueryWhoisRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) NameAccessGrants(ctx context.Context, in *QueryNameAccessGrantsRequest, opts ...grpc.CallOption) (*QueryNameAccessGrantsResponse, error) {
+	out := new(QueryNameAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_NameAccessGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error) {
 	out := new(QueryLookupLrnResponse)
 	err := c.cc.Invoke(ctx, Query_LookupLrn_FullMethodName, in, out, opts...)
@@ -155,6 +233,24 @@ func (c *queryClient) Authorities(ctx context.Context, in *QueryAuthoritiesReque
 	return out, nil
 }
 
+func (c *queryClient) Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error) {
+	out := new(QuerySchemasResponse)
+	err := c.cc.Invoke(ctx, Query_Schemas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetSchema(ctx context.Context, in *QueryGetSchemaRequest, opts ...grpc.CallOption) (*QueryGetSchemaResponse, error) {
+	out := new(QueryGetSchemaResponse)
+	err := c.cc.Invoke(ctx, Query_GetSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -165,12 +261,24 @@ type QueryServer interface {
 	Records(context.Context, *QueryRecordsRequest) (*QueryRecordsResponse, error)
 	// Get record by id
 	GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error)
+	// Get all versions of a record, latest first
+	GetRecordHistory(context.Context, *QueryGetRecordHistoryRequest) (*QueryGetRecordHistoryResponse, error)
+	// Get records by owner address
+	GetRecordsByOwner(context.Context, *QueryGetRecordsByOwnerRequest) (*QueryGetRecordsByOwnerResponse, error)
+	// Get records with a link to a record
+	GetReferencingRecords(context.Context, *QueryGetReferencingRecordsRequest) (*QueryGetReferencingRecordsResponse, error)
+	// Get the rent of a new or existing record
+	QuoteRecordRent(context.Context, *QueryQuoteRecordRentRequest) (*QueryQuoteRecordRentResponse, error)
 	// Get records by bond id
 	GetRecordsByBondId(context.Context, *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error)
 	// NameRecords queries all name records
 	NameRecords(context.Context, *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error)
+	// NamesByPrefix queries the name records under an LRN prefix
+	NamesByPrefix(context.Context, *QueryNamesByPrefixRequest) (*QueryNamesByPrefixResponse, error)
 	// Whois method retrieve the name authority info
 	Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error)
+	// NameAccessGrants queries the name access grants of a name authority
+	NameAccessGrants(context.Context, *QueryNameAccessGrantsRequest) (*QueryNameAccessGrantsResponse, error)
 	// LookupLrn
 	LookupLrn(context.Context, *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error)
 	// ResolveLrn
@@ -179,6 +287,10 @@ type QueryServer interface {
 	GetRegistryModuleBalance(context.Context, *QueryGetRegistryModuleBalanceRequest) (*QueryGetRegistryModuleBalanceResponse, error)
 	// Authorities queries all authorities
 	Authorities(context.Context, *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error)
+	// Schemas queries all record type schemas
+	Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error)
+	// Get schema by record type
+	GetSchema(context.Context, *QueryGetSchemaRequest) (*QueryGetSchemaResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -195,15 +307,33 @@ func (UnimplementedQueryServer) Records(context.Context, *QueryRecordsRequest) (
 func (UnimplementedQueryServer) GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
 }
+func (UnimplementedQueryServer) GetRecordHistory(context.Context, *QueryGetRecordHistoryRequest) (*QueryGetRecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordHistory not implemented")
+}
+func (UnimplementedQueryServer) GetRecordsByOwner(context.Context, *QueryGetRecordsByOwnerRequest) (*QueryGetRecordsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByOwner not implemented")
+}
+func (UnimplementedQueryServer) GetReferencingRecords(context.Context, *QueryGetReferencingRecordsRequest) (*QueryGetReferencingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferencingRecords not implemented")
+}
+func (UnimplementedQueryServer) QuoteRecordRent(context.Context, *QueryQuoteRecordRentRequest) (*QueryQuoteRecordRentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRecordRent not implemented")
+}
 func (UnimplementedQueryServer) GetRecordsByBondId(context.Context, *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByBondId not implemented")
 }
 func (UnimplementedQueryServer) NameRecords(context.Context, *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NameRecords not implemented")
 }
+func (UnimplementedQueryServer) NamesByPrefix(context.Context, *QueryNamesByPrefixRequest) (*QueryNamesByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamesByPrefix not implemented")
+}
 func (UnimplementedQueryServer) Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whois not implemented")
 }
+func (UnimplementedQueryServer) NameAccessGrants(context.Context, *QueryNameAccessGrantsRequest) (*QueryNameAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameAccessGrants not implemented")
+}
 func (UnimplementedQueryServer) LookupLrn(context.Context, *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupLrn not implemented")
 }
@@ -216,6 +346,12 @@ func (UnimplementedQueryServer) GetRegistryModuleBalance(context.Context, *Query
 func (UnimplementedQueryServer) Authorities(context.Context, *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authorities not implemented")
 }
+func (UnimplementedQueryServer) Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schemas not implemented")
+}
+func (UnimplementedQueryServer) GetSchema(context.Context, *QueryGetSchemaRequest) (*QueryGetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -283,6 +419,78 @@ func _Query_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordHistory(ctx, req.(*QueryGetRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRecordsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRecordsByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByOwner(ctx, req.(*QueryGetRecordsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetReferencingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReferencingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReferencingRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetReferencingRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReferencingRecords(ctx, req.(*QueryGetReferencingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteRecordRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRecordRentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteRecordRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QuoteRecordRent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteRecordRent(ctx, req.(*QueryQuoteRecordRentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetRecordsByBondId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetRecordsByBondIdRequest)
 	if err := dec(in); err != nil {
@@ -319,6 +527,24 @@ func _Query_NameRecords_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NamesByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamesByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamesByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NamesByPrefix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamesByPrefix(ctx, req.(*QueryNamesByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Whois_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryWhoisRequest)
 	if err := dec(in); err != nil {
@@ -337,6 +563,24 @@ func _Query_Whois_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NameAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NameAccessGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameAccessGrants(ctx, req.(*QueryNameAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LookupLrn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLookupLrnRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +653,42 @@ func _Query_Authorities_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Schemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Schemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schemas(ctx, req.(*QuerySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSchema(ctx, req.(*QueryGetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -428,6 +708,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecord",
 			Handler:    _Query_GetRecord_Handler,
 		},
+		{
+			MethodName: "GetRecordHistory",
+			Handler:    _Query_GetRecordHistory_Handler,
+		},
+		{
+			MethodName: "GetRecordsByOwner",
+			Handler:    _Query_GetRecordsByOwner_Handler,
+		},
+		{
+			MethodName: "GetReferencingRecords",
+			Handler:    _Query_GetReferencingRecords_Handler,
+		},
+		{
+			MethodName: "QuoteRecordRent",
+			Handler:    _Query_QuoteRecordRent_Handler,
+		},
 		{
 			MethodName: "GetRecordsByBondId",
 			Handler:    _Query_GetRecordsByBondId_Handler,
@@ -436,10 +732,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NameRecords",
 			Handler:    _Query_NameRecords_Handler,
 		},
+		{
+			MethodName: "NamesByPrefix",
+			Handler:    _Query_NamesByPrefix_Handler,
+		},
 		{
 			MethodName: "Whois",
 			Handler:    _Query_Whois_Handler,
 		},
+		{
+			MethodName: "NameAccessGrants",
+			Handler:    _Query_NameAccessGrants_Handler,
+		},
 		{
 			MethodName: "LookupLrn",
 			Handler:    _Query_LookupLrn_Handler,
@@ -456,6 +760,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authorities",
 			Handler:    _Query_Authorities_Handler,
 		},
+		{
+			MethodName: "Schemas",
+			Handler:    _Query_Schemas_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Query_GetSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cerc/registry/v1/query.proto",
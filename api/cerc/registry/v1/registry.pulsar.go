@@ -29,6 +29,12 @@ var (
 	fd_Params_authority_auction_commit_fee       protoreflect.FieldDescriptor
 	fd_Params_authority_auction_reveal_fee       protoreflect.FieldDescriptor
 	fd_Params_authority_auction_minimum_bid      protoreflect.FieldDescriptor
+	fd_Params_max_record_lifetime                protoreflect.FieldDescriptor
+	fd_Params_record_rent_per_byte               protoreflect.FieldDescriptor
+	fd_Params_max_record_attributes_size         protoreflect.FieldDescriptor
+	fd_Params_max_record_attributes_depth        protoreflect.FieldDescriptor
+	fd_Params_max_record_indexed_attributes      protoreflect.FieldDescriptor
+	fd_Params_max_schema_size                    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -45,6 +51,12 @@ func init() {
 	fd_Params_authority_auction_commit_fee = md_Params.Fields().ByName("authority_auction_commit_fee")
 	fd_Params_authority_auction_reveal_fee = md_Params.Fields().ByName("authority_auction_reveal_fee")
 	fd_Params_authority_auction_minimum_bid = md_Params.Fields().ByName("authority_auction_minimum_bid")
+	fd_Params_max_record_lifetime = md_Params.Fields().ByName("max_record_lifetime")
+	fd_Params_record_rent_per_byte = md_Params.Fields().ByName("record_rent_per_byte")
+	fd_Params_max_record_attributes_size = md_Params.Fields().ByName("max_record_attributes_size")
+	fd_Params_max_record_attributes_depth = md_Params.Fields().ByName("max_record_attributes_depth")
+	fd_Params_max_record_indexed_attributes = md_Params.Fields().ByName("max_record_indexed_attributes")
+	fd_Params_max_schema_size = md_Params.Fields().ByName("max_schema_size")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -178,6 +190,42 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxRecordLifetime != nil {
+		value := protoreflect.ValueOfMessage(x.MaxRecordLifetime.ProtoReflect())
+		if !f(fd_Params_max_record_lifetime, value) {
+			return
+		}
+	}
+	if x.RecordRentPerByte != nil {
+		value := protoreflect.ValueOfMessage(x.RecordRentPerByte.ProtoReflect())
+		if !f(fd_Params_record_rent_per_byte, value) {
+			return
+		}
+	}
+	if x.MaxRecordAttributesSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecordAttributesSize)
+		if !f(fd_Params_max_record_attributes_size, value) {
+			return
+		}
+	}
+	if x.MaxRecordAttributesDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecordAttributesDepth)
+		if !f(fd_Params_max_record_attributes_depth, value) {
+			return
+		}
+	}
+	if x.MaxRecordIndexedAttributes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecordIndexedAttributes)
+		if !f(fd_Params_max_record_indexed_attributes, value) {
+			return
+		}
+	}
+	if x.MaxSchemaSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSchemaSize)
+		if !f(fd_Params_max_schema_size, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -215,6 +263,18 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.AuthorityAuctionRevealFee != nil
 	case "cerc.registry.v1.Params.authority_auction_minimum_bid":
 		return x.AuthorityAuctionMinimumBid != nil
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		return x.MaxRecordLifetime != nil
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		return x.RecordRentPerByte != nil
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		return x.MaxRecordAttributesSize != uint64(0)
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		return x.MaxRecordAttributesDepth != uint64(0)
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		return x.MaxRecordIndexedAttributes != uint64(0)
+	case "cerc.registry.v1.Params.max_schema_size":
+		return x.MaxSchemaSize != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -253,6 +313,18 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.AuthorityAuctionRevealFee = nil
 	case "cerc.registry.v1.Params.authority_auction_minimum_bid":
 		x.AuthorityAuctionMinimumBid = nil
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		x.MaxRecordLifetime = nil
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		x.RecordRentPerByte = nil
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		x.MaxRecordAttributesSize = uint64(0)
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		x.MaxRecordAttributesDepth = uint64(0)
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		x.MaxRecordIndexedAttributes = uint64(0)
+	case "cerc.registry.v1.Params.max_schema_size":
+		x.MaxSchemaSize = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -302,6 +374,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cerc.registry.v1.Params.authority_auction_minimum_bid":
 		value := x.AuthorityAuctionMinimumBid
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		value := x.MaxRecordLifetime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		value := x.RecordRentPerByte
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		value := x.MaxRecordAttributesSize
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		value := x.MaxRecordAttributesDepth
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		value := x.MaxRecordIndexedAttributes
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.Params.max_schema_size":
+		value := x.MaxSchemaSize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -344,6 +434,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.AuthorityAuctionRevealFee = value.Message().Interface().(*v1beta1.Coin)
 	case "cerc.registry.v1.Params.authority_auction_minimum_bid":
 		x.AuthorityAuctionMinimumBid = value.Message().Interface().(*v1beta1.Coin)
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		x.MaxRecordLifetime = value.Message().Interface().(*durationpb.Duration)
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		x.RecordRentPerByte = value.Message().Interface().(*v1beta1.Coin)
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		x.MaxRecordAttributesSize = value.Uint()
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		x.MaxRecordAttributesDepth = value.Uint()
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		x.MaxRecordIndexedAttributes = value.Uint()
+	case "cerc.registry.v1.Params.max_schema_size":
+		x.MaxSchemaSize = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -414,8 +516,26 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.AuthorityAuctionMinimumBid = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.AuthorityAuctionMinimumBid.ProtoReflect())
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		if x.MaxRecordLifetime == nil {
+			x.MaxRecordLifetime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MaxRecordLifetime.ProtoReflect())
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		if x.RecordRentPerByte == nil {
+			x.RecordRentPerByte = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.RecordRentPerByte.ProtoReflect())
 	case "cerc.registry.v1.Params.authority_auction_enabled":
 		panic(fmt.Errorf("field authority_auction_enabled of message cerc.registry.v1.Params is not mutable"))
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		panic(fmt.Errorf("field max_record_attributes_size of message cerc.registry.v1.Params is not mutable"))
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		panic(fmt.Errorf("field max_record_attributes_depth of message cerc.registry.v1.Params is not mutable"))
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		panic(fmt.Errorf("field max_record_indexed_attributes of message cerc.registry.v1.Params is not mutable"))
+	case "cerc.registry.v1.Params.max_schema_size":
+		panic(fmt.Errorf("field max_schema_size of message cerc.registry.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -461,6 +581,20 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "cerc.registry.v1.Params.authority_auction_minimum_bid":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.Params.max_record_lifetime":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.Params.record_rent_per_byte":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.Params.max_record_attributes_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.Params.max_record_attributes_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.Params.max_record_indexed_attributes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.Params.max_schema_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Params"))
@@ -573,6 +707,26 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.AuthorityAuctionMinimumBid)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxRecordLifetime != nil {
+			l = options.Size(x.MaxRecordLifetime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecordRentPerByte != nil {
+			l = options.Size(x.RecordRentPerByte)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxRecordAttributesSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRecordAttributesSize))
+		}
+		if x.MaxRecordAttributesDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRecordAttributesDepth))
+		}
+		if x.MaxRecordIndexedAttributes != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxRecordIndexedAttributes))
+		}
+		if x.MaxSchemaSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxSchemaSize))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -602,6 +756,58 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxSchemaSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSchemaSize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.MaxRecordIndexedAttributes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecordIndexedAttributes))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MaxRecordAttributesDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecordAttributesDepth))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxRecordAttributesSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecordAttributesSize))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.RecordRentPerByte != nil {
+			encoded, err := options.Marshal(x.RecordRentPerByte)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.MaxRecordLifetime != nil {
+			encoded, err := options.Marshal(x.MaxRecordLifetime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
 		if x.AuthorityAuctionMinimumBid != nil {
 			encoded, err := options.Marshal(x.AuthorityAuctionMinimumBid)
 			if err != nil {
@@ -1181,6 +1387,154 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecordLifetime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxRecordLifetime == nil {
+					x.MaxRecordLifetime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxRecordLifetime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordRentPerByte", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecordRentPerByte == nil {
+					x.RecordRentPerByte = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecordRentPerByte); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecordAttributesSize", wireType)
+				}
+				x.MaxRecordAttributesSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecordAttributesSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecordAttributesDepth", wireType)
+				}
+				x.MaxRecordAttributesDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecordAttributesDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecordIndexedAttributes", wireType)
+				}
+				x.MaxRecordIndexedAttributes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecordIndexedAttributes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSchemaSize", wireType)
+				}
+				x.MaxSchemaSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSchemaSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1309,16 +1663,21 @@ func (x *_Record_8_list) IsValid() bool {
 }
 
 var (
-	md_Record             protoreflect.MessageDescriptor
-	fd_Record_id          protoreflect.FieldDescriptor
-	fd_Record_bond_id     protoreflect.FieldDescriptor
-	fd_Record_create_time protoreflect.FieldDescriptor
-	fd_Record_expiry_time protoreflect.FieldDescriptor
-	fd_Record_deleted     protoreflect.FieldDescriptor
-	fd_Record_owners      protoreflect.FieldDescriptor
-	fd_Record_attributes  protoreflect.FieldDescriptor
-	fd_Record_names       protoreflect.FieldDescriptor
-	fd_Record_type        protoreflect.FieldDescriptor
+	md_Record                 protoreflect.MessageDescriptor
+	fd_Record_id              protoreflect.FieldDescriptor
+	fd_Record_bond_id         protoreflect.FieldDescriptor
+	fd_Record_create_time     protoreflect.FieldDescriptor
+	fd_Record_expiry_time     protoreflect.FieldDescriptor
+	fd_Record_deleted         protoreflect.FieldDescriptor
+	fd_Record_owners          protoreflect.FieldDescriptor
+	fd_Record_attributes      protoreflect.FieldDescriptor
+	fd_Record_names           protoreflect.FieldDescriptor
+	fd_Record_type            protoreflect.FieldDescriptor
+	fd_Record_previous_id     protoreflect.FieldDescriptor
+	fd_Record_owner_threshold protoreflect.FieldDescriptor
+	fd_Record_schema_version  protoreflect.FieldDescriptor
+	fd_Record_rent            protoreflect.FieldDescriptor
+	fd_Record_nonce           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1333,6 +1692,11 @@ func init() {
 	fd_Record_attributes = md_Record.Fields().ByName("attributes")
 	fd_Record_names = md_Record.Fields().ByName("names")
 	fd_Record_type = md_Record.Fields().ByName("type")
+	fd_Record_previous_id = md_Record.Fields().ByName("previous_id")
+	fd_Record_owner_threshold = md_Record.Fields().ByName("owner_threshold")
+	fd_Record_schema_version = md_Record.Fields().ByName("schema_version")
+	fd_Record_rent = md_Record.Fields().ByName("rent")
+	fd_Record_nonce = md_Record.Fields().ByName("nonce")
 }
 
 var _ protoreflect.Message = (*fastReflection_Record)(nil)
@@ -1454,6 +1818,36 @@ func (x *fastReflection_Record) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PreviousId != "" {
+		value := protoreflect.ValueOfString(x.PreviousId)
+		if !f(fd_Record_previous_id, value) {
+			return
+		}
+	}
+	if x.OwnerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OwnerThreshold)
+		if !f(fd_Record_owner_threshold, value) {
+			return
+		}
+	}
+	if x.SchemaVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SchemaVersion)
+		if !f(fd_Record_schema_version, value) {
+			return
+		}
+	}
+	if x.Rent != nil {
+		value := protoreflect.ValueOfMessage(x.Rent.ProtoReflect())
+		if !f(fd_Record_rent, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_Record_nonce, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1487,6 +1881,16 @@ func (x *fastReflection_Record) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Names) != 0
 	case "cerc.registry.v1.Record.type":
 		return x.Type_ != ""
+	case "cerc.registry.v1.Record.previous_id":
+		return x.PreviousId != ""
+	case "cerc.registry.v1.Record.owner_threshold":
+		return x.OwnerThreshold != uint32(0)
+	case "cerc.registry.v1.Record.schema_version":
+		return x.SchemaVersion != uint64(0)
+	case "cerc.registry.v1.Record.rent":
+		return x.Rent != nil
+	case "cerc.registry.v1.Record.nonce":
+		return x.Nonce != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1521,6 +1925,16 @@ func (x *fastReflection_Record) Clear(fd protoreflect.FieldDescriptor) {
 		x.Names = nil
 	case "cerc.registry.v1.Record.type":
 		x.Type_ = ""
+	case "cerc.registry.v1.Record.previous_id":
+		x.PreviousId = ""
+	case "cerc.registry.v1.Record.owner_threshold":
+		x.OwnerThreshold = uint32(0)
+	case "cerc.registry.v1.Record.schema_version":
+		x.SchemaVersion = uint64(0)
+	case "cerc.registry.v1.Record.rent":
+		x.Rent = nil
+	case "cerc.registry.v1.Record.nonce":
+		x.Nonce = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1570,6 +1984,21 @@ func (x *fastReflection_Record) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cerc.registry.v1.Record.type":
 		value := x.Type_
 		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Record.previous_id":
+		value := x.PreviousId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Record.owner_threshold":
+		value := x.OwnerThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "cerc.registry.v1.Record.schema_version":
+		value := x.SchemaVersion
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.Record.rent":
+		value := x.Rent
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.Record.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1612,6 +2041,16 @@ func (x *fastReflection_Record) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Names = *clv.list
 	case "cerc.registry.v1.Record.type":
 		x.Type_ = value.Interface().(string)
+	case "cerc.registry.v1.Record.previous_id":
+		x.PreviousId = value.Interface().(string)
+	case "cerc.registry.v1.Record.owner_threshold":
+		x.OwnerThreshold = uint32(value.Uint())
+	case "cerc.registry.v1.Record.schema_version":
+		x.SchemaVersion = value.Uint()
+	case "cerc.registry.v1.Record.rent":
+		x.Rent = value.Message().Interface().(*RecordRent)
+	case "cerc.registry.v1.Record.nonce":
+		x.Nonce = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1644,6 +2083,11 @@ func (x *fastReflection_Record) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Record_8_list{list: &x.Names}
 		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.Record.rent":
+		if x.Rent == nil {
+			x.Rent = new(RecordRent)
+		}
+		return protoreflect.ValueOfMessage(x.Rent.ProtoReflect())
 	case "cerc.registry.v1.Record.id":
 		panic(fmt.Errorf("field id of message cerc.registry.v1.Record is not mutable"))
 	case "cerc.registry.v1.Record.bond_id":
@@ -1658,6 +2102,14 @@ func (x *fastReflection_Record) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field attributes of message cerc.registry.v1.Record is not mutable"))
 	case "cerc.registry.v1.Record.type":
 		panic(fmt.Errorf("field type of message cerc.registry.v1.Record is not mutable"))
+	case "cerc.registry.v1.Record.previous_id":
+		panic(fmt.Errorf("field previous_id of message cerc.registry.v1.Record is not mutable"))
+	case "cerc.registry.v1.Record.owner_threshold":
+		panic(fmt.Errorf("field owner_threshold of message cerc.registry.v1.Record is not mutable"))
+	case "cerc.registry.v1.Record.schema_version":
+		panic(fmt.Errorf("field schema_version of message cerc.registry.v1.Record is not mutable"))
+	case "cerc.registry.v1.Record.nonce":
+		panic(fmt.Errorf("field nonce of message cerc.registry.v1.Record is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1691,6 +2143,17 @@ func (x *fastReflection_Record) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Record_8_list{list: &list})
 	case "cerc.registry.v1.Record.type":
 		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Record.previous_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Record.owner_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cerc.registry.v1.Record.schema_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.Record.rent":
+		m := new(RecordRent)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.Record.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Record"))
@@ -1799,6 +2262,23 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.PreviousId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OwnerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerThreshold))
+		}
+		if x.SchemaVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.SchemaVersion))
+		}
+		if x.Rent != nil {
+			l = options.Size(x.Rent)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1828,6 +2308,42 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.Rent != nil {
+			encoded, err := options.Marshal(x.Rent)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.SchemaVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SchemaVersion))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.OwnerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerThreshold))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.PreviousId) > 0 {
+			i -= len(x.PreviousId)
+			copy(dAtA[i:], x.PreviousId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousId)))
+			i--
+			dAtA[i] = 0x52
+		}
 		if len(x.Type_) > 0 {
 			i -= len(x.Type_)
 			copy(dAtA[i:], x.Type_)
@@ -2225,13 +2741,138 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 				}
 				x.Type_ = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+				}
+				x.OwnerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OwnerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+				}
+				x.SchemaVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SchemaVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Rent == nil {
+					x.Rent = &RecordRent{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rent); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
@@ -2261,27 +2902,31 @@ func (x *fastReflection_Record) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AuthorityEntry       protoreflect.MessageDescriptor
-	fd_AuthorityEntry_name  protoreflect.FieldDescriptor
-	fd_AuthorityEntry_entry protoreflect.FieldDescriptor
+	md_RecordRent            protoreflect.MessageDescriptor
+	fd_RecordRent_amount     protoreflect.FieldDescriptor
+	fd_RecordRent_bond_id    protoreflect.FieldDescriptor
+	fd_RecordRent_start_time protoreflect.FieldDescriptor
+	fd_RecordRent_end_time   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_AuthorityEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("AuthorityEntry")
-	fd_AuthorityEntry_name = md_AuthorityEntry.Fields().ByName("name")
-	fd_AuthorityEntry_entry = md_AuthorityEntry.Fields().ByName("entry")
+	md_RecordRent = File_cerc_registry_v1_registry_proto.Messages().ByName("RecordRent")
+	fd_RecordRent_amount = md_RecordRent.Fields().ByName("amount")
+	fd_RecordRent_bond_id = md_RecordRent.Fields().ByName("bond_id")
+	fd_RecordRent_start_time = md_RecordRent.Fields().ByName("start_time")
+	fd_RecordRent_end_time = md_RecordRent.Fields().ByName("end_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_AuthorityEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_RecordRent)(nil)
 
-type fastReflection_AuthorityEntry AuthorityEntry
+type fastReflection_RecordRent RecordRent
 
-func (x *AuthorityEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AuthorityEntry)(x)
+func (x *RecordRent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordRent)(x)
 }
 
-func (x *AuthorityEntry) slowProtoReflect() protoreflect.Message {
+func (x *RecordRent) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_registry_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2293,43 +2938,43 @@ func (x *AuthorityEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AuthorityEntry_messageType fastReflection_AuthorityEntry_messageType
-var _ protoreflect.MessageType = fastReflection_AuthorityEntry_messageType{}
+var _fastReflection_RecordRent_messageType fastReflection_RecordRent_messageType
+var _ protoreflect.MessageType = fastReflection_RecordRent_messageType{}
 
-type fastReflection_AuthorityEntry_messageType struct{}
+type fastReflection_RecordRent_messageType struct{}
 
-func (x fastReflection_AuthorityEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AuthorityEntry)(nil)
+func (x fastReflection_RecordRent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordRent)(nil)
 }
-func (x fastReflection_AuthorityEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_AuthorityEntry)
+func (x fastReflection_RecordRent_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordRent)
 }
-func (x fastReflection_AuthorityEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AuthorityEntry
+func (x fastReflection_RecordRent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordRent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AuthorityEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_AuthorityEntry
+func (x *fastReflection_RecordRent) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordRent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AuthorityEntry) Type() protoreflect.MessageType {
-	return _fastReflection_AuthorityEntry_messageType
+func (x *fastReflection_RecordRent) Type() protoreflect.MessageType {
+	return _fastReflection_RecordRent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AuthorityEntry) New() protoreflect.Message {
-	return new(fastReflection_AuthorityEntry)
+func (x *fastReflection_RecordRent) New() protoreflect.Message {
+	return new(fastReflection_RecordRent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AuthorityEntry) Interface() protoreflect.ProtoMessage {
-	return (*AuthorityEntry)(x)
+func (x *fastReflection_RecordRent) Interface() protoreflect.ProtoMessage {
+	return (*RecordRent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2337,16 +2982,28 @@ func (x *fastReflection_AuthorityEntry) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AuthorityEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_AuthorityEntry_name, value) {
+func (x *fastReflection_RecordRent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_RecordRent_amount, value) {
 			return
 		}
 	}
-	if x.Entry != nil {
-		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
-		if !f(fd_AuthorityEntry_entry, value) {
+	if x.BondId != "" {
+		value := protoreflect.ValueOfString(x.BondId)
+		if !f(fd_RecordRent_bond_id, value) {
+			return
+		}
+	}
+	if x.StartTime != "" {
+		value := protoreflect.ValueOfString(x.StartTime)
+		if !f(fd_RecordRent_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != "" {
+		value := protoreflect.ValueOfString(x.EndTime)
+		if !f(fd_RecordRent_end_time, value) {
 			return
 		}
 	}
@@ -2363,17 +3020,21 @@ func (x *fastReflection_AuthorityEntry) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AuthorityEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RecordRent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.name":
-		return x.Name != ""
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		return x.Entry != nil
+	case "cerc.registry.v1.RecordRent.amount":
+		return x.Amount != nil
+	case "cerc.registry.v1.RecordRent.bond_id":
+		return x.BondId != ""
+	case "cerc.registry.v1.RecordRent.start_time":
+		return x.StartTime != ""
+	case "cerc.registry.v1.RecordRent.end_time":
+		return x.EndTime != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2383,17 +3044,21 @@ func (x *fastReflection_AuthorityEntry) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuthorityEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RecordRent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.name":
-		x.Name = ""
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		x.Entry = nil
+	case "cerc.registry.v1.RecordRent.amount":
+		x.Amount = nil
+	case "cerc.registry.v1.RecordRent.bond_id":
+		x.BondId = ""
+	case "cerc.registry.v1.RecordRent.start_time":
+		x.StartTime = ""
+	case "cerc.registry.v1.RecordRent.end_time":
+		x.EndTime = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2403,19 +3068,25 @@ func (x *fastReflection_AuthorityEntry) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AuthorityEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordRent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		value := x.Entry
+	case "cerc.registry.v1.RecordRent.amount":
+		value := x.Amount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.RecordRent.bond_id":
+		value := x.BondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.RecordRent.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.RecordRent.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2429,17 +3100,21 @@ func (x *fastReflection_AuthorityEntry) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuthorityEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RecordRent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.name":
-		x.Name = value.Interface().(string)
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		x.Entry = value.Message().Interface().(*NameAuthority)
+	case "cerc.registry.v1.RecordRent.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "cerc.registry.v1.RecordRent.bond_id":
+		x.BondId = value.Interface().(string)
+	case "cerc.registry.v1.RecordRent.start_time":
+		x.StartTime = value.Interface().(string)
+	case "cerc.registry.v1.RecordRent.end_time":
+		x.EndTime = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2453,48 +3128,56 @@ func (x *fastReflection_AuthorityEntry) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuthorityEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordRent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		if x.Entry == nil {
-			x.Entry = new(NameAuthority)
-		}
-		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
-	case "cerc.registry.v1.AuthorityEntry.name":
-		panic(fmt.Errorf("field name of message cerc.registry.v1.AuthorityEntry is not mutable"))
+	case "cerc.registry.v1.RecordRent.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "cerc.registry.v1.RecordRent.bond_id":
+		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.RecordRent is not mutable"))
+	case "cerc.registry.v1.RecordRent.start_time":
+		panic(fmt.Errorf("field start_time of message cerc.registry.v1.RecordRent is not mutable"))
+	case "cerc.registry.v1.RecordRent.end_time":
+		panic(fmt.Errorf("field end_time of message cerc.registry.v1.RecordRent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AuthorityEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordRent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.AuthorityEntry.name":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.AuthorityEntry.entry":
-		m := new(NameAuthority)
+	case "cerc.registry.v1.RecordRent.amount":
+		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.RecordRent.bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.RecordRent.start_time":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.RecordRent.end_time":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordRent"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordRent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AuthorityEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RecordRent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.AuthorityEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.RecordRent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2502,7 +3185,7 @@ func (x *fastReflection_AuthorityEntry) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AuthorityEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RecordRent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2513,7 +3196,7 @@ func (x *fastReflection_AuthorityEntry) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AuthorityEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RecordRent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2525,7 +3208,7 @@ func (x *fastReflection_AuthorityEntry) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AuthorityEntry) IsValid() bool {
+func (x *fastReflection_RecordRent) IsValid() bool {
 	return x != nil
 }
 
@@ -2535,9 +3218,9 @@ func (x *fastReflection_AuthorityEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RecordRent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AuthorityEntry)
+		x := input.Message.Interface().(*RecordRent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2549,12 +3232,20 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Entry != nil {
-			l = options.Size(x.Entry)
+		l = len(x.StartTime)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndTime)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2567,7 +3258,7 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AuthorityEntry)
+		x := input.Message.Interface().(*RecordRent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2586,8 +3277,29 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Entry != nil {
-			encoded, err := options.Marshal(x.Entry)
+		if len(x.EndTime) > 0 {
+			i -= len(x.EndTime)
+			copy(dAtA[i:], x.EndTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndTime)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.StartTime) > 0 {
+			i -= len(x.StartTime)
+			copy(dAtA[i:], x.StartTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartTime)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BondId) > 0 {
+			i -= len(x.BondId)
+			copy(dAtA[i:], x.BondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2598,13 +3310,6 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -2618,7 +3323,7 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AuthorityEntry)
+		x := input.Message.Interface().(*RecordRent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2650,17 +3355,53 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordRent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordRent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				var stringLen uint64
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				}
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2686,13 +3427,13 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.BondId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2702,27 +3443,55 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Entry == nil {
-					x.Entry = &NameAuthority{}
+				x.StartTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.EndTime = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2760,37 +3529,27 @@ func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_NameAuthority                  protoreflect.MessageDescriptor
-	fd_NameAuthority_owner_public_key protoreflect.FieldDescriptor
-	fd_NameAuthority_owner_address    protoreflect.FieldDescriptor
-	fd_NameAuthority_height           protoreflect.FieldDescriptor
-	fd_NameAuthority_status           protoreflect.FieldDescriptor
-	fd_NameAuthority_auction_id       protoreflect.FieldDescriptor
-	fd_NameAuthority_bond_id          protoreflect.FieldDescriptor
-	fd_NameAuthority_expiry_time      protoreflect.FieldDescriptor
+	md_AuthorityEntry       protoreflect.MessageDescriptor
+	fd_AuthorityEntry_name  protoreflect.FieldDescriptor
+	fd_AuthorityEntry_entry protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_NameAuthority = File_cerc_registry_v1_registry_proto.Messages().ByName("NameAuthority")
-	fd_NameAuthority_owner_public_key = md_NameAuthority.Fields().ByName("owner_public_key")
-	fd_NameAuthority_owner_address = md_NameAuthority.Fields().ByName("owner_address")
-	fd_NameAuthority_height = md_NameAuthority.Fields().ByName("height")
-	fd_NameAuthority_status = md_NameAuthority.Fields().ByName("status")
-	fd_NameAuthority_auction_id = md_NameAuthority.Fields().ByName("auction_id")
-	fd_NameAuthority_bond_id = md_NameAuthority.Fields().ByName("bond_id")
-	fd_NameAuthority_expiry_time = md_NameAuthority.Fields().ByName("expiry_time")
+	md_AuthorityEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("AuthorityEntry")
+	fd_AuthorityEntry_name = md_AuthorityEntry.Fields().ByName("name")
+	fd_AuthorityEntry_entry = md_AuthorityEntry.Fields().ByName("entry")
 }
 
-var _ protoreflect.Message = (*fastReflection_NameAuthority)(nil)
+var _ protoreflect.Message = (*fastReflection_AuthorityEntry)(nil)
 
-type fastReflection_NameAuthority NameAuthority
+type fastReflection_AuthorityEntry AuthorityEntry
 
-func (x *NameAuthority) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_NameAuthority)(x)
+func (x *AuthorityEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AuthorityEntry)(x)
 }
 
-func (x *NameAuthority) slowProtoReflect() protoreflect.Message {
+func (x *AuthorityEntry) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_registry_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2802,43 +3561,43 @@ func (x *NameAuthority) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_NameAuthority_messageType fastReflection_NameAuthority_messageType
-var _ protoreflect.MessageType = fastReflection_NameAuthority_messageType{}
+var _fastReflection_AuthorityEntry_messageType fastReflection_AuthorityEntry_messageType
+var _ protoreflect.MessageType = fastReflection_AuthorityEntry_messageType{}
 
-type fastReflection_NameAuthority_messageType struct{}
+type fastReflection_AuthorityEntry_messageType struct{}
 
-func (x fastReflection_NameAuthority_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_NameAuthority)(nil)
+func (x fastReflection_AuthorityEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AuthorityEntry)(nil)
 }
-func (x fastReflection_NameAuthority_messageType) New() protoreflect.Message {
-	return new(fastReflection_NameAuthority)
+func (x fastReflection_AuthorityEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_AuthorityEntry)
 }
-func (x fastReflection_NameAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameAuthority
+func (x fastReflection_AuthorityEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuthorityEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_NameAuthority) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameAuthority
+func (x *fastReflection_AuthorityEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuthorityEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_NameAuthority) Type() protoreflect.MessageType {
-	return _fastReflection_NameAuthority_messageType
+func (x *fastReflection_AuthorityEntry) Type() protoreflect.MessageType {
+	return _fastReflection_AuthorityEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_NameAuthority) New() protoreflect.Message {
-	return new(fastReflection_NameAuthority)
+func (x *fastReflection_AuthorityEntry) New() protoreflect.Message {
+	return new(fastReflection_AuthorityEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_NameAuthority) Interface() protoreflect.ProtoMessage {
-	return (*NameAuthority)(x)
+func (x *fastReflection_AuthorityEntry) Interface() protoreflect.ProtoMessage {
+	return (*AuthorityEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2846,46 +3605,16 @@ func (x *fastReflection_NameAuthority) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_NameAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.OwnerPublicKey != "" {
-		value := protoreflect.ValueOfString(x.OwnerPublicKey)
-		if !f(fd_NameAuthority_owner_public_key, value) {
-			return
-		}
-	}
-	if x.OwnerAddress != "" {
-		value := protoreflect.ValueOfString(x.OwnerAddress)
-		if !f(fd_NameAuthority_owner_address, value) {
-			return
-		}
-	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_NameAuthority_height, value) {
-			return
-		}
-	}
-	if x.Status != "" {
-		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_NameAuthority_status, value) {
-			return
-		}
-	}
-	if x.AuctionId != "" {
-		value := protoreflect.ValueOfString(x.AuctionId)
-		if !f(fd_NameAuthority_auction_id, value) {
-			return
-		}
-	}
-	if x.BondId != "" {
-		value := protoreflect.ValueOfString(x.BondId)
-		if !f(fd_NameAuthority_bond_id, value) {
+func (x *fastReflection_AuthorityEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_AuthorityEntry_name, value) {
 			return
 		}
 	}
-	if x.ExpiryTime != nil {
-		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
-		if !f(fd_NameAuthority_expiry_time, value) {
+	if x.Entry != nil {
+		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+		if !f(fd_AuthorityEntry_entry, value) {
 			return
 		}
 	}
@@ -2902,27 +3631,17 @@ func (x *fastReflection_NameAuthority) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_NameAuthority) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AuthorityEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		return x.OwnerPublicKey != ""
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		return x.OwnerAddress != ""
-	case "cerc.registry.v1.NameAuthority.height":
-		return x.Height != uint64(0)
-	case "cerc.registry.v1.NameAuthority.status":
-		return x.Status != ""
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		return x.AuctionId != ""
-	case "cerc.registry.v1.NameAuthority.bond_id":
-		return x.BondId != ""
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		return x.ExpiryTime != nil
+	case "cerc.registry.v1.AuthorityEntry.name":
+		return x.Name != ""
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		return x.Entry != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2932,27 +3651,17 @@ func (x *fastReflection_NameAuthority) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameAuthority) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AuthorityEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		x.OwnerPublicKey = ""
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		x.OwnerAddress = ""
-	case "cerc.registry.v1.NameAuthority.height":
-		x.Height = uint64(0)
-	case "cerc.registry.v1.NameAuthority.status":
-		x.Status = ""
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		x.AuctionId = ""
-	case "cerc.registry.v1.NameAuthority.bond_id":
-		x.BondId = ""
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		x.ExpiryTime = nil
+	case "cerc.registry.v1.AuthorityEntry.name":
+		x.Name = ""
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		x.Entry = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2962,34 +3671,19 @@ func (x *fastReflection_NameAuthority) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_NameAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AuthorityEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		value := x.OwnerPublicKey
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		value := x.OwnerAddress
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameAuthority.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
-	case "cerc.registry.v1.NameAuthority.status":
-		value := x.Status
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		value := x.AuctionId
+	case "cerc.registry.v1.AuthorityEntry.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameAuthority.bond_id":
-		value := x.BondId
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		value := x.ExpiryTime
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		value := x.Entry
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3003,27 +3697,17 @@ func (x *fastReflection_NameAuthority) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AuthorityEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		x.OwnerPublicKey = value.Interface().(string)
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		x.OwnerAddress = value.Interface().(string)
-	case "cerc.registry.v1.NameAuthority.height":
-		x.Height = value.Uint()
-	case "cerc.registry.v1.NameAuthority.status":
-		x.Status = value.Interface().(string)
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		x.AuctionId = value.Interface().(string)
-	case "cerc.registry.v1.NameAuthority.bond_id":
-		x.BondId = value.Interface().(string)
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cerc.registry.v1.AuthorityEntry.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		x.Entry = value.Message().Interface().(*NameAuthority)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3037,68 +3721,48 @@ func (x *fastReflection_NameAuthority) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AuthorityEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		if x.ExpiryTime == nil {
-			x.ExpiryTime = new(timestamppb.Timestamp)
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		if x.Entry == nil {
+			x.Entry = new(NameAuthority)
 		}
-		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		panic(fmt.Errorf("field owner_public_key of message cerc.registry.v1.NameAuthority is not mutable"))
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		panic(fmt.Errorf("field owner_address of message cerc.registry.v1.NameAuthority is not mutable"))
-	case "cerc.registry.v1.NameAuthority.height":
-		panic(fmt.Errorf("field height of message cerc.registry.v1.NameAuthority is not mutable"))
-	case "cerc.registry.v1.NameAuthority.status":
-		panic(fmt.Errorf("field status of message cerc.registry.v1.NameAuthority is not mutable"))
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		panic(fmt.Errorf("field auction_id of message cerc.registry.v1.NameAuthority is not mutable"))
-	case "cerc.registry.v1.NameAuthority.bond_id":
-		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.NameAuthority is not mutable"))
+		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+	case "cerc.registry.v1.AuthorityEntry.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.AuthorityEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_NameAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AuthorityEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameAuthority.owner_public_key":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameAuthority.owner_address":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameAuthority.height":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cerc.registry.v1.NameAuthority.status":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameAuthority.auction_id":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameAuthority.bond_id":
+	case "cerc.registry.v1.AuthorityEntry.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameAuthority.expiry_time":
-		m := new(timestamppb.Timestamp)
+	case "cerc.registry.v1.AuthorityEntry.entry":
+		m := new(NameAuthority)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_NameAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AuthorityEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameAuthority", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.AuthorityEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3106,7 +3770,7 @@ func (x *fastReflection_NameAuthority) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_NameAuthority) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AuthorityEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3117,7 +3781,7 @@ func (x *fastReflection_NameAuthority) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameAuthority) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AuthorityEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3129,7 +3793,7 @@ func (x *fastReflection_NameAuthority) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_NameAuthority) IsValid() bool {
+func (x *fastReflection_AuthorityEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -3139,9 +3803,9 @@ func (x *fastReflection_NameAuthority) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AuthorityEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*NameAuthority)
+		x := input.Message.Interface().(*AuthorityEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3153,31 +3817,12 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.OwnerPublicKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OwnerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
-		}
-		l = len(x.Status)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AuctionId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BondId)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExpiryTime != nil {
-			l = options.Size(x.ExpiryTime)
+		if x.Entry != nil {
+			l = options.Size(x.Entry)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3190,7 +3835,7 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*NameAuthority)
+		x := input.Message.Interface().(*AuthorityEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3209,8 +3854,8 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ExpiryTime != nil {
-			encoded, err := options.Marshal(x.ExpiryTime)
+		if x.Entry != nil {
+			encoded, err := options.Marshal(x.Entry)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3221,45 +3866,12 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BondId) > 0 {
-			i -= len(x.BondId)
-			copy(dAtA[i:], x.BondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.AuctionId) > 0 {
-			i -= len(x.AuctionId)
-			copy(dAtA[i:], x.AuctionId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionId)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Status) > 0 {
-			i -= len(x.Status)
-			copy(dAtA[i:], x.Status)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.OwnerAddress) > 0 {
-			i -= len(x.OwnerAddress)
-			copy(dAtA[i:], x.OwnerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.OwnerPublicKey) > 0 {
-			i -= len(x.OwnerPublicKey)
-			copy(dAtA[i:], x.OwnerPublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerPublicKey)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3274,7 +3886,7 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*NameAuthority)
+		x := input.Message.Interface().(*AuthorityEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3306,15 +3918,15 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAuthority: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerPublicKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3342,13 +3954,13 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OwnerPublicKey = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3358,80 +3970,2034 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-				}
-				x.Height = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				if x.Entry == nil {
+					x.Entry = &NameAuthority{}
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Status = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				var stringLen uint64
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NameAuthority                  protoreflect.MessageDescriptor
+	fd_NameAuthority_owner_public_key protoreflect.FieldDescriptor
+	fd_NameAuthority_owner_address    protoreflect.FieldDescriptor
+	fd_NameAuthority_height           protoreflect.FieldDescriptor
+	fd_NameAuthority_status           protoreflect.FieldDescriptor
+	fd_NameAuthority_auction_id       protoreflect.FieldDescriptor
+	fd_NameAuthority_bond_id          protoreflect.FieldDescriptor
+	fd_NameAuthority_expiry_time      protoreflect.FieldDescriptor
+	fd_NameAuthority_pending_transfer protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_registry_proto_init()
+	md_NameAuthority = File_cerc_registry_v1_registry_proto.Messages().ByName("NameAuthority")
+	fd_NameAuthority_owner_public_key = md_NameAuthority.Fields().ByName("owner_public_key")
+	fd_NameAuthority_owner_address = md_NameAuthority.Fields().ByName("owner_address")
+	fd_NameAuthority_height = md_NameAuthority.Fields().ByName("height")
+	fd_NameAuthority_status = md_NameAuthority.Fields().ByName("status")
+	fd_NameAuthority_auction_id = md_NameAuthority.Fields().ByName("auction_id")
+	fd_NameAuthority_bond_id = md_NameAuthority.Fields().ByName("bond_id")
+	fd_NameAuthority_expiry_time = md_NameAuthority.Fields().ByName("expiry_time")
+	fd_NameAuthority_pending_transfer = md_NameAuthority.Fields().ByName("pending_transfer")
+}
+
+var _ protoreflect.Message = (*fastReflection_NameAuthority)(nil)
+
+type fastReflection_NameAuthority NameAuthority
+
+func (x *NameAuthority) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameAuthority)(x)
+}
+
+func (x *NameAuthority) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NameAuthority_messageType fastReflection_NameAuthority_messageType
+var _ protoreflect.MessageType = fastReflection_NameAuthority_messageType{}
+
+type fastReflection_NameAuthority_messageType struct{}
+
+func (x fastReflection_NameAuthority_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameAuthority)(nil)
+}
+func (x fastReflection_NameAuthority_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameAuthority)
+}
+func (x fastReflection_NameAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameAuthority
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NameAuthority) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameAuthority
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NameAuthority) Type() protoreflect.MessageType {
+	return _fastReflection_NameAuthority_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NameAuthority) New() protoreflect.Message {
+	return new(fastReflection_NameAuthority)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NameAuthority) Interface() protoreflect.ProtoMessage {
+	return (*NameAuthority)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NameAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OwnerPublicKey != "" {
+		value := protoreflect.ValueOfString(x.OwnerPublicKey)
+		if !f(fd_NameAuthority_owner_public_key, value) {
+			return
+		}
+	}
+	if x.OwnerAddress != "" {
+		value := protoreflect.ValueOfString(x.OwnerAddress)
+		if !f(fd_NameAuthority_owner_address, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_NameAuthority_height, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_NameAuthority_status, value) {
+			return
+		}
+	}
+	if x.AuctionId != "" {
+		value := protoreflect.ValueOfString(x.AuctionId)
+		if !f(fd_NameAuthority_auction_id, value) {
+			return
+		}
+	}
+	if x.BondId != "" {
+		value := protoreflect.ValueOfString(x.BondId)
+		if !f(fd_NameAuthority_bond_id, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_NameAuthority_expiry_time, value) {
+			return
+		}
+	}
+	if x.PendingTransfer != nil {
+		value := protoreflect.ValueOfMessage(x.PendingTransfer.ProtoReflect())
+		if !f(fd_NameAuthority_pending_transfer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NameAuthority) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		return x.OwnerPublicKey != ""
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		return x.OwnerAddress != ""
+	case "cerc.registry.v1.NameAuthority.height":
+		return x.Height != uint64(0)
+	case "cerc.registry.v1.NameAuthority.status":
+		return x.Status != ""
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		return x.AuctionId != ""
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		return x.BondId != ""
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		return x.ExpiryTime != nil
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		return x.PendingTransfer != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAuthority) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		x.OwnerPublicKey = ""
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		x.OwnerAddress = ""
+	case "cerc.registry.v1.NameAuthority.height":
+		x.Height = uint64(0)
+	case "cerc.registry.v1.NameAuthority.status":
+		x.Status = ""
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		x.AuctionId = ""
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		x.BondId = ""
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		x.ExpiryTime = nil
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		x.PendingTransfer = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NameAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		value := x.OwnerPublicKey
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		value := x.OwnerAddress
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAuthority.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.NameAuthority.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		value := x.BondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		value := x.PendingTransfer
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		x.OwnerPublicKey = value.Interface().(string)
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		x.OwnerAddress = value.Interface().(string)
+	case "cerc.registry.v1.NameAuthority.height":
+		x.Height = value.Uint()
+	case "cerc.registry.v1.NameAuthority.status":
+		x.Status = value.Interface().(string)
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		x.AuctionId = value.Interface().(string)
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		x.BondId = value.Interface().(string)
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		x.PendingTransfer = value.Message().Interface().(*AuthorityTransfer)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		if x.PendingTransfer == nil {
+			x.PendingTransfer = new(AuthorityTransfer)
+		}
+		return protoreflect.ValueOfMessage(x.PendingTransfer.ProtoReflect())
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		panic(fmt.Errorf("field owner_public_key of message cerc.registry.v1.NameAuthority is not mutable"))
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		panic(fmt.Errorf("field owner_address of message cerc.registry.v1.NameAuthority is not mutable"))
+	case "cerc.registry.v1.NameAuthority.height":
+		panic(fmt.Errorf("field height of message cerc.registry.v1.NameAuthority is not mutable"))
+	case "cerc.registry.v1.NameAuthority.status":
+		panic(fmt.Errorf("field status of message cerc.registry.v1.NameAuthority is not mutable"))
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		panic(fmt.Errorf("field auction_id of message cerc.registry.v1.NameAuthority is not mutable"))
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.NameAuthority is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NameAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAuthority.owner_public_key":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAuthority.owner_address":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAuthority.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.NameAuthority.status":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAuthority.auction_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAuthority.bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAuthority.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.NameAuthority.pending_transfer":
+		m := new(AuthorityTransfer)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAuthority"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAuthority does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NameAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameAuthority", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NameAuthority) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAuthority) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NameAuthority) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NameAuthority)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OwnerPublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OwnerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingTransfer != nil {
+			l = options.Size(x.PendingTransfer)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NameAuthority)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PendingTransfer != nil {
+			encoded, err := options.Marshal(x.PendingTransfer)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BondId) > 0 {
+			i -= len(x.BondId)
+			copy(dAtA[i:], x.BondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.AuctionId) > 0 {
+			i -= len(x.AuctionId)
+			copy(dAtA[i:], x.AuctionId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.OwnerAddress) > 0 {
+			i -= len(x.OwnerAddress)
+			copy(dAtA[i:], x.OwnerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OwnerPublicKey) > 0 {
+			i -= len(x.OwnerPublicKey)
+			copy(dAtA[i:], x.OwnerPublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OwnerPublicKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NameAuthority)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAuthority: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerPublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerPublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OwnerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTransfer", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingTransfer == nil {
+					x.PendingTransfer = &AuthorityTransfer{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingTransfer); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AuthorityTransfer                  protoreflect.MessageDescriptor
+	fd_AuthorityTransfer_new_owner        protoreflect.FieldDescriptor
+	fd_AuthorityTransfer_keep_bond        protoreflect.FieldDescriptor
+	fd_AuthorityTransfer_invalidate_names protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_registry_proto_init()
+	md_AuthorityTransfer = File_cerc_registry_v1_registry_proto.Messages().ByName("AuthorityTransfer")
+	fd_AuthorityTransfer_new_owner = md_AuthorityTransfer.Fields().ByName("new_owner")
+	fd_AuthorityTransfer_keep_bond = md_AuthorityTransfer.Fields().ByName("keep_bond")
+	fd_AuthorityTransfer_invalidate_names = md_AuthorityTransfer.Fields().ByName("invalidate_names")
+}
+
+var _ protoreflect.Message = (*fastReflection_AuthorityTransfer)(nil)
+
+type fastReflection_AuthorityTransfer AuthorityTransfer
+
+func (x *AuthorityTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AuthorityTransfer)(x)
+}
+
+func (x *AuthorityTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AuthorityTransfer_messageType fastReflection_AuthorityTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_AuthorityTransfer_messageType{}
+
+type fastReflection_AuthorityTransfer_messageType struct{}
+
+func (x fastReflection_AuthorityTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AuthorityTransfer)(nil)
+}
+func (x fastReflection_AuthorityTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_AuthorityTransfer)
+}
+func (x fastReflection_AuthorityTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuthorityTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AuthorityTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_AuthorityTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AuthorityTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_AuthorityTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AuthorityTransfer) New() protoreflect.Message {
+	return new(fastReflection_AuthorityTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AuthorityTransfer) Interface() protoreflect.ProtoMessage {
+	return (*AuthorityTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AuthorityTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_AuthorityTransfer_new_owner, value) {
+			return
+		}
+	}
+	if x.KeepBond != false {
+		value := protoreflect.ValueOfBool(x.KeepBond)
+		if !f(fd_AuthorityTransfer_keep_bond, value) {
+			return
+		}
+	}
+	if x.InvalidateNames != false {
+		value := protoreflect.ValueOfBool(x.InvalidateNames)
+		if !f(fd_AuthorityTransfer_invalidate_names, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AuthorityTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		return x.NewOwner != ""
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		return x.KeepBond != false
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		return x.InvalidateNames != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuthorityTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		x.NewOwner = ""
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		x.KeepBond = false
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		x.InvalidateNames = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AuthorityTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		value := x.KeepBond
+		return protoreflect.ValueOfBool(value)
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		value := x.InvalidateNames
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuthorityTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		x.NewOwner = value.Interface().(string)
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		x.KeepBond = value.Bool()
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		x.InvalidateNames = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuthorityTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		panic(fmt.Errorf("field new_owner of message cerc.registry.v1.AuthorityTransfer is not mutable"))
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		panic(fmt.Errorf("field keep_bond of message cerc.registry.v1.AuthorityTransfer is not mutable"))
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		panic(fmt.Errorf("field invalidate_names of message cerc.registry.v1.AuthorityTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AuthorityTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.AuthorityTransfer.new_owner":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.AuthorityTransfer.keep_bond":
+		return protoreflect.ValueOfBool(false)
+	case "cerc.registry.v1.AuthorityTransfer.invalidate_names":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.AuthorityTransfer"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.AuthorityTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AuthorityTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.AuthorityTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AuthorityTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AuthorityTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AuthorityTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AuthorityTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AuthorityTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.KeepBond {
+			n += 2
+		}
+		if x.InvalidateNames {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AuthorityTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.InvalidateNames {
+			i--
+			if x.InvalidateNames {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.KeepBond {
+			i--
+			if x.KeepBond {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AuthorityTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AuthorityTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeepBond", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.KeepBond = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidateNames", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InvalidateNames = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NameAccessGrant             protoreflect.MessageDescriptor
+	fd_NameAccessGrant_lrn_prefix  protoreflect.FieldDescriptor
+	fd_NameAccessGrant_grantee     protoreflect.FieldDescriptor
+	fd_NameAccessGrant_granter     protoreflect.FieldDescriptor
+	fd_NameAccessGrant_expiry_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_registry_proto_init()
+	md_NameAccessGrant = File_cerc_registry_v1_registry_proto.Messages().ByName("NameAccessGrant")
+	fd_NameAccessGrant_lrn_prefix = md_NameAccessGrant.Fields().ByName("lrn_prefix")
+	fd_NameAccessGrant_grantee = md_NameAccessGrant.Fields().ByName("grantee")
+	fd_NameAccessGrant_granter = md_NameAccessGrant.Fields().ByName("granter")
+	fd_NameAccessGrant_expiry_time = md_NameAccessGrant.Fields().ByName("expiry_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_NameAccessGrant)(nil)
+
+type fastReflection_NameAccessGrant NameAccessGrant
+
+func (x *NameAccessGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameAccessGrant)(x)
+}
+
+func (x *NameAccessGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NameAccessGrant_messageType fastReflection_NameAccessGrant_messageType
+var _ protoreflect.MessageType = fastReflection_NameAccessGrant_messageType{}
+
+type fastReflection_NameAccessGrant_messageType struct{}
+
+func (x fastReflection_NameAccessGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameAccessGrant)(nil)
+}
+func (x fastReflection_NameAccessGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameAccessGrant)
+}
+func (x fastReflection_NameAccessGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameAccessGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NameAccessGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameAccessGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NameAccessGrant) Type() protoreflect.MessageType {
+	return _fastReflection_NameAccessGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NameAccessGrant) New() protoreflect.Message {
+	return new(fastReflection_NameAccessGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NameAccessGrant) Interface() protoreflect.ProtoMessage {
+	return (*NameAccessGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NameAccessGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LrnPrefix != "" {
+		value := protoreflect.ValueOfString(x.LrnPrefix)
+		if !f(fd_NameAccessGrant_lrn_prefix, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_NameAccessGrant_grantee, value) {
+			return
+		}
+	}
+	if x.Granter != "" {
+		value := protoreflect.ValueOfString(x.Granter)
+		if !f(fd_NameAccessGrant_granter, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_NameAccessGrant_expiry_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NameAccessGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		return x.LrnPrefix != ""
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		return x.Grantee != ""
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		return x.Granter != ""
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		return x.ExpiryTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAccessGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		x.LrnPrefix = ""
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		x.Grantee = ""
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		x.Granter = ""
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		x.ExpiryTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NameAccessGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		value := x.LrnPrefix
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		value := x.Granter
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAccessGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		x.LrnPrefix = value.Interface().(string)
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		x.Grantee = value.Interface().(string)
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		x.Granter = value.Interface().(string)
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAccessGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		panic(fmt.Errorf("field lrn_prefix of message cerc.registry.v1.NameAccessGrant is not mutable"))
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		panic(fmt.Errorf("field grantee of message cerc.registry.v1.NameAccessGrant is not mutable"))
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		panic(fmt.Errorf("field granter of message cerc.registry.v1.NameAccessGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NameAccessGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameAccessGrant.lrn_prefix":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAccessGrant.grantee":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAccessGrant.granter":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameAccessGrant.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameAccessGrant"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameAccessGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NameAccessGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameAccessGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NameAccessGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameAccessGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NameAccessGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NameAccessGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NameAccessGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LrnPrefix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Granter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NameAccessGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Granter) > 0 {
+			i -= len(x.Granter)
+			copy(dAtA[i:], x.Granter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Granter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.LrnPrefix) > 0 {
+			i -= len(x.LrnPrefix)
+			copy(dAtA[i:], x.LrnPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LrnPrefix)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NameAccessGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAccessGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LrnPrefix = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Granter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3441,27 +6007,462 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NameEntry       protoreflect.MessageDescriptor
+	fd_NameEntry_name  protoreflect.FieldDescriptor
+	fd_NameEntry_entry protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_registry_proto_init()
+	md_NameEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("NameEntry")
+	fd_NameEntry_name = md_NameEntry.Fields().ByName("name")
+	fd_NameEntry_entry = md_NameEntry.Fields().ByName("entry")
+}
+
+var _ protoreflect.Message = (*fastReflection_NameEntry)(nil)
+
+type fastReflection_NameEntry NameEntry
+
+func (x *NameEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameEntry)(x)
+}
+
+func (x *NameEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NameEntry_messageType fastReflection_NameEntry_messageType
+var _ protoreflect.MessageType = fastReflection_NameEntry_messageType{}
+
+type fastReflection_NameEntry_messageType struct{}
+
+func (x fastReflection_NameEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameEntry)(nil)
+}
+func (x fastReflection_NameEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameEntry)
+}
+func (x fastReflection_NameEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NameEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NameEntry) Type() protoreflect.MessageType {
+	return _fastReflection_NameEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NameEntry) New() protoreflect.Message {
+	return new(fastReflection_NameEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NameEntry) Interface() protoreflect.ProtoMessage {
+	return (*NameEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NameEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_NameEntry_name, value) {
+			return
+		}
+	}
+	if x.Entry != nil {
+		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+		if !f(fd_NameEntry_entry, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NameEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameEntry.name":
+		return x.Name != ""
+	case "cerc.registry.v1.NameEntry.entry":
+		return x.Entry != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameEntry.name":
+		x.Name = ""
+	case "cerc.registry.v1.NameEntry.entry":
+		x.Entry = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NameEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.NameEntry.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameEntry.entry":
+		value := x.Entry
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameEntry.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.NameEntry.entry":
+		x.Entry = value.Message().Interface().(*NameRecord)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameEntry.entry":
+		if x.Entry == nil {
+			x.Entry = new(NameRecord)
+		}
+		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+	case "cerc.registry.v1.NameEntry.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.NameEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NameEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.NameEntry.name":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameEntry.entry":
+		m := new(NameRecord)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NameEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NameEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NameEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NameEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Entry != nil {
+			l = options.Size(x.Entry)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NameEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Entry != nil {
+			encoded, err := options.Marshal(x.Entry)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NameEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AuctionId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3489,11 +6490,11 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3520,10 +6521,10 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.ExpiryTime == nil {
-					x.ExpiryTime = &timestamppb.Timestamp{}
+				if x.Entry == nil {
+					x.Entry = &NameRecord{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3562,29 +6563,80 @@ func (x *fastReflection_NameAuthority) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_NameRecord_2_list)(nil)
+
+type _NameRecord_2_list struct {
+	list *[]*NameRecordEntry
+}
+
+func (x *_NameRecord_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_NameRecord_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_NameRecord_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NameRecordEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_NameRecord_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NameRecordEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_NameRecord_2_list) AppendMutable() protoreflect.Value {
+	v := new(NameRecordEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NameRecord_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_NameRecord_2_list) NewElement() protoreflect.Value {
+	v := new(NameRecordEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_NameRecord_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_NameEntry       protoreflect.MessageDescriptor
-	fd_NameEntry_name  protoreflect.FieldDescriptor
-	fd_NameEntry_entry protoreflect.FieldDescriptor
+	md_NameRecord         protoreflect.MessageDescriptor
+	fd_NameRecord_latest  protoreflect.FieldDescriptor
+	fd_NameRecord_history protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_NameEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("NameEntry")
-	fd_NameEntry_name = md_NameEntry.Fields().ByName("name")
-	fd_NameEntry_entry = md_NameEntry.Fields().ByName("entry")
+	md_NameRecord = File_cerc_registry_v1_registry_proto.Messages().ByName("NameRecord")
+	fd_NameRecord_latest = md_NameRecord.Fields().ByName("latest")
+	fd_NameRecord_history = md_NameRecord.Fields().ByName("history")
 }
 
-var _ protoreflect.Message = (*fastReflection_NameEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_NameRecord)(nil)
 
-type fastReflection_NameEntry NameEntry
+type fastReflection_NameRecord NameRecord
 
-func (x *NameEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_NameEntry)(x)
+func (x *NameRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameRecord)(x)
 }
 
-func (x *NameEntry) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[4]
+func (x *NameRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,43 +6647,43 @@ func (x *NameEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_NameEntry_messageType fastReflection_NameEntry_messageType
-var _ protoreflect.MessageType = fastReflection_NameEntry_messageType{}
+var _fastReflection_NameRecord_messageType fastReflection_NameRecord_messageType
+var _ protoreflect.MessageType = fastReflection_NameRecord_messageType{}
 
-type fastReflection_NameEntry_messageType struct{}
+type fastReflection_NameRecord_messageType struct{}
 
-func (x fastReflection_NameEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_NameEntry)(nil)
+func (x fastReflection_NameRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameRecord)(nil)
 }
-func (x fastReflection_NameEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_NameEntry)
+func (x fastReflection_NameRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameRecord)
 }
-func (x fastReflection_NameEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameEntry
+func (x fastReflection_NameRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameRecord
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_NameEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameEntry
+func (x *fastReflection_NameRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameRecord
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_NameEntry) Type() protoreflect.MessageType {
-	return _fastReflection_NameEntry_messageType
+func (x *fastReflection_NameRecord) Type() protoreflect.MessageType {
+	return _fastReflection_NameRecord_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_NameEntry) New() protoreflect.Message {
-	return new(fastReflection_NameEntry)
+func (x *fastReflection_NameRecord) New() protoreflect.Message {
+	return new(fastReflection_NameRecord)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_NameEntry) Interface() protoreflect.ProtoMessage {
-	return (*NameEntry)(x)
+func (x *fastReflection_NameRecord) Interface() protoreflect.ProtoMessage {
+	return (*NameRecord)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3639,16 +6691,16 @@ func (x *fastReflection_NameEntry) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_NameEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_NameEntry_name, value) {
+func (x *fastReflection_NameRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Latest != nil {
+		value := protoreflect.ValueOfMessage(x.Latest.ProtoReflect())
+		if !f(fd_NameRecord_latest, value) {
 			return
 		}
 	}
-	if x.Entry != nil {
-		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
-		if !f(fd_NameEntry_entry, value) {
+	if len(x.History) != 0 {
+		value := protoreflect.ValueOfList(&_NameRecord_2_list{list: &x.History})
+		if !f(fd_NameRecord_history, value) {
 			return
 		}
 	}
@@ -3665,17 +6717,17 @@ func (x *fastReflection_NameEntry) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_NameEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_NameRecord) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameEntry.name":
-		return x.Name != ""
-	case "cerc.registry.v1.NameEntry.entry":
-		return x.Entry != nil
+	case "cerc.registry.v1.NameRecord.latest":
+		return x.Latest != nil
+	case "cerc.registry.v1.NameRecord.history":
+		return len(x.History) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3685,17 +6737,17 @@ func (x *fastReflection_NameEntry) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_NameRecord) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameEntry.name":
-		x.Name = ""
-	case "cerc.registry.v1.NameEntry.entry":
-		x.Entry = nil
+	case "cerc.registry.v1.NameRecord.latest":
+		x.Latest = nil
+	case "cerc.registry.v1.NameRecord.history":
+		x.History = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3705,19 +6757,22 @@ func (x *fastReflection_NameEntry) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_NameEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.NameEntry.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameEntry.entry":
-		value := x.Entry
+	case "cerc.registry.v1.NameRecord.latest":
+		value := x.Latest
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.NameRecord.history":
+		if len(x.History) == 0 {
+			return protoreflect.ValueOfList(&_NameRecord_2_list{})
+		}
+		listValue := &_NameRecord_2_list{list: &x.History}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3731,17 +6786,19 @@ func (x *fastReflection_NameEntry) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_NameRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameEntry.name":
-		x.Name = value.Interface().(string)
-	case "cerc.registry.v1.NameEntry.entry":
-		x.Entry = value.Message().Interface().(*NameRecord)
+	case "cerc.registry.v1.NameRecord.latest":
+		x.Latest = value.Message().Interface().(*NameRecordEntry)
+	case "cerc.registry.v1.NameRecord.history":
+		lv := value.List()
+		clv := lv.(*_NameRecord_2_list)
+		x.History = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3755,48 +6812,53 @@ func (x *fastReflection_NameEntry) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameEntry.entry":
-		if x.Entry == nil {
-			x.Entry = new(NameRecord)
+	case "cerc.registry.v1.NameRecord.latest":
+		if x.Latest == nil {
+			x.Latest = new(NameRecordEntry)
 		}
-		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
-	case "cerc.registry.v1.NameEntry.name":
-		panic(fmt.Errorf("field name of message cerc.registry.v1.NameEntry is not mutable"))
+		return protoreflect.ValueOfMessage(x.Latest.ProtoReflect())
+	case "cerc.registry.v1.NameRecord.history":
+		if x.History == nil {
+			x.History = []*NameRecordEntry{}
+		}
+		value := &_NameRecord_2_list{list: &x.History}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_NameEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameEntry.name":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameEntry.entry":
-		m := new(NameRecord)
+	case "cerc.registry.v1.NameRecord.latest":
+		m := new(NameRecordEntry)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.NameRecord.history":
+		list := []*NameRecordEntry{}
+		return protoreflect.ValueOfList(&_NameRecord_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_NameEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_NameRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameRecord", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3804,7 +6866,7 @@ func (x *fastReflection_NameEntry) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_NameEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_NameRecord) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3815,7 +6877,7 @@ func (x *fastReflection_NameEntry) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_NameRecord) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3827,7 +6889,7 @@ func (x *fastReflection_NameEntry) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_NameEntry) IsValid() bool {
+func (x *fastReflection_NameRecord) IsValid() bool {
 	return x != nil
 }
 
@@ -3837,9 +6899,9 @@ func (x *fastReflection_NameEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*NameEntry)
+		x := input.Message.Interface().(*NameRecord)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3851,13 +6913,15 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
-		if l > 0 {
+		if x.Latest != nil {
+			l = options.Size(x.Latest)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Entry != nil {
-			l = options.Size(x.Entry)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.History) > 0 {
+			for _, e := range x.History {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3869,7 +6933,7 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*NameEntry)
+		x := input.Message.Interface().(*NameRecord)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3888,8 +6952,24 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Entry != nil {
-			encoded, err := options.Marshal(x.Entry)
+		if len(x.History) > 0 {
+			for iNdEx := len(x.History) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.History[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Latest != nil {
+			encoded, err := options.Marshal(x.Latest)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3900,13 +6980,6 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -3920,7 +6993,7 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*NameEntry)
+		x := input.Message.Interface().(*NameRecord)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3952,17 +7025,17 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3972,27 +7045,31 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				if x.Latest == nil {
+					x.Latest = &NameRecordEntry{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Latest); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4019,10 +7096,8 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Entry == nil {
-					x.Entry = &NameRecord{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
+				x.History = append(x.History, &NameRecordEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.History[len(x.History)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4061,80 +7136,29 @@ func (x *fastReflection_NameEntry) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_NameRecord_2_list)(nil)
-
-type _NameRecord_2_list struct {
-	list *[]*NameRecordEntry
-}
-
-func (x *_NameRecord_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_NameRecord_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_NameRecord_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*NameRecordEntry)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_NameRecord_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*NameRecordEntry)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_NameRecord_2_list) AppendMutable() protoreflect.Value {
-	v := new(NameRecordEntry)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_NameRecord_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_NameRecord_2_list) NewElement() protoreflect.Value {
-	v := new(NameRecordEntry)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_NameRecord_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_NameRecord         protoreflect.MessageDescriptor
-	fd_NameRecord_latest  protoreflect.FieldDescriptor
-	fd_NameRecord_history protoreflect.FieldDescriptor
+	md_NameRecordEntry        protoreflect.MessageDescriptor
+	fd_NameRecordEntry_id     protoreflect.FieldDescriptor
+	fd_NameRecordEntry_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_NameRecord = File_cerc_registry_v1_registry_proto.Messages().ByName("NameRecord")
-	fd_NameRecord_latest = md_NameRecord.Fields().ByName("latest")
-	fd_NameRecord_history = md_NameRecord.Fields().ByName("history")
+	md_NameRecordEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("NameRecordEntry")
+	fd_NameRecordEntry_id = md_NameRecordEntry.Fields().ByName("id")
+	fd_NameRecordEntry_height = md_NameRecordEntry.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_NameRecord)(nil)
+var _ protoreflect.Message = (*fastReflection_NameRecordEntry)(nil)
 
-type fastReflection_NameRecord NameRecord
+type fastReflection_NameRecordEntry NameRecordEntry
 
-func (x *NameRecord) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_NameRecord)(x)
+func (x *NameRecordEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameRecordEntry)(x)
 }
 
-func (x *NameRecord) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[5]
+func (x *NameRecordEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4145,43 +7169,43 @@ func (x *NameRecord) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_NameRecord_messageType fastReflection_NameRecord_messageType
-var _ protoreflect.MessageType = fastReflection_NameRecord_messageType{}
+var _fastReflection_NameRecordEntry_messageType fastReflection_NameRecordEntry_messageType
+var _ protoreflect.MessageType = fastReflection_NameRecordEntry_messageType{}
 
-type fastReflection_NameRecord_messageType struct{}
+type fastReflection_NameRecordEntry_messageType struct{}
 
-func (x fastReflection_NameRecord_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_NameRecord)(nil)
+func (x fastReflection_NameRecordEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameRecordEntry)(nil)
 }
-func (x fastReflection_NameRecord_messageType) New() protoreflect.Message {
-	return new(fastReflection_NameRecord)
+func (x fastReflection_NameRecordEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameRecordEntry)
 }
-func (x fastReflection_NameRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameRecord
+func (x fastReflection_NameRecordEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameRecordEntry
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_NameRecord) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameRecord
+func (x *fastReflection_NameRecordEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameRecordEntry
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_NameRecord) Type() protoreflect.MessageType {
-	return _fastReflection_NameRecord_messageType
+func (x *fastReflection_NameRecordEntry) Type() protoreflect.MessageType {
+	return _fastReflection_NameRecordEntry_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_NameRecord) New() protoreflect.Message {
-	return new(fastReflection_NameRecord)
+func (x *fastReflection_NameRecordEntry) New() protoreflect.Message {
+	return new(fastReflection_NameRecordEntry)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_NameRecord) Interface() protoreflect.ProtoMessage {
-	return (*NameRecord)(x)
+func (x *fastReflection_NameRecordEntry) Interface() protoreflect.ProtoMessage {
+	return (*NameRecordEntry)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4189,16 +7213,16 @@ func (x *fastReflection_NameRecord) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_NameRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Latest != nil {
-		value := protoreflect.ValueOfMessage(x.Latest.ProtoReflect())
-		if !f(fd_NameRecord_latest, value) {
+func (x *fastReflection_NameRecordEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_NameRecordEntry_id, value) {
 			return
 		}
 	}
-	if len(x.History) != 0 {
-		value := protoreflect.ValueOfList(&_NameRecord_2_list{list: &x.History})
-		if !f(fd_NameRecord_history, value) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_NameRecordEntry_height, value) {
 			return
 		}
 	}
@@ -4215,17 +7239,17 @@ func (x *fastReflection_NameRecord) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_NameRecord) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_NameRecordEntry) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		return x.Latest != nil
-	case "cerc.registry.v1.NameRecord.history":
-		return len(x.History) != 0
+	case "cerc.registry.v1.NameRecordEntry.id":
+		return x.Id != ""
+	case "cerc.registry.v1.NameRecordEntry.height":
+		return x.Height != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4235,17 +7259,17 @@ func (x *fastReflection_NameRecord) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecord) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_NameRecordEntry) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		x.Latest = nil
-	case "cerc.registry.v1.NameRecord.history":
-		x.History = nil
+	case "cerc.registry.v1.NameRecordEntry.id":
+		x.Id = ""
+	case "cerc.registry.v1.NameRecordEntry.height":
+		x.Height = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4255,22 +7279,19 @@ func (x *fastReflection_NameRecord) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_NameRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecordEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		value := x.Latest
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cerc.registry.v1.NameRecord.history":
-		if len(x.History) == 0 {
-			return protoreflect.ValueOfList(&_NameRecord_2_list{})
-		}
-		listValue := &_NameRecord_2_list{list: &x.History}
-		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.NameRecordEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.NameRecordEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4284,19 +7305,17 @@ func (x *fastReflection_NameRecord) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_NameRecordEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		x.Latest = value.Message().Interface().(*NameRecordEntry)
-	case "cerc.registry.v1.NameRecord.history":
-		lv := value.List()
-		clv := lv.(*_NameRecord_2_list)
-		x.History = *clv.list
+	case "cerc.registry.v1.NameRecordEntry.id":
+		x.Id = value.Interface().(string)
+	case "cerc.registry.v1.NameRecordEntry.height":
+		x.Height = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4310,53 +7329,44 @@ func (x *fastReflection_NameRecord) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecordEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		if x.Latest == nil {
-			x.Latest = new(NameRecordEntry)
-		}
-		return protoreflect.ValueOfMessage(x.Latest.ProtoReflect())
-	case "cerc.registry.v1.NameRecord.history":
-		if x.History == nil {
-			x.History = []*NameRecordEntry{}
-		}
-		value := &_NameRecord_2_list{list: &x.History}
-		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.NameRecordEntry.id":
+		panic(fmt.Errorf("field id of message cerc.registry.v1.NameRecordEntry is not mutable"))
+	case "cerc.registry.v1.NameRecordEntry.height":
+		panic(fmt.Errorf("field height of message cerc.registry.v1.NameRecordEntry is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_NameRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_NameRecordEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecord.latest":
-		m := new(NameRecordEntry)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cerc.registry.v1.NameRecord.history":
-		list := []*NameRecordEntry{}
-		return protoreflect.ValueOfList(&_NameRecord_2_list{list: &list})
+	case "cerc.registry.v1.NameRecordEntry.id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.NameRecordEntry.height":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_NameRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_NameRecordEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameRecord", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameRecordEntry", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4364,7 +7374,7 @@ func (x *fastReflection_NameRecord) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_NameRecord) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_NameRecordEntry) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4375,7 +7385,7 @@ func (x *fastReflection_NameRecord) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecord) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_NameRecordEntry) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4387,7 +7397,7 @@ func (x *fastReflection_NameRecord) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_NameRecord) IsValid() bool {
+func (x *fastReflection_NameRecordEntry) IsValid() bool {
 	return x != nil
 }
 
@@ -4397,9 +7407,9 @@ func (x *fastReflection_NameRecord) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*NameRecord)
+		x := input.Message.Interface().(*NameRecordEntry)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4411,15 +7421,12 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Latest != nil {
-			l = options.Size(x.Latest)
+		l = len(x.Id)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.History) > 0 {
-			for _, e := range x.History {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4431,7 +7438,7 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*NameRecord)
+		x := input.Message.Interface().(*NameRecordEntry)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4450,33 +7457,15 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.History) > 0 {
-			for iNdEx := len(x.History) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.History[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
 		}
-		if x.Latest != nil {
-			encoded, err := options.Marshal(x.Latest)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4491,7 +7480,7 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*NameRecord)
+		x := input.Message.Interface().(*NameRecordEntry)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4523,17 +7512,17 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecordEntry: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecordEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4543,33 +7532,29 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Latest == nil {
-					x.Latest = &NameRecordEntry{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Latest); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 				}
-				var msglen int
+				x.Height = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4579,26 +7564,11 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Height |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.History = append(x.History, &NameRecordEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.History[len(x.History)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4635,28 +7605,30 @@ func (x *fastReflection_NameRecord) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_NameRecordEntry        protoreflect.MessageDescriptor
-	fd_NameRecordEntry_id     protoreflect.FieldDescriptor
-	fd_NameRecordEntry_height protoreflect.FieldDescriptor
+	md_Signature         protoreflect.MessageDescriptor
+	fd_Signature_sig     protoreflect.FieldDescriptor
+	fd_Signature_pub_key protoreflect.FieldDescriptor
+	fd_Signature_scheme  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_NameRecordEntry = File_cerc_registry_v1_registry_proto.Messages().ByName("NameRecordEntry")
-	fd_NameRecordEntry_id = md_NameRecordEntry.Fields().ByName("id")
-	fd_NameRecordEntry_height = md_NameRecordEntry.Fields().ByName("height")
+	md_Signature = File_cerc_registry_v1_registry_proto.Messages().ByName("Signature")
+	fd_Signature_sig = md_Signature.Fields().ByName("sig")
+	fd_Signature_pub_key = md_Signature.Fields().ByName("pub_key")
+	fd_Signature_scheme = md_Signature.Fields().ByName("scheme")
 }
 
-var _ protoreflect.Message = (*fastReflection_NameRecordEntry)(nil)
+var _ protoreflect.Message = (*fastReflection_Signature)(nil)
 
-type fastReflection_NameRecordEntry NameRecordEntry
+type fastReflection_Signature Signature
 
-func (x *NameRecordEntry) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_NameRecordEntry)(x)
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Signature)(x)
 }
 
-func (x *NameRecordEntry) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[6]
+func (x *Signature) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4667,43 +7639,43 @@ func (x *NameRecordEntry) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_NameRecordEntry_messageType fastReflection_NameRecordEntry_messageType
-var _ protoreflect.MessageType = fastReflection_NameRecordEntry_messageType{}
+var _fastReflection_Signature_messageType fastReflection_Signature_messageType
+var _ protoreflect.MessageType = fastReflection_Signature_messageType{}
 
-type fastReflection_NameRecordEntry_messageType struct{}
+type fastReflection_Signature_messageType struct{}
 
-func (x fastReflection_NameRecordEntry_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_NameRecordEntry)(nil)
+func (x fastReflection_Signature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Signature)(nil)
 }
-func (x fastReflection_NameRecordEntry_messageType) New() protoreflect.Message {
-	return new(fastReflection_NameRecordEntry)
+func (x fastReflection_Signature_messageType) New() protoreflect.Message {
+	return new(fastReflection_Signature)
 }
-func (x fastReflection_NameRecordEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameRecordEntry
+func (x fastReflection_Signature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Signature
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_NameRecordEntry) Descriptor() protoreflect.MessageDescriptor {
-	return md_NameRecordEntry
+func (x *fastReflection_Signature) Descriptor() protoreflect.MessageDescriptor {
+	return md_Signature
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_NameRecordEntry) Type() protoreflect.MessageType {
-	return _fastReflection_NameRecordEntry_messageType
+func (x *fastReflection_Signature) Type() protoreflect.MessageType {
+	return _fastReflection_Signature_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_NameRecordEntry) New() protoreflect.Message {
-	return new(fastReflection_NameRecordEntry)
+func (x *fastReflection_Signature) New() protoreflect.Message {
+	return new(fastReflection_Signature)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_NameRecordEntry) Interface() protoreflect.ProtoMessage {
-	return (*NameRecordEntry)(x)
+func (x *fastReflection_Signature) Interface() protoreflect.ProtoMessage {
+	return (*Signature)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4711,16 +7683,22 @@ func (x *fastReflection_NameRecordEntry) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_NameRecordEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_NameRecordEntry_id, value) {
+func (x *fastReflection_Signature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sig != "" {
+		value := protoreflect.ValueOfString(x.Sig)
+		if !f(fd_Signature_sig, value) {
 			return
 		}
 	}
-	if x.Height != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Height)
-		if !f(fd_NameRecordEntry_height, value) {
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_Signature_pub_key, value) {
+			return
+		}
+	}
+	if x.Scheme != "" {
+		value := protoreflect.ValueOfString(x.Scheme)
+		if !f(fd_Signature_scheme, value) {
 			return
 		}
 	}
@@ -4737,17 +7715,19 @@ func (x *fastReflection_NameRecordEntry) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_NameRecordEntry) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Signature) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
-		return x.Id != ""
-	case "cerc.registry.v1.NameRecordEntry.height":
-		return x.Height != uint64(0)
+	case "cerc.registry.v1.Signature.sig":
+		return x.Sig != ""
+	case "cerc.registry.v1.Signature.pub_key":
+		return x.PubKey != ""
+	case "cerc.registry.v1.Signature.scheme":
+		return x.Scheme != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4757,17 +7737,19 @@ func (x *fastReflection_NameRecordEntry) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecordEntry) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Signature) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
-		x.Id = ""
-	case "cerc.registry.v1.NameRecordEntry.height":
-		x.Height = uint64(0)
+	case "cerc.registry.v1.Signature.sig":
+		x.Sig = ""
+	case "cerc.registry.v1.Signature.pub_key":
+		x.PubKey = ""
+	case "cerc.registry.v1.Signature.scheme":
+		x.Scheme = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4777,19 +7759,22 @@ func (x *fastReflection_NameRecordEntry) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_NameRecordEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Signature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
-		value := x.Id
+	case "cerc.registry.v1.Signature.sig":
+		value := x.Sig
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Signature.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Signature.scheme":
+		value := x.Scheme
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.NameRecordEntry.height":
-		value := x.Height
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4803,17 +7788,19 @@ func (x *fastReflection_NameRecordEntry) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecordEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Signature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
-		x.Id = value.Interface().(string)
-	case "cerc.registry.v1.NameRecordEntry.height":
-		x.Height = value.Uint()
+	case "cerc.registry.v1.Signature.sig":
+		x.Sig = value.Interface().(string)
+	case "cerc.registry.v1.Signature.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "cerc.registry.v1.Signature.scheme":
+		x.Scheme = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4827,44 +7814,48 @@ func (x *fastReflection_NameRecordEntry) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecordEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Signature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
-		panic(fmt.Errorf("field id of message cerc.registry.v1.NameRecordEntry is not mutable"))
-	case "cerc.registry.v1.NameRecordEntry.height":
-		panic(fmt.Errorf("field height of message cerc.registry.v1.NameRecordEntry is not mutable"))
+	case "cerc.registry.v1.Signature.sig":
+		panic(fmt.Errorf("field sig of message cerc.registry.v1.Signature is not mutable"))
+	case "cerc.registry.v1.Signature.pub_key":
+		panic(fmt.Errorf("field pub_key of message cerc.registry.v1.Signature is not mutable"))
+	case "cerc.registry.v1.Signature.scheme":
+		panic(fmt.Errorf("field scheme of message cerc.registry.v1.Signature is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_NameRecordEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Signature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.NameRecordEntry.id":
+	case "cerc.registry.v1.Signature.sig":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Signature.pub_key":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Signature.scheme":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.NameRecordEntry.height":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.NameRecordEntry"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.NameRecordEntry does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_NameRecordEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Signature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.NameRecordEntry", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.Signature", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4872,7 +7863,7 @@ func (x *fastReflection_NameRecordEntry) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_NameRecordEntry) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Signature) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4883,7 +7874,7 @@ func (x *fastReflection_NameRecordEntry) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_NameRecordEntry) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Signature) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4895,7 +7886,7 @@ func (x *fastReflection_NameRecordEntry) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_NameRecordEntry) IsValid() bool {
+func (x *fastReflection_Signature) IsValid() bool {
 	return x != nil
 }
 
@@ -4905,9 +7896,9 @@ func (x *fastReflection_NameRecordEntry) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*NameRecordEntry)
+		x := input.Message.Interface().(*Signature)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4919,12 +7910,17 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
+		l = len(x.Sig)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Scheme)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4936,7 +7932,7 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*NameRecordEntry)
+		x := input.Message.Interface().(*Signature)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4955,15 +7951,24 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if len(x.Scheme) > 0 {
+			i -= len(x.Scheme)
+			copy(dAtA[i:], x.Scheme)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Scheme)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x1a
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sig) > 0 {
+			i -= len(x.Sig)
+			copy(dAtA[i:], x.Sig)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sig)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4978,7 +7983,7 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*NameRecordEntry)
+		x := input.Message.Interface().(*Signature)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5010,15 +8015,15 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecordEntry: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Signature: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameRecordEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5046,13 +8051,13 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Id = string(dAtA[iNdEx:postIndex])
+				x.Sig = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 				}
-				x.Height = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5062,11 +8067,56 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Scheme = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5102,29 +8152,75 @@ func (x *fastReflection_NameRecordEntry) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ExpiryQueue_2_list)(nil)
+
+type _ExpiryQueue_2_list struct {
+	list *[]string
+}
+
+func (x *_ExpiryQueue_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExpiryQueue_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ExpiryQueue_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExpiryQueue_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExpiryQueue_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ExpiryQueue at list field Value as it is not of Message kind"))
+}
+
+func (x *_ExpiryQueue_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExpiryQueue_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ExpiryQueue_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Signature         protoreflect.MessageDescriptor
-	fd_Signature_sig     protoreflect.FieldDescriptor
-	fd_Signature_pub_key protoreflect.FieldDescriptor
+	md_ExpiryQueue       protoreflect.MessageDescriptor
+	fd_ExpiryQueue_id    protoreflect.FieldDescriptor
+	fd_ExpiryQueue_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_Signature = File_cerc_registry_v1_registry_proto.Messages().ByName("Signature")
-	fd_Signature_sig = md_Signature.Fields().ByName("sig")
-	fd_Signature_pub_key = md_Signature.Fields().ByName("pub_key")
+	md_ExpiryQueue = File_cerc_registry_v1_registry_proto.Messages().ByName("ExpiryQueue")
+	fd_ExpiryQueue_id = md_ExpiryQueue.Fields().ByName("id")
+	fd_ExpiryQueue_value = md_ExpiryQueue.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_Signature)(nil)
+var _ protoreflect.Message = (*fastReflection_ExpiryQueue)(nil)
 
-type fastReflection_Signature Signature
+type fastReflection_ExpiryQueue ExpiryQueue
 
-func (x *Signature) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Signature)(x)
+func (x *ExpiryQueue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExpiryQueue)(x)
 }
 
-func (x *Signature) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[7]
+func (x *ExpiryQueue) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5135,43 +8231,43 @@ func (x *Signature) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Signature_messageType fastReflection_Signature_messageType
-var _ protoreflect.MessageType = fastReflection_Signature_messageType{}
+var _fastReflection_ExpiryQueue_messageType fastReflection_ExpiryQueue_messageType
+var _ protoreflect.MessageType = fastReflection_ExpiryQueue_messageType{}
 
-type fastReflection_Signature_messageType struct{}
+type fastReflection_ExpiryQueue_messageType struct{}
 
-func (x fastReflection_Signature_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Signature)(nil)
+func (x fastReflection_ExpiryQueue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExpiryQueue)(nil)
 }
-func (x fastReflection_Signature_messageType) New() protoreflect.Message {
-	return new(fastReflection_Signature)
+func (x fastReflection_ExpiryQueue_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExpiryQueue)
 }
-func (x fastReflection_Signature_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Signature
+func (x fastReflection_ExpiryQueue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpiryQueue
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Signature) Descriptor() protoreflect.MessageDescriptor {
-	return md_Signature
+func (x *fastReflection_ExpiryQueue) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExpiryQueue
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Signature) Type() protoreflect.MessageType {
-	return _fastReflection_Signature_messageType
+func (x *fastReflection_ExpiryQueue) Type() protoreflect.MessageType {
+	return _fastReflection_ExpiryQueue_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Signature) New() protoreflect.Message {
-	return new(fastReflection_Signature)
+func (x *fastReflection_ExpiryQueue) New() protoreflect.Message {
+	return new(fastReflection_ExpiryQueue)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Signature) Interface() protoreflect.ProtoMessage {
-	return (*Signature)(x)
+func (x *fastReflection_ExpiryQueue) Interface() protoreflect.ProtoMessage {
+	return (*ExpiryQueue)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5179,16 +8275,16 @@ func (x *fastReflection_Signature) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Signature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Sig != "" {
-		value := protoreflect.ValueOfString(x.Sig)
-		if !f(fd_Signature_sig, value) {
+func (x *fastReflection_ExpiryQueue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_ExpiryQueue_id, value) {
 			return
 		}
 	}
-	if x.PubKey != "" {
-		value := protoreflect.ValueOfString(x.PubKey)
-		if !f(fd_Signature_pub_key, value) {
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfList(&_ExpiryQueue_2_list{list: &x.Value})
+		if !f(fd_ExpiryQueue_value, value) {
 			return
 		}
 	}
@@ -5205,17 +8301,17 @@ func (x *fastReflection_Signature) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Signature) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExpiryQueue) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		return x.Sig != ""
-	case "cerc.registry.v1.Signature.pub_key":
-		return x.PubKey != ""
+	case "cerc.registry.v1.ExpiryQueue.id":
+		return x.Id != ""
+	case "cerc.registry.v1.ExpiryQueue.value":
+		return len(x.Value) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5225,17 +8321,17 @@ func (x *fastReflection_Signature) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Signature) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExpiryQueue) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		x.Sig = ""
-	case "cerc.registry.v1.Signature.pub_key":
-		x.PubKey = ""
+	case "cerc.registry.v1.ExpiryQueue.id":
+		x.Id = ""
+	case "cerc.registry.v1.ExpiryQueue.value":
+		x.Value = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5245,19 +8341,22 @@ func (x *fastReflection_Signature) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Signature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpiryQueue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		value := x.Sig
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.Signature.pub_key":
-		value := x.PubKey
+	case "cerc.registry.v1.ExpiryQueue.id":
+		value := x.Id
 		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.ExpiryQueue.value":
+		if len(x.Value) == 0 {
+			return protoreflect.ValueOfList(&_ExpiryQueue_2_list{})
+		}
+		listValue := &_ExpiryQueue_2_list{list: &x.Value}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5271,17 +8370,19 @@ func (x *fastReflection_Signature) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Signature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ExpiryQueue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		x.Sig = value.Interface().(string)
-	case "cerc.registry.v1.Signature.pub_key":
-		x.PubKey = value.Interface().(string)
+	case "cerc.registry.v1.ExpiryQueue.id":
+		x.Id = value.Interface().(string)
+	case "cerc.registry.v1.ExpiryQueue.value":
+		lv := value.List()
+		clv := lv.(*_ExpiryQueue_2_list)
+		x.Value = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5295,44 +8396,49 @@ func (x *fastReflection_Signature) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Signature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpiryQueue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		panic(fmt.Errorf("field sig of message cerc.registry.v1.Signature is not mutable"))
-	case "cerc.registry.v1.Signature.pub_key":
-		panic(fmt.Errorf("field pub_key of message cerc.registry.v1.Signature is not mutable"))
+	case "cerc.registry.v1.ExpiryQueue.value":
+		if x.Value == nil {
+			x.Value = []string{}
+		}
+		value := &_ExpiryQueue_2_list{list: &x.Value}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.ExpiryQueue.id":
+		panic(fmt.Errorf("field id of message cerc.registry.v1.ExpiryQueue is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Signature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExpiryQueue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Signature.sig":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.Signature.pub_key":
+	case "cerc.registry.v1.ExpiryQueue.id":
 		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.ExpiryQueue.value":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ExpiryQueue_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Signature"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Signature does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Signature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExpiryQueue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.Signature", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.ExpiryQueue", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5340,7 +8446,7 @@ func (x *fastReflection_Signature) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Signature) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExpiryQueue) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5351,7 +8457,7 @@ func (x *fastReflection_Signature) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Signature) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExpiryQueue) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5363,7 +8469,7 @@ func (x *fastReflection_Signature) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Signature) IsValid() bool {
+func (x *fastReflection_ExpiryQueue) IsValid() bool {
 	return x != nil
 }
 
@@ -5373,9 +8479,9 @@ func (x *fastReflection_Signature) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Signature)
+		x := input.Message.Interface().(*ExpiryQueue)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5387,13 +8493,15 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Sig)
+		l = len(x.Id)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PubKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Value) > 0 {
+			for _, s := range x.Value {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5405,7 +8513,7 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Signature)
+		x := input.Message.Interface().(*ExpiryQueue)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5424,17 +8532,19 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.PubKey) > 0 {
-			i -= len(x.PubKey)
-			copy(dAtA[i:], x.PubKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Value) > 0 {
+			for iNdEx := len(x.Value) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Value[iNdEx])
+				copy(dAtA[i:], x.Value[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Sig) > 0 {
-			i -= len(x.Sig)
-			copy(dAtA[i:], x.Sig)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sig)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5449,7 +8559,7 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Signature)
+		x := input.Message.Interface().(*ExpiryQueue)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5481,15 +8591,15 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Signature: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpiryQueue: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpiryQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5517,11 +8627,11 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Sig = string(dAtA[iNdEx:postIndex])
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5549,7 +8659,7 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PubKey = string(dAtA[iNdEx:postIndex])
+				x.Value = append(x.Value, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5586,75 +8696,73 @@ func (x *fastReflection_Signature) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_ExpiryQueue_2_list)(nil)
+var _ protoreflect.List = (*_RecordsList_1_list)(nil)
 
-type _ExpiryQueue_2_list struct {
+type _RecordsList_1_list struct {
 	list *[]string
 }
 
-func (x *_ExpiryQueue_2_list) Len() int {
+func (x *_RecordsList_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_ExpiryQueue_2_list) Get(i int) protoreflect.Value {
+func (x *_RecordsList_1_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_ExpiryQueue_2_list) Set(i int, value protoreflect.Value) {
+func (x *_RecordsList_1_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_ExpiryQueue_2_list) Append(value protoreflect.Value) {
+func (x *_RecordsList_1_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_ExpiryQueue_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message ExpiryQueue at list field Value as it is not of Message kind"))
+func (x *_RecordsList_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RecordsList at list field Value as it is not of Message kind"))
 }
 
-func (x *_ExpiryQueue_2_list) Truncate(n int) {
+func (x *_RecordsList_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_ExpiryQueue_2_list) NewElement() protoreflect.Value {
+func (x *_RecordsList_1_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_ExpiryQueue_2_list) IsValid() bool {
+func (x *_RecordsList_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_ExpiryQueue       protoreflect.MessageDescriptor
-	fd_ExpiryQueue_id    protoreflect.FieldDescriptor
-	fd_ExpiryQueue_value protoreflect.FieldDescriptor
+	md_RecordsList       protoreflect.MessageDescriptor
+	fd_RecordsList_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_ExpiryQueue = File_cerc_registry_v1_registry_proto.Messages().ByName("ExpiryQueue")
-	fd_ExpiryQueue_id = md_ExpiryQueue.Fields().ByName("id")
-	fd_ExpiryQueue_value = md_ExpiryQueue.Fields().ByName("value")
+	md_RecordsList = File_cerc_registry_v1_registry_proto.Messages().ByName("RecordsList")
+	fd_RecordsList_value = md_RecordsList.Fields().ByName("value")
 }
 
-var _ protoreflect.Message = (*fastReflection_ExpiryQueue)(nil)
+var _ protoreflect.Message = (*fastReflection_RecordsList)(nil)
 
-type fastReflection_ExpiryQueue ExpiryQueue
+type fastReflection_RecordsList RecordsList
 
-func (x *ExpiryQueue) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ExpiryQueue)(x)
+func (x *RecordsList) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RecordsList)(x)
 }
 
-func (x *ExpiryQueue) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[8]
+func (x *RecordsList) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5665,43 +8773,43 @@ func (x *ExpiryQueue) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ExpiryQueue_messageType fastReflection_ExpiryQueue_messageType
-var _ protoreflect.MessageType = fastReflection_ExpiryQueue_messageType{}
+var _fastReflection_RecordsList_messageType fastReflection_RecordsList_messageType
+var _ protoreflect.MessageType = fastReflection_RecordsList_messageType{}
 
-type fastReflection_ExpiryQueue_messageType struct{}
+type fastReflection_RecordsList_messageType struct{}
 
-func (x fastReflection_ExpiryQueue_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ExpiryQueue)(nil)
+func (x fastReflection_RecordsList_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RecordsList)(nil)
 }
-func (x fastReflection_ExpiryQueue_messageType) New() protoreflect.Message {
-	return new(fastReflection_ExpiryQueue)
+func (x fastReflection_RecordsList_messageType) New() protoreflect.Message {
+	return new(fastReflection_RecordsList)
 }
-func (x fastReflection_ExpiryQueue_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExpiryQueue
+func (x fastReflection_RecordsList_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordsList
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ExpiryQueue) Descriptor() protoreflect.MessageDescriptor {
-	return md_ExpiryQueue
+func (x *fastReflection_RecordsList) Descriptor() protoreflect.MessageDescriptor {
+	return md_RecordsList
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ExpiryQueue) Type() protoreflect.MessageType {
-	return _fastReflection_ExpiryQueue_messageType
+func (x *fastReflection_RecordsList) Type() protoreflect.MessageType {
+	return _fastReflection_RecordsList_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ExpiryQueue) New() protoreflect.Message {
-	return new(fastReflection_ExpiryQueue)
+func (x *fastReflection_RecordsList) New() protoreflect.Message {
+	return new(fastReflection_RecordsList)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ExpiryQueue) Interface() protoreflect.ProtoMessage {
-	return (*ExpiryQueue)(x)
+func (x *fastReflection_RecordsList) Interface() protoreflect.ProtoMessage {
+	return (*RecordsList)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5709,16 +8817,10 @@ func (x *fastReflection_ExpiryQueue) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ExpiryQueue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != "" {
-		value := protoreflect.ValueOfString(x.Id)
-		if !f(fd_ExpiryQueue_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_RecordsList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.Value) != 0 {
-		value := protoreflect.ValueOfList(&_ExpiryQueue_2_list{list: &x.Value})
-		if !f(fd_ExpiryQueue_value, value) {
+		value := protoreflect.ValueOfList(&_RecordsList_1_list{list: &x.Value})
+		if !f(fd_RecordsList_value, value) {
 			return
 		}
 	}
@@ -5735,17 +8837,15 @@ func (x *fastReflection_ExpiryQueue) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ExpiryQueue) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_RecordsList) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.id":
-		return x.Id != ""
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		return len(x.Value) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5755,17 +8855,15 @@ func (x *fastReflection_ExpiryQueue) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExpiryQueue) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_RecordsList) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.id":
-		x.Id = ""
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		x.Value = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5775,22 +8873,19 @@ func (x *fastReflection_ExpiryQueue) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ExpiryQueue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordsList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.id":
-		value := x.Id
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		if len(x.Value) == 0 {
-			return protoreflect.ValueOfList(&_ExpiryQueue_2_list{})
+			return protoreflect.ValueOfList(&_RecordsList_1_list{})
 		}
-		listValue := &_ExpiryQueue_2_list{list: &x.Value}
+		listValue := &_RecordsList_1_list{list: &x.Value}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5804,19 +8899,17 @@ func (x *fastReflection_ExpiryQueue) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExpiryQueue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_RecordsList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.id":
-		x.Id = value.Interface().(string)
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		lv := value.List()
-		clv := lv.(*_ExpiryQueue_2_list)
+		clv := lv.(*_RecordsList_1_list)
 		x.Value = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5830,49 +8923,45 @@ func (x *fastReflection_ExpiryQueue) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExpiryQueue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordsList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		if x.Value == nil {
 			x.Value = []string{}
 		}
-		value := &_ExpiryQueue_2_list{list: &x.Value}
+		value := &_RecordsList_1_list{list: &x.Value}
 		return protoreflect.ValueOfList(value)
-	case "cerc.registry.v1.ExpiryQueue.id":
-		panic(fmt.Errorf("field id of message cerc.registry.v1.ExpiryQueue is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ExpiryQueue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_RecordsList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.ExpiryQueue.id":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.ExpiryQueue.value":
+	case "cerc.registry.v1.RecordsList.value":
 		list := []string{}
-		return protoreflect.ValueOfList(&_ExpiryQueue_2_list{list: &list})
+		return protoreflect.ValueOfList(&_RecordsList_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.ExpiryQueue"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.ExpiryQueue does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ExpiryQueue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_RecordsList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.ExpiryQueue", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.RecordsList", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5880,7 +8969,7 @@ func (x *fastReflection_ExpiryQueue) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ExpiryQueue) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_RecordsList) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5891,7 +8980,7 @@ func (x *fastReflection_ExpiryQueue) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ExpiryQueue) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_RecordsList) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5903,7 +8992,7 @@ func (x *fastReflection_ExpiryQueue) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ExpiryQueue) IsValid() bool {
+func (x *fastReflection_RecordsList) IsValid() bool {
 	return x != nil
 }
 
@@ -5913,9 +9002,9 @@ func (x *fastReflection_ExpiryQueue) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ExpiryQueue)
+		x := input.Message.Interface().(*RecordsList)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5927,10 +9016,6 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Id)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if len(x.Value) > 0 {
 			for _, s := range x.Value {
 				l = len(s)
@@ -5947,7 +9032,7 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ExpiryQueue)
+		x := input.Message.Interface().(*RecordsList)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5972,16 +9057,9 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], x.Value[iNdEx])
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value[iNdEx])))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0xa
 			}
 		}
-		if len(x.Id) > 0 {
-			i -= len(x.Id)
-			copy(dAtA[i:], x.Id)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5993,7 +9071,7 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ExpiryQueue)
+		x := input.Message.Interface().(*RecordsList)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6025,45 +9103,13 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpiryQueue: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordsList: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExpiryQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordsList: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Id = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
@@ -6130,73 +9176,37 @@ func (x *fastReflection_ExpiryQueue) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_RecordsList_1_list)(nil)
-
-type _RecordsList_1_list struct {
-	list *[]string
-}
-
-func (x *_RecordsList_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_RecordsList_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_RecordsList_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_RecordsList_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_RecordsList_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message RecordsList at list field Value as it is not of Message kind"))
-}
-
-func (x *_RecordsList_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_RecordsList_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_RecordsList_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_RecordsList       protoreflect.MessageDescriptor
-	fd_RecordsList_value protoreflect.FieldDescriptor
+	md_Schema             protoreflect.MessageDescriptor
+	fd_Schema_record_type protoreflect.FieldDescriptor
+	fd_Schema_authority   protoreflect.FieldDescriptor
+	fd_Schema_format      protoreflect.FieldDescriptor
+	fd_Schema_definition  protoreflect.FieldDescriptor
+	fd_Schema_height      protoreflect.FieldDescriptor
+	fd_Schema_version     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_registry_proto_init()
-	md_RecordsList = File_cerc_registry_v1_registry_proto.Messages().ByName("RecordsList")
-	fd_RecordsList_value = md_RecordsList.Fields().ByName("value")
+	md_Schema = File_cerc_registry_v1_registry_proto.Messages().ByName("Schema")
+	fd_Schema_record_type = md_Schema.Fields().ByName("record_type")
+	fd_Schema_authority = md_Schema.Fields().ByName("authority")
+	fd_Schema_format = md_Schema.Fields().ByName("format")
+	fd_Schema_definition = md_Schema.Fields().ByName("definition")
+	fd_Schema_height = md_Schema.Fields().ByName("height")
+	fd_Schema_version = md_Schema.Fields().ByName("version")
 }
 
-var _ protoreflect.Message = (*fastReflection_RecordsList)(nil)
+var _ protoreflect.Message = (*fastReflection_Schema)(nil)
 
-type fastReflection_RecordsList RecordsList
+type fastReflection_Schema Schema
 
-func (x *RecordsList) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RecordsList)(x)
+func (x *Schema) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Schema)(x)
 }
 
-func (x *RecordsList) slowProtoReflect() protoreflect.Message {
-	mi := &file_cerc_registry_v1_registry_proto_msgTypes[9]
+func (x *Schema) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_registry_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6207,43 +9217,43 @@ func (x *RecordsList) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RecordsList_messageType fastReflection_RecordsList_messageType
-var _ protoreflect.MessageType = fastReflection_RecordsList_messageType{}
+var _fastReflection_Schema_messageType fastReflection_Schema_messageType
+var _ protoreflect.MessageType = fastReflection_Schema_messageType{}
 
-type fastReflection_RecordsList_messageType struct{}
+type fastReflection_Schema_messageType struct{}
 
-func (x fastReflection_RecordsList_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RecordsList)(nil)
+func (x fastReflection_Schema_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Schema)(nil)
 }
-func (x fastReflection_RecordsList_messageType) New() protoreflect.Message {
-	return new(fastReflection_RecordsList)
+func (x fastReflection_Schema_messageType) New() protoreflect.Message {
+	return new(fastReflection_Schema)
 }
-func (x fastReflection_RecordsList_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordsList
+func (x fastReflection_Schema_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Schema
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RecordsList) Descriptor() protoreflect.MessageDescriptor {
-	return md_RecordsList
+func (x *fastReflection_Schema) Descriptor() protoreflect.MessageDescriptor {
+	return md_Schema
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RecordsList) Type() protoreflect.MessageType {
-	return _fastReflection_RecordsList_messageType
+func (x *fastReflection_Schema) Type() protoreflect.MessageType {
+	return _fastReflection_Schema_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RecordsList) New() protoreflect.Message {
-	return new(fastReflection_RecordsList)
+func (x *fastReflection_Schema) New() protoreflect.Message {
+	return new(fastReflection_Schema)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RecordsList) Interface() protoreflect.ProtoMessage {
-	return (*RecordsList)(x)
+func (x *fastReflection_Schema) Interface() protoreflect.ProtoMessage {
+	return (*Schema)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6251,10 +9261,40 @@ func (x *fastReflection_RecordsList) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RecordsList) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Value) != 0 {
-		value := protoreflect.ValueOfList(&_RecordsList_1_list{list: &x.Value})
-		if !f(fd_RecordsList_value, value) {
+func (x *fastReflection_Schema) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordType != "" {
+		value := protoreflect.ValueOfString(x.RecordType)
+		if !f(fd_Schema_record_type, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_Schema_authority, value) {
+			return
+		}
+	}
+	if x.Format != "" {
+		value := protoreflect.ValueOfString(x.Format)
+		if !f(fd_Schema_format, value) {
+			return
+		}
+	}
+	if x.Definition != "" {
+		value := protoreflect.ValueOfString(x.Definition)
+		if !f(fd_Schema_definition, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_Schema_height, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_Schema_version, value) {
 			return
 		}
 	}
@@ -6271,15 +9311,25 @@ func (x *fastReflection_RecordsList) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RecordsList) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Schema) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		return len(x.Value) != 0
+	case "cerc.registry.v1.Schema.record_type":
+		return x.RecordType != ""
+	case "cerc.registry.v1.Schema.authority":
+		return x.Authority != ""
+	case "cerc.registry.v1.Schema.format":
+		return x.Format != ""
+	case "cerc.registry.v1.Schema.definition":
+		return x.Definition != ""
+	case "cerc.registry.v1.Schema.height":
+		return x.Height != uint64(0)
+	case "cerc.registry.v1.Schema.version":
+		return x.Version != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6289,15 +9339,25 @@ func (x *fastReflection_RecordsList) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordsList) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Schema) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		x.Value = nil
+	case "cerc.registry.v1.Schema.record_type":
+		x.RecordType = ""
+	case "cerc.registry.v1.Schema.authority":
+		x.Authority = ""
+	case "cerc.registry.v1.Schema.format":
+		x.Format = ""
+	case "cerc.registry.v1.Schema.definition":
+		x.Definition = ""
+	case "cerc.registry.v1.Schema.height":
+		x.Height = uint64(0)
+	case "cerc.registry.v1.Schema.version":
+		x.Version = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6307,19 +9367,31 @@ func (x *fastReflection_RecordsList) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RecordsList) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schema) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		if len(x.Value) == 0 {
-			return protoreflect.ValueOfList(&_RecordsList_1_list{})
-		}
-		listValue := &_RecordsList_1_list{list: &x.Value}
-		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.Schema.record_type":
+		value := x.RecordType
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Schema.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Schema.format":
+		value := x.Format
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Schema.definition":
+		value := x.Definition
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.Schema.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.Schema.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6333,17 +9405,25 @@ func (x *fastReflection_RecordsList) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordsList) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Schema) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		lv := value.List()
-		clv := lv.(*_RecordsList_1_list)
-		x.Value = *clv.list
+	case "cerc.registry.v1.Schema.record_type":
+		x.RecordType = value.Interface().(string)
+	case "cerc.registry.v1.Schema.authority":
+		x.Authority = value.Interface().(string)
+	case "cerc.registry.v1.Schema.format":
+		x.Format = value.Interface().(string)
+	case "cerc.registry.v1.Schema.definition":
+		x.Definition = value.Interface().(string)
+	case "cerc.registry.v1.Schema.height":
+		x.Height = value.Uint()
+	case "cerc.registry.v1.Schema.version":
+		x.Version = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6357,45 +9437,60 @@ func (x *fastReflection_RecordsList) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordsList) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schema) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		if x.Value == nil {
-			x.Value = []string{}
-		}
-		value := &_RecordsList_1_list{list: &x.Value}
-		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.Schema.record_type":
+		panic(fmt.Errorf("field record_type of message cerc.registry.v1.Schema is not mutable"))
+	case "cerc.registry.v1.Schema.authority":
+		panic(fmt.Errorf("field authority of message cerc.registry.v1.Schema is not mutable"))
+	case "cerc.registry.v1.Schema.format":
+		panic(fmt.Errorf("field format of message cerc.registry.v1.Schema is not mutable"))
+	case "cerc.registry.v1.Schema.definition":
+		panic(fmt.Errorf("field definition of message cerc.registry.v1.Schema is not mutable"))
+	case "cerc.registry.v1.Schema.height":
+		panic(fmt.Errorf("field height of message cerc.registry.v1.Schema is not mutable"))
+	case "cerc.registry.v1.Schema.version":
+		panic(fmt.Errorf("field version of message cerc.registry.v1.Schema is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RecordsList) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Schema) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.RecordsList.value":
-		list := []string{}
-		return protoreflect.ValueOfList(&_RecordsList_1_list{list: &list})
+	case "cerc.registry.v1.Schema.record_type":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Schema.authority":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Schema.format":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Schema.definition":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.Schema.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.Schema.version":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.RecordsList"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Schema"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.RecordsList does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Schema does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RecordsList) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Schema) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.RecordsList", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.Schema", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6403,7 +9498,7 @@ func (x *fastReflection_RecordsList) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RecordsList) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Schema) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6414,7 +9509,7 @@ func (x *fastReflection_RecordsList) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RecordsList) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Schema) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6426,7 +9521,7 @@ func (x *fastReflection_RecordsList) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RecordsList) IsValid() bool {
+func (x *fastReflection_Schema) IsValid() bool {
 	return x != nil
 }
 
@@ -6436,9 +9531,9 @@ func (x *fastReflection_RecordsList) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Schema) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RecordsList)
+		x := input.Message.Interface().(*Schema)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6450,11 +9545,27 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Value) > 0 {
-			for _, s := range x.Value {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.RecordType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Format)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Definition)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6466,7 +9577,7 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RecordsList)
+		x := input.Message.Interface().(*Schema)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6485,14 +9596,43 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Value) > 0 {
-			for iNdEx := len(x.Value) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Value[iNdEx])
-				copy(dAtA[i:], x.Value[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Definition) > 0 {
+			i -= len(x.Definition)
+			copy(dAtA[i:], x.Definition)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Definition)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Format) > 0 {
+			i -= len(x.Format)
+			copy(dAtA[i:], x.Format)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Format)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordType) > 0 {
+			i -= len(x.RecordType)
+			copy(dAtA[i:], x.RecordType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordType)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6505,7 +9645,7 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RecordsList)
+		x := input.Message.Interface().(*Schema)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6537,15 +9677,15 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordsList: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Schema: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RecordsList: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6573,8 +9713,142 @@ func (x *fastReflection_RecordsList) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = append(x.Value, string(dAtA[iNdEx:postIndex]))
+				x.RecordType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Format = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Definition = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6640,6 +9914,21 @@ type Params struct {
 	AuthorityAuctionCommitFee       *v1beta1.Coin        `protobuf:"bytes,9,opt,name=authority_auction_commit_fee,json=authorityAuctionCommitFee,proto3" json:"authority_auction_commit_fee,omitempty"`
 	AuthorityAuctionRevealFee       *v1beta1.Coin        `protobuf:"bytes,10,opt,name=authority_auction_reveal_fee,json=authorityAuctionRevealFee,proto3" json:"authority_auction_reveal_fee,omitempty"`
 	AuthorityAuctionMinimumBid      *v1beta1.Coin        `protobuf:"bytes,11,opt,name=authority_auction_minimum_bid,json=authorityAuctionMinimumBid,proto3" json:"authority_auction_minimum_bid,omitempty"`
+	// Maximum time a record can be paid for in advance (see MsgSetRecord
+	// lifetime and MsgRenewRecord periods)
+	MaxRecordLifetime *durationpb.Duration `protobuf:"bytes,12,opt,name=max_record_lifetime,json=maxRecordLifetime,proto3" json:"max_record_lifetime,omitempty"`
+	// Rent per byte of record attributes for 1 time period, charged in addition
+	// to the record rent
+	RecordRentPerByte *v1beta1.Coin `protobuf:"bytes,13,opt,name=record_rent_per_byte,json=recordRentPerByte,proto3" json:"record_rent_per_byte,omitempty"`
+	// Maximum size of record attributes in bytes
+	MaxRecordAttributesSize uint64 `protobuf:"varint,14,opt,name=max_record_attributes_size,json=maxRecordAttributesSize,proto3" json:"max_record_attributes_size,omitempty"`
+	// Maximum nesting depth of record attributes (maps and lists)
+	MaxRecordAttributesDepth uint64 `protobuf:"varint,15,opt,name=max_record_attributes_depth,json=maxRecordAttributesDepth,proto3" json:"max_record_attributes_depth,omitempty"`
+	// Maximum number of attribute index entries of a record (one per leaf value
+	// and list, see record queries)
+	MaxRecordIndexedAttributes uint64 `protobuf:"varint,16,opt,name=max_record_indexed_attributes,json=maxRecordIndexedAttributes,proto3" json:"max_record_indexed_attributes,omitempty"`
+	// Maximum size of record type schema definitions, in bytes
+	MaxSchemaSize uint64 `protobuf:"varint,17,opt,name=max_schema_size,json=maxSchemaSize,proto3" json:"max_schema_size,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -6739,6 +10028,48 @@ func (x *Params) GetAuthorityAuctionMinimumBid() *v1beta1.Coin {
 	return nil
 }
 
+func (x *Params) GetMaxRecordLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxRecordLifetime
+	}
+	return nil
+}
+
+func (x *Params) GetRecordRentPerByte() *v1beta1.Coin {
+	if x != nil {
+		return x.RecordRentPerByte
+	}
+	return nil
+}
+
+func (x *Params) GetMaxRecordAttributesSize() uint64 {
+	if x != nil {
+		return x.MaxRecordAttributesSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRecordAttributesDepth() uint64 {
+	if x != nil {
+		return x.MaxRecordAttributesDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxRecordIndexedAttributes() uint64 {
+	if x != nil {
+		return x.MaxRecordIndexedAttributes
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSchemaSize() uint64 {
+	if x != nil {
+		return x.MaxSchemaSize
+	}
+	return 0
+}
+
 // Record defines a registry record
 type Record struct {
 	state         protoimpl.MessageState
@@ -6751,9 +10082,23 @@ type Record struct {
 	ExpiryTime string   `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
 	Deleted    bool     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	Owners     []string `protobuf:"bytes,6,rep,name=owners,proto3" json:"owners,omitempty"`
+	// Record attributes: canonical DAG-CBOR (the block addressed by the record
+	// id) for stored records, JSON in record payloads
 	Attributes []byte   `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
 	Names      []string `protobuf:"bytes,8,rep,name=names,proto3" json:"names,omitempty"`
 	Type_      string   `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"`
+	// id of the record version this record replaces (see MsgUpdateRecord).
+	PreviousId string `protobuf:"bytes,10,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty"`
+	// number of owner approvals required for record-scoped messages (at least 1)
+	OwnerThreshold uint32 `protobuf:"varint,11,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty"`
+	// version of the record type schema the record was validated against, if
+	// any
+	SchemaVersion uint64 `protobuf:"varint,12,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// rent paid for the current (and prepaid) rent periods of the record, if any
+	Rent *RecordRent `protobuf:"bytes,13,opt,name=rent,proto3" json:"rent,omitempty"`
+	// number of record operations approved so far, signed by the record owners
+	// with each operation so that their approvals can't be replayed
+	Nonce uint64 `protobuf:"varint,14,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (x *Record) Reset() {
@@ -6790,51 +10135,147 @@ func (x *Record) GetBondId() string {
 	return ""
 }
 
-func (x *Record) GetCreateTime() string {
+func (x *Record) GetCreateTime() string {
+	if x != nil {
+		return x.CreateTime
+	}
+	return ""
+}
+
+func (x *Record) GetExpiryTime() string {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return ""
+}
+
+func (x *Record) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Record) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *Record) GetAttributes() []byte {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Record) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Record) GetType_() string {
+	if x != nil {
+		return x.Type_
+	}
+	return ""
+}
+
+func (x *Record) GetPreviousId() string {
+	if x != nil {
+		return x.PreviousId
+	}
+	return ""
+}
+
+func (x *Record) GetOwnerThreshold() uint32 {
+	if x != nil {
+		return x.OwnerThreshold
+	}
+	return 0
+}
+
+func (x *Record) GetSchemaVersion() uint64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *Record) GetRent() *RecordRent {
 	if x != nil {
-		return x.CreateTime
+		return x.Rent
 	}
-	return ""
+	return nil
 }
 
-func (x *Record) GetExpiryTime() string {
+func (x *Record) GetNonce() uint64 {
 	if x != nil {
-		return x.ExpiryTime
+		return x.Nonce
 	}
-	return ""
+	return 0
 }
 
-func (x *Record) GetDeleted() bool {
-	if x != nil {
-		return x.Deleted
+// RecordRent is the rent paid by a bond for a record, for the time from
+// start_time to end_time (the record expiry time)
+type RecordRent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount    *v1beta1.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	BondId    string        `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	StartTime string        `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string        `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *RecordRent) Reset() {
+	*x = RecordRent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Record) GetOwners() []string {
+func (x *RecordRent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRent) ProtoMessage() {}
+
+// Deprecated: Use RecordRent.ProtoReflect.Descriptor instead.
+func (*RecordRent) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordRent) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.Owners
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *Record) GetAttributes() []byte {
+func (x *RecordRent) GetBondId() string {
 	if x != nil {
-		return x.Attributes
+		return x.BondId
 	}
-	return nil
+	return ""
 }
 
-func (x *Record) GetNames() []string {
+func (x *RecordRent) GetStartTime() string {
 	if x != nil {
-		return x.Names
+		return x.StartTime
 	}
-	return nil
+	return ""
 }
 
-func (x *Record) GetType_() string {
+func (x *RecordRent) GetEndTime() string {
 	if x != nil {
-		return x.Type_
+		return x.EndTime
 	}
 	return ""
 }
@@ -6852,7 +10293,7 @@ type AuthorityEntry struct {
 func (x *AuthorityEntry) Reset() {
 	*x = AuthorityEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[2]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6866,7 +10307,7 @@ func (*AuthorityEntry) ProtoMessage() {}
 
 // Deprecated: Use AuthorityEntry.ProtoReflect.Descriptor instead.
 func (*AuthorityEntry) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{2}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AuthorityEntry) GetName() string {
@@ -6894,17 +10335,21 @@ type NameAuthority struct {
 	// Owner address.
 	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	// height at which name/authority was created.
-	Height     uint64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Status: active, grace (rent unpaid, see AuthorityGracePeriod), auction or
+	// expired.
 	Status     string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	AuctionId  string                 `protobuf:"bytes,5,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
 	BondId     string                 `protobuf:"bytes,6,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
 	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// Transfer to a new owner, pending acceptance (see MsgTransferAuthority)
+	PendingTransfer *AuthorityTransfer `protobuf:"bytes,8,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
 }
 
 func (x *NameAuthority) Reset() {
 	*x = NameAuthority{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[3]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6918,7 +10363,7 @@ func (*NameAuthority) ProtoMessage() {}
 
 // Deprecated: Use NameAuthority.ProtoReflect.Descriptor instead.
 func (*NameAuthority) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{3}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NameAuthority) GetOwnerPublicKey() string {
@@ -6970,6 +10415,131 @@ func (x *NameAuthority) GetExpiryTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *NameAuthority) GetPendingTransfer() *AuthorityTransfer {
+	if x != nil {
+		return x.PendingTransfer
+	}
+	return nil
+}
+
+// AuthorityTransfer defines a name authority transfer, pending acceptance by
+// the new owner
+type AuthorityTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NewOwner        string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	KeepBond        bool   `protobuf:"varint,2,opt,name=keep_bond,json=keepBond,proto3" json:"keep_bond,omitempty"`
+	InvalidateNames bool   `protobuf:"varint,3,opt,name=invalidate_names,json=invalidateNames,proto3" json:"invalidate_names,omitempty"`
+}
+
+func (x *AuthorityTransfer) Reset() {
+	*x = AuthorityTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorityTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorityTransfer) ProtoMessage() {}
+
+// Deprecated: Use AuthorityTransfer.ProtoReflect.Descriptor instead.
+func (*AuthorityTransfer) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuthorityTransfer) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *AuthorityTransfer) GetKeepBond() bool {
+	if x != nil {
+		return x.KeepBond
+	}
+	return false
+}
+
+func (x *AuthorityTransfer) GetInvalidateNames() bool {
+	if x != nil {
+		return x.InvalidateNames
+	}
+	return false
+}
+
+// NameAccessGrant defines the right of a grantee to set and delete the names
+// matching an LRN prefix, granted by the owner of the name authority
+type NameAccessGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// LRN, or LRN path prefix ending with "/*" (e.g. lrn://cerc-io/apps/*)
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Authority owner who granted access; the grant lapses if the authority
+	// changes owner
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Optional time after which the grant no longer applies
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (x *NameAccessGrant) Reset() {
+	*x = NameAccessGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameAccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameAccessGrant) ProtoMessage() {}
+
+// Deprecated: Use NameAccessGrant.ProtoReflect.Descriptor instead.
+func (*NameAccessGrant) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NameAccessGrant) GetLrnPrefix() string {
+	if x != nil {
+		return x.LrnPrefix
+	}
+	return ""
+}
+
+func (x *NameAccessGrant) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *NameAccessGrant) GetGranter() string {
+	if x != nil {
+		return x.Granter
+	}
+	return ""
+}
+
+func (x *NameAccessGrant) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
 // NameEntry
 type NameEntry struct {
 	state         protoimpl.MessageState
@@ -6983,7 +10553,7 @@ type NameEntry struct {
 func (x *NameEntry) Reset() {
 	*x = NameEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[4]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6997,7 +10567,7 @@ func (*NameEntry) ProtoMessage() {}
 
 // Deprecated: Use NameEntry.ProtoReflect.Descriptor instead.
 func (*NameEntry) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{4}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *NameEntry) GetName() string {
@@ -7027,7 +10597,7 @@ type NameRecord struct {
 func (x *NameRecord) Reset() {
 	*x = NameRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[5]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7041,7 +10611,7 @@ func (*NameRecord) ProtoMessage() {}
 
 // Deprecated: Use NameRecord.ProtoReflect.Descriptor instead.
 func (*NameRecord) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{5}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NameRecord) GetLatest() *NameRecordEntry {
@@ -7071,7 +10641,7 @@ type NameRecordEntry struct {
 func (x *NameRecordEntry) Reset() {
 	*x = NameRecordEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[6]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7085,7 +10655,7 @@ func (*NameRecordEntry) ProtoMessage() {}
 
 // Deprecated: Use NameRecordEntry.ProtoReflect.Descriptor instead.
 func (*NameRecordEntry) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{6}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NameRecordEntry) GetId() string {
@@ -7110,12 +10680,16 @@ type Signature struct {
 
 	Sig    string `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty"`
 	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// Signature scheme: cosmos (default, amino encoded pub_key), eip191 (personal_sign)
+	// or eip712 (typed data); Ethereum signatures are hex encoded and pub_key is the
+	// (optional) expected signer address
+	Scheme string `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
 }
 
 func (x *Signature) Reset() {
 	*x = Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[7]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7129,7 +10703,7 @@ func (*Signature) ProtoMessage() {}
 
 // Deprecated: Use Signature.ProtoReflect.Descriptor instead.
 func (*Signature) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{7}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Signature) GetSig() string {
@@ -7146,6 +10720,13 @@ func (x *Signature) GetPubKey() string {
 	return ""
 }
 
+func (x *Signature) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
 // ExpiryQueue: record / authority expiry queue type
 // id:    expiry time
 // value: array of ids (record cids / authority names)
@@ -7161,7 +10742,7 @@ type ExpiryQueue struct {
 func (x *ExpiryQueue) Reset() {
 	*x = ExpiryQueue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[8]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7175,7 +10756,7 @@ func (*ExpiryQueue) ProtoMessage() {}
 
 // Deprecated: Use ExpiryQueue.ProtoReflect.Descriptor instead.
 func (*ExpiryQueue) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{8}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ExpiryQueue) GetId() string {
@@ -7205,7 +10786,7 @@ type RecordsList struct {
 func (x *RecordsList) Reset() {
 	*x = RecordsList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_registry_proto_msgTypes[9]
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7219,7 +10800,7 @@ func (*RecordsList) ProtoMessage() {}
 
 // Deprecated: Use RecordsList.ProtoReflect.Descriptor instead.
 func (*RecordsList) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{9}
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RecordsList) GetValue() []string {
@@ -7229,6 +10810,89 @@ func (x *RecordsList) GetValue() []string {
 	return nil
 }
 
+// Schema defines a record type schema owned by a name authority
+type Schema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Record type name, matched against the `type` attribute of records.
+	RecordType string `protobuf:"bytes,1,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// Name authority that owns the schema.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Schema format (json or ipld).
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Schema definition (JSON Schema document or IPLD schema DSL).
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// height at which the schema version was registered.
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Schema version, starting at 1. Registered versions are immutable,
+	// registering the schema again adds a new version.
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Schema) Reset() {
+	*x = Schema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_registry_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schema) ProtoMessage() {}
+
+// Deprecated: Use Schema.ProtoReflect.Descriptor instead.
+func (*Schema) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_registry_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Schema) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *Schema) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *Schema) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Schema) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *Schema) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Schema) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 var File_cerc_registry_v1_registry_proto protoreflect.FileDescriptor
 
 var file_cerc_registry_v1_registry_proto_rawDesc = []byte{
@@ -7242,7 +10906,7 @@ var file_cerc_registry_v1_registry_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x0d, 0x0a, 0x06, 0x50,
+	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x13, 0x0a, 0x06, 0x50,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x69, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
 	0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
@@ -7352,120 +11016,271 @@ var file_cerc_registry_v1_registry_proto_rawDesc = []byte{
 	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x62, 0x69, 0x64, 0x22, 0x52, 0x1a,
 	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x69, 0x64, 0x22, 0x89, 0x04, 0x0a, 0x06, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22,
-	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x69, 0x64, 0x12, 0x8c, 0x01, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x35, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69,
+	0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x3f, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x37, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x22, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x6e, 0x74,
+	0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x84, 0x01, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x47, 0xf2, 0xde,
+	0x1f, 0x43, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x88,
+	0x01, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x49, 0xf2, 0xde, 0x1f, 0x45, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x20, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x52,
+	0x18, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x90, 0x01, 0x0a, 0x1d, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x4d, 0xf2, 0xde, 0x1f, 0x49, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22,
+	0x52, 0x1a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x42, 0x31, 0xf2, 0xde, 0x1f, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8d, 0x07, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x27, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17,
+	0xf2, 0xde, 0x1f, 0x13, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x64, 0x22, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x62,
+	0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde,
+	0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52,
+	0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde,
+	0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x52,
+	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d,
+	0xf2, 0xde, 0x1f, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x69, 0x64, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x69, 0x64, 0x22, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x49, 0x64, 0x12,
+	0x5a, 0x0a, 0x0f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x31, 0xf2, 0xde, 0x1f, 0x2d, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0e, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x56, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x2f, 0xf2, 0xde, 0x1f, 0x2b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x04, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x6e, 0x74, 0x42,
+	0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x04, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a,
 	0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f,
 	0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69,
-	0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f,
-	0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x06,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1f, 0xf2, 0xde,
-	0x1f, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x20,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x52, 0x06, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x6a,
-	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22,
-	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x22, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x33,
-	0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xf2,
-	0xde, 0x1f, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x05, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5b, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65,
-	0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-	0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x05, 0x65, 0x6e,
-	0x74, 0x72, 0x79, 0x22, 0xe6, 0x03, 0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x5d, 0x0a, 0x10, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x33, 0xf2, 0xde, 0x1f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x20, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
-	0x6b, 0x65, 0x79, 0x22, 0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xf2, 0xde, 0x1f,
-	0x29, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xf2, 0xde,
-	0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e,
-	0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64,
-	0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0b,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27,
+	0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0xec, 0x04, 0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x5d, 0x0a, 0x10, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xf2, 0xde, 0x1f,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x22,
+	0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x52, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xf2, 0xde, 0x1f, 0x29, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x20,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x09, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07,
+	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2,
+	0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x6e, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x10, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x33, 0xf2, 0xde, 0x1f, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x52, 0x0f, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xfb,
+	0x01, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x20, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x09, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x25, 0xf2, 0xde, 0x1f,
+	0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x6f, 0x6e, 0x64,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x6f, 0x6e,
+	0x64, 0x22, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x5e, 0x0a, 0x10,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x33, 0xf2, 0xde, 0x1f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x0f, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xf9, 0x01, 0x0a,
+	0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x12, 0x46, 0x0a, 0x0a, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x52, 0x09, 0x6c,
+	0x72, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x31, 0xc8,
-	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01,
-	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x09,
-	0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a,
-	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
-	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x12, 0x39, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x2d, 0xf2,
+	0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x84, 0x01,
+	0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x39, 0x0a, 0x06,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
 	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
 	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x39, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x74, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x12, 0x2b, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xf2,
-	0xde, 0x1f, 0x15, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x22, 0x20, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x22, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x3a, 0x0a,
-	0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
-	0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
-	0x79, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
-	0x22, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x33, 0x0a, 0x0b, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23,
-	0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x65, 0x72, 0x63,
-	0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
-	0x69, 0x74, 0x2e, 0x76, 0x64, 0x62, 0x2e, 0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2d, 0x69,
-	0x6f, 0x2f, 0x6c, 0x61, 0x63, 0x6f, 0x6e, 0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58,
-	0xaa, 0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x22, 0x39, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xc1, 0x01, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a,
+	0x03, 0x73, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x73, 0x69, 0x67, 0x22, 0x52, 0x03, 0x73, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f,
+	0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x20,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xf2, 0xde, 0x1f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x06, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdc, 0x01,
+	0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2,
+	0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0xc4, 0x01, 0x0a,
+	0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x64, 0x62, 0x2e,
+	0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2d, 0x69, 0x6f, 0x2f, 0x6c, 0x61, 0x63, 0x6f, 0x6e,
+	0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x10, 0x43, 0x65, 0x72, 0x63,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43,
+	0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7480,43 +11295,53 @@ func file_cerc_registry_v1_registry_proto_rawDescGZIP() []byte {
 	return file_cerc_registry_v1_registry_proto_rawDescData
 }
 
-var file_cerc_registry_v1_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cerc_registry_v1_registry_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_cerc_registry_v1_registry_proto_goTypes = []interface{}{
 	(*Params)(nil),                // 0: cerc.registry.v1.Params
 	(*Record)(nil),                // 1: cerc.registry.v1.Record
-	(*AuthorityEntry)(nil),        // 2: cerc.registry.v1.AuthorityEntry
-	(*NameAuthority)(nil),         // 3: cerc.registry.v1.NameAuthority
-	(*NameEntry)(nil),             // 4: cerc.registry.v1.NameEntry
-	(*NameRecord)(nil),            // 5: cerc.registry.v1.NameRecord
-	(*NameRecordEntry)(nil),       // 6: cerc.registry.v1.NameRecordEntry
-	(*Signature)(nil),             // 7: cerc.registry.v1.Signature
-	(*ExpiryQueue)(nil),           // 8: cerc.registry.v1.ExpiryQueue
-	(*RecordsList)(nil),           // 9: cerc.registry.v1.RecordsList
-	(*v1beta1.Coin)(nil),          // 10: cosmos.base.v1beta1.Coin
-	(*durationpb.Duration)(nil),   // 11: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*RecordRent)(nil),            // 2: cerc.registry.v1.RecordRent
+	(*AuthorityEntry)(nil),        // 3: cerc.registry.v1.AuthorityEntry
+	(*NameAuthority)(nil),         // 4: cerc.registry.v1.NameAuthority
+	(*AuthorityTransfer)(nil),     // 5: cerc.registry.v1.AuthorityTransfer
+	(*NameAccessGrant)(nil),       // 6: cerc.registry.v1.NameAccessGrant
+	(*NameEntry)(nil),             // 7: cerc.registry.v1.NameEntry
+	(*NameRecord)(nil),            // 8: cerc.registry.v1.NameRecord
+	(*NameRecordEntry)(nil),       // 9: cerc.registry.v1.NameRecordEntry
+	(*Signature)(nil),             // 10: cerc.registry.v1.Signature
+	(*ExpiryQueue)(nil),           // 11: cerc.registry.v1.ExpiryQueue
+	(*RecordsList)(nil),           // 12: cerc.registry.v1.RecordsList
+	(*Schema)(nil),                // 13: cerc.registry.v1.Schema
+	(*v1beta1.Coin)(nil),          // 14: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_cerc_registry_v1_registry_proto_depIdxs = []int32{
-	10, // 0: cerc.registry.v1.Params.record_rent:type_name -> cosmos.base.v1beta1.Coin
-	11, // 1: cerc.registry.v1.Params.record_rent_duration:type_name -> google.protobuf.Duration
-	10, // 2: cerc.registry.v1.Params.authority_rent:type_name -> cosmos.base.v1beta1.Coin
-	11, // 3: cerc.registry.v1.Params.authority_rent_duration:type_name -> google.protobuf.Duration
-	11, // 4: cerc.registry.v1.Params.authority_grace_period:type_name -> google.protobuf.Duration
-	11, // 5: cerc.registry.v1.Params.authority_auction_commits_duration:type_name -> google.protobuf.Duration
-	11, // 6: cerc.registry.v1.Params.authority_auction_reveals_duration:type_name -> google.protobuf.Duration
-	10, // 7: cerc.registry.v1.Params.authority_auction_commit_fee:type_name -> cosmos.base.v1beta1.Coin
-	10, // 8: cerc.registry.v1.Params.authority_auction_reveal_fee:type_name -> cosmos.base.v1beta1.Coin
-	10, // 9: cerc.registry.v1.Params.authority_auction_minimum_bid:type_name -> cosmos.base.v1beta1.Coin
-	3,  // 10: cerc.registry.v1.AuthorityEntry.entry:type_name -> cerc.registry.v1.NameAuthority
-	12, // 11: cerc.registry.v1.NameAuthority.expiry_time:type_name -> google.protobuf.Timestamp
-	5,  // 12: cerc.registry.v1.NameEntry.entry:type_name -> cerc.registry.v1.NameRecord
-	6,  // 13: cerc.registry.v1.NameRecord.latest:type_name -> cerc.registry.v1.NameRecordEntry
-	6,  // 14: cerc.registry.v1.NameRecord.history:type_name -> cerc.registry.v1.NameRecordEntry
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	14, // 0: cerc.registry.v1.Params.record_rent:type_name -> cosmos.base.v1beta1.Coin
+	15, // 1: cerc.registry.v1.Params.record_rent_duration:type_name -> google.protobuf.Duration
+	14, // 2: cerc.registry.v1.Params.authority_rent:type_name -> cosmos.base.v1beta1.Coin
+	15, // 3: cerc.registry.v1.Params.authority_rent_duration:type_name -> google.protobuf.Duration
+	15, // 4: cerc.registry.v1.Params.authority_grace_period:type_name -> google.protobuf.Duration
+	15, // 5: cerc.registry.v1.Params.authority_auction_commits_duration:type_name -> google.protobuf.Duration
+	15, // 6: cerc.registry.v1.Params.authority_auction_reveals_duration:type_name -> google.protobuf.Duration
+	14, // 7: cerc.registry.v1.Params.authority_auction_commit_fee:type_name -> cosmos.base.v1beta1.Coin
+	14, // 8: cerc.registry.v1.Params.authority_auction_reveal_fee:type_name -> cosmos.base.v1beta1.Coin
+	14, // 9: cerc.registry.v1.Params.authority_auction_minimum_bid:type_name -> cosmos.base.v1beta1.Coin
+	15, // 10: cerc.registry.v1.Params.max_record_lifetime:type_name -> google.protobuf.Duration
+	14, // 11: cerc.registry.v1.Params.record_rent_per_byte:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 12: cerc.registry.v1.Record.rent:type_name -> cerc.registry.v1.RecordRent
+	14, // 13: cerc.registry.v1.RecordRent.amount:type_name -> cosmos.base.v1beta1.Coin
+	4,  // 14: cerc.registry.v1.AuthorityEntry.entry:type_name -> cerc.registry.v1.NameAuthority
+	16, // 15: cerc.registry.v1.NameAuthority.expiry_time:type_name -> google.protobuf.Timestamp
+	5,  // 16: cerc.registry.v1.NameAuthority.pending_transfer:type_name -> cerc.registry.v1.AuthorityTransfer
+	16, // 17: cerc.registry.v1.NameAccessGrant.expiry_time:type_name -> google.protobuf.Timestamp
+	8,  // 18: cerc.registry.v1.NameEntry.entry:type_name -> cerc.registry.v1.NameRecord
+	9,  // 19: cerc.registry.v1.NameRecord.latest:type_name -> cerc.registry.v1.NameRecordEntry
+	9,  // 20: cerc.registry.v1.NameRecord.history:type_name -> cerc.registry.v1.NameRecordEntry
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_cerc_registry_v1_registry_proto_init() }
@@ -7550,7 +11375,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorityEntry); i {
+			switch v := v.(*RecordRent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7562,7 +11387,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NameAuthority); i {
+			switch v := v.(*AuthorityEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7574,7 +11399,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NameEntry); i {
+			switch v := v.(*NameAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7586,7 +11411,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NameRecord); i {
+			switch v := v.(*AuthorityTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7598,7 +11423,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NameRecordEntry); i {
+			switch v := v.(*NameAccessGrant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7610,7 +11435,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Signature); i {
+			switch v := v.(*NameEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7622,7 +11447,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpiryQueue); i {
+			switch v := v.(*NameRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7634,6 +11459,42 @@ func file_cerc_registry_v1_registry_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_registry_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameRecordEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_registry_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Signature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_registry_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiryQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_registry_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecordsList); i {
 			case 0:
 				return &v.state
@@ -7645,6 +11506,18 @@ func file_cerc_registry_v1_registry_proto_init() {
 				return nil
 			}
 		}
+		file_cerc_registry_v1_registry_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7652,7 +11525,7 @@ func file_cerc_registry_v1_registry_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cerc_registry_v1_registry_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
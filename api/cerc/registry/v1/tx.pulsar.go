@@ -10,16 +10,20 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_MsgSetRecord         protoreflect.MessageDescriptor
-	fd_MsgSetRecord_bond_id protoreflect.FieldDescriptor
-	fd_MsgSetRecord_signer  protoreflect.FieldDescriptor
-	fd_MsgSetRecord_payload protoreflect.FieldDescriptor
+	md_MsgSetRecord                 protoreflect.MessageDescriptor
+	fd_MsgSetRecord_bond_id         protoreflect.FieldDescriptor
+	fd_MsgSetRecord_signer          protoreflect.FieldDescriptor
+	fd_MsgSetRecord_payload         protoreflect.FieldDescriptor
+	fd_MsgSetRecord_lifetime        protoreflect.FieldDescriptor
+	fd_MsgSetRecord_owner_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +32,8 @@ func init() {
 	fd_MsgSetRecord_bond_id = md_MsgSetRecord.Fields().ByName("bond_id")
 	fd_MsgSetRecord_signer = md_MsgSetRecord.Fields().ByName("signer")
 	fd_MsgSetRecord_payload = md_MsgSetRecord.Fields().ByName("payload")
+	fd_MsgSetRecord_lifetime = md_MsgSetRecord.Fields().ByName("lifetime")
+	fd_MsgSetRecord_owner_threshold = md_MsgSetRecord.Fields().ByName("owner_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSetRecord)(nil)
@@ -113,6 +119,18 @@ func (x *fastReflection_MsgSetRecord) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Lifetime != nil {
+		value := protoreflect.ValueOfMessage(x.Lifetime.ProtoReflect())
+		if !f(fd_MsgSetRecord_lifetime, value) {
+			return
+		}
+	}
+	if x.OwnerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OwnerThreshold)
+		if !f(fd_MsgSetRecord_owner_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -134,6 +152,10 @@ func (x *fastReflection_MsgSetRecord) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Signer != ""
 	case "cerc.registry.v1.MsgSetRecord.payload":
 		return x.Payload != nil
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		return x.Lifetime != nil
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		return x.OwnerThreshold != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -156,6 +178,10 @@ func (x *fastReflection_MsgSetRecord) Clear(fd protoreflect.FieldDescriptor) {
 		x.Signer = ""
 	case "cerc.registry.v1.MsgSetRecord.payload":
 		x.Payload = nil
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		x.Lifetime = nil
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		x.OwnerThreshold = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -181,6 +207,12 @@ func (x *fastReflection_MsgSetRecord) Get(descriptor protoreflect.FieldDescripto
 	case "cerc.registry.v1.MsgSetRecord.payload":
 		value := x.Payload
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		value := x.Lifetime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		value := x.OwnerThreshold
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -207,6 +239,10 @@ func (x *fastReflection_MsgSetRecord) Set(fd protoreflect.FieldDescriptor, value
 		x.Signer = value.Interface().(string)
 	case "cerc.registry.v1.MsgSetRecord.payload":
 		x.Payload = value.Message().Interface().(*Payload)
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		x.Lifetime = value.Message().Interface().(*durationpb.Duration)
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		x.OwnerThreshold = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -232,10 +268,17 @@ func (x *fastReflection_MsgSetRecord) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Payload = new(Payload)
 		}
 		return protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		if x.Lifetime == nil {
+			x.Lifetime = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Lifetime.ProtoReflect())
 	case "cerc.registry.v1.MsgSetRecord.bond_id":
 		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgSetRecord is not mutable"))
 	case "cerc.registry.v1.MsgSetRecord.signer":
 		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgSetRecord is not mutable"))
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		panic(fmt.Errorf("field owner_threshold of message cerc.registry.v1.MsgSetRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -256,6 +299,11 @@ func (x *fastReflection_MsgSetRecord) NewField(fd protoreflect.FieldDescriptor)
 	case "cerc.registry.v1.MsgSetRecord.payload":
 		m := new(Payload)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.MsgSetRecord.lifetime":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.MsgSetRecord.owner_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetRecord"))
@@ -337,6 +385,13 @@ func (x *fastReflection_MsgSetRecord) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Payload)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Lifetime != nil {
+			l = options.Size(x.Lifetime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OwnerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -366,6 +421,25 @@ func (x *fastReflection_MsgSetRecord) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.OwnerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerThreshold))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Lifetime != nil {
+			encoded, err := options.Marshal(x.Lifetime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.Payload != nil {
 			encoded, err := options.Marshal(x.Payload)
 			if err != nil {
@@ -543,6 +617,61 @@ func (x *fastReflection_MsgSetRecord) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Lifetime == nil {
+					x.Lifetime = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Lifetime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+				}
+				x.OwnerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OwnerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -998,79 +1127,83 @@ func (x *fastReflection_MsgSetRecordResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_Payload_2_list)(nil)
+var _ protoreflect.List = (*_MsgUpdateRecord_4_list)(nil)
 
-type _Payload_2_list struct {
+type _MsgUpdateRecord_4_list struct {
 	list *[]*Signature
 }
 
-func (x *_Payload_2_list) Len() int {
+func (x *_MsgUpdateRecord_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Payload_2_list) Get(i int) protoreflect.Value {
+func (x *_MsgUpdateRecord_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_Payload_2_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgUpdateRecord_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Signature)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Payload_2_list) Append(value protoreflect.Value) {
+func (x *_MsgUpdateRecord_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
 	concreteValue := valueUnwrapped.Interface().(*Signature)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Payload_2_list) AppendMutable() protoreflect.Value {
+func (x *_MsgUpdateRecord_4_list) AppendMutable() protoreflect.Value {
 	v := new(Signature)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Payload_2_list) Truncate(n int) {
+func (x *_MsgUpdateRecord_4_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Payload_2_list) NewElement() protoreflect.Value {
+func (x *_MsgUpdateRecord_4_list) NewElement() protoreflect.Value {
 	v := new(Signature)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Payload_2_list) IsValid() bool {
+func (x *_MsgUpdateRecord_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Payload            protoreflect.MessageDescriptor
-	fd_Payload_record     protoreflect.FieldDescriptor
-	fd_Payload_signatures protoreflect.FieldDescriptor
+	md_MsgUpdateRecord            protoreflect.MessageDescriptor
+	fd_MsgUpdateRecord_record_id  protoreflect.FieldDescriptor
+	fd_MsgUpdateRecord_signer     protoreflect.FieldDescriptor
+	fd_MsgUpdateRecord_payload    protoreflect.FieldDescriptor
+	fd_MsgUpdateRecord_signatures protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_Payload = File_cerc_registry_v1_tx_proto.Messages().ByName("Payload")
-	fd_Payload_record = md_Payload.Fields().ByName("record")
-	fd_Payload_signatures = md_Payload.Fields().ByName("signatures")
+	md_MsgUpdateRecord = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgUpdateRecord")
+	fd_MsgUpdateRecord_record_id = md_MsgUpdateRecord.Fields().ByName("record_id")
+	fd_MsgUpdateRecord_signer = md_MsgUpdateRecord.Fields().ByName("signer")
+	fd_MsgUpdateRecord_payload = md_MsgUpdateRecord.Fields().ByName("payload")
+	fd_MsgUpdateRecord_signatures = md_MsgUpdateRecord.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_Payload)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateRecord)(nil)
 
-type fastReflection_Payload Payload
+type fastReflection_MsgUpdateRecord MsgUpdateRecord
 
-func (x *Payload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Payload)(x)
+func (x *MsgUpdateRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRecord)(x)
 }
 
-func (x *Payload) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateRecord) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,43 +1215,43 @@ func (x *Payload) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Payload_messageType fastReflection_Payload_messageType
-var _ protoreflect.MessageType = fastReflection_Payload_messageType{}
+var _fastReflection_MsgUpdateRecord_messageType fastReflection_MsgUpdateRecord_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateRecord_messageType{}
 
-type fastReflection_Payload_messageType struct{}
+type fastReflection_MsgUpdateRecord_messageType struct{}
 
-func (x fastReflection_Payload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Payload)(nil)
+func (x fastReflection_MsgUpdateRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRecord)(nil)
 }
-func (x fastReflection_Payload_messageType) New() protoreflect.Message {
-	return new(fastReflection_Payload)
+func (x fastReflection_MsgUpdateRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRecord)
 }
-func (x fastReflection_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Payload
+func (x fastReflection_MsgUpdateRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRecord
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Payload) Descriptor() protoreflect.MessageDescriptor {
-	return md_Payload
+func (x *fastReflection_MsgUpdateRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRecord
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Payload) Type() protoreflect.MessageType {
-	return _fastReflection_Payload_messageType
+func (x *fastReflection_MsgUpdateRecord) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateRecord_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Payload) New() protoreflect.Message {
-	return new(fastReflection_Payload)
+func (x *fastReflection_MsgUpdateRecord) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRecord)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
-	return (*Payload)(x)
+func (x *fastReflection_MsgUpdateRecord) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateRecord)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1126,16 +1259,28 @@ func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Record != nil {
-		value := protoreflect.ValueOfMessage(x.Record.ProtoReflect())
-		if !f(fd_Payload_record, value) {
+func (x *fastReflection_MsgUpdateRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgUpdateRecord_record_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgUpdateRecord_signer, value) {
+			return
+		}
+	}
+	if x.Payload != nil {
+		value := protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+		if !f(fd_MsgUpdateRecord_payload, value) {
 			return
 		}
 	}
 	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_Payload_2_list{list: &x.Signatures})
-		if !f(fd_Payload_signatures, value) {
+		value := protoreflect.ValueOfList(&_MsgUpdateRecord_4_list{list: &x.Signatures})
+		if !f(fd_MsgUpdateRecord_signatures, value) {
 			return
 		}
 	}
@@ -1152,17 +1297,21 @@ func (x *fastReflection_Payload) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Payload) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateRecord) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		return x.Record != nil
-	case "cerc.registry.v1.Payload.signatures":
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		return x.Signer != ""
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		return x.Payload != nil
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1172,17 +1321,21 @@ func (x *fastReflection_Payload) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Payload) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateRecord) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		x.Record = nil
-	case "cerc.registry.v1.Payload.signatures":
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		x.Signer = ""
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		x.Payload = nil
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1192,22 +1345,28 @@ func (x *fastReflection_Payload) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Payload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		value := x.Record
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		value := x.Payload
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cerc.registry.v1.Payload.signatures":
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_Payload_2_list{})
+			return protoreflect.ValueOfList(&_MsgUpdateRecord_4_list{})
 		}
-		listValue := &_Payload_2_list{list: &x.Signatures}
+		listValue := &_MsgUpdateRecord_4_list{list: &x.Signatures}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1221,19 +1380,23 @@ func (x *fastReflection_Payload) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Payload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		x.Record = value.Message().Interface().(*Record)
-	case "cerc.registry.v1.Payload.signatures":
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		x.Payload = value.Message().Interface().(*Payload)
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		lv := value.List()
-		clv := lv.(*_Payload_2_list)
+		clv := lv.(*_MsgUpdateRecord_4_list)
 		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1247,53 +1410,61 @@ func (x *fastReflection_Payload) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Payload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		if x.Record == nil {
-			x.Record = new(Record)
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		if x.Payload == nil {
+			x.Payload = new(Payload)
 		}
-		return protoreflect.ValueOfMessage(x.Record.ProtoReflect())
-	case "cerc.registry.v1.Payload.signatures":
+		return protoreflect.ValueOfMessage(x.Payload.ProtoReflect())
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		if x.Signatures == nil {
 			x.Signatures = []*Signature{}
 		}
-		value := &_Payload_2_list{list: &x.Signatures}
+		value := &_MsgUpdateRecord_4_list{list: &x.Signatures}
 		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgUpdateRecord is not mutable"))
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgUpdateRecord is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Payload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.Payload.record":
-		m := new(Record)
+	case "cerc.registry.v1.MsgUpdateRecord.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgUpdateRecord.signer":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgUpdateRecord.payload":
+		m := new(Payload)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cerc.registry.v1.Payload.signatures":
+	case "cerc.registry.v1.MsgUpdateRecord.signatures":
 		list := []*Signature{}
-		return protoreflect.ValueOfList(&_Payload_2_list{list: &list})
+		return protoreflect.ValueOfList(&_MsgUpdateRecord_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecord"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecord does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Payload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.Payload", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgUpdateRecord", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1301,7 +1472,7 @@ func (x *fastReflection_Payload) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Payload) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateRecord) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1312,7 +1483,7 @@ func (x *fastReflection_Payload) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Payload) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateRecord) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1324,7 +1495,7 @@ func (x *fastReflection_Payload) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Payload) IsValid() bool {
+func (x *fastReflection_MsgUpdateRecord) IsValid() bool {
 	return x != nil
 }
 
@@ -1334,9 +1505,9 @@ func (x *fastReflection_Payload) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateRecord) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Payload)
+		x := input.Message.Interface().(*MsgUpdateRecord)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1348,8 +1519,16 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Record != nil {
-			l = options.Size(x.Record)
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Payload != nil {
+			l = options.Size(x.Payload)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if len(x.Signatures) > 0 {
@@ -1368,7 +1547,7 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Payload)
+		x := input.Message.Interface().(*MsgUpdateRecord)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1400,11 +1579,11 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0x12
+				dAtA[i] = 0x22
 			}
 		}
-		if x.Record != nil {
-			encoded, err := options.Marshal(x.Record)
+		if x.Payload != nil {
+			encoded, err := options.Marshal(x.Payload)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1415,6 +1594,20 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -1428,7 +1621,7 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Payload)
+		x := input.Message.Interface().(*MsgUpdateRecord)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1460,17 +1653,17 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Payload: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRecord: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1480,33 +1673,29 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Record == nil {
-					x.Record = &Record{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Record); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1516,12 +1705,80 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Payload == nil {
+					x.Payload = &Payload{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Payload); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				postIndex := iNdEx + msglen
@@ -1572,29 +1829,25 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSetName        protoreflect.MessageDescriptor
-	fd_MsgSetName_lrn    protoreflect.FieldDescriptor
-	fd_MsgSetName_cid    protoreflect.FieldDescriptor
-	fd_MsgSetName_signer protoreflect.FieldDescriptor
+	md_MsgUpdateRecordResponse    protoreflect.MessageDescriptor
+	fd_MsgUpdateRecordResponse_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgSetName = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetName")
-	fd_MsgSetName_lrn = md_MsgSetName.Fields().ByName("lrn")
-	fd_MsgSetName_cid = md_MsgSetName.Fields().ByName("cid")
-	fd_MsgSetName_signer = md_MsgSetName.Fields().ByName("signer")
+	md_MsgUpdateRecordResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgUpdateRecordResponse")
+	fd_MsgUpdateRecordResponse_id = md_MsgUpdateRecordResponse.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetName)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateRecordResponse)(nil)
 
-type fastReflection_MsgSetName MsgSetName
+type fastReflection_MsgUpdateRecordResponse MsgUpdateRecordResponse
 
-func (x *MsgSetName) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetName)(x)
+func (x *MsgUpdateRecordResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRecordResponse)(x)
 }
 
-func (x *MsgSetName) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateRecordResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1606,43 +1859,43 @@ func (x *MsgSetName) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetName_messageType fastReflection_MsgSetName_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetName_messageType{}
+var _fastReflection_MsgUpdateRecordResponse_messageType fastReflection_MsgUpdateRecordResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateRecordResponse_messageType{}
 
-type fastReflection_MsgSetName_messageType struct{}
+type fastReflection_MsgUpdateRecordResponse_messageType struct{}
 
-func (x fastReflection_MsgSetName_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetName)(nil)
+func (x fastReflection_MsgUpdateRecordResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRecordResponse)(nil)
 }
-func (x fastReflection_MsgSetName_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetName)
+func (x fastReflection_MsgUpdateRecordResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRecordResponse)
 }
-func (x fastReflection_MsgSetName_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetName
+func (x fastReflection_MsgUpdateRecordResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRecordResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetName) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetName
+func (x *fastReflection_MsgUpdateRecordResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRecordResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetName) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetName_messageType
+func (x *fastReflection_MsgUpdateRecordResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateRecordResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetName) New() protoreflect.Message {
-	return new(fastReflection_MsgSetName)
+func (x *fastReflection_MsgUpdateRecordResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRecordResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetName) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetName)(x)
+func (x *fastReflection_MsgUpdateRecordResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateRecordResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1650,22 +1903,10 @@ func (x *fastReflection_MsgSetName) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetName) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Lrn != "" {
-		value := protoreflect.ValueOfString(x.Lrn)
-		if !f(fd_MsgSetName_lrn, value) {
-			return
-		}
-	}
-	if x.Cid != "" {
-		value := protoreflect.ValueOfString(x.Cid)
-		if !f(fd_MsgSetName_cid, value) {
-			return
-		}
-	}
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgSetName_signer, value) {
+func (x *fastReflection_MsgUpdateRecordResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != "" {
+		value := protoreflect.ValueOfString(x.Id)
+		if !f(fd_MsgUpdateRecordResponse_id, value) {
 			return
 		}
 	}
@@ -1682,19 +1923,15 @@ func (x *fastReflection_MsgSetName) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetName) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateRecordResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		return x.Lrn != ""
-	case "cerc.registry.v1.MsgSetName.cid":
-		return x.Cid != ""
-	case "cerc.registry.v1.MsgSetName.signer":
-		return x.Signer != ""
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
+		return x.Id != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1704,19 +1941,15 @@ func (x *fastReflection_MsgSetName) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetName) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateRecordResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		x.Lrn = ""
-	case "cerc.registry.v1.MsgSetName.cid":
-		x.Cid = ""
-	case "cerc.registry.v1.MsgSetName.signer":
-		x.Signer = ""
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
+		x.Id = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1726,22 +1959,16 @@ func (x *fastReflection_MsgSetName) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetName) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecordResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		value := x.Lrn
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgSetName.cid":
-		value := x.Cid
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgSetName.signer":
-		value := x.Signer
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
+		value := x.Id
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1755,19 +1982,15 @@ func (x *fastReflection_MsgSetName) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetName) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateRecordResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		x.Lrn = value.Interface().(string)
-	case "cerc.registry.v1.MsgSetName.cid":
-		x.Cid = value.Interface().(string)
-	case "cerc.registry.v1.MsgSetName.signer":
-		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
+		x.Id = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1781,48 +2004,40 @@ func (x *fastReflection_MsgSetName) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetName) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecordResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		panic(fmt.Errorf("field lrn of message cerc.registry.v1.MsgSetName is not mutable"))
-	case "cerc.registry.v1.MsgSetName.cid":
-		panic(fmt.Errorf("field cid of message cerc.registry.v1.MsgSetName is not mutable"))
-	case "cerc.registry.v1.MsgSetName.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgSetName is not mutable"))
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
+		panic(fmt.Errorf("field id of message cerc.registry.v1.MsgUpdateRecordResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetName) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRecordResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetName.lrn":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgSetName.cid":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgSetName.signer":
+	case "cerc.registry.v1.MsgUpdateRecordResponse.id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgUpdateRecordResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgUpdateRecordResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetName) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateRecordResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetName", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgUpdateRecordResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1830,7 +2045,7 @@ func (x *fastReflection_MsgSetName) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetName) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateRecordResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1841,7 +2056,7 @@ func (x *fastReflection_MsgSetName) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetName) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateRecordResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1853,7 +2068,7 @@ func (x *fastReflection_MsgSetName) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetName) IsValid() bool {
+func (x *fastReflection_MsgUpdateRecordResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -1863,9 +2078,9 @@ func (x *fastReflection_MsgSetName) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateRecordResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetName)
+		x := input.Message.Interface().(*MsgUpdateRecordResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1877,15 +2092,7 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Lrn)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Cid)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Signer)
+		l = len(x.Id)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1899,7 +2106,7 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetName)
+		x := input.Message.Interface().(*MsgUpdateRecordResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1918,24 +2125,10 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signer) > 0 {
-			i -= len(x.Signer)
-			copy(dAtA[i:], x.Signer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Cid) > 0 {
-			i -= len(x.Cid)
-			copy(dAtA[i:], x.Cid)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cid)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Lrn) > 0 {
-			i -= len(x.Lrn)
-			copy(dAtA[i:], x.Lrn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lrn)))
+		if len(x.Id) > 0 {
+			i -= len(x.Id)
+			copy(dAtA[i:], x.Id)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Id)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1950,7 +2143,7 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetName)
+		x := input.Message.Interface().(*MsgUpdateRecordResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1982,15 +2175,15 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetName: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRecordResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetName: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2018,82 +2211,18 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Lrn = string(dAtA[iNdEx:postIndex])
+				x.Id = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Cid = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -2119,24 +2248,79 @@ func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Payload_2_list)(nil)
+
+type _Payload_2_list struct {
+	list *[]*Signature
+}
+
+func (x *_Payload_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Payload_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Payload_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Payload_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Payload_2_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Payload_2_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgSetNameResponse protoreflect.MessageDescriptor
+	md_Payload            protoreflect.MessageDescriptor
+	fd_Payload_record     protoreflect.FieldDescriptor
+	fd_Payload_signatures protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgSetNameResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetNameResponse")
+	md_Payload = File_cerc_registry_v1_tx_proto.Messages().ByName("Payload")
+	fd_Payload_record = md_Payload.Fields().ByName("record")
+	fd_Payload_signatures = md_Payload.Fields().ByName("signatures")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetNameResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_Payload)(nil)
 
-type fastReflection_MsgSetNameResponse MsgSetNameResponse
+type fastReflection_Payload Payload
 
-func (x *MsgSetNameResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetNameResponse)(x)
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Payload)(x)
 }
 
-func (x *MsgSetNameResponse) slowProtoReflect() protoreflect.Message {
+func (x *Payload) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2148,43 +2332,43 @@ func (x *MsgSetNameResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetNameResponse_messageType fastReflection_MsgSetNameResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetNameResponse_messageType{}
+var _fastReflection_Payload_messageType fastReflection_Payload_messageType
+var _ protoreflect.MessageType = fastReflection_Payload_messageType{}
 
-type fastReflection_MsgSetNameResponse_messageType struct{}
+type fastReflection_Payload_messageType struct{}
 
-func (x fastReflection_MsgSetNameResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetNameResponse)(nil)
+func (x fastReflection_Payload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Payload)(nil)
 }
-func (x fastReflection_MsgSetNameResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetNameResponse)
+func (x fastReflection_Payload_messageType) New() protoreflect.Message {
+	return new(fastReflection_Payload)
 }
-func (x fastReflection_MsgSetNameResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetNameResponse
+func (x fastReflection_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Payload
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetNameResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetNameResponse
+func (x *fastReflection_Payload) Descriptor() protoreflect.MessageDescriptor {
+	return md_Payload
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetNameResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetNameResponse_messageType
+func (x *fastReflection_Payload) Type() protoreflect.MessageType {
+	return _fastReflection_Payload_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetNameResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetNameResponse)
+func (x *fastReflection_Payload) New() protoreflect.Message {
+	return new(fastReflection_Payload)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetNameResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetNameResponse)(x)
+func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
+	return (*Payload)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2192,7 +2376,19 @@ func (x *fastReflection_MsgSetNameResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetNameResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Record != nil {
+		value := protoreflect.ValueOfMessage(x.Record.ProtoReflect())
+		if !f(fd_Payload_record, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_Payload_2_list{list: &x.Signatures})
+		if !f(fd_Payload_signatures, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2206,13 +2402,17 @@ func (x *fastReflection_MsgSetNameResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetNameResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Payload) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		return x.Record != nil
+	case "cerc.registry.v1.Payload.signatures":
+		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2222,13 +2422,17 @@ func (x *fastReflection_MsgSetNameResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetNameResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Payload) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		x.Record = nil
+	case "cerc.registry.v1.Payload.signatures":
+		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2238,13 +2442,22 @@ func (x *fastReflection_MsgSetNameResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetNameResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Payload) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		value := x.Record
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.Payload.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_Payload_2_list{})
+		}
+		listValue := &_Payload_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2258,13 +2471,19 @@ func (x *fastReflection_MsgSetNameResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetNameResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Payload) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		x.Record = value.Message().Interface().(*Record)
+	case "cerc.registry.v1.Payload.signatures":
+		lv := value.List()
+		clv := lv.(*_Payload_2_list)
+		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2278,36 +2497,53 @@ func (x *fastReflection_MsgSetNameResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetNameResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Payload) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		if x.Record == nil {
+			x.Record = new(Record)
+		}
+		return protoreflect.ValueOfMessage(x.Record.ProtoReflect())
+	case "cerc.registry.v1.Payload.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_Payload_2_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetNameResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Payload) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cerc.registry.v1.Payload.record":
+		m := new(Record)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.Payload.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_Payload_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.Payload"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.Payload does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetNameResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Payload) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetNameResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.Payload", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2315,7 +2551,7 @@ func (x *fastReflection_MsgSetNameResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetNameResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Payload) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2326,7 +2562,7 @@ func (x *fastReflection_MsgSetNameResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetNameResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Payload) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2338,7 +2574,7 @@ func (x *fastReflection_MsgSetNameResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetNameResponse) IsValid() bool {
+func (x *fastReflection_Payload) IsValid() bool {
 	return x != nil
 }
 
@@ -2348,9 +2584,9 @@ func (x *fastReflection_MsgSetNameResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetNameResponse)
+		x := input.Message.Interface().(*Payload)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2362,8 +2598,18 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.Record != nil {
+			l = options.Size(x.Record)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2372,7 +2618,7 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetNameResponse)
+		x := input.Message.Interface().(*Payload)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2391,6 +2637,36 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Record != nil {
+			encoded, err := options.Marshal(x.Record)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2402,7 +2678,7 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetNameResponse)
+		x := input.Message.Interface().(*Payload)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2434,12 +2710,82 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetNameResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Payload: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Record == nil {
+					x.Record = &Record{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Record); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2476,29 +2822,29 @@ func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgReserveAuthority        protoreflect.MessageDescriptor
-	fd_MsgReserveAuthority_name   protoreflect.FieldDescriptor
-	fd_MsgReserveAuthority_signer protoreflect.FieldDescriptor
-	fd_MsgReserveAuthority_owner  protoreflect.FieldDescriptor
+	md_MsgSetName        protoreflect.MessageDescriptor
+	fd_MsgSetName_lrn    protoreflect.FieldDescriptor
+	fd_MsgSetName_cid    protoreflect.FieldDescriptor
+	fd_MsgSetName_signer protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgReserveAuthority = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReserveAuthority")
-	fd_MsgReserveAuthority_name = md_MsgReserveAuthority.Fields().ByName("name")
-	fd_MsgReserveAuthority_signer = md_MsgReserveAuthority.Fields().ByName("signer")
-	fd_MsgReserveAuthority_owner = md_MsgReserveAuthority.Fields().ByName("owner")
+	md_MsgSetName = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetName")
+	fd_MsgSetName_lrn = md_MsgSetName.Fields().ByName("lrn")
+	fd_MsgSetName_cid = md_MsgSetName.Fields().ByName("cid")
+	fd_MsgSetName_signer = md_MsgSetName.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgReserveAuthority)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetName)(nil)
 
-type fastReflection_MsgReserveAuthority MsgReserveAuthority
+type fastReflection_MsgSetName MsgSetName
 
-func (x *MsgReserveAuthority) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgReserveAuthority)(x)
+func (x *MsgSetName) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetName)(x)
 }
 
-func (x *MsgReserveAuthority) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetName) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2510,43 +2856,43 @@ func (x *MsgReserveAuthority) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgReserveAuthority_messageType fastReflection_MsgReserveAuthority_messageType
-var _ protoreflect.MessageType = fastReflection_MsgReserveAuthority_messageType{}
+var _fastReflection_MsgSetName_messageType fastReflection_MsgSetName_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetName_messageType{}
 
-type fastReflection_MsgReserveAuthority_messageType struct{}
+type fastReflection_MsgSetName_messageType struct{}
 
-func (x fastReflection_MsgReserveAuthority_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgReserveAuthority)(nil)
+func (x fastReflection_MsgSetName_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetName)(nil)
 }
-func (x fastReflection_MsgReserveAuthority_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgReserveAuthority)
+func (x fastReflection_MsgSetName_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetName)
 }
-func (x fastReflection_MsgReserveAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReserveAuthority
+func (x fastReflection_MsgSetName_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetName
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgReserveAuthority) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReserveAuthority
+func (x *fastReflection_MsgSetName) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetName
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgReserveAuthority) Type() protoreflect.MessageType {
-	return _fastReflection_MsgReserveAuthority_messageType
+func (x *fastReflection_MsgSetName) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetName_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgReserveAuthority) New() protoreflect.Message {
-	return new(fastReflection_MsgReserveAuthority)
+func (x *fastReflection_MsgSetName) New() protoreflect.Message {
+	return new(fastReflection_MsgSetName)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgReserveAuthority) Interface() protoreflect.ProtoMessage {
-	return (*MsgReserveAuthority)(x)
+func (x *fastReflection_MsgSetName) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetName)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2554,22 +2900,22 @@ func (x *fastReflection_MsgReserveAuthority) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgReserveAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgReserveAuthority_name, value) {
+func (x *fastReflection_MsgSetName) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lrn != "" {
+		value := protoreflect.ValueOfString(x.Lrn)
+		if !f(fd_MsgSetName_lrn, value) {
 			return
 		}
 	}
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgReserveAuthority_signer, value) {
+	if x.Cid != "" {
+		value := protoreflect.ValueOfString(x.Cid)
+		if !f(fd_MsgSetName_cid, value) {
 			return
 		}
 	}
-	if x.Owner != "" {
-		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_MsgReserveAuthority_owner, value) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgSetName_signer, value) {
 			return
 		}
 	}
@@ -2586,19 +2932,19 @@ func (x *fastReflection_MsgReserveAuthority) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgReserveAuthority) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetName) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
-		return x.Name != ""
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
+	case "cerc.registry.v1.MsgSetName.lrn":
+		return x.Lrn != ""
+	case "cerc.registry.v1.MsgSetName.cid":
+		return x.Cid != ""
+	case "cerc.registry.v1.MsgSetName.signer":
 		return x.Signer != ""
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
-		return x.Owner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2608,19 +2954,19 @@ func (x *fastReflection_MsgReserveAuthority) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthority) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetName) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
-		x.Name = ""
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
+	case "cerc.registry.v1.MsgSetName.lrn":
+		x.Lrn = ""
+	case "cerc.registry.v1.MsgSetName.cid":
+		x.Cid = ""
+	case "cerc.registry.v1.MsgSetName.signer":
 		x.Signer = ""
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
-		x.Owner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2630,22 +2976,22 @@ func (x *fastReflection_MsgReserveAuthority) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgReserveAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetName) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
-		value := x.Name
+	case "cerc.registry.v1.MsgSetName.lrn":
+		value := x.Lrn
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
-		value := x.Signer
+	case "cerc.registry.v1.MsgSetName.cid":
+		value := x.Cid
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
-		value := x.Owner
+	case "cerc.registry.v1.MsgSetName.signer":
+		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2659,19 +3005,19 @@ func (x *fastReflection_MsgReserveAuthority) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetName) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
-		x.Name = value.Interface().(string)
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
-		x.Signer = value.Interface().(string)
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
-		x.Owner = value.Interface().(string)
-	default:
+	case "cerc.registry.v1.MsgSetName.lrn":
+		x.Lrn = value.Interface().(string)
+	case "cerc.registry.v1.MsgSetName.cid":
+		x.Cid = value.Interface().(string)
+	case "cerc.registry.v1.MsgSetName.signer":
+		x.Signer = value.Interface().(string)
+	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2685,48 +3031,48 @@ func (x *fastReflection_MsgReserveAuthority) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetName) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
-		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
-		panic(fmt.Errorf("field owner of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
+	case "cerc.registry.v1.MsgSetName.lrn":
+		panic(fmt.Errorf("field lrn of message cerc.registry.v1.MsgSetName is not mutable"))
+	case "cerc.registry.v1.MsgSetName.cid":
+		panic(fmt.Errorf("field cid of message cerc.registry.v1.MsgSetName is not mutable"))
+	case "cerc.registry.v1.MsgSetName.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgSetName is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgReserveAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetName) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReserveAuthority.name":
+	case "cerc.registry.v1.MsgSetName.lrn":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgReserveAuthority.signer":
+	case "cerc.registry.v1.MsgSetName.cid":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgReserveAuthority.owner":
+	case "cerc.registry.v1.MsgSetName.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetName"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetName does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgReserveAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetName) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReserveAuthority", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetName", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2734,7 +3080,7 @@ func (x *fastReflection_MsgReserveAuthority) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgReserveAuthority) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetName) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2745,7 +3091,7 @@ func (x *fastReflection_MsgReserveAuthority) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthority) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetName) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2757,7 +3103,7 @@ func (x *fastReflection_MsgReserveAuthority) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgReserveAuthority) IsValid() bool {
+func (x *fastReflection_MsgSetName) IsValid() bool {
 	return x != nil
 }
 
@@ -2767,9 +3113,9 @@ func (x *fastReflection_MsgReserveAuthority) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetName) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgReserveAuthority)
+		x := input.Message.Interface().(*MsgSetName)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2781,15 +3127,15 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		l = len(x.Lrn)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signer)
+		l = len(x.Cid)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Owner)
+		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2803,7 +3149,7 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReserveAuthority)
+		x := input.Message.Interface().(*MsgSetName)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2822,24 +3168,24 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Owner) > 0 {
-			i -= len(x.Owner)
-			copy(dAtA[i:], x.Owner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
-			i--
-			dAtA[i] = 0x1a
-		}
 		if len(x.Signer) > 0 {
 			i -= len(x.Signer)
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Cid) > 0 {
+			i -= len(x.Cid)
+			copy(dAtA[i:], x.Cid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cid)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if len(x.Lrn) > 0 {
+			i -= len(x.Lrn)
+			copy(dAtA[i:], x.Lrn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lrn)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2854,7 +3200,7 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReserveAuthority)
+		x := input.Message.Interface().(*MsgSetName)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2886,15 +3232,15 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthority: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetName: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetName: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2922,11 +3268,11 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Lrn = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2954,11 +3300,11 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
+				x.Cid = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2986,7 +3332,7 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Owner = string(dAtA[iNdEx:postIndex])
+				x.Signer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3024,23 +3370,23 @@ func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgReserveAuthorityResponse protoreflect.MessageDescriptor
+	md_MsgSetNameResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgReserveAuthorityResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReserveAuthorityResponse")
+	md_MsgSetNameResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetNameResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgReserveAuthorityResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetNameResponse)(nil)
 
-type fastReflection_MsgReserveAuthorityResponse MsgReserveAuthorityResponse
+type fastReflection_MsgSetNameResponse MsgSetNameResponse
 
-func (x *MsgReserveAuthorityResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgReserveAuthorityResponse)(x)
+func (x *MsgSetNameResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetNameResponse)(x)
 }
 
-func (x *MsgReserveAuthorityResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetNameResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3052,43 +3398,43 @@ func (x *MsgReserveAuthorityResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgReserveAuthorityResponse_messageType fastReflection_MsgReserveAuthorityResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgReserveAuthorityResponse_messageType{}
+var _fastReflection_MsgSetNameResponse_messageType fastReflection_MsgSetNameResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetNameResponse_messageType{}
 
-type fastReflection_MsgReserveAuthorityResponse_messageType struct{}
+type fastReflection_MsgSetNameResponse_messageType struct{}
 
-func (x fastReflection_MsgReserveAuthorityResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgReserveAuthorityResponse)(nil)
+func (x fastReflection_MsgSetNameResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetNameResponse)(nil)
 }
-func (x fastReflection_MsgReserveAuthorityResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgReserveAuthorityResponse)
+func (x fastReflection_MsgSetNameResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetNameResponse)
 }
-func (x fastReflection_MsgReserveAuthorityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReserveAuthorityResponse
+func (x fastReflection_MsgSetNameResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetNameResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgReserveAuthorityResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReserveAuthorityResponse
+func (x *fastReflection_MsgSetNameResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetNameResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgReserveAuthorityResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgReserveAuthorityResponse_messageType
+func (x *fastReflection_MsgSetNameResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetNameResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgReserveAuthorityResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgReserveAuthorityResponse)
+func (x *fastReflection_MsgSetNameResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetNameResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgReserveAuthorityResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgReserveAuthorityResponse)(x)
+func (x *fastReflection_MsgSetNameResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetNameResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3096,7 +3442,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgReserveAuthorityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSetNameResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3110,13 +3456,13 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgReserveAuthorityResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetNameResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3126,13 +3472,13 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthorityResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetNameResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3142,13 +3488,13 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgReserveAuthorityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetNameResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3162,13 +3508,13 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthorityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetNameResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3182,36 +3528,36 @@ func (x *fastReflection_MsgReserveAuthorityResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthorityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetNameResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgReserveAuthorityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetNameResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetNameResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetNameResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgReserveAuthorityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetNameResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReserveAuthorityResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetNameResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3219,7 +3565,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgReserveAuthorityResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetNameResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3230,7 +3576,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReserveAuthorityResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetNameResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3242,7 +3588,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgReserveAuthorityResponse) IsValid() bool {
+func (x *fastReflection_MsgSetNameResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3252,9 +3598,9 @@ func (x *fastReflection_MsgReserveAuthorityResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetNameResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
+		x := input.Message.Interface().(*MsgSetNameResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3276,7 +3622,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
+		x := input.Message.Interface().(*MsgSetNameResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3306,7 +3652,7 @@ func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
+		x := input.Message.Interface().(*MsgSetNameResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3338,10 +3684,10 @@ func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthorityResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetNameResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3380,29 +3726,29 @@ func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgSetAuthorityBond         protoreflect.MessageDescriptor
-	fd_MsgSetAuthorityBond_name    protoreflect.FieldDescriptor
-	fd_MsgSetAuthorityBond_bond_id protoreflect.FieldDescriptor
-	fd_MsgSetAuthorityBond_signer  protoreflect.FieldDescriptor
+	md_MsgReserveAuthority        protoreflect.MessageDescriptor
+	fd_MsgReserveAuthority_name   protoreflect.FieldDescriptor
+	fd_MsgReserveAuthority_signer protoreflect.FieldDescriptor
+	fd_MsgReserveAuthority_owner  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgSetAuthorityBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetAuthorityBond")
-	fd_MsgSetAuthorityBond_name = md_MsgSetAuthorityBond.Fields().ByName("name")
-	fd_MsgSetAuthorityBond_bond_id = md_MsgSetAuthorityBond.Fields().ByName("bond_id")
-	fd_MsgSetAuthorityBond_signer = md_MsgSetAuthorityBond.Fields().ByName("signer")
+	md_MsgReserveAuthority = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReserveAuthority")
+	fd_MsgReserveAuthority_name = md_MsgReserveAuthority.Fields().ByName("name")
+	fd_MsgReserveAuthority_signer = md_MsgReserveAuthority.Fields().ByName("signer")
+	fd_MsgReserveAuthority_owner = md_MsgReserveAuthority.Fields().ByName("owner")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetAuthorityBond)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgReserveAuthority)(nil)
 
-type fastReflection_MsgSetAuthorityBond MsgSetAuthorityBond
+type fastReflection_MsgReserveAuthority MsgReserveAuthority
 
-func (x *MsgSetAuthorityBond) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetAuthorityBond)(x)
+func (x *MsgReserveAuthority) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReserveAuthority)(x)
 }
 
-func (x *MsgSetAuthorityBond) slowProtoReflect() protoreflect.Message {
+func (x *MsgReserveAuthority) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3414,43 +3760,43 @@ func (x *MsgSetAuthorityBond) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetAuthorityBond_messageType fastReflection_MsgSetAuthorityBond_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetAuthorityBond_messageType{}
+var _fastReflection_MsgReserveAuthority_messageType fastReflection_MsgReserveAuthority_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReserveAuthority_messageType{}
 
-type fastReflection_MsgSetAuthorityBond_messageType struct{}
+type fastReflection_MsgReserveAuthority_messageType struct{}
 
-func (x fastReflection_MsgSetAuthorityBond_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetAuthorityBond)(nil)
+func (x fastReflection_MsgReserveAuthority_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReserveAuthority)(nil)
 }
-func (x fastReflection_MsgSetAuthorityBond_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetAuthorityBond)
+func (x fastReflection_MsgReserveAuthority_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReserveAuthority)
 }
-func (x fastReflection_MsgSetAuthorityBond_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetAuthorityBond
+func (x fastReflection_MsgReserveAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReserveAuthority
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetAuthorityBond) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetAuthorityBond
+func (x *fastReflection_MsgReserveAuthority) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReserveAuthority
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetAuthorityBond) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetAuthorityBond_messageType
+func (x *fastReflection_MsgReserveAuthority) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReserveAuthority_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetAuthorityBond) New() protoreflect.Message {
-	return new(fastReflection_MsgSetAuthorityBond)
+func (x *fastReflection_MsgReserveAuthority) New() protoreflect.Message {
+	return new(fastReflection_MsgReserveAuthority)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetAuthorityBond) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetAuthorityBond)(x)
+func (x *fastReflection_MsgReserveAuthority) Interface() protoreflect.ProtoMessage {
+	return (*MsgReserveAuthority)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3458,22 +3804,22 @@ func (x *fastReflection_MsgSetAuthorityBond) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetAuthorityBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgReserveAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgSetAuthorityBond_name, value) {
+		if !f(fd_MsgReserveAuthority_name, value) {
 			return
 		}
 	}
-	if x.BondId != "" {
-		value := protoreflect.ValueOfString(x.BondId)
-		if !f(fd_MsgSetAuthorityBond_bond_id, value) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgReserveAuthority_signer, value) {
 			return
 		}
 	}
-	if x.Signer != "" {
-		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgSetAuthorityBond_signer, value) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_MsgReserveAuthority_owner, value) {
 			return
 		}
 	}
@@ -3490,19 +3836,19 @@ func (x *fastReflection_MsgSetAuthorityBond) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetAuthorityBond) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgReserveAuthority) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+	case "cerc.registry.v1.MsgReserveAuthority.name":
 		return x.Name != ""
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
-		return x.BondId != ""
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
 		return x.Signer != ""
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
+		return x.Owner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3512,19 +3858,19 @@ func (x *fastReflection_MsgSetAuthorityBond) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBond) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgReserveAuthority) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+	case "cerc.registry.v1.MsgReserveAuthority.name":
 		x.Name = ""
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
-		x.BondId = ""
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
 		x.Signer = ""
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
+		x.Owner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3534,22 +3880,22 @@ func (x *fastReflection_MsgSetAuthorityBond) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetAuthorityBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+	case "cerc.registry.v1.MsgReserveAuthority.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
-		value := x.BondId
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3563,19 +3909,19 @@ func (x *fastReflection_MsgSetAuthorityBond) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgReserveAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+	case "cerc.registry.v1.MsgReserveAuthority.name":
 		x.Name = value.Interface().(string)
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
-		x.BondId = value.Interface().(string)
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
 		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
+		x.Owner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3589,48 +3935,48 @@ func (x *fastReflection_MsgSetAuthorityBond) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
-		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
-		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
+	case "cerc.registry.v1.MsgReserveAuthority.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
+		panic(fmt.Errorf("field owner of message cerc.registry.v1.MsgReserveAuthority is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetAuthorityBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+	case "cerc.registry.v1.MsgReserveAuthority.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+	case "cerc.registry.v1.MsgReserveAuthority.signer":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+	case "cerc.registry.v1.MsgReserveAuthority.owner":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetAuthorityBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgReserveAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetAuthorityBond", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReserveAuthority", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3638,7 +3984,7 @@ func (x *fastReflection_MsgSetAuthorityBond) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetAuthorityBond) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgReserveAuthority) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3649,7 +3995,7 @@ func (x *fastReflection_MsgSetAuthorityBond) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBond) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgReserveAuthority) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3661,7 +4007,7 @@ func (x *fastReflection_MsgSetAuthorityBond) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetAuthorityBond) IsValid() bool {
+func (x *fastReflection_MsgReserveAuthority) IsValid() bool {
 	return x != nil
 }
 
@@ -3671,9 +4017,9 @@ func (x *fastReflection_MsgSetAuthorityBond) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgReserveAuthority) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetAuthorityBond)
+		x := input.Message.Interface().(*MsgReserveAuthority)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3689,11 +4035,11 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BondId)
+		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Signer)
+		l = len(x.Owner)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3707,7 +4053,7 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetAuthorityBond)
+		x := input.Message.Interface().(*MsgReserveAuthority)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3726,18 +4072,18 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Signer) > 0 {
 			i -= len(x.Signer)
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BondId) > 0 {
-			i -= len(x.BondId)
-			copy(dAtA[i:], x.BondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
-			i--
 			dAtA[i] = 0x12
 		}
 		if len(x.Name) > 0 {
@@ -3758,7 +4104,7 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetAuthorityBond)
+		x := input.Message.Interface().(*MsgReserveAuthority)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3790,10 +4136,10 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBond: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthority: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBond: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3830,7 +4176,7 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3858,11 +4204,11 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondId = string(dAtA[iNdEx:postIndex])
+				x.Signer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3890,7 +4236,7 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
+				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3928,23 +4274,23 @@ func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgSetAuthorityBondResponse protoreflect.MessageDescriptor
+	md_MsgReserveAuthorityResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgSetAuthorityBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetAuthorityBondResponse")
+	md_MsgReserveAuthorityResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReserveAuthorityResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSetAuthorityBondResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgReserveAuthorityResponse)(nil)
 
-type fastReflection_MsgSetAuthorityBondResponse MsgSetAuthorityBondResponse
+type fastReflection_MsgReserveAuthorityResponse MsgReserveAuthorityResponse
 
-func (x *MsgSetAuthorityBondResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSetAuthorityBondResponse)(x)
+func (x *MsgReserveAuthorityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReserveAuthorityResponse)(x)
 }
 
-func (x *MsgSetAuthorityBondResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgReserveAuthorityResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3956,43 +4302,43 @@ func (x *MsgSetAuthorityBondResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSetAuthorityBondResponse_messageType fastReflection_MsgSetAuthorityBondResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSetAuthorityBondResponse_messageType{}
+var _fastReflection_MsgReserveAuthorityResponse_messageType fastReflection_MsgReserveAuthorityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReserveAuthorityResponse_messageType{}
 
-type fastReflection_MsgSetAuthorityBondResponse_messageType struct{}
+type fastReflection_MsgReserveAuthorityResponse_messageType struct{}
 
-func (x fastReflection_MsgSetAuthorityBondResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSetAuthorityBondResponse)(nil)
+func (x fastReflection_MsgReserveAuthorityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReserveAuthorityResponse)(nil)
 }
-func (x fastReflection_MsgSetAuthorityBondResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSetAuthorityBondResponse)
+func (x fastReflection_MsgReserveAuthorityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReserveAuthorityResponse)
 }
-func (x fastReflection_MsgSetAuthorityBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetAuthorityBondResponse
+func (x fastReflection_MsgReserveAuthorityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReserveAuthorityResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSetAuthorityBondResponse
+func (x *fastReflection_MsgReserveAuthorityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReserveAuthorityResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSetAuthorityBondResponse_messageType
+func (x *fastReflection_MsgReserveAuthorityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReserveAuthorityResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSetAuthorityBondResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSetAuthorityBondResponse)
+func (x *fastReflection_MsgReserveAuthorityResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReserveAuthorityResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSetAuthorityBondResponse)(x)
+func (x *fastReflection_MsgReserveAuthorityResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReserveAuthorityResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4000,7 +4346,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgReserveAuthorityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4014,13 +4360,13 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgReserveAuthorityResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4030,13 +4376,13 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgReserveAuthorityResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4046,13 +4392,13 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthorityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4066,13 +4412,13 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgReserveAuthorityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4086,36 +4432,36 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthorityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSetAuthorityBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgReserveAuthorityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReserveAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReserveAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSetAuthorityBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgReserveAuthorityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetAuthorityBondResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReserveAuthorityResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4123,7 +4469,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSetAuthorityBondResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgReserveAuthorityResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4134,7 +4480,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSetAuthorityBondResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgReserveAuthorityResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4146,7 +4492,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSetAuthorityBondResponse) IsValid() bool {
+func (x *fastReflection_MsgReserveAuthorityResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4156,9 +4502,9 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgReserveAuthorityResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
+		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4180,7 +4526,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
+		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4210,7 +4556,7 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
+		x := input.Message.Interface().(*MsgReserveAuthorityResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4242,10 +4588,10 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBondResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthorityResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReserveAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4284,27 +4630,29 @@ func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgDeleteName        protoreflect.MessageDescriptor
-	fd_MsgDeleteName_lrn    protoreflect.FieldDescriptor
-	fd_MsgDeleteName_signer protoreflect.FieldDescriptor
+	md_MsgSetAuthorityBond         protoreflect.MessageDescriptor
+	fd_MsgSetAuthorityBond_name    protoreflect.FieldDescriptor
+	fd_MsgSetAuthorityBond_bond_id protoreflect.FieldDescriptor
+	fd_MsgSetAuthorityBond_signer  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDeleteName = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteName")
-	fd_MsgDeleteName_lrn = md_MsgDeleteName.Fields().ByName("lrn")
-	fd_MsgDeleteName_signer = md_MsgDeleteName.Fields().ByName("signer")
+	md_MsgSetAuthorityBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetAuthorityBond")
+	fd_MsgSetAuthorityBond_name = md_MsgSetAuthorityBond.Fields().ByName("name")
+	fd_MsgSetAuthorityBond_bond_id = md_MsgSetAuthorityBond.Fields().ByName("bond_id")
+	fd_MsgSetAuthorityBond_signer = md_MsgSetAuthorityBond.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeleteName)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetAuthorityBond)(nil)
 
-type fastReflection_MsgDeleteName MsgDeleteName
+type fastReflection_MsgSetAuthorityBond MsgSetAuthorityBond
 
-func (x *MsgDeleteName) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeleteName)(x)
+func (x *MsgSetAuthorityBond) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAuthorityBond)(x)
 }
 
-func (x *MsgDeleteName) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetAuthorityBond) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4316,43 +4664,43 @@ func (x *MsgDeleteName) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeleteName_messageType fastReflection_MsgDeleteName_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeleteName_messageType{}
+var _fastReflection_MsgSetAuthorityBond_messageType fastReflection_MsgSetAuthorityBond_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAuthorityBond_messageType{}
 
-type fastReflection_MsgDeleteName_messageType struct{}
+type fastReflection_MsgSetAuthorityBond_messageType struct{}
 
-func (x fastReflection_MsgDeleteName_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeleteName)(nil)
+func (x fastReflection_MsgSetAuthorityBond_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAuthorityBond)(nil)
 }
-func (x fastReflection_MsgDeleteName_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteName)
+func (x fastReflection_MsgSetAuthorityBond_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAuthorityBond)
 }
-func (x fastReflection_MsgDeleteName_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteName
+func (x fastReflection_MsgSetAuthorityBond_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAuthorityBond
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeleteName) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteName
+func (x *fastReflection_MsgSetAuthorityBond) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAuthorityBond
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeleteName) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeleteName_messageType
+func (x *fastReflection_MsgSetAuthorityBond) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAuthorityBond_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeleteName) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteName)
+func (x *fastReflection_MsgSetAuthorityBond) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAuthorityBond)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeleteName) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeleteName)(x)
+func (x *fastReflection_MsgSetAuthorityBond) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAuthorityBond)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4360,16 +4708,22 @@ func (x *fastReflection_MsgDeleteName) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeleteName) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Lrn != "" {
-		value := protoreflect.ValueOfString(x.Lrn)
-		if !f(fd_MsgDeleteName_lrn, value) {
+func (x *fastReflection_MsgSetAuthorityBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgSetAuthorityBond_name, value) {
+			return
+		}
+	}
+	if x.BondId != "" {
+		value := protoreflect.ValueOfString(x.BondId)
+		if !f(fd_MsgSetAuthorityBond_bond_id, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgDeleteName_signer, value) {
+		if !f(fd_MsgSetAuthorityBond_signer, value) {
 			return
 		}
 	}
@@ -4386,17 +4740,19 @@ func (x *fastReflection_MsgDeleteName) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeleteName) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetAuthorityBond) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
-		return x.Lrn != ""
-	case "cerc.registry.v1.MsgDeleteName.signer":
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+		return x.Name != ""
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		return x.BondId != ""
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4406,17 +4762,19 @@ func (x *fastReflection_MsgDeleteName) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteName) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetAuthorityBond) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
-		x.Lrn = ""
-	case "cerc.registry.v1.MsgDeleteName.signer":
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+		x.Name = ""
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		x.BondId = ""
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4426,19 +4784,22 @@ func (x *fastReflection_MsgDeleteName) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeleteName) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
-		value := x.Lrn
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgDeleteName.signer":
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		value := x.BondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4452,17 +4813,19 @@ func (x *fastReflection_MsgDeleteName) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteName) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetAuthorityBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
-		x.Lrn = value.Interface().(string)
-	case "cerc.registry.v1.MsgDeleteName.signer":
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		x.BondId = value.Interface().(string)
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4476,44 +4839,48 @@ func (x *fastReflection_MsgDeleteName) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteName) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
-		panic(fmt.Errorf("field lrn of message cerc.registry.v1.MsgDeleteName is not mutable"))
-	case "cerc.registry.v1.MsgDeleteName.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDeleteName is not mutable"))
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgSetAuthorityBond is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeleteName) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDeleteName.lrn":
+	case "cerc.registry.v1.MsgSetAuthorityBond.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgDeleteName.signer":
+	case "cerc.registry.v1.MsgSetAuthorityBond.bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgSetAuthorityBond.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBond"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBond does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeleteName) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetAuthorityBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteName", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetAuthorityBond", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4521,7 +4888,7 @@ func (x *fastReflection_MsgDeleteName) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeleteName) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetAuthorityBond) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4532,7 +4899,7 @@ func (x *fastReflection_MsgDeleteName) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteName) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetAuthorityBond) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4544,7 +4911,7 @@ func (x *fastReflection_MsgDeleteName) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeleteName) IsValid() bool {
+func (x *fastReflection_MsgSetAuthorityBond) IsValid() bool {
 	return x != nil
 }
 
@@ -4554,9 +4921,9 @@ func (x *fastReflection_MsgDeleteName) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetAuthorityBond) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeleteName)
+		x := input.Message.Interface().(*MsgSetAuthorityBond)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4568,7 +4935,11 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Lrn)
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4586,7 +4957,7 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteName)
+		x := input.Message.Interface().(*MsgSetAuthorityBond)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4610,12 +4981,19 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BondId) > 0 {
+			i -= len(x.BondId)
+			copy(dAtA[i:], x.BondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Lrn) > 0 {
-			i -= len(x.Lrn)
-			copy(dAtA[i:], x.Lrn)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lrn)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4630,7 +5008,7 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteName)
+		x := input.Message.Interface().(*MsgSetAuthorityBond)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4662,15 +5040,15 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteName: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBond: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteName: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBond: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4698,11 +5076,11 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Lrn = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4730,18 +5108,50 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signer = string(dAtA[iNdEx:postIndex])
+				x.BondId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if !options.DiscardUnknown {
@@ -4768,23 +5178,23 @@ func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgDeleteNameResponse protoreflect.MessageDescriptor
+	md_MsgSetAuthorityBondResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDeleteNameResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteNameResponse")
+	md_MsgSetAuthorityBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgSetAuthorityBondResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDeleteNameResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetAuthorityBondResponse)(nil)
 
-type fastReflection_MsgDeleteNameResponse MsgDeleteNameResponse
+type fastReflection_MsgSetAuthorityBondResponse MsgSetAuthorityBondResponse
 
-func (x *MsgDeleteNameResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDeleteNameResponse)(x)
+func (x *MsgSetAuthorityBondResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAuthorityBondResponse)(x)
 }
 
-func (x *MsgDeleteNameResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetAuthorityBondResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4796,43 +5206,43 @@ func (x *MsgDeleteNameResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDeleteNameResponse_messageType fastReflection_MsgDeleteNameResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDeleteNameResponse_messageType{}
+var _fastReflection_MsgSetAuthorityBondResponse_messageType fastReflection_MsgSetAuthorityBondResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAuthorityBondResponse_messageType{}
 
-type fastReflection_MsgDeleteNameResponse_messageType struct{}
+type fastReflection_MsgSetAuthorityBondResponse_messageType struct{}
 
-func (x fastReflection_MsgDeleteNameResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDeleteNameResponse)(nil)
+func (x fastReflection_MsgSetAuthorityBondResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAuthorityBondResponse)(nil)
 }
-func (x fastReflection_MsgDeleteNameResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteNameResponse)
+func (x fastReflection_MsgSetAuthorityBondResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAuthorityBondResponse)
 }
-func (x fastReflection_MsgDeleteNameResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteNameResponse
+func (x fastReflection_MsgSetAuthorityBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAuthorityBondResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDeleteNameResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDeleteNameResponse
+func (x *fastReflection_MsgSetAuthorityBondResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAuthorityBondResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDeleteNameResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDeleteNameResponse_messageType
+func (x *fastReflection_MsgSetAuthorityBondResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAuthorityBondResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDeleteNameResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDeleteNameResponse)
+func (x *fastReflection_MsgSetAuthorityBondResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAuthorityBondResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDeleteNameResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDeleteNameResponse)(x)
+func (x *fastReflection_MsgSetAuthorityBondResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAuthorityBondResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4840,7 +5250,7 @@ func (x *fastReflection_MsgDeleteNameResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDeleteNameResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4854,13 +5264,13 @@ func (x *fastReflection_MsgDeleteNameResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDeleteNameResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4870,13 +5280,13 @@ func (x *fastReflection_MsgDeleteNameResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteNameResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4886,13 +5296,13 @@ func (x *fastReflection_MsgDeleteNameResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDeleteNameResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4906,13 +5316,13 @@ func (x *fastReflection_MsgDeleteNameResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteNameResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4926,36 +5336,36 @@ func (x *fastReflection_MsgDeleteNameResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteNameResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDeleteNameResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetAuthorityBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgSetAuthorityBondResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgSetAuthorityBondResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDeleteNameResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetAuthorityBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteNameResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgSetAuthorityBondResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4963,7 +5373,7 @@ func (x *fastReflection_MsgDeleteNameResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDeleteNameResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetAuthorityBondResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4974,7 +5384,7 @@ func (x *fastReflection_MsgDeleteNameResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDeleteNameResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetAuthorityBondResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4986,7 +5396,7 @@ func (x *fastReflection_MsgDeleteNameResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDeleteNameResponse) IsValid() bool {
+func (x *fastReflection_MsgSetAuthorityBondResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4996,9 +5406,9 @@ func (x *fastReflection_MsgDeleteNameResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetAuthorityBondResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5020,7 +5430,7 @@ func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5050,7 +5460,7 @@ func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		x := input.Message.Interface().(*MsgSetAuthorityBondResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5082,10 +5492,10 @@ func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBondResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAuthorityBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5124,27 +5534,35 @@ func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgRenewRecord           protoreflect.MessageDescriptor
-	fd_MsgRenewRecord_record_id protoreflect.FieldDescriptor
-	fd_MsgRenewRecord_signer    protoreflect.FieldDescriptor
+	md_MsgTransferAuthority                  protoreflect.MessageDescriptor
+	fd_MsgTransferAuthority_name             protoreflect.FieldDescriptor
+	fd_MsgTransferAuthority_new_owner        protoreflect.FieldDescriptor
+	fd_MsgTransferAuthority_require_accept   protoreflect.FieldDescriptor
+	fd_MsgTransferAuthority_keep_bond        protoreflect.FieldDescriptor
+	fd_MsgTransferAuthority_invalidate_names protoreflect.FieldDescriptor
+	fd_MsgTransferAuthority_signer           protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgRenewRecord = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewRecord")
-	fd_MsgRenewRecord_record_id = md_MsgRenewRecord.Fields().ByName("record_id")
-	fd_MsgRenewRecord_signer = md_MsgRenewRecord.Fields().ByName("signer")
+	md_MsgTransferAuthority = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgTransferAuthority")
+	fd_MsgTransferAuthority_name = md_MsgTransferAuthority.Fields().ByName("name")
+	fd_MsgTransferAuthority_new_owner = md_MsgTransferAuthority.Fields().ByName("new_owner")
+	fd_MsgTransferAuthority_require_accept = md_MsgTransferAuthority.Fields().ByName("require_accept")
+	fd_MsgTransferAuthority_keep_bond = md_MsgTransferAuthority.Fields().ByName("keep_bond")
+	fd_MsgTransferAuthority_invalidate_names = md_MsgTransferAuthority.Fields().ByName("invalidate_names")
+	fd_MsgTransferAuthority_signer = md_MsgTransferAuthority.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRenewRecord)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferAuthority)(nil)
 
-type fastReflection_MsgRenewRecord MsgRenewRecord
+type fastReflection_MsgTransferAuthority MsgTransferAuthority
 
-func (x *MsgRenewRecord) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRenewRecord)(x)
+func (x *MsgTransferAuthority) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferAuthority)(x)
 }
 
-func (x *MsgRenewRecord) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferAuthority) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5156,43 +5574,43 @@ func (x *MsgRenewRecord) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRenewRecord_messageType fastReflection_MsgRenewRecord_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRenewRecord_messageType{}
+var _fastReflection_MsgTransferAuthority_messageType fastReflection_MsgTransferAuthority_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferAuthority_messageType{}
 
-type fastReflection_MsgRenewRecord_messageType struct{}
+type fastReflection_MsgTransferAuthority_messageType struct{}
 
-func (x fastReflection_MsgRenewRecord_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRenewRecord)(nil)
+func (x fastReflection_MsgTransferAuthority_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferAuthority)(nil)
 }
-func (x fastReflection_MsgRenewRecord_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRenewRecord)
+func (x fastReflection_MsgTransferAuthority_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferAuthority)
 }
-func (x fastReflection_MsgRenewRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRenewRecord
+func (x fastReflection_MsgTransferAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferAuthority
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRenewRecord) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRenewRecord
+func (x *fastReflection_MsgTransferAuthority) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferAuthority
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRenewRecord) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRenewRecord_messageType
+func (x *fastReflection_MsgTransferAuthority) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferAuthority_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRenewRecord) New() protoreflect.Message {
-	return new(fastReflection_MsgRenewRecord)
+func (x *fastReflection_MsgTransferAuthority) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferAuthority)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRenewRecord) Interface() protoreflect.ProtoMessage {
-	return (*MsgRenewRecord)(x)
+func (x *fastReflection_MsgTransferAuthority) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferAuthority)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5200,16 +5618,40 @@ func (x *fastReflection_MsgRenewRecord) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRenewRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RecordId != "" {
-		value := protoreflect.ValueOfString(x.RecordId)
-		if !f(fd_MsgRenewRecord_record_id, value) {
+func (x *fastReflection_MsgTransferAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgTransferAuthority_name, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_MsgTransferAuthority_new_owner, value) {
+			return
+		}
+	}
+	if x.RequireAccept != false {
+		value := protoreflect.ValueOfBool(x.RequireAccept)
+		if !f(fd_MsgTransferAuthority_require_accept, value) {
+			return
+		}
+	}
+	if x.KeepBond != false {
+		value := protoreflect.ValueOfBool(x.KeepBond)
+		if !f(fd_MsgTransferAuthority_keep_bond, value) {
+			return
+		}
+	}
+	if x.InvalidateNames != false {
+		value := protoreflect.ValueOfBool(x.InvalidateNames)
+		if !f(fd_MsgTransferAuthority_invalidate_names, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgRenewRecord_signer, value) {
+		if !f(fd_MsgTransferAuthority_signer, value) {
 			return
 		}
 	}
@@ -5226,17 +5668,25 @@ func (x *fastReflection_MsgRenewRecord) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRenewRecord) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferAuthority) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
-		return x.RecordId != ""
-	case "cerc.registry.v1.MsgRenewRecord.signer":
+	case "cerc.registry.v1.MsgTransferAuthority.name":
+		return x.Name != ""
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		return x.NewOwner != ""
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		return x.RequireAccept != false
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		return x.KeepBond != false
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		return x.InvalidateNames != false
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5246,17 +5696,25 @@ func (x *fastReflection_MsgRenewRecord) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecord) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferAuthority) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
-		x.RecordId = ""
-	case "cerc.registry.v1.MsgRenewRecord.signer":
+	case "cerc.registry.v1.MsgTransferAuthority.name":
+		x.Name = ""
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		x.NewOwner = ""
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		x.RequireAccept = false
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		x.KeepBond = false
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		x.InvalidateNames = false
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5266,19 +5724,31 @@ func (x *fastReflection_MsgRenewRecord) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRenewRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
-		value := x.RecordId
+	case "cerc.registry.v1.MsgTransferAuthority.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgRenewRecord.signer":
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		value := x.RequireAccept
+		return protoreflect.ValueOfBool(value)
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		value := x.KeepBond
+		return protoreflect.ValueOfBool(value)
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		value := x.InvalidateNames
+		return protoreflect.ValueOfBool(value)
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5292,17 +5762,25 @@ func (x *fastReflection_MsgRenewRecord) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
-		x.RecordId = value.Interface().(string)
-	case "cerc.registry.v1.MsgRenewRecord.signer":
+	case "cerc.registry.v1.MsgTransferAuthority.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		x.NewOwner = value.Interface().(string)
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		x.RequireAccept = value.Bool()
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		x.KeepBond = value.Bool()
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		x.InvalidateNames = value.Bool()
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5316,44 +5794,60 @@ func (x *fastReflection_MsgRenewRecord) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
-		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgRenewRecord is not mutable"))
-	case "cerc.registry.v1.MsgRenewRecord.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgRenewRecord is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		panic(fmt.Errorf("field new_owner of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		panic(fmt.Errorf("field require_accept of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		panic(fmt.Errorf("field keep_bond of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		panic(fmt.Errorf("field invalidate_names of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgTransferAuthority is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRenewRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgRenewRecord.record_id":
+	case "cerc.registry.v1.MsgTransferAuthority.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgRenewRecord.signer":
+	case "cerc.registry.v1.MsgTransferAuthority.new_owner":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgTransferAuthority.require_accept":
+		return protoreflect.ValueOfBool(false)
+	case "cerc.registry.v1.MsgTransferAuthority.keep_bond":
+		return protoreflect.ValueOfBool(false)
+	case "cerc.registry.v1.MsgTransferAuthority.invalidate_names":
+		return protoreflect.ValueOfBool(false)
+	case "cerc.registry.v1.MsgTransferAuthority.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRenewRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewRecord", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgTransferAuthority", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5361,7 +5855,7 @@ func (x *fastReflection_MsgRenewRecord) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRenewRecord) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferAuthority) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5372,7 +5866,7 @@ func (x *fastReflection_MsgRenewRecord) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecord) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferAuthority) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5384,7 +5878,7 @@ func (x *fastReflection_MsgRenewRecord) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRenewRecord) IsValid() bool {
+func (x *fastReflection_MsgTransferAuthority) IsValid() bool {
 	return x != nil
 }
 
@@ -5394,9 +5888,9 @@ func (x *fastReflection_MsgRenewRecord) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferAuthority) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRenewRecord)
+		x := input.Message.Interface().(*MsgTransferAuthority)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5408,10 +5902,23 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RecordId)
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RequireAccept {
+			n += 2
+		}
+		if x.KeepBond {
+			n += 2
+		}
+		if x.InvalidateNames {
+			n += 2
+		}
 		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -5426,7 +5933,7 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRenewRecord)
+		x := input.Message.Interface().(*MsgTransferAuthority)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5450,12 +5957,49 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
+			dAtA[i] = 0x32
+		}
+		if x.InvalidateNames {
+			i--
+			if x.InvalidateNames {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.KeepBond {
+			i--
+			if x.KeepBond {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.RequireAccept {
+			i--
+			if x.RequireAccept {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.RecordId) > 0 {
-			i -= len(x.RecordId)
-			copy(dAtA[i:], x.RecordId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5470,7 +6014,7 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRenewRecord)
+		x := input.Message.Interface().(*MsgTransferAuthority)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5502,15 +6046,15 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecord: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferAuthority: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5538,9 +6082,101 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecordId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequireAccept", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RequireAccept = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeepBond", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.KeepBond = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InvalidateNames", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.InvalidateNames = bool(v != 0)
+			case 6:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
@@ -5608,23 +6244,23 @@ func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRenewRecordResponse protoreflect.MessageDescriptor
+	md_MsgTransferAuthorityResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgRenewRecordResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewRecordResponse")
+	md_MsgTransferAuthorityResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgTransferAuthorityResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRenewRecordResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgTransferAuthorityResponse)(nil)
 
-type fastReflection_MsgRenewRecordResponse MsgRenewRecordResponse
+type fastReflection_MsgTransferAuthorityResponse MsgTransferAuthorityResponse
 
-func (x *MsgRenewRecordResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRenewRecordResponse)(x)
+func (x *MsgTransferAuthorityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferAuthorityResponse)(x)
 }
 
-func (x *MsgRenewRecordResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgTransferAuthorityResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5636,43 +6272,43 @@ func (x *MsgRenewRecordResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRenewRecordResponse_messageType fastReflection_MsgRenewRecordResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRenewRecordResponse_messageType{}
+var _fastReflection_MsgTransferAuthorityResponse_messageType fastReflection_MsgTransferAuthorityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferAuthorityResponse_messageType{}
 
-type fastReflection_MsgRenewRecordResponse_messageType struct{}
+type fastReflection_MsgTransferAuthorityResponse_messageType struct{}
 
-func (x fastReflection_MsgRenewRecordResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRenewRecordResponse)(nil)
+func (x fastReflection_MsgTransferAuthorityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferAuthorityResponse)(nil)
 }
-func (x fastReflection_MsgRenewRecordResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRenewRecordResponse)
+func (x fastReflection_MsgTransferAuthorityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferAuthorityResponse)
 }
-func (x fastReflection_MsgRenewRecordResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRenewRecordResponse
+func (x fastReflection_MsgTransferAuthorityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferAuthorityResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRenewRecordResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRenewRecordResponse
+func (x *fastReflection_MsgTransferAuthorityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferAuthorityResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRenewRecordResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRenewRecordResponse_messageType
+func (x *fastReflection_MsgTransferAuthorityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferAuthorityResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRenewRecordResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRenewRecordResponse)
+func (x *fastReflection_MsgTransferAuthorityResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferAuthorityResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRenewRecordResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRenewRecordResponse)(x)
+func (x *fastReflection_MsgTransferAuthorityResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferAuthorityResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5680,7 +6316,7 @@ func (x *fastReflection_MsgRenewRecordResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRenewRecordResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgTransferAuthorityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5694,13 +6330,13 @@ func (x *fastReflection_MsgRenewRecordResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRenewRecordResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgTransferAuthorityResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5710,13 +6346,13 @@ func (x *fastReflection_MsgRenewRecordResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecordResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgTransferAuthorityResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5726,13 +6362,13 @@ func (x *fastReflection_MsgRenewRecordResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRenewRecordResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthorityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5746,13 +6382,13 @@ func (x *fastReflection_MsgRenewRecordResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecordResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgTransferAuthorityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5766,36 +6402,36 @@ func (x *fastReflection_MsgRenewRecordResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecordResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthorityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRenewRecordResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgTransferAuthorityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRenewRecordResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgTransferAuthorityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewRecordResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgTransferAuthorityResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5803,7 +6439,7 @@ func (x *fastReflection_MsgRenewRecordResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRenewRecordResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgTransferAuthorityResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5814,7 +6450,7 @@ func (x *fastReflection_MsgRenewRecordResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRenewRecordResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgTransferAuthorityResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5826,7 +6462,7 @@ func (x *fastReflection_MsgRenewRecordResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRenewRecordResponse) IsValid() bool {
+func (x *fastReflection_MsgTransferAuthorityResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5836,9 +6472,9 @@ func (x *fastReflection_MsgRenewRecordResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgTransferAuthorityResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		x := input.Message.Interface().(*MsgTransferAuthorityResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5860,7 +6496,7 @@ func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		x := input.Message.Interface().(*MsgTransferAuthorityResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5890,7 +6526,7 @@ func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		x := input.Message.Interface().(*MsgTransferAuthorityResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5922,10 +6558,10 @@ func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecordResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferAuthorityResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5964,29 +6600,27 @@ func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_MsgAssociateBond           protoreflect.MessageDescriptor
-	fd_MsgAssociateBond_record_id protoreflect.FieldDescriptor
-	fd_MsgAssociateBond_bond_id   protoreflect.FieldDescriptor
-	fd_MsgAssociateBond_signer    protoreflect.FieldDescriptor
+	md_MsgAcceptAuthority        protoreflect.MessageDescriptor
+	fd_MsgAcceptAuthority_name   protoreflect.FieldDescriptor
+	fd_MsgAcceptAuthority_signer protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgAssociateBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAssociateBond")
-	fd_MsgAssociateBond_record_id = md_MsgAssociateBond.Fields().ByName("record_id")
-	fd_MsgAssociateBond_bond_id = md_MsgAssociateBond.Fields().ByName("bond_id")
-	fd_MsgAssociateBond_signer = md_MsgAssociateBond.Fields().ByName("signer")
+	md_MsgAcceptAuthority = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAcceptAuthority")
+	fd_MsgAcceptAuthority_name = md_MsgAcceptAuthority.Fields().ByName("name")
+	fd_MsgAcceptAuthority_signer = md_MsgAcceptAuthority.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAssociateBond)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcceptAuthority)(nil)
 
-type fastReflection_MsgAssociateBond MsgAssociateBond
+type fastReflection_MsgAcceptAuthority MsgAcceptAuthority
 
-func (x *MsgAssociateBond) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAssociateBond)(x)
+func (x *MsgAcceptAuthority) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptAuthority)(x)
 }
 
-func (x *MsgAssociateBond) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcceptAuthority) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5998,43 +6632,43 @@ func (x *MsgAssociateBond) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAssociateBond_messageType fastReflection_MsgAssociateBond_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAssociateBond_messageType{}
+var _fastReflection_MsgAcceptAuthority_messageType fastReflection_MsgAcceptAuthority_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptAuthority_messageType{}
 
-type fastReflection_MsgAssociateBond_messageType struct{}
+type fastReflection_MsgAcceptAuthority_messageType struct{}
 
-func (x fastReflection_MsgAssociateBond_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAssociateBond)(nil)
+func (x fastReflection_MsgAcceptAuthority_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptAuthority)(nil)
 }
-func (x fastReflection_MsgAssociateBond_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAssociateBond)
+func (x fastReflection_MsgAcceptAuthority_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptAuthority)
 }
-func (x fastReflection_MsgAssociateBond_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAssociateBond
+func (x fastReflection_MsgAcceptAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptAuthority
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAssociateBond) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAssociateBond
+func (x *fastReflection_MsgAcceptAuthority) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptAuthority
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAssociateBond) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAssociateBond_messageType
+func (x *fastReflection_MsgAcceptAuthority) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptAuthority_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAssociateBond) New() protoreflect.Message {
-	return new(fastReflection_MsgAssociateBond)
+func (x *fastReflection_MsgAcceptAuthority) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptAuthority)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAssociateBond) Interface() protoreflect.ProtoMessage {
-	return (*MsgAssociateBond)(x)
+func (x *fastReflection_MsgAcceptAuthority) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptAuthority)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6042,22 +6676,16 @@ func (x *fastReflection_MsgAssociateBond) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAssociateBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RecordId != "" {
-		value := protoreflect.ValueOfString(x.RecordId)
-		if !f(fd_MsgAssociateBond_record_id, value) {
-			return
-		}
-	}
-	if x.BondId != "" {
-		value := protoreflect.ValueOfString(x.BondId)
-		if !f(fd_MsgAssociateBond_bond_id, value) {
+func (x *fastReflection_MsgAcceptAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgAcceptAuthority_name, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgAssociateBond_signer, value) {
+		if !f(fd_MsgAcceptAuthority_signer, value) {
 			return
 		}
 	}
@@ -6074,19 +6702,17 @@ func (x *fastReflection_MsgAssociateBond) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAssociateBond) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcceptAuthority) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		return x.RecordId != ""
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
-		return x.BondId != ""
-	case "cerc.registry.v1.MsgAssociateBond.signer":
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
+		return x.Name != ""
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6096,19 +6722,17 @@ func (x *fastReflection_MsgAssociateBond) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBond) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcceptAuthority) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		x.RecordId = ""
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
-		x.BondId = ""
-	case "cerc.registry.v1.MsgAssociateBond.signer":
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
+		x.Name = ""
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6118,22 +6742,19 @@ func (x *fastReflection_MsgAssociateBond) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAssociateBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		value := x.RecordId
-		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
-		value := x.BondId
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgAssociateBond.signer":
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6147,19 +6768,17 @@ func (x *fastReflection_MsgAssociateBond) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcceptAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		x.RecordId = value.Interface().(string)
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
-		x.BondId = value.Interface().(string)
-	case "cerc.registry.v1.MsgAssociateBond.signer":
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6173,48 +6792,44 @@ func (x *fastReflection_MsgAssociateBond) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgAssociateBond is not mutable"))
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
-		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgAssociateBond is not mutable"))
-	case "cerc.registry.v1.MsgAssociateBond.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgAssociateBond is not mutable"))
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgAcceptAuthority is not mutable"))
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgAcceptAuthority is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAssociateBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgAssociateBond.record_id":
-		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+	case "cerc.registry.v1.MsgAcceptAuthority.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgAssociateBond.signer":
+	case "cerc.registry.v1.MsgAcceptAuthority.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAssociateBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcceptAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAssociateBond", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAcceptAuthority", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6222,7 +6837,7 @@ func (x *fastReflection_MsgAssociateBond) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAssociateBond) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcceptAuthority) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6233,7 +6848,7 @@ func (x *fastReflection_MsgAssociateBond) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBond) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcceptAuthority) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6245,7 +6860,7 @@ func (x *fastReflection_MsgAssociateBond) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAssociateBond) IsValid() bool {
+func (x *fastReflection_MsgAcceptAuthority) IsValid() bool {
 	return x != nil
 }
 
@@ -6255,9 +6870,9 @@ func (x *fastReflection_MsgAssociateBond) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcceptAuthority) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAssociateBond)
+		x := input.Message.Interface().(*MsgAcceptAuthority)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6269,11 +6884,7 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RecordId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BondId)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6291,7 +6902,7 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAssociateBond)
+		x := input.Message.Interface().(*MsgAcceptAuthority)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6315,19 +6926,12 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BondId) > 0 {
-			i -= len(x.BondId)
-			copy(dAtA[i:], x.BondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.RecordId) > 0 {
-			i -= len(x.RecordId)
-			copy(dAtA[i:], x.RecordId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6342,7 +6946,7 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAssociateBond)
+		x := input.Message.Interface().(*MsgAcceptAuthority)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6374,15 +6978,15 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBond: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptAuthority: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6410,41 +7014,9 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecordId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BondId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
@@ -6512,23 +7084,23 @@ func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgAssociateBondResponse protoreflect.MessageDescriptor
+	md_MsgAcceptAuthorityResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgAssociateBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAssociateBondResponse")
+	md_MsgAcceptAuthorityResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAcceptAuthorityResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAssociateBondResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAcceptAuthorityResponse)(nil)
 
-type fastReflection_MsgAssociateBondResponse MsgAssociateBondResponse
+type fastReflection_MsgAcceptAuthorityResponse MsgAcceptAuthorityResponse
 
-func (x *MsgAssociateBondResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAssociateBondResponse)(x)
+func (x *MsgAcceptAuthorityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAcceptAuthorityResponse)(x)
 }
 
-func (x *MsgAssociateBondResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgAcceptAuthorityResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6540,43 +7112,43 @@ func (x *MsgAssociateBondResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAssociateBondResponse_messageType fastReflection_MsgAssociateBondResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAssociateBondResponse_messageType{}
+var _fastReflection_MsgAcceptAuthorityResponse_messageType fastReflection_MsgAcceptAuthorityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAcceptAuthorityResponse_messageType{}
 
-type fastReflection_MsgAssociateBondResponse_messageType struct{}
+type fastReflection_MsgAcceptAuthorityResponse_messageType struct{}
 
-func (x fastReflection_MsgAssociateBondResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAssociateBondResponse)(nil)
+func (x fastReflection_MsgAcceptAuthorityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAcceptAuthorityResponse)(nil)
 }
-func (x fastReflection_MsgAssociateBondResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAssociateBondResponse)
+func (x fastReflection_MsgAcceptAuthorityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptAuthorityResponse)
 }
-func (x fastReflection_MsgAssociateBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAssociateBondResponse
+func (x fastReflection_MsgAcceptAuthorityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptAuthorityResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAssociateBondResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAssociateBondResponse
+func (x *fastReflection_MsgAcceptAuthorityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAcceptAuthorityResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAssociateBondResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAssociateBondResponse_messageType
+func (x *fastReflection_MsgAcceptAuthorityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAcceptAuthorityResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAssociateBondResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgAssociateBondResponse)
+func (x *fastReflection_MsgAcceptAuthorityResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAcceptAuthorityResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAssociateBondResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgAssociateBondResponse)(x)
+func (x *fastReflection_MsgAcceptAuthorityResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAcceptAuthorityResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6584,7 +7156,7 @@ func (x *fastReflection_MsgAssociateBondResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAssociateBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6598,13 +7170,13 @@ func (x *fastReflection_MsgAssociateBondResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAssociateBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6614,13 +7186,13 @@ func (x *fastReflection_MsgAssociateBondResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBondResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6630,13 +7202,13 @@ func (x *fastReflection_MsgAssociateBondResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAssociateBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6650,13 +7222,13 @@ func (x *fastReflection_MsgAssociateBondResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6670,36 +7242,36 @@ func (x *fastReflection_MsgAssociateBondResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthorityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAssociateBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAcceptAuthorityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAcceptAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAcceptAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAssociateBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAcceptAuthorityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAssociateBondResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAcceptAuthorityResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6707,7 +7279,7 @@ func (x *fastReflection_MsgAssociateBondResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAssociateBondResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAcceptAuthorityResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6718,7 +7290,7 @@ func (x *fastReflection_MsgAssociateBondResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAssociateBondResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAcceptAuthorityResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6730,7 +7302,7 @@ func (x *fastReflection_MsgAssociateBondResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAssociateBondResponse) IsValid() bool {
+func (x *fastReflection_MsgAcceptAuthorityResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6740,9 +7312,9 @@ func (x *fastReflection_MsgAssociateBondResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAcceptAuthorityResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		x := input.Message.Interface().(*MsgAcceptAuthorityResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6764,7 +7336,7 @@ func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		x := input.Message.Interface().(*MsgAcceptAuthorityResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6794,7 +7366,7 @@ func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		x := input.Message.Interface().(*MsgAcceptAuthorityResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6826,10 +7398,10 @@ func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBondResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptAuthorityResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAcceptAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6868,27 +7440,29 @@ func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgDissociateBond           protoreflect.MessageDescriptor
-	fd_MsgDissociateBond_record_id protoreflect.FieldDescriptor
-	fd_MsgDissociateBond_signer    protoreflect.FieldDescriptor
+	md_MsgRenewAuthority         protoreflect.MessageDescriptor
+	fd_MsgRenewAuthority_name    protoreflect.FieldDescriptor
+	fd_MsgRenewAuthority_periods protoreflect.FieldDescriptor
+	fd_MsgRenewAuthority_signer  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDissociateBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateBond")
-	fd_MsgDissociateBond_record_id = md_MsgDissociateBond.Fields().ByName("record_id")
-	fd_MsgDissociateBond_signer = md_MsgDissociateBond.Fields().ByName("signer")
+	md_MsgRenewAuthority = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewAuthority")
+	fd_MsgRenewAuthority_name = md_MsgRenewAuthority.Fields().ByName("name")
+	fd_MsgRenewAuthority_periods = md_MsgRenewAuthority.Fields().ByName("periods")
+	fd_MsgRenewAuthority_signer = md_MsgRenewAuthority.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDissociateBond)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRenewAuthority)(nil)
 
-type fastReflection_MsgDissociateBond MsgDissociateBond
+type fastReflection_MsgRenewAuthority MsgRenewAuthority
 
-func (x *MsgDissociateBond) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDissociateBond)(x)
+func (x *MsgRenewAuthority) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewAuthority)(x)
 }
 
-func (x *MsgDissociateBond) slowProtoReflect() protoreflect.Message {
+func (x *MsgRenewAuthority) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6900,43 +7474,43 @@ func (x *MsgDissociateBond) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDissociateBond_messageType fastReflection_MsgDissociateBond_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDissociateBond_messageType{}
+var _fastReflection_MsgRenewAuthority_messageType fastReflection_MsgRenewAuthority_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewAuthority_messageType{}
 
-type fastReflection_MsgDissociateBond_messageType struct{}
+type fastReflection_MsgRenewAuthority_messageType struct{}
 
-func (x fastReflection_MsgDissociateBond_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDissociateBond)(nil)
+func (x fastReflection_MsgRenewAuthority_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewAuthority)(nil)
 }
-func (x fastReflection_MsgDissociateBond_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateBond)
+func (x fastReflection_MsgRenewAuthority_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewAuthority)
 }
-func (x fastReflection_MsgDissociateBond_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateBond
+func (x fastReflection_MsgRenewAuthority_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewAuthority
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDissociateBond) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateBond
+func (x *fastReflection_MsgRenewAuthority) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewAuthority
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDissociateBond) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDissociateBond_messageType
+func (x *fastReflection_MsgRenewAuthority) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewAuthority_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDissociateBond) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateBond)
+func (x *fastReflection_MsgRenewAuthority) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewAuthority)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDissociateBond) Interface() protoreflect.ProtoMessage {
-	return (*MsgDissociateBond)(x)
+func (x *fastReflection_MsgRenewAuthority) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewAuthority)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6944,16 +7518,22 @@ func (x *fastReflection_MsgDissociateBond) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDissociateBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.RecordId != "" {
-		value := protoreflect.ValueOfString(x.RecordId)
-		if !f(fd_MsgDissociateBond_record_id, value) {
+func (x *fastReflection_MsgRenewAuthority) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgRenewAuthority_name, value) {
+			return
+		}
+	}
+	if x.Periods != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Periods)
+		if !f(fd_MsgRenewAuthority_periods, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgDissociateBond_signer, value) {
+		if !f(fd_MsgRenewAuthority_signer, value) {
 			return
 		}
 	}
@@ -6970,17 +7550,19 @@ func (x *fastReflection_MsgDissociateBond) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDissociateBond) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRenewAuthority) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
-		return x.RecordId != ""
-	case "cerc.registry.v1.MsgDissociateBond.signer":
+	case "cerc.registry.v1.MsgRenewAuthority.name":
+		return x.Name != ""
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		return x.Periods != uint64(0)
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6990,17 +7572,19 @@ func (x *fastReflection_MsgDissociateBond) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBond) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRenewAuthority) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
-		x.RecordId = ""
-	case "cerc.registry.v1.MsgDissociateBond.signer":
+	case "cerc.registry.v1.MsgRenewAuthority.name":
+		x.Name = ""
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		x.Periods = uint64(0)
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7010,19 +7594,22 @@ func (x *fastReflection_MsgDissociateBond) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDissociateBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthority) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
-		value := x.RecordId
+	case "cerc.registry.v1.MsgRenewAuthority.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgDissociateBond.signer":
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		value := x.Periods
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7036,17 +7623,19 @@ func (x *fastReflection_MsgDissociateBond) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRenewAuthority) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
-		x.RecordId = value.Interface().(string)
-	case "cerc.registry.v1.MsgDissociateBond.signer":
+	case "cerc.registry.v1.MsgRenewAuthority.name":
+		x.Name = value.Interface().(string)
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		x.Periods = value.Uint()
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7060,44 +7649,48 @@ func (x *fastReflection_MsgDissociateBond) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthority) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
-		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgDissociateBond is not mutable"))
-	case "cerc.registry.v1.MsgDissociateBond.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDissociateBond is not mutable"))
+	case "cerc.registry.v1.MsgRenewAuthority.name":
+		panic(fmt.Errorf("field name of message cerc.registry.v1.MsgRenewAuthority is not mutable"))
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		panic(fmt.Errorf("field periods of message cerc.registry.v1.MsgRenewAuthority is not mutable"))
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgRenewAuthority is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDissociateBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthority) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateBond.record_id":
+	case "cerc.registry.v1.MsgRenewAuthority.name":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgDissociateBond.signer":
+	case "cerc.registry.v1.MsgRenewAuthority.periods":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.MsgRenewAuthority.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthority"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthority does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDissociateBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRenewAuthority) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateBond", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewAuthority", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7105,7 +7698,7 @@ func (x *fastReflection_MsgDissociateBond) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDissociateBond) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRenewAuthority) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7116,7 +7709,7 @@ func (x *fastReflection_MsgDissociateBond) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBond) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRenewAuthority) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7128,7 +7721,7 @@ func (x *fastReflection_MsgDissociateBond) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDissociateBond) IsValid() bool {
+func (x *fastReflection_MsgRenewAuthority) IsValid() bool {
 	return x != nil
 }
 
@@ -7138,9 +7731,9 @@ func (x *fastReflection_MsgDissociateBond) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRenewAuthority) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDissociateBond)
+		x := input.Message.Interface().(*MsgRenewAuthority)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7152,10 +7745,13 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.RecordId)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Periods != 0 {
+			n += 1 + runtime.Sov(uint64(x.Periods))
+		}
 		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -7170,7 +7766,7 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateBond)
+		x := input.Message.Interface().(*MsgRenewAuthority)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7194,12 +7790,17 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if len(x.RecordId) > 0 {
-			i -= len(x.RecordId)
-			copy(dAtA[i:], x.RecordId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+		if x.Periods != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Periods))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7214,7 +7815,7 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateBond)
+		x := input.Message.Interface().(*MsgRenewAuthority)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7246,15 +7847,15 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBond: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewAuthority: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7282,9 +7883,28 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RecordId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+				}
+				x.Periods = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Periods |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
@@ -7352,23 +7972,23 @@ func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgDissociateBondResponse protoreflect.MessageDescriptor
+	md_MsgRenewAuthorityResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDissociateBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateBondResponse")
+	md_MsgRenewAuthorityResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewAuthorityResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDissociateBondResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRenewAuthorityResponse)(nil)
 
-type fastReflection_MsgDissociateBondResponse MsgDissociateBondResponse
+type fastReflection_MsgRenewAuthorityResponse MsgRenewAuthorityResponse
 
-func (x *MsgDissociateBondResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDissociateBondResponse)(x)
+func (x *MsgRenewAuthorityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewAuthorityResponse)(x)
 }
 
-func (x *MsgDissociateBondResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRenewAuthorityResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7380,43 +8000,43 @@ func (x *MsgDissociateBondResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDissociateBondResponse_messageType fastReflection_MsgDissociateBondResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDissociateBondResponse_messageType{}
+var _fastReflection_MsgRenewAuthorityResponse_messageType fastReflection_MsgRenewAuthorityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewAuthorityResponse_messageType{}
 
-type fastReflection_MsgDissociateBondResponse_messageType struct{}
+type fastReflection_MsgRenewAuthorityResponse_messageType struct{}
 
-func (x fastReflection_MsgDissociateBondResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDissociateBondResponse)(nil)
+func (x fastReflection_MsgRenewAuthorityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewAuthorityResponse)(nil)
 }
-func (x fastReflection_MsgDissociateBondResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateBondResponse)
+func (x fastReflection_MsgRenewAuthorityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewAuthorityResponse)
 }
-func (x fastReflection_MsgDissociateBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateBondResponse
+func (x fastReflection_MsgRenewAuthorityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewAuthorityResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDissociateBondResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateBondResponse
+func (x *fastReflection_MsgRenewAuthorityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewAuthorityResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDissociateBondResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDissociateBondResponse_messageType
+func (x *fastReflection_MsgRenewAuthorityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewAuthorityResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDissociateBondResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateBondResponse)
+func (x *fastReflection_MsgRenewAuthorityResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewAuthorityResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDissociateBondResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDissociateBondResponse)(x)
+func (x *fastReflection_MsgRenewAuthorityResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewAuthorityResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7424,7 +8044,7 @@ func (x *fastReflection_MsgDissociateBondResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDissociateBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRenewAuthorityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7438,13 +8058,13 @@ func (x *fastReflection_MsgDissociateBondResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDissociateBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRenewAuthorityResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7454,13 +8074,13 @@ func (x *fastReflection_MsgDissociateBondResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBondResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRenewAuthorityResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7470,13 +8090,13 @@ func (x *fastReflection_MsgDissociateBondResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDissociateBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthorityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7490,13 +8110,13 @@ func (x *fastReflection_MsgDissociateBondResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRenewAuthorityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7510,36 +8130,36 @@ func (x *fastReflection_MsgDissociateBondResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthorityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDissociateBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRenewAuthorityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewAuthorityResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewAuthorityResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDissociateBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRenewAuthorityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateBondResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewAuthorityResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7547,7 +8167,7 @@ func (x *fastReflection_MsgDissociateBondResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDissociateBondResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRenewAuthorityResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7558,7 +8178,7 @@ func (x *fastReflection_MsgDissociateBondResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateBondResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRenewAuthorityResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7570,7 +8190,7 @@ func (x *fastReflection_MsgDissociateBondResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDissociateBondResponse) IsValid() bool {
+func (x *fastReflection_MsgRenewAuthorityResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7580,9 +8200,9 @@ func (x *fastReflection_MsgDissociateBondResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRenewAuthorityResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		x := input.Message.Interface().(*MsgRenewAuthorityResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7604,7 +8224,7 @@ func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		x := input.Message.Interface().(*MsgRenewAuthorityResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7634,7 +8254,7 @@ func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		x := input.Message.Interface().(*MsgRenewAuthorityResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7666,10 +8286,10 @@ func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBondResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewAuthorityResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7708,27 +8328,31 @@ func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgDissociateRecords         protoreflect.MessageDescriptor
-	fd_MsgDissociateRecords_bond_id protoreflect.FieldDescriptor
-	fd_MsgDissociateRecords_signer  protoreflect.FieldDescriptor
+	md_MsgGrantNameAccess             protoreflect.MessageDescriptor
+	fd_MsgGrantNameAccess_lrn_prefix  protoreflect.FieldDescriptor
+	fd_MsgGrantNameAccess_grantee     protoreflect.FieldDescriptor
+	fd_MsgGrantNameAccess_expiry_time protoreflect.FieldDescriptor
+	fd_MsgGrantNameAccess_signer      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDissociateRecords = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateRecords")
-	fd_MsgDissociateRecords_bond_id = md_MsgDissociateRecords.Fields().ByName("bond_id")
-	fd_MsgDissociateRecords_signer = md_MsgDissociateRecords.Fields().ByName("signer")
+	md_MsgGrantNameAccess = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgGrantNameAccess")
+	fd_MsgGrantNameAccess_lrn_prefix = md_MsgGrantNameAccess.Fields().ByName("lrn_prefix")
+	fd_MsgGrantNameAccess_grantee = md_MsgGrantNameAccess.Fields().ByName("grantee")
+	fd_MsgGrantNameAccess_expiry_time = md_MsgGrantNameAccess.Fields().ByName("expiry_time")
+	fd_MsgGrantNameAccess_signer = md_MsgGrantNameAccess.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDissociateRecords)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgGrantNameAccess)(nil)
 
-type fastReflection_MsgDissociateRecords MsgDissociateRecords
+type fastReflection_MsgGrantNameAccess MsgGrantNameAccess
 
-func (x *MsgDissociateRecords) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDissociateRecords)(x)
+func (x *MsgGrantNameAccess) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGrantNameAccess)(x)
 }
 
-func (x *MsgDissociateRecords) slowProtoReflect() protoreflect.Message {
+func (x *MsgGrantNameAccess) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7740,43 +8364,43 @@ func (x *MsgDissociateRecords) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDissociateRecords_messageType fastReflection_MsgDissociateRecords_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDissociateRecords_messageType{}
+var _fastReflection_MsgGrantNameAccess_messageType fastReflection_MsgGrantNameAccess_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGrantNameAccess_messageType{}
 
-type fastReflection_MsgDissociateRecords_messageType struct{}
+type fastReflection_MsgGrantNameAccess_messageType struct{}
 
-func (x fastReflection_MsgDissociateRecords_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDissociateRecords)(nil)
+func (x fastReflection_MsgGrantNameAccess_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGrantNameAccess)(nil)
 }
-func (x fastReflection_MsgDissociateRecords_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateRecords)
+func (x fastReflection_MsgGrantNameAccess_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantNameAccess)
 }
-func (x fastReflection_MsgDissociateRecords_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateRecords
+func (x fastReflection_MsgGrantNameAccess_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantNameAccess
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDissociateRecords) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateRecords
+func (x *fastReflection_MsgGrantNameAccess) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantNameAccess
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDissociateRecords) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDissociateRecords_messageType
+func (x *fastReflection_MsgGrantNameAccess) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGrantNameAccess_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDissociateRecords) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateRecords)
+func (x *fastReflection_MsgGrantNameAccess) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantNameAccess)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDissociateRecords) Interface() protoreflect.ProtoMessage {
-	return (*MsgDissociateRecords)(x)
+func (x *fastReflection_MsgGrantNameAccess) Interface() protoreflect.ProtoMessage {
+	return (*MsgGrantNameAccess)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7784,16 +8408,28 @@ func (x *fastReflection_MsgDissociateRecords) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDissociateRecords) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BondId != "" {
-		value := protoreflect.ValueOfString(x.BondId)
-		if !f(fd_MsgDissociateRecords_bond_id, value) {
+func (x *fastReflection_MsgGrantNameAccess) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LrnPrefix != "" {
+		value := protoreflect.ValueOfString(x.LrnPrefix)
+		if !f(fd_MsgGrantNameAccess_lrn_prefix, value) {
+			return
+		}
+	}
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_MsgGrantNameAccess_grantee, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != nil {
+		value := protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+		if !f(fd_MsgGrantNameAccess_expiry_time, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgDissociateRecords_signer, value) {
+		if !f(fd_MsgGrantNameAccess_signer, value) {
 			return
 		}
 	}
@@ -7810,17 +8446,21 @@ func (x *fastReflection_MsgDissociateRecords) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDissociateRecords) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgGrantNameAccess) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
-		return x.BondId != ""
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
+		return x.LrnPrefix != ""
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		return x.Grantee != ""
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		return x.ExpiryTime != nil
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7830,17 +8470,21 @@ func (x *fastReflection_MsgDissociateRecords) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecords) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgGrantNameAccess) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
-		x.BondId = ""
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
+		x.LrnPrefix = ""
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		x.Grantee = ""
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		x.ExpiryTime = nil
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7850,19 +8494,25 @@ func (x *fastReflection_MsgDissociateRecords) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDissociateRecords) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccess) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
-		value := x.BondId
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
+		value := x.LrnPrefix
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		value := x.Grantee
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7876,17 +8526,21 @@ func (x *fastReflection_MsgDissociateRecords) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecords) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgGrantNameAccess) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
-		x.BondId = value.Interface().(string)
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
+		x.LrnPrefix = value.Interface().(string)
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		x.Grantee = value.Interface().(string)
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		x.ExpiryTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7900,44 +8554,56 @@ func (x *fastReflection_MsgDissociateRecords) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecords) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccess) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
-		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgDissociateRecords is not mutable"))
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDissociateRecords is not mutable"))
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		if x.ExpiryTime == nil {
+			x.ExpiryTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExpiryTime.ProtoReflect())
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
+		panic(fmt.Errorf("field lrn_prefix of message cerc.registry.v1.MsgGrantNameAccess is not mutable"))
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		panic(fmt.Errorf("field grantee of message cerc.registry.v1.MsgGrantNameAccess is not mutable"))
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgGrantNameAccess is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDissociateRecords) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccess) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+	case "cerc.registry.v1.MsgGrantNameAccess.lrn_prefix":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgDissociateRecords.signer":
+	case "cerc.registry.v1.MsgGrantNameAccess.grantee":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgGrantNameAccess.expiry_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cerc.registry.v1.MsgGrantNameAccess.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDissociateRecords) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgGrantNameAccess) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateRecords", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgGrantNameAccess", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7945,7 +8611,7 @@ func (x *fastReflection_MsgDissociateRecords) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDissociateRecords) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgGrantNameAccess) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7956,7 +8622,7 @@ func (x *fastReflection_MsgDissociateRecords) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecords) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgGrantNameAccess) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7968,7 +8634,7 @@ func (x *fastReflection_MsgDissociateRecords) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDissociateRecords) IsValid() bool {
+func (x *fastReflection_MsgGrantNameAccess) IsValid() bool {
 	return x != nil
 }
 
@@ -7978,9 +8644,9 @@ func (x *fastReflection_MsgDissociateRecords) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgGrantNameAccess) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDissociateRecords)
+		x := input.Message.Interface().(*MsgGrantNameAccess)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7992,10 +8658,18 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.BondId)
+		l = len(x.LrnPrefix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Grantee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiryTime != nil {
+			l = options.Size(x.ExpiryTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.Signer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -8010,7 +8684,7 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateRecords)
+		x := input.Message.Interface().(*MsgGrantNameAccess)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8034,12 +8708,33 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], x.Signer)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if x.ExpiryTime != nil {
+			encoded, err := options.Marshal(x.ExpiryTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BondId) > 0 {
-			i -= len(x.BondId)
-			copy(dAtA[i:], x.BondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+		if len(x.LrnPrefix) > 0 {
+			i -= len(x.LrnPrefix)
+			copy(dAtA[i:], x.LrnPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LrnPrefix)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8054,7 +8749,7 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateRecords)
+		x := input.Message.Interface().(*MsgGrantNameAccess)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8086,15 +8781,15 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecords: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantNameAccess: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantNameAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8122,9 +8817,77 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BondId = string(dAtA[iNdEx:postIndex])
+				x.LrnPrefix = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Grantee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExpiryTime == nil {
+					x.ExpiryTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExpiryTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 				}
@@ -8192,23 +8955,23 @@ func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgDissociateRecordsResponse protoreflect.MessageDescriptor
+	md_MsgGrantNameAccessResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgDissociateRecordsResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateRecordsResponse")
+	md_MsgGrantNameAccessResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgGrantNameAccessResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgDissociateRecordsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgGrantNameAccessResponse)(nil)
 
-type fastReflection_MsgDissociateRecordsResponse MsgDissociateRecordsResponse
+type fastReflection_MsgGrantNameAccessResponse MsgGrantNameAccessResponse
 
-func (x *MsgDissociateRecordsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgDissociateRecordsResponse)(x)
+func (x *MsgGrantNameAccessResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGrantNameAccessResponse)(x)
 }
 
-func (x *MsgDissociateRecordsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgGrantNameAccessResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8220,43 +8983,43 @@ func (x *MsgDissociateRecordsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgDissociateRecordsResponse_messageType fastReflection_MsgDissociateRecordsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgDissociateRecordsResponse_messageType{}
+var _fastReflection_MsgGrantNameAccessResponse_messageType fastReflection_MsgGrantNameAccessResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGrantNameAccessResponse_messageType{}
 
-type fastReflection_MsgDissociateRecordsResponse_messageType struct{}
+type fastReflection_MsgGrantNameAccessResponse_messageType struct{}
 
-func (x fastReflection_MsgDissociateRecordsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgDissociateRecordsResponse)(nil)
+func (x fastReflection_MsgGrantNameAccessResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGrantNameAccessResponse)(nil)
 }
-func (x fastReflection_MsgDissociateRecordsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateRecordsResponse)
+func (x fastReflection_MsgGrantNameAccessResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantNameAccessResponse)
 }
-func (x fastReflection_MsgDissociateRecordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateRecordsResponse
+func (x fastReflection_MsgGrantNameAccessResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantNameAccessResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgDissociateRecordsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgDissociateRecordsResponse
+func (x *fastReflection_MsgGrantNameAccessResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantNameAccessResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgDissociateRecordsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgDissociateRecordsResponse_messageType
+func (x *fastReflection_MsgGrantNameAccessResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGrantNameAccessResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgDissociateRecordsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgDissociateRecordsResponse)
+func (x *fastReflection_MsgGrantNameAccessResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantNameAccessResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgDissociateRecordsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgDissociateRecordsResponse)(x)
+func (x *fastReflection_MsgGrantNameAccessResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgGrantNameAccessResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8264,7 +9027,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgDissociateRecordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgGrantNameAccessResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8278,13 +9041,13 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgDissociateRecordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgGrantNameAccessResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8294,13 +9057,13 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgGrantNameAccessResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8310,13 +9073,13 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgDissociateRecordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccessResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8330,13 +9093,13 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgGrantNameAccessResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8350,36 +9113,36 @@ func (x *fastReflection_MsgDissociateRecordsResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccessResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgDissociateRecordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgGrantNameAccessResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgGrantNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgGrantNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgDissociateRecordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgGrantNameAccessResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateRecordsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgGrantNameAccessResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8387,7 +9150,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgDissociateRecordsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgGrantNameAccessResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8398,7 +9161,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgDissociateRecordsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgGrantNameAccessResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8410,7 +9173,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgDissociateRecordsResponse) IsValid() bool {
+func (x *fastReflection_MsgGrantNameAccessResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8420,9 +9183,9 @@ func (x *fastReflection_MsgDissociateRecordsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgGrantNameAccessResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		x := input.Message.Interface().(*MsgGrantNameAccessResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8444,7 +9207,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		x := input.Message.Interface().(*MsgGrantNameAccessResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8474,7 +9237,7 @@ func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		x := input.Message.Interface().(*MsgGrantNameAccessResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8506,10 +9269,10 @@ func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecordsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantNameAccessResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantNameAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -8548,29 +9311,29 @@ func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface
 }
 
 var (
-	md_MsgReassociateRecords             protoreflect.MessageDescriptor
-	fd_MsgReassociateRecords_new_bond_id protoreflect.FieldDescriptor
-	fd_MsgReassociateRecords_old_bond_id protoreflect.FieldDescriptor
-	fd_MsgReassociateRecords_signer      protoreflect.FieldDescriptor
+	md_MsgRevokeNameAccess            protoreflect.MessageDescriptor
+	fd_MsgRevokeNameAccess_lrn_prefix protoreflect.FieldDescriptor
+	fd_MsgRevokeNameAccess_grantee    protoreflect.FieldDescriptor
+	fd_MsgRevokeNameAccess_signer     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgReassociateRecords = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReassociateRecords")
-	fd_MsgReassociateRecords_new_bond_id = md_MsgReassociateRecords.Fields().ByName("new_bond_id")
-	fd_MsgReassociateRecords_old_bond_id = md_MsgReassociateRecords.Fields().ByName("old_bond_id")
-	fd_MsgReassociateRecords_signer = md_MsgReassociateRecords.Fields().ByName("signer")
+	md_MsgRevokeNameAccess = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRevokeNameAccess")
+	fd_MsgRevokeNameAccess_lrn_prefix = md_MsgRevokeNameAccess.Fields().ByName("lrn_prefix")
+	fd_MsgRevokeNameAccess_grantee = md_MsgRevokeNameAccess.Fields().ByName("grantee")
+	fd_MsgRevokeNameAccess_signer = md_MsgRevokeNameAccess.Fields().ByName("signer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgReassociateRecords)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeNameAccess)(nil)
 
-type fastReflection_MsgReassociateRecords MsgReassociateRecords
+type fastReflection_MsgRevokeNameAccess MsgRevokeNameAccess
 
-func (x *MsgReassociateRecords) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgReassociateRecords)(x)
+func (x *MsgRevokeNameAccess) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeNameAccess)(x)
 }
 
-func (x *MsgReassociateRecords) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeNameAccess) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8582,43 +9345,43 @@ func (x *MsgReassociateRecords) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgReassociateRecords_messageType fastReflection_MsgReassociateRecords_messageType
-var _ protoreflect.MessageType = fastReflection_MsgReassociateRecords_messageType{}
+var _fastReflection_MsgRevokeNameAccess_messageType fastReflection_MsgRevokeNameAccess_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeNameAccess_messageType{}
 
-type fastReflection_MsgReassociateRecords_messageType struct{}
+type fastReflection_MsgRevokeNameAccess_messageType struct{}
 
-func (x fastReflection_MsgReassociateRecords_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgReassociateRecords)(nil)
+func (x fastReflection_MsgRevokeNameAccess_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeNameAccess)(nil)
 }
-func (x fastReflection_MsgReassociateRecords_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgReassociateRecords)
+func (x fastReflection_MsgRevokeNameAccess_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeNameAccess)
 }
-func (x fastReflection_MsgReassociateRecords_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReassociateRecords
+func (x fastReflection_MsgRevokeNameAccess_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeNameAccess
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgReassociateRecords) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReassociateRecords
+func (x *fastReflection_MsgRevokeNameAccess) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeNameAccess
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgReassociateRecords) Type() protoreflect.MessageType {
-	return _fastReflection_MsgReassociateRecords_messageType
+func (x *fastReflection_MsgRevokeNameAccess) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeNameAccess_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgReassociateRecords) New() protoreflect.Message {
-	return new(fastReflection_MsgReassociateRecords)
+func (x *fastReflection_MsgRevokeNameAccess) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeNameAccess)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgReassociateRecords) Interface() protoreflect.ProtoMessage {
-	return (*MsgReassociateRecords)(x)
+func (x *fastReflection_MsgRevokeNameAccess) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeNameAccess)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8626,22 +9389,22 @@ func (x *fastReflection_MsgReassociateRecords) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgReassociateRecords) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.NewBondId != "" {
-		value := protoreflect.ValueOfString(x.NewBondId)
-		if !f(fd_MsgReassociateRecords_new_bond_id, value) {
+func (x *fastReflection_MsgRevokeNameAccess) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LrnPrefix != "" {
+		value := protoreflect.ValueOfString(x.LrnPrefix)
+		if !f(fd_MsgRevokeNameAccess_lrn_prefix, value) {
 			return
 		}
 	}
-	if x.OldBondId != "" {
-		value := protoreflect.ValueOfString(x.OldBondId)
-		if !f(fd_MsgReassociateRecords_old_bond_id, value) {
+	if x.Grantee != "" {
+		value := protoreflect.ValueOfString(x.Grantee)
+		if !f(fd_MsgRevokeNameAccess_grantee, value) {
 			return
 		}
 	}
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgReassociateRecords_signer, value) {
+		if !f(fd_MsgRevokeNameAccess_signer, value) {
 			return
 		}
 	}
@@ -8658,19 +9421,19 @@ func (x *fastReflection_MsgReassociateRecords) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgReassociateRecords) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeNameAccess) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
-		return x.NewBondId != ""
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
-		return x.OldBondId != ""
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
+		return x.LrnPrefix != ""
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
+		return x.Grantee != ""
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
 		return x.Signer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8680,19 +9443,19 @@ func (x *fastReflection_MsgReassociateRecords) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecords) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeNameAccess) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
-		x.NewBondId = ""
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
-		x.OldBondId = ""
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
+		x.LrnPrefix = ""
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
+		x.Grantee = ""
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
 		x.Signer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8702,22 +9465,22 @@ func (x *fastReflection_MsgReassociateRecords) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgReassociateRecords) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccess) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
-		value := x.NewBondId
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
+		value := x.LrnPrefix
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
-		value := x.OldBondId
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
+		value := x.Grantee
 		return protoreflect.ValueOfString(value)
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8731,19 +9494,19 @@ func (x *fastReflection_MsgReassociateRecords) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecords) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeNameAccess) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
-		x.NewBondId = value.Interface().(string)
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
-		x.OldBondId = value.Interface().(string)
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
+		x.LrnPrefix = value.Interface().(string)
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
+		x.Grantee = value.Interface().(string)
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
 		x.Signer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8757,48 +9520,48 @@ func (x *fastReflection_MsgReassociateRecords) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecords) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccess) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
-		panic(fmt.Errorf("field new_bond_id of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
-		panic(fmt.Errorf("field old_bond_id of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
-		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
+		panic(fmt.Errorf("field lrn_prefix of message cerc.registry.v1.MsgRevokeNameAccess is not mutable"))
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
+		panic(fmt.Errorf("field grantee of message cerc.registry.v1.MsgRevokeNameAccess is not mutable"))
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgRevokeNameAccess is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgReassociateRecords) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccess) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+	case "cerc.registry.v1.MsgRevokeNameAccess.lrn_prefix":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+	case "cerc.registry.v1.MsgRevokeNameAccess.grantee":
 		return protoreflect.ValueOfString("")
-	case "cerc.registry.v1.MsgReassociateRecords.signer":
+	case "cerc.registry.v1.MsgRevokeNameAccess.signer":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccess"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccess does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgReassociateRecords) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeNameAccess) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReassociateRecords", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRevokeNameAccess", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8806,7 +9569,7 @@ func (x *fastReflection_MsgReassociateRecords) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgReassociateRecords) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeNameAccess) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8817,7 +9580,7 @@ func (x *fastReflection_MsgReassociateRecords) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecords) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeNameAccess) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8829,7 +9592,7 @@ func (x *fastReflection_MsgReassociateRecords) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgReassociateRecords) IsValid() bool {
+func (x *fastReflection_MsgRevokeNameAccess) IsValid() bool {
 	return x != nil
 }
 
@@ -8839,9 +9602,9 @@ func (x *fastReflection_MsgReassociateRecords) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeNameAccess) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgReassociateRecords)
+		x := input.Message.Interface().(*MsgRevokeNameAccess)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8853,11 +9616,11 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.NewBondId)
+		l = len(x.LrnPrefix)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.OldBondId)
+		l = len(x.Grantee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8875,7 +9638,7 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReassociateRecords)
+		x := input.Message.Interface().(*MsgRevokeNameAccess)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8901,17 +9664,17 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.OldBondId) > 0 {
-			i -= len(x.OldBondId)
-			copy(dAtA[i:], x.OldBondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldBondId)))
+		if len(x.Grantee) > 0 {
+			i -= len(x.Grantee)
+			copy(dAtA[i:], x.Grantee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Grantee)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.NewBondId) > 0 {
-			i -= len(x.NewBondId)
-			copy(dAtA[i:], x.NewBondId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewBondId)))
+		if len(x.LrnPrefix) > 0 {
+			i -= len(x.LrnPrefix)
+			copy(dAtA[i:], x.LrnPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LrnPrefix)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8926,7 +9689,7 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReassociateRecords)
+		x := input.Message.Interface().(*MsgRevokeNameAccess)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8958,15 +9721,15 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecords: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeNameAccess: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeNameAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBondId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8994,11 +9757,11 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewBondId = string(dAtA[iNdEx:postIndex])
+				x.LrnPrefix = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldBondId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9026,7 +9789,7 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OldBondId = string(dAtA[iNdEx:postIndex])
+				x.Grantee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
@@ -9096,23 +9859,23 @@ func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgReassociateRecordsResponse protoreflect.MessageDescriptor
+	md_MsgRevokeNameAccessResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cerc_registry_v1_tx_proto_init()
-	md_MsgReassociateRecordsResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReassociateRecordsResponse")
+	md_MsgRevokeNameAccessResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRevokeNameAccessResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgReassociateRecordsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRevokeNameAccessResponse)(nil)
 
-type fastReflection_MsgReassociateRecordsResponse MsgReassociateRecordsResponse
+type fastReflection_MsgRevokeNameAccessResponse MsgRevokeNameAccessResponse
 
-func (x *MsgReassociateRecordsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgReassociateRecordsResponse)(x)
+func (x *MsgRevokeNameAccessResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeNameAccessResponse)(x)
 }
 
-func (x *MsgReassociateRecordsResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRevokeNameAccessResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cerc_registry_v1_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9124,43 +9887,43 @@ func (x *MsgReassociateRecordsResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgReassociateRecordsResponse_messageType fastReflection_MsgReassociateRecordsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgReassociateRecordsResponse_messageType{}
+var _fastReflection_MsgRevokeNameAccessResponse_messageType fastReflection_MsgRevokeNameAccessResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeNameAccessResponse_messageType{}
 
-type fastReflection_MsgReassociateRecordsResponse_messageType struct{}
+type fastReflection_MsgRevokeNameAccessResponse_messageType struct{}
 
-func (x fastReflection_MsgReassociateRecordsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgReassociateRecordsResponse)(nil)
+func (x fastReflection_MsgRevokeNameAccessResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeNameAccessResponse)(nil)
 }
-func (x fastReflection_MsgReassociateRecordsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgReassociateRecordsResponse)
+func (x fastReflection_MsgRevokeNameAccessResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeNameAccessResponse)
 }
-func (x fastReflection_MsgReassociateRecordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReassociateRecordsResponse
+func (x fastReflection_MsgRevokeNameAccessResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeNameAccessResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgReassociateRecordsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgReassociateRecordsResponse
+func (x *fastReflection_MsgRevokeNameAccessResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeNameAccessResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgReassociateRecordsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgReassociateRecordsResponse_messageType
+func (x *fastReflection_MsgRevokeNameAccessResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeNameAccessResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgReassociateRecordsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgReassociateRecordsResponse)
+func (x *fastReflection_MsgRevokeNameAccessResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeNameAccessResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgReassociateRecordsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgReassociateRecordsResponse)(x)
+func (x *fastReflection_MsgRevokeNameAccessResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeNameAccessResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9168,7 +9931,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgReassociateRecordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9182,13 +9945,13 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgReassociateRecordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9198,13 +9961,13 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9214,13 +9977,13 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgReassociateRecordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9234,13 +9997,13 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9254,36 +10017,36 @@ func (x *fastReflection_MsgReassociateRecordsResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccessResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgReassociateRecordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRevokeNameAccessResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRevokeNameAccessResponse"))
 		}
-		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRevokeNameAccessResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgReassociateRecordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRevokeNameAccessResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReassociateRecordsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRevokeNameAccessResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9291,7 +10054,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgReassociateRecordsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRevokeNameAccessResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9302,7 +10065,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgReassociateRecordsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRevokeNameAccessResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9314,7 +10077,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgReassociateRecordsResponse) IsValid() bool {
+func (x *fastReflection_MsgRevokeNameAccessResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9324,9 +10087,9 @@ func (x *fastReflection_MsgReassociateRecordsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRevokeNameAccessResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		x := input.Message.Interface().(*MsgRevokeNameAccessResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9348,7 +10111,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		x := input.Message.Interface().(*MsgRevokeNameAccessResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9378,7 +10141,7 @@ func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		x := input.Message.Interface().(*MsgRevokeNameAccessResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9410,10 +10173,10 @@ func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecordsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeNameAccessResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeNameAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -9451,387 +10214,9725 @@ func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoifac
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cerc/registry/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgDeleteName        protoreflect.MessageDescriptor
+	fd_MsgDeleteName_lrn    protoreflect.FieldDescriptor
+	fd_MsgDeleteName_signer protoreflect.FieldDescriptor
 )
 
-// MsgSetRecord
-type MsgSetRecord struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDeleteName = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteName")
+	fd_MsgDeleteName_lrn = md_MsgDeleteName.Fields().ByName("lrn")
+	fd_MsgDeleteName_signer = md_MsgDeleteName.Fields().ByName("signer")
+}
 
-	BondId  string   `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
-	Signer  string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
-	Payload *Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgDeleteName)(nil)
+
+type fastReflection_MsgDeleteName MsgDeleteName
+
+func (x *MsgDeleteName) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteName)(x)
 }
 
-func (x *MsgSetRecord) Reset() {
-	*x = MsgSetRecord{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[0]
+func (x *MsgDeleteName) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgSetRecord) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgDeleteName_messageType fastReflection_MsgDeleteName_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteName_messageType{}
 
-func (*MsgSetRecord) ProtoMessage() {}
+type fastReflection_MsgDeleteName_messageType struct{}
 
-// Deprecated: Use MsgSetRecord.ProtoReflect.Descriptor instead.
-func (*MsgSetRecord) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgDeleteName_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteName)(nil)
 }
-
-func (x *MsgSetRecord) GetBondId() string {
-	if x != nil {
-		return x.BondId
-	}
-	return ""
+func (x fastReflection_MsgDeleteName_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteName)
 }
-
-func (x *MsgSetRecord) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
+func (x fastReflection_MsgDeleteName_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteName
 }
 
-func (x *MsgSetRecord) GetPayload() *Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeleteName) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteName
 }
 
-// MsgSetRecordResponse
-type MsgSetRecordResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeleteName) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteName_messageType
 }
 
-func (x *MsgSetRecordResponse) Reset() {
-	*x = MsgSetRecordResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeleteName) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteName)
 }
 
-func (x *MsgSetRecordResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeleteName) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteName)(x)
 }
 
-func (*MsgSetRecordResponse) ProtoMessage() {}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeleteName) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Lrn != "" {
+		value := protoreflect.ValueOfString(x.Lrn)
+		if !f(fd_MsgDeleteName_lrn, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgDeleteName_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeleteName) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		return x.Lrn != ""
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteName) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		x.Lrn = ""
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeleteName) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		value := x.Lrn
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteName) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		x.Lrn = value.Interface().(string)
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteName) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		panic(fmt.Errorf("field lrn of message cerc.registry.v1.MsgDeleteName is not mutable"))
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDeleteName is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeleteName) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteName.lrn":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDeleteName.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteName"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteName does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeleteName) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteName", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeleteName) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteName) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeleteName) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeleteName) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeleteName)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Lrn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteName)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Lrn) > 0 {
+			i -= len(x.Lrn)
+			copy(dAtA[i:], x.Lrn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Lrn)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteName)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteName: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteName: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Lrn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeleteNameResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDeleteNameResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteNameResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeleteNameResponse)(nil)
+
+type fastReflection_MsgDeleteNameResponse MsgDeleteNameResponse
+
+func (x *MsgDeleteNameResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteNameResponse)(x)
+}
+
+func (x *MsgDeleteNameResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeleteNameResponse_messageType fastReflection_MsgDeleteNameResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteNameResponse_messageType{}
+
+type fastReflection_MsgDeleteNameResponse_messageType struct{}
+
+func (x fastReflection_MsgDeleteNameResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteNameResponse)(nil)
+}
+func (x fastReflection_MsgDeleteNameResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteNameResponse)
+}
+func (x fastReflection_MsgDeleteNameResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteNameResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeleteNameResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteNameResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeleteNameResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteNameResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeleteNameResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteNameResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeleteNameResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteNameResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeleteNameResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeleteNameResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteNameResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeleteNameResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteNameResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteNameResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeleteNameResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteNameResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteNameResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeleteNameResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteNameResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeleteNameResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteNameResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeleteNameResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeleteNameResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteNameResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRenewRecord_4_list)(nil)
+
+type _MsgRenewRecord_4_list struct {
+	list *[]*Signature
+}
+
+func (x *_MsgRenewRecord_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRenewRecord_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgRenewRecord_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRenewRecord_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRenewRecord_4_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRenewRecord_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRenewRecord_4_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgRenewRecord_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRenewRecord            protoreflect.MessageDescriptor
+	fd_MsgRenewRecord_record_id  protoreflect.FieldDescriptor
+	fd_MsgRenewRecord_signer     protoreflect.FieldDescriptor
+	fd_MsgRenewRecord_periods    protoreflect.FieldDescriptor
+	fd_MsgRenewRecord_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgRenewRecord = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewRecord")
+	fd_MsgRenewRecord_record_id = md_MsgRenewRecord.Fields().ByName("record_id")
+	fd_MsgRenewRecord_signer = md_MsgRenewRecord.Fields().ByName("signer")
+	fd_MsgRenewRecord_periods = md_MsgRenewRecord.Fields().ByName("periods")
+	fd_MsgRenewRecord_signatures = md_MsgRenewRecord.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRenewRecord)(nil)
+
+type fastReflection_MsgRenewRecord MsgRenewRecord
+
+func (x *MsgRenewRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewRecord)(x)
+}
+
+func (x *MsgRenewRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRenewRecord_messageType fastReflection_MsgRenewRecord_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewRecord_messageType{}
+
+type fastReflection_MsgRenewRecord_messageType struct{}
+
+func (x fastReflection_MsgRenewRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewRecord)(nil)
+}
+func (x fastReflection_MsgRenewRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewRecord)
+}
+func (x fastReflection_MsgRenewRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRenewRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRenewRecord) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRenewRecord) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRenewRecord) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRenewRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgRenewRecord_record_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgRenewRecord_signer, value) {
+			return
+		}
+	}
+	if x.Periods != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Periods)
+		if !f(fd_MsgRenewRecord_periods, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRenewRecord_4_list{list: &x.Signatures})
+		if !f(fd_MsgRenewRecord_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRenewRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		return x.Signer != ""
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		return x.Periods != uint64(0)
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		x.Signer = ""
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		x.Periods = uint64(0)
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRenewRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		value := x.Periods
+		return protoreflect.ValueOfUint64(value)
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgRenewRecord_4_list{})
+		}
+		listValue := &_MsgRenewRecord_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		x.Periods = value.Uint()
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgRenewRecord_4_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_MsgRenewRecord_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgRenewRecord is not mutable"))
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgRenewRecord is not mutable"))
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		panic(fmt.Errorf("field periods of message cerc.registry.v1.MsgRenewRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRenewRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRenewRecord.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRenewRecord.signer":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRenewRecord.periods":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cerc.registry.v1.MsgRenewRecord.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_MsgRenewRecord_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRenewRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRenewRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRenewRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRenewRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRenewRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Periods != 0 {
+			n += 1 + runtime.Sov(uint64(x.Periods))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRenewRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Periods != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Periods))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRenewRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+				}
+				x.Periods = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Periods |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRenewRecordResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgRenewRecordResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRenewRecordResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRenewRecordResponse)(nil)
+
+type fastReflection_MsgRenewRecordResponse MsgRenewRecordResponse
+
+func (x *MsgRenewRecordResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRenewRecordResponse)(x)
+}
+
+func (x *MsgRenewRecordResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRenewRecordResponse_messageType fastReflection_MsgRenewRecordResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRenewRecordResponse_messageType{}
+
+type fastReflection_MsgRenewRecordResponse_messageType struct{}
+
+func (x fastReflection_MsgRenewRecordResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRenewRecordResponse)(nil)
+}
+func (x fastReflection_MsgRenewRecordResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewRecordResponse)
+}
+func (x fastReflection_MsgRenewRecordResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewRecordResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRenewRecordResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRenewRecordResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRenewRecordResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRenewRecordResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRenewRecordResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRenewRecordResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRenewRecordResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRenewRecordResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRenewRecordResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRenewRecordResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecordResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRenewRecordResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecordResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecordResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRenewRecordResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRenewRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRenewRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRenewRecordResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRenewRecordResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRenewRecordResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRenewRecordResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRenewRecordResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRenewRecordResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRenewRecordResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecordResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRenewRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgDeleteRecord_3_list)(nil)
+
+type _MsgDeleteRecord_3_list struct {
+	list *[]*Signature
+}
+
+func (x *_MsgDeleteRecord_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgDeleteRecord_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgDeleteRecord_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgDeleteRecord_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgDeleteRecord_3_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDeleteRecord_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgDeleteRecord_3_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDeleteRecord_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgDeleteRecord            protoreflect.MessageDescriptor
+	fd_MsgDeleteRecord_record_id  protoreflect.FieldDescriptor
+	fd_MsgDeleteRecord_signer     protoreflect.FieldDescriptor
+	fd_MsgDeleteRecord_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDeleteRecord = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteRecord")
+	fd_MsgDeleteRecord_record_id = md_MsgDeleteRecord.Fields().ByName("record_id")
+	fd_MsgDeleteRecord_signer = md_MsgDeleteRecord.Fields().ByName("signer")
+	fd_MsgDeleteRecord_signatures = md_MsgDeleteRecord.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeleteRecord)(nil)
+
+type fastReflection_MsgDeleteRecord MsgDeleteRecord
+
+func (x *MsgDeleteRecord) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteRecord)(x)
+}
+
+func (x *MsgDeleteRecord) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeleteRecord_messageType fastReflection_MsgDeleteRecord_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteRecord_messageType{}
+
+type fastReflection_MsgDeleteRecord_messageType struct{}
+
+func (x fastReflection_MsgDeleteRecord_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteRecord)(nil)
+}
+func (x fastReflection_MsgDeleteRecord_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteRecord)
+}
+func (x fastReflection_MsgDeleteRecord_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteRecord
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeleteRecord) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteRecord
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeleteRecord) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteRecord_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeleteRecord) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteRecord)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeleteRecord) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteRecord)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeleteRecord) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgDeleteRecord_record_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgDeleteRecord_signer, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgDeleteRecord_3_list{list: &x.Signatures})
+		if !f(fd_MsgDeleteRecord_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeleteRecord) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		return x.Signer != ""
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecord) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		x.Signer = ""
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeleteRecord) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgDeleteRecord_3_list{})
+		}
+		listValue := &_MsgDeleteRecord_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecord) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgDeleteRecord_3_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecord) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_MsgDeleteRecord_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgDeleteRecord is not mutable"))
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDeleteRecord is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeleteRecord) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDeleteRecord.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDeleteRecord.signer":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDeleteRecord.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_MsgDeleteRecord_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecord"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecord does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeleteRecord) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteRecord", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeleteRecord) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecord) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeleteRecord) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeleteRecord) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeleteRecord)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteRecord)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteRecord)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteRecord: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDeleteRecordResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDeleteRecordResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDeleteRecordResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDeleteRecordResponse)(nil)
+
+type fastReflection_MsgDeleteRecordResponse MsgDeleteRecordResponse
+
+func (x *MsgDeleteRecordResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDeleteRecordResponse)(x)
+}
+
+func (x *MsgDeleteRecordResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDeleteRecordResponse_messageType fastReflection_MsgDeleteRecordResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDeleteRecordResponse_messageType{}
+
+type fastReflection_MsgDeleteRecordResponse_messageType struct{}
+
+func (x fastReflection_MsgDeleteRecordResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDeleteRecordResponse)(nil)
+}
+func (x fastReflection_MsgDeleteRecordResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteRecordResponse)
+}
+func (x fastReflection_MsgDeleteRecordResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteRecordResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDeleteRecordResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDeleteRecordResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDeleteRecordResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDeleteRecordResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDeleteRecordResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDeleteRecordResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDeleteRecordResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDeleteRecordResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDeleteRecordResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDeleteRecordResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecordResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDeleteRecordResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecordResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecordResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDeleteRecordResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDeleteRecordResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDeleteRecordResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDeleteRecordResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDeleteRecordResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDeleteRecordResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDeleteRecordResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDeleteRecordResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDeleteRecordResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDeleteRecordResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteRecordResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDeleteRecordResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgTransferRecordOwnership_2_list)(nil)
+
+type _MsgTransferRecordOwnership_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgTransferRecordOwnership at list field Owners as it is not of Message kind"))
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgTransferRecordOwnership_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgTransferRecordOwnership_4_list)(nil)
+
+type _MsgTransferRecordOwnership_4_list struct {
+	list *[]*Signature
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgTransferRecordOwnership_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgTransferRecordOwnership                 protoreflect.MessageDescriptor
+	fd_MsgTransferRecordOwnership_record_id       protoreflect.FieldDescriptor
+	fd_MsgTransferRecordOwnership_owners          protoreflect.FieldDescriptor
+	fd_MsgTransferRecordOwnership_owner_threshold protoreflect.FieldDescriptor
+	fd_MsgTransferRecordOwnership_signatures      protoreflect.FieldDescriptor
+	fd_MsgTransferRecordOwnership_signer          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgTransferRecordOwnership = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgTransferRecordOwnership")
+	fd_MsgTransferRecordOwnership_record_id = md_MsgTransferRecordOwnership.Fields().ByName("record_id")
+	fd_MsgTransferRecordOwnership_owners = md_MsgTransferRecordOwnership.Fields().ByName("owners")
+	fd_MsgTransferRecordOwnership_owner_threshold = md_MsgTransferRecordOwnership.Fields().ByName("owner_threshold")
+	fd_MsgTransferRecordOwnership_signatures = md_MsgTransferRecordOwnership.Fields().ByName("signatures")
+	fd_MsgTransferRecordOwnership_signer = md_MsgTransferRecordOwnership.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferRecordOwnership)(nil)
+
+type fastReflection_MsgTransferRecordOwnership MsgTransferRecordOwnership
+
+func (x *MsgTransferRecordOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferRecordOwnership)(x)
+}
+
+func (x *MsgTransferRecordOwnership) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferRecordOwnership_messageType fastReflection_MsgTransferRecordOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferRecordOwnership_messageType{}
+
+type fastReflection_MsgTransferRecordOwnership_messageType struct{}
+
+func (x fastReflection_MsgTransferRecordOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferRecordOwnership)(nil)
+}
+func (x fastReflection_MsgTransferRecordOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferRecordOwnership)
+}
+func (x fastReflection_MsgTransferRecordOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferRecordOwnership
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferRecordOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferRecordOwnership
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferRecordOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferRecordOwnership_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferRecordOwnership) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferRecordOwnership)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferRecordOwnership) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferRecordOwnership)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferRecordOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgTransferRecordOwnership_record_id, value) {
+			return
+		}
+	}
+	if len(x.Owners) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTransferRecordOwnership_2_list{list: &x.Owners})
+		if !f(fd_MsgTransferRecordOwnership_owners, value) {
+			return
+		}
+	}
+	if x.OwnerThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.OwnerThreshold)
+		if !f(fd_MsgTransferRecordOwnership_owner_threshold, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgTransferRecordOwnership_4_list{list: &x.Signatures})
+		if !f(fd_MsgTransferRecordOwnership_signatures, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgTransferRecordOwnership_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferRecordOwnership) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		return len(x.Owners) != 0
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		return x.OwnerThreshold != uint32(0)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		return len(x.Signatures) != 0
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnership) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		x.Owners = nil
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		x.OwnerThreshold = uint32(0)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		x.Signatures = nil
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferRecordOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		if len(x.Owners) == 0 {
+			return protoreflect.ValueOfList(&_MsgTransferRecordOwnership_2_list{})
+		}
+		listValue := &_MsgTransferRecordOwnership_2_list{list: &x.Owners}
+		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		value := x.OwnerThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgTransferRecordOwnership_4_list{})
+		}
+		listValue := &_MsgTransferRecordOwnership_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		lv := value.List()
+		clv := lv.(*_MsgTransferRecordOwnership_2_list)
+		x.Owners = *clv.list
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		x.OwnerThreshold = uint32(value.Uint())
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgTransferRecordOwnership_4_list)
+		x.Signatures = *clv.list
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		if x.Owners == nil {
+			x.Owners = []string{}
+		}
+		value := &_MsgTransferRecordOwnership_2_list{list: &x.Owners}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_MsgTransferRecordOwnership_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgTransferRecordOwnership is not mutable"))
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		panic(fmt.Errorf("field owner_threshold of message cerc.registry.v1.MsgTransferRecordOwnership is not mutable"))
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgTransferRecordOwnership is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferRecordOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgTransferRecordOwnership.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owners":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgTransferRecordOwnership_2_list{list: &list})
+	case "cerc.registry.v1.MsgTransferRecordOwnership.owner_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_MsgTransferRecordOwnership_4_list{list: &list})
+	case "cerc.registry.v1.MsgTransferRecordOwnership.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnership"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferRecordOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgTransferRecordOwnership", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferRecordOwnership) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnership) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferRecordOwnership) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferRecordOwnership) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferRecordOwnership)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Owners) > 0 {
+			for _, s := range x.Owners {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.OwnerThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.OwnerThreshold))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferRecordOwnership)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.OwnerThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OwnerThreshold))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owners) > 0 {
+			for iNdEx := len(x.Owners) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Owners[iNdEx])
+				copy(dAtA[i:], x.Owners[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owners[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferRecordOwnership)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferRecordOwnership: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferRecordOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owners = append(x.Owners, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+				}
+				x.OwnerThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OwnerThreshold |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferRecordOwnershipResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgTransferRecordOwnershipResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgTransferRecordOwnershipResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferRecordOwnershipResponse)(nil)
+
+type fastReflection_MsgTransferRecordOwnershipResponse MsgTransferRecordOwnershipResponse
+
+func (x *MsgTransferRecordOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferRecordOwnershipResponse)(x)
+}
+
+func (x *MsgTransferRecordOwnershipResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferRecordOwnershipResponse_messageType fastReflection_MsgTransferRecordOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferRecordOwnershipResponse_messageType{}
+
+type fastReflection_MsgTransferRecordOwnershipResponse_messageType struct{}
+
+func (x fastReflection_MsgTransferRecordOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferRecordOwnershipResponse)(nil)
+}
+func (x fastReflection_MsgTransferRecordOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferRecordOwnershipResponse)
+}
+func (x fastReflection_MsgTransferRecordOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferRecordOwnershipResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferRecordOwnershipResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferRecordOwnershipResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferRecordOwnershipResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferRecordOwnershipResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgTransferRecordOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgTransferRecordOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgTransferRecordOwnershipResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferRecordOwnershipResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferRecordOwnershipResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferRecordOwnershipResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferRecordOwnershipResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferRecordOwnershipResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferRecordOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgAssociateBond_4_list)(nil)
+
+type _MsgAssociateBond_4_list struct {
+	list *[]*Signature
+}
+
+func (x *_MsgAssociateBond_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAssociateBond_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgAssociateBond_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAssociateBond_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAssociateBond_4_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgAssociateBond_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAssociateBond_4_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgAssociateBond_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgAssociateBond            protoreflect.MessageDescriptor
+	fd_MsgAssociateBond_record_id  protoreflect.FieldDescriptor
+	fd_MsgAssociateBond_bond_id    protoreflect.FieldDescriptor
+	fd_MsgAssociateBond_signer     protoreflect.FieldDescriptor
+	fd_MsgAssociateBond_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgAssociateBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAssociateBond")
+	fd_MsgAssociateBond_record_id = md_MsgAssociateBond.Fields().ByName("record_id")
+	fd_MsgAssociateBond_bond_id = md_MsgAssociateBond.Fields().ByName("bond_id")
+	fd_MsgAssociateBond_signer = md_MsgAssociateBond.Fields().ByName("signer")
+	fd_MsgAssociateBond_signatures = md_MsgAssociateBond.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAssociateBond)(nil)
+
+type fastReflection_MsgAssociateBond MsgAssociateBond
+
+func (x *MsgAssociateBond) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAssociateBond)(x)
+}
+
+func (x *MsgAssociateBond) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAssociateBond_messageType fastReflection_MsgAssociateBond_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAssociateBond_messageType{}
+
+type fastReflection_MsgAssociateBond_messageType struct{}
+
+func (x fastReflection_MsgAssociateBond_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAssociateBond)(nil)
+}
+func (x fastReflection_MsgAssociateBond_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAssociateBond)
+}
+func (x fastReflection_MsgAssociateBond_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAssociateBond
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAssociateBond) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAssociateBond
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAssociateBond) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAssociateBond_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAssociateBond) New() protoreflect.Message {
+	return new(fastReflection_MsgAssociateBond)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAssociateBond) Interface() protoreflect.ProtoMessage {
+	return (*MsgAssociateBond)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAssociateBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgAssociateBond_record_id, value) {
+			return
+		}
+	}
+	if x.BondId != "" {
+		value := protoreflect.ValueOfString(x.BondId)
+		if !f(fd_MsgAssociateBond_bond_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgAssociateBond_signer, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAssociateBond_4_list{list: &x.Signatures})
+		if !f(fd_MsgAssociateBond_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAssociateBond) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		return x.BondId != ""
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		return x.Signer != ""
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBond) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		x.BondId = ""
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		x.Signer = ""
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAssociateBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		value := x.BondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgAssociateBond_4_list{})
+		}
+		listValue := &_MsgAssociateBond_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		x.BondId = value.Interface().(string)
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgAssociateBond_4_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_MsgAssociateBond_4_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgAssociateBond is not mutable"))
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgAssociateBond is not mutable"))
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgAssociateBond is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAssociateBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgAssociateBond.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgAssociateBond.bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgAssociateBond.signer":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgAssociateBond.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_MsgAssociateBond_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAssociateBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAssociateBond", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAssociateBond) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBond) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAssociateBond) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAssociateBond) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAssociateBond)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BondId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAssociateBond)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BondId) > 0 {
+			i -= len(x.BondId)
+			copy(dAtA[i:], x.BondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAssociateBond)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBond: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAssociateBondResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgAssociateBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgAssociateBondResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAssociateBondResponse)(nil)
+
+type fastReflection_MsgAssociateBondResponse MsgAssociateBondResponse
+
+func (x *MsgAssociateBondResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAssociateBondResponse)(x)
+}
+
+func (x *MsgAssociateBondResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAssociateBondResponse_messageType fastReflection_MsgAssociateBondResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAssociateBondResponse_messageType{}
+
+type fastReflection_MsgAssociateBondResponse_messageType struct{}
+
+func (x fastReflection_MsgAssociateBondResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAssociateBondResponse)(nil)
+}
+func (x fastReflection_MsgAssociateBondResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAssociateBondResponse)
+}
+func (x fastReflection_MsgAssociateBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAssociateBondResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAssociateBondResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAssociateBondResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAssociateBondResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAssociateBondResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAssociateBondResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgAssociateBondResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAssociateBondResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgAssociateBondResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAssociateBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAssociateBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBondResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAssociateBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAssociateBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgAssociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgAssociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAssociateBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgAssociateBondResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAssociateBondResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAssociateBondResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAssociateBondResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAssociateBondResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAssociateBondResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBondResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAssociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgDissociateBond_3_list)(nil)
+
+type _MsgDissociateBond_3_list struct {
+	list *[]*Signature
+}
+
+func (x *_MsgDissociateBond_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgDissociateBond_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgDissociateBond_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgDissociateBond_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Signature)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgDissociateBond_3_list) AppendMutable() protoreflect.Value {
+	v := new(Signature)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDissociateBond_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgDissociateBond_3_list) NewElement() protoreflect.Value {
+	v := new(Signature)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDissociateBond_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgDissociateBond            protoreflect.MessageDescriptor
+	fd_MsgDissociateBond_record_id  protoreflect.FieldDescriptor
+	fd_MsgDissociateBond_signer     protoreflect.FieldDescriptor
+	fd_MsgDissociateBond_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDissociateBond = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateBond")
+	fd_MsgDissociateBond_record_id = md_MsgDissociateBond.Fields().ByName("record_id")
+	fd_MsgDissociateBond_signer = md_MsgDissociateBond.Fields().ByName("signer")
+	fd_MsgDissociateBond_signatures = md_MsgDissociateBond.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDissociateBond)(nil)
+
+type fastReflection_MsgDissociateBond MsgDissociateBond
+
+func (x *MsgDissociateBond) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDissociateBond)(x)
+}
+
+func (x *MsgDissociateBond) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDissociateBond_messageType fastReflection_MsgDissociateBond_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDissociateBond_messageType{}
+
+type fastReflection_MsgDissociateBond_messageType struct{}
+
+func (x fastReflection_MsgDissociateBond_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDissociateBond)(nil)
+}
+func (x fastReflection_MsgDissociateBond_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateBond)
+}
+func (x fastReflection_MsgDissociateBond_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateBond
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDissociateBond) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateBond
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDissociateBond) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDissociateBond_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDissociateBond) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateBond)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDissociateBond) Interface() protoreflect.ProtoMessage {
+	return (*MsgDissociateBond)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDissociateBond) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordId != "" {
+		value := protoreflect.ValueOfString(x.RecordId)
+		if !f(fd_MsgDissociateBond_record_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgDissociateBond_signer, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgDissociateBond_3_list{list: &x.Signatures})
+		if !f(fd_MsgDissociateBond_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDissociateBond) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		return x.RecordId != ""
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		return x.Signer != ""
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBond) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		x.RecordId = ""
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		x.Signer = ""
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDissociateBond) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		value := x.RecordId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgDissociateBond_3_list{})
+		}
+		listValue := &_MsgDissociateBond_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBond) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		x.RecordId = value.Interface().(string)
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		x.Signer = value.Interface().(string)
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgDissociateBond_3_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBond) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []*Signature{}
+		}
+		value := &_MsgDissociateBond_3_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		panic(fmt.Errorf("field record_id of message cerc.registry.v1.MsgDissociateBond is not mutable"))
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDissociateBond is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDissociateBond) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateBond.record_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDissociateBond.signer":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDissociateBond.signatures":
+		list := []*Signature{}
+		return protoreflect.ValueOfList(&_MsgDissociateBond_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBond"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBond does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDissociateBond) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateBond", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDissociateBond) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBond) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDissociateBond) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDissociateBond) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDissociateBond)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, e := range x.Signatures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateBond)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Signatures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordId) > 0 {
+			i -= len(x.RecordId)
+			copy(dAtA[i:], x.RecordId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateBond)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBond: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, &Signature{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Signatures[len(x.Signatures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDissociateBondResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDissociateBondResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateBondResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDissociateBondResponse)(nil)
+
+type fastReflection_MsgDissociateBondResponse MsgDissociateBondResponse
+
+func (x *MsgDissociateBondResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDissociateBondResponse)(x)
+}
+
+func (x *MsgDissociateBondResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDissociateBondResponse_messageType fastReflection_MsgDissociateBondResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDissociateBondResponse_messageType{}
+
+type fastReflection_MsgDissociateBondResponse_messageType struct{}
+
+func (x fastReflection_MsgDissociateBondResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDissociateBondResponse)(nil)
+}
+func (x fastReflection_MsgDissociateBondResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateBondResponse)
+}
+func (x fastReflection_MsgDissociateBondResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateBondResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDissociateBondResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateBondResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDissociateBondResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDissociateBondResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDissociateBondResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateBondResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDissociateBondResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDissociateBondResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDissociateBondResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDissociateBondResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBondResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDissociateBondResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBondResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBondResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDissociateBondResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateBondResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateBondResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDissociateBondResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateBondResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDissociateBondResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateBondResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDissociateBondResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDissociateBondResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateBondResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBondResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDissociateRecords         protoreflect.MessageDescriptor
+	fd_MsgDissociateRecords_bond_id protoreflect.FieldDescriptor
+	fd_MsgDissociateRecords_signer  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDissociateRecords = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateRecords")
+	fd_MsgDissociateRecords_bond_id = md_MsgDissociateRecords.Fields().ByName("bond_id")
+	fd_MsgDissociateRecords_signer = md_MsgDissociateRecords.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDissociateRecords)(nil)
+
+type fastReflection_MsgDissociateRecords MsgDissociateRecords
+
+func (x *MsgDissociateRecords) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDissociateRecords)(x)
+}
+
+func (x *MsgDissociateRecords) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDissociateRecords_messageType fastReflection_MsgDissociateRecords_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDissociateRecords_messageType{}
+
+type fastReflection_MsgDissociateRecords_messageType struct{}
+
+func (x fastReflection_MsgDissociateRecords_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDissociateRecords)(nil)
+}
+func (x fastReflection_MsgDissociateRecords_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateRecords)
+}
+func (x fastReflection_MsgDissociateRecords_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateRecords
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDissociateRecords) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateRecords
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDissociateRecords) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDissociateRecords_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDissociateRecords) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateRecords)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDissociateRecords) Interface() protoreflect.ProtoMessage {
+	return (*MsgDissociateRecords)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDissociateRecords) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BondId != "" {
+		value := protoreflect.ValueOfString(x.BondId)
+		if !f(fd_MsgDissociateRecords_bond_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgDissociateRecords_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDissociateRecords) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		return x.BondId != ""
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecords) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		x.BondId = ""
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDissociateRecords) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		value := x.BondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecords) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		x.BondId = value.Interface().(string)
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecords) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		panic(fmt.Errorf("field bond_id of message cerc.registry.v1.MsgDissociateRecords is not mutable"))
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgDissociateRecords is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDissociateRecords) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgDissociateRecords.bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgDissociateRecords.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDissociateRecords) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateRecords", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDissociateRecords) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecords) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDissociateRecords) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDissociateRecords) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDissociateRecords)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BondId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateRecords)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BondId) > 0 {
+			i -= len(x.BondId)
+			copy(dAtA[i:], x.BondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BondId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateRecords)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecords: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BondId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDissociateRecordsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgDissociateRecordsResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgDissociateRecordsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDissociateRecordsResponse)(nil)
+
+type fastReflection_MsgDissociateRecordsResponse MsgDissociateRecordsResponse
+
+func (x *MsgDissociateRecordsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDissociateRecordsResponse)(x)
+}
+
+func (x *MsgDissociateRecordsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDissociateRecordsResponse_messageType fastReflection_MsgDissociateRecordsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDissociateRecordsResponse_messageType{}
+
+type fastReflection_MsgDissociateRecordsResponse_messageType struct{}
+
+func (x fastReflection_MsgDissociateRecordsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDissociateRecordsResponse)(nil)
+}
+func (x fastReflection_MsgDissociateRecordsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateRecordsResponse)
+}
+func (x fastReflection_MsgDissociateRecordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateRecordsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDissociateRecordsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDissociateRecordsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDissociateRecordsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDissociateRecordsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDissociateRecordsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDissociateRecordsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDissociateRecordsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDissociateRecordsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDissociateRecordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDissociateRecordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDissociateRecordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDissociateRecordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgDissociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgDissociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDissociateRecordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgDissociateRecordsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDissociateRecordsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDissociateRecordsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDissociateRecordsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDissociateRecordsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDissociateRecordsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecordsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDissociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReassociateRecords             protoreflect.MessageDescriptor
+	fd_MsgReassociateRecords_new_bond_id protoreflect.FieldDescriptor
+	fd_MsgReassociateRecords_old_bond_id protoreflect.FieldDescriptor
+	fd_MsgReassociateRecords_signer      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgReassociateRecords = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReassociateRecords")
+	fd_MsgReassociateRecords_new_bond_id = md_MsgReassociateRecords.Fields().ByName("new_bond_id")
+	fd_MsgReassociateRecords_old_bond_id = md_MsgReassociateRecords.Fields().ByName("old_bond_id")
+	fd_MsgReassociateRecords_signer = md_MsgReassociateRecords.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReassociateRecords)(nil)
+
+type fastReflection_MsgReassociateRecords MsgReassociateRecords
+
+func (x *MsgReassociateRecords) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReassociateRecords)(x)
+}
+
+func (x *MsgReassociateRecords) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReassociateRecords_messageType fastReflection_MsgReassociateRecords_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReassociateRecords_messageType{}
+
+type fastReflection_MsgReassociateRecords_messageType struct{}
+
+func (x fastReflection_MsgReassociateRecords_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReassociateRecords)(nil)
+}
+func (x fastReflection_MsgReassociateRecords_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReassociateRecords)
+}
+func (x fastReflection_MsgReassociateRecords_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReassociateRecords
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReassociateRecords) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReassociateRecords
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReassociateRecords) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReassociateRecords_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReassociateRecords) New() protoreflect.Message {
+	return new(fastReflection_MsgReassociateRecords)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReassociateRecords) Interface() protoreflect.ProtoMessage {
+	return (*MsgReassociateRecords)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReassociateRecords) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NewBondId != "" {
+		value := protoreflect.ValueOfString(x.NewBondId)
+		if !f(fd_MsgReassociateRecords_new_bond_id, value) {
+			return
+		}
+	}
+	if x.OldBondId != "" {
+		value := protoreflect.ValueOfString(x.OldBondId)
+		if !f(fd_MsgReassociateRecords_old_bond_id, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgReassociateRecords_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReassociateRecords) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		return x.NewBondId != ""
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		return x.OldBondId != ""
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecords) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		x.NewBondId = ""
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		x.OldBondId = ""
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReassociateRecords) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		value := x.NewBondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		value := x.OldBondId
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecords) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		x.NewBondId = value.Interface().(string)
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		x.OldBondId = value.Interface().(string)
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecords) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		panic(fmt.Errorf("field new_bond_id of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		panic(fmt.Errorf("field old_bond_id of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgReassociateRecords is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReassociateRecords) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgReassociateRecords.new_bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgReassociateRecords.old_bond_id":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgReassociateRecords.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecords"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecords does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReassociateRecords) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReassociateRecords", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReassociateRecords) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecords) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReassociateRecords) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReassociateRecords) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReassociateRecords)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NewBondId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OldBondId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReassociateRecords)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.OldBondId) > 0 {
+			i -= len(x.OldBondId)
+			copy(dAtA[i:], x.OldBondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldBondId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.NewBondId) > 0 {
+			i -= len(x.NewBondId)
+			copy(dAtA[i:], x.NewBondId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewBondId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReassociateRecords)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecords: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBondId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewBondId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldBondId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldBondId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReassociateRecordsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgReassociateRecordsResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgReassociateRecordsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReassociateRecordsResponse)(nil)
+
+type fastReflection_MsgReassociateRecordsResponse MsgReassociateRecordsResponse
+
+func (x *MsgReassociateRecordsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReassociateRecordsResponse)(x)
+}
+
+func (x *MsgReassociateRecordsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReassociateRecordsResponse_messageType fastReflection_MsgReassociateRecordsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReassociateRecordsResponse_messageType{}
+
+type fastReflection_MsgReassociateRecordsResponse_messageType struct{}
+
+func (x fastReflection_MsgReassociateRecordsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReassociateRecordsResponse)(nil)
+}
+func (x fastReflection_MsgReassociateRecordsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReassociateRecordsResponse)
+}
+func (x fastReflection_MsgReassociateRecordsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReassociateRecordsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReassociateRecordsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReassociateRecordsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReassociateRecordsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReassociateRecordsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReassociateRecordsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReassociateRecordsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReassociateRecordsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReassociateRecordsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReassociateRecordsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReassociateRecordsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecordsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReassociateRecordsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecordsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecordsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReassociateRecordsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgReassociateRecordsResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgReassociateRecordsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReassociateRecordsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgReassociateRecordsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReassociateRecordsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReassociateRecordsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReassociateRecordsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReassociateRecordsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReassociateRecordsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecordsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReassociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterSchema             protoreflect.MessageDescriptor
+	fd_MsgRegisterSchema_record_type protoreflect.FieldDescriptor
+	fd_MsgRegisterSchema_authority   protoreflect.FieldDescriptor
+	fd_MsgRegisterSchema_format      protoreflect.FieldDescriptor
+	fd_MsgRegisterSchema_definition  protoreflect.FieldDescriptor
+	fd_MsgRegisterSchema_signer      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgRegisterSchema = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRegisterSchema")
+	fd_MsgRegisterSchema_record_type = md_MsgRegisterSchema.Fields().ByName("record_type")
+	fd_MsgRegisterSchema_authority = md_MsgRegisterSchema.Fields().ByName("authority")
+	fd_MsgRegisterSchema_format = md_MsgRegisterSchema.Fields().ByName("format")
+	fd_MsgRegisterSchema_definition = md_MsgRegisterSchema.Fields().ByName("definition")
+	fd_MsgRegisterSchema_signer = md_MsgRegisterSchema.Fields().ByName("signer")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterSchema)(nil)
+
+type fastReflection_MsgRegisterSchema MsgRegisterSchema
+
+func (x *MsgRegisterSchema) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterSchema)(x)
+}
+
+func (x *MsgRegisterSchema) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterSchema_messageType fastReflection_MsgRegisterSchema_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterSchema_messageType{}
+
+type fastReflection_MsgRegisterSchema_messageType struct{}
+
+func (x fastReflection_MsgRegisterSchema_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterSchema)(nil)
+}
+func (x fastReflection_MsgRegisterSchema_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterSchema)
+}
+func (x fastReflection_MsgRegisterSchema_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterSchema
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterSchema) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterSchema
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterSchema) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterSchema_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterSchema) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterSchema)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterSchema) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterSchema)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterSchema) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RecordType != "" {
+		value := protoreflect.ValueOfString(x.RecordType)
+		if !f(fd_MsgRegisterSchema_record_type, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgRegisterSchema_authority, value) {
+			return
+		}
+	}
+	if x.Format != "" {
+		value := protoreflect.ValueOfString(x.Format)
+		if !f(fd_MsgRegisterSchema_format, value) {
+			return
+		}
+	}
+	if x.Definition != "" {
+		value := protoreflect.ValueOfString(x.Definition)
+		if !f(fd_MsgRegisterSchema_definition, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgRegisterSchema_signer, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterSchema) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		return x.RecordType != ""
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		return x.Authority != ""
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		return x.Format != ""
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		return x.Definition != ""
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		return x.Signer != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchema) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		x.RecordType = ""
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		x.Authority = ""
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		x.Format = ""
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		x.Definition = ""
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		x.Signer = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterSchema) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		value := x.RecordType
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		value := x.Format
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		value := x.Definition
+		return protoreflect.ValueOfString(value)
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchema) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		x.RecordType = value.Interface().(string)
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		x.Authority = value.Interface().(string)
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		x.Format = value.Interface().(string)
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		x.Definition = value.Interface().(string)
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		x.Signer = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchema) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		panic(fmt.Errorf("field record_type of message cerc.registry.v1.MsgRegisterSchema is not mutable"))
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		panic(fmt.Errorf("field authority of message cerc.registry.v1.MsgRegisterSchema is not mutable"))
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		panic(fmt.Errorf("field format of message cerc.registry.v1.MsgRegisterSchema is not mutable"))
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		panic(fmt.Errorf("field definition of message cerc.registry.v1.MsgRegisterSchema is not mutable"))
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		panic(fmt.Errorf("field signer of message cerc.registry.v1.MsgRegisterSchema is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterSchema) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cerc.registry.v1.MsgRegisterSchema.record_type":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRegisterSchema.authority":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRegisterSchema.format":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRegisterSchema.definition":
+		return protoreflect.ValueOfString("")
+	case "cerc.registry.v1.MsgRegisterSchema.signer":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchema"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchema does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterSchema) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRegisterSchema", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterSchema) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchema) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterSchema) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterSchema) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterSchema)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.RecordType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Format)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Definition)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterSchema)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Definition) > 0 {
+			i -= len(x.Definition)
+			copy(dAtA[i:], x.Definition)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Definition)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Format) > 0 {
+			i -= len(x.Format)
+			copy(dAtA[i:], x.Format)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Format)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.RecordType) > 0 {
+			i -= len(x.RecordType)
+			copy(dAtA[i:], x.RecordType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RecordType)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterSchema)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterSchema: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RecordType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Format = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Definition = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRegisterSchemaResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cerc_registry_v1_tx_proto_init()
+	md_MsgRegisterSchemaResponse = File_cerc_registry_v1_tx_proto.Messages().ByName("MsgRegisterSchemaResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRegisterSchemaResponse)(nil)
+
+type fastReflection_MsgRegisterSchemaResponse MsgRegisterSchemaResponse
+
+func (x *MsgRegisterSchemaResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRegisterSchemaResponse)(x)
+}
+
+func (x *MsgRegisterSchemaResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cerc_registry_v1_tx_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRegisterSchemaResponse_messageType fastReflection_MsgRegisterSchemaResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRegisterSchemaResponse_messageType{}
+
+type fastReflection_MsgRegisterSchemaResponse_messageType struct{}
+
+func (x fastReflection_MsgRegisterSchemaResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRegisterSchemaResponse)(nil)
+}
+func (x fastReflection_MsgRegisterSchemaResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterSchemaResponse)
+}
+func (x fastReflection_MsgRegisterSchemaResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterSchemaResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRegisterSchemaResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRegisterSchemaResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRegisterSchemaResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRegisterSchemaResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRegisterSchemaResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRegisterSchemaResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRegisterSchemaResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRegisterSchemaResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRegisterSchemaResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRegisterSchemaResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchemaResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRegisterSchemaResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchemaResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchemaResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRegisterSchemaResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cerc.registry.v1.MsgRegisterSchemaResponse"))
+		}
+		panic(fmt.Errorf("message cerc.registry.v1.MsgRegisterSchemaResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRegisterSchemaResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cerc.registry.v1.MsgRegisterSchemaResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRegisterSchemaResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRegisterSchemaResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRegisterSchemaResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRegisterSchemaResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRegisterSchemaResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterSchemaResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRegisterSchemaResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterSchemaResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRegisterSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cerc/registry/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgSetRecord
+type MsgSetRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BondId  string   `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Signer  string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Payload *Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Initial lifetime of the record, paid for upfront; defaults to the record
+	// rent duration if not set
+	Lifetime *durationpb.Duration `protobuf:"bytes,4,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	// Number of owner approvals required for record-scoped messages; defaults
+	// to 1
+	OwnerThreshold uint32 `protobuf:"varint,5,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty"`
+}
+
+func (x *MsgSetRecord) Reset() {
+	*x = MsgSetRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetRecord) ProtoMessage() {}
+
+// Deprecated: Use MsgSetRecord.ProtoReflect.Descriptor instead.
+func (*MsgSetRecord) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgSetRecord) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *MsgSetRecord) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgSetRecord) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgSetRecord) GetLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.Lifetime
+	}
+	return nil
+}
+
+func (x *MsgSetRecord) GetOwnerThreshold() uint32 {
+	if x != nil {
+		return x.OwnerThreshold
+	}
+	return 0
+}
+
+// MsgSetRecordResponse
+type MsgSetRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgSetRecordResponse) Reset() {
+	*x = MsgSetRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetRecordResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetRecordResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetRecordResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgSetRecordResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// MsgUpdateRecord
+type MsgUpdateRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordId string   `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Signer   string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Payload  *Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgUpdateRecord) Reset() {
+	*x = MsgUpdateRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRecord) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRecord.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRecord) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdateRecord) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *MsgUpdateRecord) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgUpdateRecord) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgUpdateRecord) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgUpdateRecordResponse
+type MsgUpdateRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *MsgUpdateRecordResponse) Reset() {
+	*x = MsgUpdateRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRecordResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRecordResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRecordResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgUpdateRecordResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Payload
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record     *Record      `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Signatures []*Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Payload) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *Payload) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgSetName
+type MsgSetName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lrn    string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgSetName) Reset() {
+	*x = MsgSetName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetName) ProtoMessage() {}
+
+// Deprecated: Use MsgSetName.ProtoReflect.Descriptor instead.
+func (*MsgSetName) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgSetName) GetLrn() string {
+	if x != nil {
+		return x.Lrn
+	}
+	return ""
+}
+
+func (x *MsgSetName) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *MsgSetName) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+// MsgSetNameResponse
+type MsgSetNameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetNameResponse) Reset() {
+	*x = MsgSetNameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetNameResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetNameResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetNameResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+// MsgReserveAuthority
+type MsgReserveAuthority struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// if creating a sub-authority.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *MsgReserveAuthority) Reset() {
+	*x = MsgReserveAuthority{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReserveAuthority) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReserveAuthority) ProtoMessage() {}
+
+// Deprecated: Use MsgReserveAuthority.ProtoReflect.Descriptor instead.
+func (*MsgReserveAuthority) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgReserveAuthority) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgReserveAuthority) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgReserveAuthority) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// MsgReserveAuthorityResponse
+type MsgReserveAuthorityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgReserveAuthorityResponse) Reset() {
+	*x = MsgReserveAuthorityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReserveAuthorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReserveAuthorityResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgReserveAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*MsgReserveAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+// MsgSetAuthorityBond
+type MsgSetAuthorityBond struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// Deprecated: Use MsgSetRecordResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetRecordResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{1}
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BondId string `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *MsgSetRecordResponse) GetId() string {
+func (x *MsgSetAuthorityBond) Reset() {
+	*x = MsgSetAuthorityBond{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetAuthorityBond) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetAuthorityBond) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAuthorityBond.ProtoReflect.Descriptor instead.
+func (*MsgSetAuthorityBond) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgSetAuthorityBond) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// Payload
-type Payload struct {
+func (x *MsgSetAuthorityBond) GetBondId() string {
+	if x != nil {
+		return x.BondId
+	}
+	return ""
+}
+
+func (x *MsgSetAuthorityBond) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+// MsgSetAuthorityBondResponse
+type MsgSetAuthorityBondResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Record     *Record      `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
-	Signatures []*Signature `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+func (x *MsgSetAuthorityBondResponse) Reset() {
+	*x = MsgSetAuthorityBondResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Payload) Reset() {
-	*x = Payload{}
+func (x *MsgSetAuthorityBondResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetAuthorityBondResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAuthorityBondResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetAuthorityBondResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+// MsgTransferAuthority
+type MsgTransferAuthority struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New owner address; transferring to the current owner cancels a pending
+	// transfer
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// Whether the new owner needs to accept the transfer (see
+	// MsgAcceptAuthority)
+	RequireAccept bool `protobuf:"varint,3,opt,name=require_accept,json=requireAccept,proto3" json:"require_accept,omitempty"`
+	// Whether the authority keeps its bond; otherwise the new owner needs to
+	// set one (see MsgSetAuthorityBond)
+	KeepBond bool `protobuf:"varint,4,opt,name=keep_bond,json=keepBond,proto3" json:"keep_bond,omitempty"`
+	// Whether the authority height is bumped, so that existing names no longer
+	// resolve
+	InvalidateNames bool   `protobuf:"varint,5,opt,name=invalidate_names,json=invalidateNames,proto3" json:"invalidate_names,omitempty"`
+	Signer          string `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgTransferAuthority) Reset() {
+	*x = MsgTransferAuthority{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[2]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Payload) String() string {
+func (x *MsgTransferAuthority) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payload) ProtoMessage() {}
+func (*MsgTransferAuthority) ProtoMessage() {}
 
-// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
-func (*Payload) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgTransferAuthority.ProtoReflect.Descriptor instead.
+func (*MsgTransferAuthority) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Payload) GetRecord() *Record {
+func (x *MsgTransferAuthority) GetName() string {
 	if x != nil {
-		return x.Record
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Payload) GetSignatures() []*Signature {
+func (x *MsgTransferAuthority) GetNewOwner() string {
 	if x != nil {
-		return x.Signatures
+		return x.NewOwner
 	}
-	return nil
+	return ""
 }
 
-// MsgSetName
-type MsgSetName struct {
+func (x *MsgTransferAuthority) GetRequireAccept() bool {
+	if x != nil {
+		return x.RequireAccept
+	}
+	return false
+}
+
+func (x *MsgTransferAuthority) GetKeepBond() bool {
+	if x != nil {
+		return x.KeepBond
+	}
+	return false
+}
+
+func (x *MsgTransferAuthority) GetInvalidateNames() bool {
+	if x != nil {
+		return x.InvalidateNames
+	}
+	return false
+}
+
+func (x *MsgTransferAuthority) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+// MsgTransferAuthorityResponse
+type MsgTransferAuthorityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Lrn    string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
-	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+func (x *MsgTransferAuthorityResponse) Reset() {
+	*x = MsgTransferAuthorityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgSetName) Reset() {
-	*x = MsgSetName{}
+func (x *MsgTransferAuthorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferAuthorityResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+// MsgAcceptAuthority
+type MsgAcceptAuthority struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgAcceptAuthority) Reset() {
+	*x = MsgAcceptAuthority{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[3]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetName) String() string {
+func (x *MsgAcceptAuthority) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetName) ProtoMessage() {}
+func (*MsgAcceptAuthority) ProtoMessage() {}
 
-// Deprecated: Use MsgSetName.ProtoReflect.Descriptor instead.
-func (*MsgSetName) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgAcceptAuthority.ProtoReflect.Descriptor instead.
+func (*MsgAcceptAuthority) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MsgSetName) GetLrn() string {
+func (x *MsgAcceptAuthority) GetName() string {
 	if x != nil {
-		return x.Lrn
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgSetName) GetCid() string {
+func (x *MsgAcceptAuthority) GetSigner() string {
 	if x != nil {
-		return x.Cid
+		return x.Signer
 	}
 	return ""
 }
 
-func (x *MsgSetName) GetSigner() string {
+// MsgAcceptAuthorityResponse
+type MsgAcceptAuthorityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgAcceptAuthorityResponse) Reset() {
+	*x = MsgAcceptAuthorityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAcceptAuthorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAcceptAuthorityResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgAcceptAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*MsgAcceptAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+// MsgRenewAuthority
+type MsgRenewAuthority struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of rent periods to pay for upfront from the authority bond,
+	// extending the authority expiry time
+	Periods uint64 `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	Signer  string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgRenewAuthority) Reset() {
+	*x = MsgRenewAuthority{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRenewAuthority) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRenewAuthority) ProtoMessage() {}
+
+// Deprecated: Use MsgRenewAuthority.ProtoReflect.Descriptor instead.
+func (*MsgRenewAuthority) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgRenewAuthority) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgRenewAuthority) GetPeriods() uint64 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *MsgRenewAuthority) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-// MsgSetNameResponse
-type MsgSetNameResponse struct {
+// MsgRenewAuthorityResponse
+type MsgRenewAuthorityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetNameResponse) Reset() {
-	*x = MsgSetNameResponse{}
+func (x *MsgRenewAuthorityResponse) Reset() {
+	*x = MsgRenewAuthorityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[4]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetNameResponse) String() string {
+func (x *MsgRenewAuthorityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetNameResponse) ProtoMessage() {}
+func (*MsgRenewAuthorityResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetNameResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetNameResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgRenewAuthorityResponse.ProtoReflect.Descriptor instead.
+func (*MsgRenewAuthorityResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
-// MsgReserveAuthority
-type MsgReserveAuthority struct {
+// MsgGrantNameAccess
+type MsgGrantNameAccess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
-	// if creating a sub-authority.
-	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// LRN, or LRN path prefix ending with "/*" (e.g. lrn://cerc-io/apps/*)
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Optional time after which the grant no longer applies
+	ExpiryTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Signer     string                 `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *MsgReserveAuthority) Reset() {
-	*x = MsgReserveAuthority{}
+func (x *MsgGrantNameAccess) Reset() {
+	*x = MsgGrantNameAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[5]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgReserveAuthority) String() string {
+func (x *MsgGrantNameAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgReserveAuthority) ProtoMessage() {}
+func (*MsgGrantNameAccess) ProtoMessage() {}
 
-// Deprecated: Use MsgReserveAuthority.ProtoReflect.Descriptor instead.
-func (*MsgReserveAuthority) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgGrantNameAccess.ProtoReflect.Descriptor instead.
+func (*MsgGrantNameAccess) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgReserveAuthority) GetName() string {
+func (x *MsgGrantNameAccess) GetLrnPrefix() string {
 	if x != nil {
-		return x.Name
+		return x.LrnPrefix
 	}
 	return ""
 }
 
-func (x *MsgReserveAuthority) GetSigner() string {
+func (x *MsgGrantNameAccess) GetGrantee() string {
 	if x != nil {
-		return x.Signer
+		return x.Grantee
 	}
 	return ""
 }
 
-func (x *MsgReserveAuthority) GetOwner() string {
+func (x *MsgGrantNameAccess) GetExpiryTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Owner
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+func (x *MsgGrantNameAccess) GetSigner() string {
+	if x != nil {
+		return x.Signer
 	}
 	return ""
 }
 
-// MsgReserveAuthorityResponse
-type MsgReserveAuthorityResponse struct {
+// MsgGrantNameAccessResponse
+type MsgGrantNameAccessResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgReserveAuthorityResponse) Reset() {
-	*x = MsgReserveAuthorityResponse{}
+func (x *MsgGrantNameAccessResponse) Reset() {
+	*x = MsgGrantNameAccessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[6]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgReserveAuthorityResponse) String() string {
+func (x *MsgGrantNameAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgReserveAuthorityResponse) ProtoMessage() {}
+func (*MsgGrantNameAccessResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgReserveAuthorityResponse.ProtoReflect.Descriptor instead.
-func (*MsgReserveAuthorityResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgGrantNameAccessResponse.ProtoReflect.Descriptor instead.
+func (*MsgGrantNameAccessResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
-// MsgSetAuthorityBond
-type MsgSetAuthorityBond struct {
+// MsgRevokeNameAccess
+type MsgRevokeNameAccess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	BondId string `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
-	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (x *MsgSetAuthorityBond) Reset() {
-	*x = MsgSetAuthorityBond{}
+func (x *MsgRevokeNameAccess) Reset() {
+	*x = MsgRevokeNameAccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[7]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetAuthorityBond) String() string {
+func (x *MsgRevokeNameAccess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetAuthorityBond) ProtoMessage() {}
+func (*MsgRevokeNameAccess) ProtoMessage() {}
 
-// Deprecated: Use MsgSetAuthorityBond.ProtoReflect.Descriptor instead.
-func (*MsgSetAuthorityBond) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgRevokeNameAccess.ProtoReflect.Descriptor instead.
+func (*MsgRevokeNameAccess) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgSetAuthorityBond) GetName() string {
+func (x *MsgRevokeNameAccess) GetLrnPrefix() string {
 	if x != nil {
-		return x.Name
+		return x.LrnPrefix
 	}
 	return ""
 }
 
-func (x *MsgSetAuthorityBond) GetBondId() string {
+func (x *MsgRevokeNameAccess) GetGrantee() string {
 	if x != nil {
-		return x.BondId
+		return x.Grantee
 	}
 	return ""
 }
 
-func (x *MsgSetAuthorityBond) GetSigner() string {
+func (x *MsgRevokeNameAccess) GetSigner() string {
 	if x != nil {
 		return x.Signer
 	}
 	return ""
 }
 
-// MsgSetAuthorityBondResponse
-type MsgSetAuthorityBondResponse struct {
+// MsgRevokeNameAccessResponse
+type MsgRevokeNameAccessResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSetAuthorityBondResponse) Reset() {
-	*x = MsgSetAuthorityBondResponse{}
+func (x *MsgRevokeNameAccessResponse) Reset() {
+	*x = MsgRevokeNameAccessResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[8]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSetAuthorityBondResponse) String() string {
+func (x *MsgRevokeNameAccessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSetAuthorityBondResponse) ProtoMessage() {}
+func (*MsgRevokeNameAccessResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSetAuthorityBondResponse.ProtoReflect.Descriptor instead.
-func (*MsgSetAuthorityBondResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgRevokeNameAccessResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevokeNameAccessResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 // MsgDeleteName
@@ -9847,7 +19948,7 @@ type MsgDeleteName struct {
 func (x *MsgDeleteName) Reset() {
 	*x = MsgDeleteName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[9]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9861,7 +19962,7 @@ func (*MsgDeleteName) ProtoMessage() {}
 
 // Deprecated: Use MsgDeleteName.ProtoReflect.Descriptor instead.
 func (*MsgDeleteName) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgDeleteName) GetLrn() string {
@@ -9888,7 +19989,7 @@ type MsgDeleteNameResponse struct {
 func (x *MsgDeleteNameResponse) Reset() {
 	*x = MsgDeleteNameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[10]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9902,7 +20003,7 @@ func (*MsgDeleteNameResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDeleteNameResponse.ProtoReflect.Descriptor instead.
 func (*MsgDeleteNameResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{10}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
 // MsgRenewRecord
@@ -9913,12 +20014,18 @@ type MsgRenewRecord struct {
 
 	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Number of rent periods to pay for upfront, extending the record expiry
+	// time; if not set, only an expired record is renewed for one period
+	Periods uint64 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	// Approvals of record owners other than the signer for prepaying rent, if
+	// the signer doesn't own the record bond (see RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
 func (x *MsgRenewRecord) Reset() {
 	*x = MsgRenewRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[11]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9932,7 +20039,7 @@ func (*MsgRenewRecord) ProtoMessage() {}
 
 // Deprecated: Use MsgRenewRecord.ProtoReflect.Descriptor instead.
 func (*MsgRenewRecord) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{11}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MsgRenewRecord) GetRecordId() string {
@@ -9949,6 +20056,20 @@ func (x *MsgRenewRecord) GetSigner() string {
 	return ""
 }
 
+func (x *MsgRenewRecord) GetPeriods() uint64 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *MsgRenewRecord) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 // MsgRenewRecordResponse
 type MsgRenewRecordResponse struct {
 	state         protoimpl.MessageState
@@ -9959,7 +20080,7 @@ type MsgRenewRecordResponse struct {
 func (x *MsgRenewRecordResponse) Reset() {
 	*x = MsgRenewRecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[12]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9973,7 +20094,186 @@ func (*MsgRenewRecordResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRenewRecordResponse.ProtoReflect.Descriptor instead.
 func (*MsgRenewRecordResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{12}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{24}
+}
+
+// MsgDeleteRecord
+type MsgDeleteRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *MsgDeleteRecord) Reset() {
+	*x = MsgDeleteRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeleteRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeleteRecord) ProtoMessage() {}
+
+// Deprecated: Use MsgDeleteRecord.ProtoReflect.Descriptor instead.
+func (*MsgDeleteRecord) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MsgDeleteRecord) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *MsgDeleteRecord) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgDeleteRecord) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// MsgDeleteRecordResponse
+type MsgDeleteRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgDeleteRecordResponse) Reset() {
+	*x = MsgDeleteRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeleteRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeleteRecordResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDeleteRecordResponse.ProtoReflect.Descriptor instead.
+func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{26}
+}
+
+// MsgTransferRecordOwnership
+type MsgTransferRecordOwnership struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// New owner addresses (account or hex)
+	Owners []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty"`
+	// New owner threshold; defaults to 1
+	OwnerThreshold uint32 `protobuf:"varint,3,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty"`
+	// Approvals of current record owners other than the signer (see
+	// RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Signer     string       `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgTransferRecordOwnership) Reset() {
+	*x = MsgTransferRecordOwnership{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferRecordOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferRecordOwnership) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferRecordOwnership.ProtoReflect.Descriptor instead.
+func (*MsgTransferRecordOwnership) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MsgTransferRecordOwnership) GetRecordId() string {
+	if x != nil {
+		return x.RecordId
+	}
+	return ""
+}
+
+func (x *MsgTransferRecordOwnership) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *MsgTransferRecordOwnership) GetOwnerThreshold() uint32 {
+	if x != nil {
+		return x.OwnerThreshold
+	}
+	return 0
+}
+
+func (x *MsgTransferRecordOwnership) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *MsgTransferRecordOwnership) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+// MsgTransferRecordOwnershipResponse
+type MsgTransferRecordOwnershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgTransferRecordOwnershipResponse) Reset() {
+	*x = MsgTransferRecordOwnershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTransferRecordOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferRecordOwnershipResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgTransferRecordOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*MsgTransferRecordOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
 // MsgAssociateBond
@@ -9985,12 +20285,14 @@ type MsgAssociateBond struct {
 	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 	BondId   string `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty"`
 	Signer   string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
 func (x *MsgAssociateBond) Reset() {
 	*x = MsgAssociateBond{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[13]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10004,7 +20306,7 @@ func (*MsgAssociateBond) ProtoMessage() {}
 
 // Deprecated: Use MsgAssociateBond.ProtoReflect.Descriptor instead.
 func (*MsgAssociateBond) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{13}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *MsgAssociateBond) GetRecordId() string {
@@ -10028,6 +20330,13 @@ func (x *MsgAssociateBond) GetSigner() string {
 	return ""
 }
 
+func (x *MsgAssociateBond) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 // MsgAssociateBondResponse
 type MsgAssociateBondResponse struct {
 	state         protoimpl.MessageState
@@ -10038,7 +20347,7 @@ type MsgAssociateBondResponse struct {
 func (x *MsgAssociateBondResponse) Reset() {
 	*x = MsgAssociateBondResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[14]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10052,7 +20361,7 @@ func (*MsgAssociateBondResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgAssociateBondResponse.ProtoReflect.Descriptor instead.
 func (*MsgAssociateBondResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{14}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{30}
 }
 
 // MsgDissociateBond
@@ -10063,12 +20372,14 @@ type MsgDissociateBond struct {
 
 	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
 	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []*Signature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
 func (x *MsgDissociateBond) Reset() {
 	*x = MsgDissociateBond{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[15]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10082,7 +20393,7 @@ func (*MsgDissociateBond) ProtoMessage() {}
 
 // Deprecated: Use MsgDissociateBond.ProtoReflect.Descriptor instead.
 func (*MsgDissociateBond) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{15}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *MsgDissociateBond) GetRecordId() string {
@@ -10099,6 +20410,13 @@ func (x *MsgDissociateBond) GetSigner() string {
 	return ""
 }
 
+func (x *MsgDissociateBond) GetSignatures() []*Signature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 // MsgDissociateBondResponse
 type MsgDissociateBondResponse struct {
 	state         protoimpl.MessageState
@@ -10109,7 +20427,7 @@ type MsgDissociateBondResponse struct {
 func (x *MsgDissociateBondResponse) Reset() {
 	*x = MsgDissociateBondResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[16]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10123,7 +20441,7 @@ func (*MsgDissociateBondResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDissociateBondResponse.ProtoReflect.Descriptor instead.
 func (*MsgDissociateBondResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{16}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{32}
 }
 
 // MsgDissociateRecords
@@ -10139,7 +20457,7 @@ type MsgDissociateRecords struct {
 func (x *MsgDissociateRecords) Reset() {
 	*x = MsgDissociateRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[17]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10153,7 +20471,7 @@ func (*MsgDissociateRecords) ProtoMessage() {}
 
 // Deprecated: Use MsgDissociateRecords.ProtoReflect.Descriptor instead.
 func (*MsgDissociateRecords) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{17}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *MsgDissociateRecords) GetBondId() string {
@@ -10180,7 +20498,7 @@ type MsgDissociateRecordsResponse struct {
 func (x *MsgDissociateRecordsResponse) Reset() {
 	*x = MsgDissociateRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[18]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10194,7 +20512,7 @@ func (*MsgDissociateRecordsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgDissociateRecordsResponse.ProtoReflect.Descriptor instead.
 func (*MsgDissociateRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{18}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{34}
 }
 
 // MsgReassociateRecords
@@ -10211,7 +20529,7 @@ type MsgReassociateRecords struct {
 func (x *MsgReassociateRecords) Reset() {
 	*x = MsgReassociateRecords{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[19]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10225,7 +20543,7 @@ func (*MsgReassociateRecords) ProtoMessage() {}
 
 // Deprecated: Use MsgReassociateRecords.ProtoReflect.Descriptor instead.
 func (*MsgReassociateRecords) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{19}
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *MsgReassociateRecords) GetNewBondId() string {
@@ -10259,7 +20577,7 @@ type MsgReassociateRecordsResponse struct {
 func (x *MsgReassociateRecordsResponse) Reset() {
 	*x = MsgReassociateRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cerc_registry_v1_tx_proto_msgTypes[20]
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10269,11 +20587,106 @@ func (x *MsgReassociateRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgReassociateRecordsResponse) ProtoMessage() {}
+func (*MsgReassociateRecordsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgReassociateRecordsResponse.ProtoReflect.Descriptor instead.
+func (*MsgReassociateRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{36}
+}
+
+// MsgRegisterSchema
+type MsgRegisterSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordType string `protobuf:"bytes,1,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	Authority  string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	Format     string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	Signer     string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (x *MsgRegisterSchema) Reset() {
+	*x = MsgRegisterSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterSchema) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterSchema.ProtoReflect.Descriptor instead.
+func (*MsgRegisterSchema) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MsgRegisterSchema) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
+
+func (x *MsgRegisterSchema) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgRegisterSchema) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *MsgRegisterSchema) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *MsgRegisterSchema) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+// MsgRegisterSchemaResponse
+type MsgRegisterSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRegisterSchemaResponse) Reset() {
+	*x = MsgRegisterSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cerc_registry_v1_tx_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterSchemaResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgReassociateRecordsResponse.ProtoReflect.Descriptor instead.
-func (*MsgReassociateRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MsgRegisterSchemaResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_cerc_registry_v1_tx_proto_rawDescGZIP(), []int{38}
 }
 
 var File_cerc_registry_v1_tx_proto protoreflect.FileDescriptor
@@ -10285,140 +20698,358 @@ var file_cerc_registry_v1_tx_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
 	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x63, 0x65, 0x72, 0x63,
-	0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x01, 0x0a, 0x0c,
-	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x07,
-	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2,
-	0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
-	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22,
-	0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x0b, 0x82, 0xe7, 0xb0,
-	0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0xa5, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x30, 0x0a, 0x06,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
-	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x68,
-	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42,
-	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53,
-	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x72, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x72, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22,
-	0x14, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x63, 0x65, 0x72,
+	0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x02, 0x0a,
+	0x0c, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3a, 0x0a,
+	0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21,
+	0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x62, 0x0a, 0x08,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x1f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x5a, 0x0a, 0x0f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x31, 0xf2, 0xde, 0x1f, 0x2d, 0x6a,
+	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0e, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x0b, 0x82, 0xe7,
+	0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x14, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x9f, 0x02, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52,
+	0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x68, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa5,
+	0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x65, 0x72,
+	0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x68, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8,
+	0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6c, 0x72, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x14, 0x0a,
+	0x12, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7,
+	0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x03, 0x0a, 0x14, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
+	0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2f, 0xf2,
+	0xde, 0x1f, 0x2b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x52, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x42, 0x0a,
+	0x09, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x65, 0x65,
+	0x70, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x22, 0x52, 0x08, 0x6b, 0x65, 0x65, 0x70, 0x42, 0x6f, 0x6e,
+	0x64, 0x12, 0x5e, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x33, 0xf2, 0xde, 0x1f,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x52, 0x0f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x0b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x4d,
-	0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x6f,
-	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f,
-	0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x65,
-	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x72, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x17,
-	0x0a, 0x15, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde,
-	0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
-	0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b,
+	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x02, 0x0a, 0x12, 0x4d, 0x73, 0x67,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x46, 0x0a, 0x0a, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x27, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c,
+	0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x52, 0x09, 0x6c, 0x72,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
+	0x65, 0x12, 0x6a, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x2d, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
 	0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x22, 0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a,
-	0x10, 0x4d, 0x73, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e,
-	0x64, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x9c, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x6c, 0x72, 0x6e, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xf2, 0xde,
+	0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x72, 0x6e, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x22, 0x52, 0x09, 0x6c, 0x72, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22,
+	0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46,
+	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x72,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x41, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x7c, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21,
-	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22,
-	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a,
-	0x14, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xfd, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x68, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22,
+	0x18, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x0f, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x42, 0x0a,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
 	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
-	0x12, 0x49, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
-	0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22,
-	0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0b, 0x6f,
-	0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x6c, 0x64, 0x5f,
-	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f,
-	0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x09, 0x6f, 0x6c, 0x64,
-	0x42, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b,
-	0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x86, 0x0b, 0x0a,
-	0x03, 0x4d, 0x73, 0x67, 0x12, 0x79, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x1e, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x1a, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1e, 0x22, 0x1c, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x81, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x20, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x1a, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x20, 0x22, 0x1e, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x03, 0x0a, 0x1a,
+	0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x42, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xf2,
+	0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x37,
+	0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1f,
+	0xf2, 0xde, 0x1f, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x52,
+	0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x31, 0xf2, 0xde, 0x1f, 0x2d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0x52, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x68, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x22, 0x24, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x10, 0x4d, 0x73, 0x67,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x42, 0x0a,
+	0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x62, 0x6f,
+	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x65, 0x72, 0x63,
+	0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x23,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a,
+	0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18,
+	0x4d, 0x73, 0x67, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67,
+	0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x42,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x25, 0xf2, 0xde, 0x1f, 0x21, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xf2, 0xde, 0x1f, 0x23, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77,
+	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x06, 0x62, 0x6f, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x44, 0x69,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x49, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0b,
+	0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6f, 0x6c, 0x64,
+	0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x09, 0x6f, 0x6c,
+	0x64, 0x42, 0x6f, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a,
+	0x0b, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xda, 0x01,
+	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x6a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x0b, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc9, 0x15, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x79, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x63,
+	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x26, 0x2e, 0x63,
+	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x63,
+	0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x63, 0x65,
+	0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x29,
+	0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x22, 0x1f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x81, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x20, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x1a, 0x28, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1e, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x85, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x29, 0x2e, 0x63, 0x65, 0x72,
+	0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1f, 0x2f,
+	0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0xb2,
+	0x01, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2c, 0x2e, 0x63, 0x65, 0x72,
+	0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x34, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x2b, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x89, 0x01, 0x0a, 0x0d, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
 	0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67,
 	0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x73, 0x73, 0x6f,
 	0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x1a, 0x2a, 0x2e, 0x63, 0x65, 0x72, 0x63,
@@ -10489,20 +21120,76 @@ var file_cerc_registry_v1_tx_proto_rawDesc = []byte{
 	0x72, 0x69, 0x74, 0x79, 0x42, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x24, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f,
 	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xbe, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x65,
-	0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x07,
-	0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x2e, 0x76,
-	0x64, 0x62, 0x2e, 0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2d, 0x69, 0x6f, 0x2f, 0x6c, 0x61,
-	0x63, 0x6f, 0x6e, 0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x10, 0x43,
-	0x65, 0x72, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x10, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x99,
+	0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x63,
+	0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x26, 0x22, 0x24, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x91, 0x01, 0x0a, 0x0f, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24,
+	0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x22, 0x2f, 0x63, 0x65, 0x72,
+	0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x8d,
+	0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x23, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6e,
+	0x65, 0x77, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x63, 0x65,
+	0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x92,
+	0x01, 0x0a, 0x0f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x2c, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x23,
+	0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a,
+	0x2d, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x24, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x8d, 0x01, 0x0a,
+	0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x23, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x1a, 0x2b, 0x2e, 0x63, 0x65, 0x72, 0x63, 0x2e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x63, 0x65, 0x72, 0x63,
+	0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x1a, 0x05, 0x80, 0xe7,
+	0xb0, 0x2a, 0x01, 0x42, 0xbe, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x65, 0x72, 0x63,
+	0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x2e, 0x76, 0x64, 0x62,
+	0x2e, 0x74, 0x6f, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2d, 0x69, 0x6f, 0x2f, 0x6c, 0x61, 0x63, 0x6f,
+	0x6e, 0x69, 0x63, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x63, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x52, 0x58, 0xaa, 0x02, 0x10, 0x43, 0x65, 0x72,
+	0x63, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10,
+	0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1c, 0x43, 0x65, 0x72, 0x63, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x12, 0x43, 0x65, 0x72, 0x63, 0x3a, 0x3a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10517,61 +21204,108 @@ func file_cerc_registry_v1_tx_proto_rawDescGZIP() []byte {
 	return file_cerc_registry_v1_tx_proto_rawDescData
 }
 
-var file_cerc_registry_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_cerc_registry_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_cerc_registry_v1_tx_proto_goTypes = []interface{}{
-	(*MsgSetRecord)(nil),                  // 0: cerc.registry.v1.MsgSetRecord
-	(*MsgSetRecordResponse)(nil),          // 1: cerc.registry.v1.MsgSetRecordResponse
-	(*Payload)(nil),                       // 2: cerc.registry.v1.Payload
-	(*MsgSetName)(nil),                    // 3: cerc.registry.v1.MsgSetName
-	(*MsgSetNameResponse)(nil),            // 4: cerc.registry.v1.MsgSetNameResponse
-	(*MsgReserveAuthority)(nil),           // 5: cerc.registry.v1.MsgReserveAuthority
-	(*MsgReserveAuthorityResponse)(nil),   // 6: cerc.registry.v1.MsgReserveAuthorityResponse
-	(*MsgSetAuthorityBond)(nil),           // 7: cerc.registry.v1.MsgSetAuthorityBond
-	(*MsgSetAuthorityBondResponse)(nil),   // 8: cerc.registry.v1.MsgSetAuthorityBondResponse
-	(*MsgDeleteName)(nil),                 // 9: cerc.registry.v1.MsgDeleteName
-	(*MsgDeleteNameResponse)(nil),         // 10: cerc.registry.v1.MsgDeleteNameResponse
-	(*MsgRenewRecord)(nil),                // 11: cerc.registry.v1.MsgRenewRecord
-	(*MsgRenewRecordResponse)(nil),        // 12: cerc.registry.v1.MsgRenewRecordResponse
-	(*MsgAssociateBond)(nil),              // 13: cerc.registry.v1.MsgAssociateBond
-	(*MsgAssociateBondResponse)(nil),      // 14: cerc.registry.v1.MsgAssociateBondResponse
-	(*MsgDissociateBond)(nil),             // 15: cerc.registry.v1.MsgDissociateBond
-	(*MsgDissociateBondResponse)(nil),     // 16: cerc.registry.v1.MsgDissociateBondResponse
-	(*MsgDissociateRecords)(nil),          // 17: cerc.registry.v1.MsgDissociateRecords
-	(*MsgDissociateRecordsResponse)(nil),  // 18: cerc.registry.v1.MsgDissociateRecordsResponse
-	(*MsgReassociateRecords)(nil),         // 19: cerc.registry.v1.MsgReassociateRecords
-	(*MsgReassociateRecordsResponse)(nil), // 20: cerc.registry.v1.MsgReassociateRecordsResponse
-	(*Record)(nil),                        // 21: cerc.registry.v1.Record
-	(*Signature)(nil),                     // 22: cerc.registry.v1.Signature
+	(*MsgSetRecord)(nil),                       // 0: cerc.registry.v1.MsgSetRecord
+	(*MsgSetRecordResponse)(nil),               // 1: cerc.registry.v1.MsgSetRecordResponse
+	(*MsgUpdateRecord)(nil),                    // 2: cerc.registry.v1.MsgUpdateRecord
+	(*MsgUpdateRecordResponse)(nil),            // 3: cerc.registry.v1.MsgUpdateRecordResponse
+	(*Payload)(nil),                            // 4: cerc.registry.v1.Payload
+	(*MsgSetName)(nil),                         // 5: cerc.registry.v1.MsgSetName
+	(*MsgSetNameResponse)(nil),                 // 6: cerc.registry.v1.MsgSetNameResponse
+	(*MsgReserveAuthority)(nil),                // 7: cerc.registry.v1.MsgReserveAuthority
+	(*MsgReserveAuthorityResponse)(nil),        // 8: cerc.registry.v1.MsgReserveAuthorityResponse
+	(*MsgSetAuthorityBond)(nil),                // 9: cerc.registry.v1.MsgSetAuthorityBond
+	(*MsgSetAuthorityBondResponse)(nil),        // 10: cerc.registry.v1.MsgSetAuthorityBondResponse
+	(*MsgTransferAuthority)(nil),               // 11: cerc.registry.v1.MsgTransferAuthority
+	(*MsgTransferAuthorityResponse)(nil),       // 12: cerc.registry.v1.MsgTransferAuthorityResponse
+	(*MsgAcceptAuthority)(nil),                 // 13: cerc.registry.v1.MsgAcceptAuthority
+	(*MsgAcceptAuthorityResponse)(nil),         // 14: cerc.registry.v1.MsgAcceptAuthorityResponse
+	(*MsgRenewAuthority)(nil),                  // 15: cerc.registry.v1.MsgRenewAuthority
+	(*MsgRenewAuthorityResponse)(nil),          // 16: cerc.registry.v1.MsgRenewAuthorityResponse
+	(*MsgGrantNameAccess)(nil),                 // 17: cerc.registry.v1.MsgGrantNameAccess
+	(*MsgGrantNameAccessResponse)(nil),         // 18: cerc.registry.v1.MsgGrantNameAccessResponse
+	(*MsgRevokeNameAccess)(nil),                // 19: cerc.registry.v1.MsgRevokeNameAccess
+	(*MsgRevokeNameAccessResponse)(nil),        // 20: cerc.registry.v1.MsgRevokeNameAccessResponse
+	(*MsgDeleteName)(nil),                      // 21: cerc.registry.v1.MsgDeleteName
+	(*MsgDeleteNameResponse)(nil),              // 22: cerc.registry.v1.MsgDeleteNameResponse
+	(*MsgRenewRecord)(nil),                     // 23: cerc.registry.v1.MsgRenewRecord
+	(*MsgRenewRecordResponse)(nil),             // 24: cerc.registry.v1.MsgRenewRecordResponse
+	(*MsgDeleteRecord)(nil),                    // 25: cerc.registry.v1.MsgDeleteRecord
+	(*MsgDeleteRecordResponse)(nil),            // 26: cerc.registry.v1.MsgDeleteRecordResponse
+	(*MsgTransferRecordOwnership)(nil),         // 27: cerc.registry.v1.MsgTransferRecordOwnership
+	(*MsgTransferRecordOwnershipResponse)(nil), // 28: cerc.registry.v1.MsgTransferRecordOwnershipResponse
+	(*MsgAssociateBond)(nil),                   // 29: cerc.registry.v1.MsgAssociateBond
+	(*MsgAssociateBondResponse)(nil),           // 30: cerc.registry.v1.MsgAssociateBondResponse
+	(*MsgDissociateBond)(nil),                  // 31: cerc.registry.v1.MsgDissociateBond
+	(*MsgDissociateBondResponse)(nil),          // 32: cerc.registry.v1.MsgDissociateBondResponse
+	(*MsgDissociateRecords)(nil),               // 33: cerc.registry.v1.MsgDissociateRecords
+	(*MsgDissociateRecordsResponse)(nil),       // 34: cerc.registry.v1.MsgDissociateRecordsResponse
+	(*MsgReassociateRecords)(nil),              // 35: cerc.registry.v1.MsgReassociateRecords
+	(*MsgReassociateRecordsResponse)(nil),      // 36: cerc.registry.v1.MsgReassociateRecordsResponse
+	(*MsgRegisterSchema)(nil),                  // 37: cerc.registry.v1.MsgRegisterSchema
+	(*MsgRegisterSchemaResponse)(nil),          // 38: cerc.registry.v1.MsgRegisterSchemaResponse
+	(*durationpb.Duration)(nil),                // 39: google.protobuf.Duration
+	(*Signature)(nil),                          // 40: cerc.registry.v1.Signature
+	(*Record)(nil),                             // 41: cerc.registry.v1.Record
+	(*timestamppb.Timestamp)(nil),              // 42: google.protobuf.Timestamp
 }
 var file_cerc_registry_v1_tx_proto_depIdxs = []int32{
-	2,  // 0: cerc.registry.v1.MsgSetRecord.payload:type_name -> cerc.registry.v1.Payload
-	21, // 1: cerc.registry.v1.Payload.record:type_name -> cerc.registry.v1.Record
-	22, // 2: cerc.registry.v1.Payload.signatures:type_name -> cerc.registry.v1.Signature
-	0,  // 3: cerc.registry.v1.Msg.SetRecord:input_type -> cerc.registry.v1.MsgSetRecord
-	11, // 4: cerc.registry.v1.Msg.RenewRecord:input_type -> cerc.registry.v1.MsgRenewRecord
-	13, // 5: cerc.registry.v1.Msg.AssociateBond:input_type -> cerc.registry.v1.MsgAssociateBond
-	15, // 6: cerc.registry.v1.Msg.DissociateBond:input_type -> cerc.registry.v1.MsgDissociateBond
-	17, // 7: cerc.registry.v1.Msg.DissociateRecords:input_type -> cerc.registry.v1.MsgDissociateRecords
-	19, // 8: cerc.registry.v1.Msg.ReassociateRecords:input_type -> cerc.registry.v1.MsgReassociateRecords
-	3,  // 9: cerc.registry.v1.Msg.SetName:input_type -> cerc.registry.v1.MsgSetName
-	9,  // 10: cerc.registry.v1.Msg.DeleteName:input_type -> cerc.registry.v1.MsgDeleteName
-	5,  // 11: cerc.registry.v1.Msg.ReserveAuthority:input_type -> cerc.registry.v1.MsgReserveAuthority
-	7,  // 12: cerc.registry.v1.Msg.SetAuthorityBond:input_type -> cerc.registry.v1.MsgSetAuthorityBond
-	1,  // 13: cerc.registry.v1.Msg.SetRecord:output_type -> cerc.registry.v1.MsgSetRecordResponse
-	12, // 14: cerc.registry.v1.Msg.RenewRecord:output_type -> cerc.registry.v1.MsgRenewRecordResponse
-	14, // 15: cerc.registry.v1.Msg.AssociateBond:output_type -> cerc.registry.v1.MsgAssociateBondResponse
-	16, // 16: cerc.registry.v1.Msg.DissociateBond:output_type -> cerc.registry.v1.MsgDissociateBondResponse
-	18, // 17: cerc.registry.v1.Msg.DissociateRecords:output_type -> cerc.registry.v1.MsgDissociateRecordsResponse
-	20, // 18: cerc.registry.v1.Msg.ReassociateRecords:output_type -> cerc.registry.v1.MsgReassociateRecordsResponse
-	4,  // 19: cerc.registry.v1.Msg.SetName:output_type -> cerc.registry.v1.MsgSetNameResponse
-	10, // 20: cerc.registry.v1.Msg.DeleteName:output_type -> cerc.registry.v1.MsgDeleteNameResponse
-	6,  // 21: cerc.registry.v1.Msg.ReserveAuthority:output_type -> cerc.registry.v1.MsgReserveAuthorityResponse
-	8,  // 22: cerc.registry.v1.Msg.SetAuthorityBond:output_type -> cerc.registry.v1.MsgSetAuthorityBondResponse
-	13, // [13:23] is the sub-list for method output_type
-	3,  // [3:13] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	4,  // 0: cerc.registry.v1.MsgSetRecord.payload:type_name -> cerc.registry.v1.Payload
+	39, // 1: cerc.registry.v1.MsgSetRecord.lifetime:type_name -> google.protobuf.Duration
+	4,  // 2: cerc.registry.v1.MsgUpdateRecord.payload:type_name -> cerc.registry.v1.Payload
+	40, // 3: cerc.registry.v1.MsgUpdateRecord.signatures:type_name -> cerc.registry.v1.Signature
+	41, // 4: cerc.registry.v1.Payload.record:type_name -> cerc.registry.v1.Record
+	40, // 5: cerc.registry.v1.Payload.signatures:type_name -> cerc.registry.v1.Signature
+	42, // 6: cerc.registry.v1.MsgGrantNameAccess.expiry_time:type_name -> google.protobuf.Timestamp
+	40, // 7: cerc.registry.v1.MsgRenewRecord.signatures:type_name -> cerc.registry.v1.Signature
+	40, // 8: cerc.registry.v1.MsgDeleteRecord.signatures:type_name -> cerc.registry.v1.Signature
+	40, // 9: cerc.registry.v1.MsgTransferRecordOwnership.signatures:type_name -> cerc.registry.v1.Signature
+	40, // 10: cerc.registry.v1.MsgAssociateBond.signatures:type_name -> cerc.registry.v1.Signature
+	40, // 11: cerc.registry.v1.MsgDissociateBond.signatures:type_name -> cerc.registry.v1.Signature
+	0,  // 12: cerc.registry.v1.Msg.SetRecord:input_type -> cerc.registry.v1.MsgSetRecord
+	2,  // 13: cerc.registry.v1.Msg.UpdateRecord:input_type -> cerc.registry.v1.MsgUpdateRecord
+	23, // 14: cerc.registry.v1.Msg.RenewRecord:input_type -> cerc.registry.v1.MsgRenewRecord
+	25, // 15: cerc.registry.v1.Msg.DeleteRecord:input_type -> cerc.registry.v1.MsgDeleteRecord
+	27, // 16: cerc.registry.v1.Msg.TransferRecordOwnership:input_type -> cerc.registry.v1.MsgTransferRecordOwnership
+	29, // 17: cerc.registry.v1.Msg.AssociateBond:input_type -> cerc.registry.v1.MsgAssociateBond
+	31, // 18: cerc.registry.v1.Msg.DissociateBond:input_type -> cerc.registry.v1.MsgDissociateBond
+	33, // 19: cerc.registry.v1.Msg.DissociateRecords:input_type -> cerc.registry.v1.MsgDissociateRecords
+	35, // 20: cerc.registry.v1.Msg.ReassociateRecords:input_type -> cerc.registry.v1.MsgReassociateRecords
+	5,  // 21: cerc.registry.v1.Msg.SetName:input_type -> cerc.registry.v1.MsgSetName
+	21, // 22: cerc.registry.v1.Msg.DeleteName:input_type -> cerc.registry.v1.MsgDeleteName
+	7,  // 23: cerc.registry.v1.Msg.ReserveAuthority:input_type -> cerc.registry.v1.MsgReserveAuthority
+	9,  // 24: cerc.registry.v1.Msg.SetAuthorityBond:input_type -> cerc.registry.v1.MsgSetAuthorityBond
+	11, // 25: cerc.registry.v1.Msg.TransferAuthority:input_type -> cerc.registry.v1.MsgTransferAuthority
+	13, // 26: cerc.registry.v1.Msg.AcceptAuthority:input_type -> cerc.registry.v1.MsgAcceptAuthority
+	15, // 27: cerc.registry.v1.Msg.RenewAuthority:input_type -> cerc.registry.v1.MsgRenewAuthority
+	17, // 28: cerc.registry.v1.Msg.GrantNameAccess:input_type -> cerc.registry.v1.MsgGrantNameAccess
+	19, // 29: cerc.registry.v1.Msg.RevokeNameAccess:input_type -> cerc.registry.v1.MsgRevokeNameAccess
+	37, // 30: cerc.registry.v1.Msg.RegisterSchema:input_type -> cerc.registry.v1.MsgRegisterSchema
+	1,  // 31: cerc.registry.v1.Msg.SetRecord:output_type -> cerc.registry.v1.MsgSetRecordResponse
+	3,  // 32: cerc.registry.v1.Msg.UpdateRecord:output_type -> cerc.registry.v1.MsgUpdateRecordResponse
+	24, // 33: cerc.registry.v1.Msg.RenewRecord:output_type -> cerc.registry.v1.MsgRenewRecordResponse
+	26, // 34: cerc.registry.v1.Msg.DeleteRecord:output_type -> cerc.registry.v1.MsgDeleteRecordResponse
+	28, // 35: cerc.registry.v1.Msg.TransferRecordOwnership:output_type -> cerc.registry.v1.MsgTransferRecordOwnershipResponse
+	30, // 36: cerc.registry.v1.Msg.AssociateBond:output_type -> cerc.registry.v1.MsgAssociateBondResponse
+	32, // 37: cerc.registry.v1.Msg.DissociateBond:output_type -> cerc.registry.v1.MsgDissociateBondResponse
+	34, // 38: cerc.registry.v1.Msg.DissociateRecords:output_type -> cerc.registry.v1.MsgDissociateRecordsResponse
+	36, // 39: cerc.registry.v1.Msg.ReassociateRecords:output_type -> cerc.registry.v1.MsgReassociateRecordsResponse
+	6,  // 40: cerc.registry.v1.Msg.SetName:output_type -> cerc.registry.v1.MsgSetNameResponse
+	22, // 41: cerc.registry.v1.Msg.DeleteName:output_type -> cerc.registry.v1.MsgDeleteNameResponse
+	8,  // 42: cerc.registry.v1.Msg.ReserveAuthority:output_type -> cerc.registry.v1.MsgReserveAuthorityResponse
+	10, // 43: cerc.registry.v1.Msg.SetAuthorityBond:output_type -> cerc.registry.v1.MsgSetAuthorityBondResponse
+	12, // 44: cerc.registry.v1.Msg.TransferAuthority:output_type -> cerc.registry.v1.MsgTransferAuthorityResponse
+	14, // 45: cerc.registry.v1.Msg.AcceptAuthority:output_type -> cerc.registry.v1.MsgAcceptAuthorityResponse
+	16, // 46: cerc.registry.v1.Msg.RenewAuthority:output_type -> cerc.registry.v1.MsgRenewAuthorityResponse
+	18, // 47: cerc.registry.v1.Msg.GrantNameAccess:output_type -> cerc.registry.v1.MsgGrantNameAccessResponse
+	20, // 48: cerc.registry.v1.Msg.RevokeNameAccess:output_type -> cerc.registry.v1.MsgRevokeNameAccessResponse
+	38, // 49: cerc.registry.v1.Msg.RegisterSchema:output_type -> cerc.registry.v1.MsgRegisterSchemaResponse
+	31, // [31:50] is the sub-list for method output_type
+	12, // [12:31] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cerc_registry_v1_tx_proto_init() }
@@ -10606,7 +21340,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payload); i {
+			switch v := v.(*MsgUpdateRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10618,7 +21352,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetName); i {
+			switch v := v.(*MsgUpdateRecordResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10630,7 +21364,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetNameResponse); i {
+			switch v := v.(*Payload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10642,7 +21376,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgReserveAuthority); i {
+			switch v := v.(*MsgSetName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10654,7 +21388,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgReserveAuthorityResponse); i {
+			switch v := v.(*MsgSetNameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10666,7 +21400,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetAuthorityBond); i {
+			switch v := v.(*MsgReserveAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10678,7 +21412,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSetAuthorityBondResponse); i {
+			switch v := v.(*MsgReserveAuthorityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10690,7 +21424,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeleteName); i {
+			switch v := v.(*MsgSetAuthorityBond); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10702,7 +21436,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDeleteNameResponse); i {
+			switch v := v.(*MsgSetAuthorityBondResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10714,7 +21448,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRenewRecord); i {
+			switch v := v.(*MsgTransferAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10726,7 +21460,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRenewRecordResponse); i {
+			switch v := v.(*MsgTransferAuthorityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10738,7 +21472,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAssociateBond); i {
+			switch v := v.(*MsgAcceptAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10750,7 +21484,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAssociateBondResponse); i {
+			switch v := v.(*MsgAcceptAuthorityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10762,7 +21496,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDissociateBond); i {
+			switch v := v.(*MsgRenewAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10774,7 +21508,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDissociateBondResponse); i {
+			switch v := v.(*MsgRenewAuthorityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10786,7 +21520,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDissociateRecords); i {
+			switch v := v.(*MsgGrantNameAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10798,7 +21532,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDissociateRecordsResponse); i {
+			switch v := v.(*MsgGrantNameAccessResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10810,7 +21544,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgReassociateRecords); i {
+			switch v := v.(*MsgRevokeNameAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10822,6 +21556,198 @@ func file_cerc_registry_v1_tx_proto_init() {
 			}
 		}
 		file_cerc_registry_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeNameAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRenewRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRenewRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeleteRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferRecordOwnership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferRecordOwnershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAssociateBond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAssociateBondResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDissociateBond); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDissociateBondResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDissociateRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDissociateRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReassociateRecords); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgReassociateRecordsResponse); i {
 			case 0:
 				return &v.state
@@ -10833,6 +21759,30 @@ func file_cerc_registry_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_cerc_registry_v1_tx_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cerc_registry_v1_tx_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRegisterSchemaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10840,7 +21790,7 @@ func file_cerc_registry_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cerc_registry_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SetRecord_FullMethodName          = "/cerc.registry.v1.Msg/SetRecord"
-	Msg_RenewRecord_FullMethodName        = "/cerc.registry.v1.Msg/RenewRecord"
-	Msg_AssociateBond_FullMethodName      = "/cerc.registry.v1.Msg/AssociateBond"
-	Msg_DissociateBond_FullMethodName     = "/cerc.registry.v1.Msg/DissociateBond"
-	Msg_DissociateRecords_FullMethodName  = "/cerc.registry.v1.Msg/DissociateRecords"
-	Msg_ReassociateRecords_FullMethodName = "/cerc.registry.v1.Msg/ReassociateRecords"
-	Msg_SetName_FullMethodName            = "/cerc.registry.v1.Msg/SetName"
-	Msg_DeleteName_FullMethodName         = "/cerc.registry.v1.Msg/DeleteName"
-	Msg_ReserveAuthority_FullMethodName   = "/cerc.registry.v1.Msg/ReserveAuthority"
-	Msg_SetAuthorityBond_FullMethodName   = "/cerc.registry.v1.Msg/SetAuthorityBond"
+	Msg_SetRecord_FullMethodName               = "/cerc.registry.v1.Msg/SetRecord"
+	Msg_UpdateRecord_FullMethodName            = "/cerc.registry.v1.Msg/UpdateRecord"
+	Msg_RenewRecord_FullMethodName             = "/cerc.registry.v1.Msg/RenewRecord"
+	Msg_DeleteRecord_FullMethodName            = "/cerc.registry.v1.Msg/DeleteRecord"
+	Msg_TransferRecordOwnership_FullMethodName = "/cerc.registry.v1.Msg/TransferRecordOwnership"
+	Msg_AssociateBond_FullMethodName           = "/cerc.registry.v1.Msg/AssociateBond"
+	Msg_DissociateBond_FullMethodName          = "/cerc.registry.v1.Msg/DissociateBond"
+	Msg_DissociateRecords_FullMethodName       = "/cerc.registry.v1.Msg/DissociateRecords"
+	Msg_ReassociateRecords_FullMethodName      = "/cerc.registry.v1.Msg/ReassociateRecords"
+	Msg_SetName_FullMethodName                 = "/cerc.registry.v1.Msg/SetName"
+	Msg_DeleteName_FullMethodName              = "/cerc.registry.v1.Msg/DeleteName"
+	Msg_ReserveAuthority_FullMethodName        = "/cerc.registry.v1.Msg/ReserveAuthority"
+	Msg_SetAuthorityBond_FullMethodName        = "/cerc.registry.v1.Msg/SetAuthorityBond"
+	Msg_TransferAuthority_FullMethodName       = "/cerc.registry.v1.Msg/TransferAuthority"
+	Msg_AcceptAuthority_FullMethodName         = "/cerc.registry.v1.Msg/AcceptAuthority"
+	Msg_RenewAuthority_FullMethodName          = "/cerc.registry.v1.Msg/RenewAuthority"
+	Msg_GrantNameAccess_FullMethodName         = "/cerc.registry.v1.Msg/GrantNameAccess"
+	Msg_RevokeNameAccess_FullMethodName        = "/cerc.registry.v1.Msg/RevokeNameAccess"
+	Msg_RegisterSchema_FullMethodName          = "/cerc.registry.v1.Msg/RegisterSchema"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,8 +46,16 @@ const (
 type MsgClient interface {
 	// SetRecord records a new record with given payload and bond id
 	SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grpc.CallOption) (*MsgSetRecordResponse, error)
-	// Renew Record renews an expired record
+	// UpdateRecord creates a new version of a record, linked to the previous one
+	UpdateRecord(ctx context.Context, in *MsgUpdateRecord, opts ...grpc.CallOption) (*MsgUpdateRecordResponse, error)
+	// Renew Record renews an expired record, or extends a record by prepaying
+	// rent periods
 	RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error)
+	// DeleteRecord deletes a record and refunds the unused rent to its bond
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// TransferRecordOwnership replaces the owners of a record, approved by a
+	// threshold of its current owners
+	TransferRecordOwnership(ctx context.Context, in *MsgTransferRecordOwnership, opts ...grpc.CallOption) (*MsgTransferRecordOwnershipResponse, error)
 	// AssociateBond
 	AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error)
 	// DissociateBond
@@ -55,6 +72,21 @@ type MsgClient interface {
 	ReserveAuthority(ctx context.Context, in *MsgReserveAuthority, opts ...grpc.CallOption) (*MsgReserveAuthorityResponse, error)
 	// SetAuthorityBond
 	SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBond, opts ...grpc.CallOption) (*MsgSetAuthorityBondResponse, error)
+	// TransferAuthority hands a name authority over to a new owner, or proposes
+	// the transfer to be accepted by the new owner (see AcceptAuthority)
+	TransferAuthority(ctx context.Context, in *MsgTransferAuthority, opts ...grpc.CallOption) (*MsgTransferAuthorityResponse, error)
+	// AcceptAuthority completes a pending name authority transfer
+	AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error)
+	// RenewAuthority prepays the rent of a name authority for a number of rent
+	// periods, bringing it back to active if it's in its grace period
+	RenewAuthority(ctx context.Context, in *MsgRenewAuthority, opts ...grpc.CallOption) (*MsgRenewAuthorityResponse, error)
+	// GrantNameAccess lets another address set and delete names matching an LRN
+	// prefix within a name authority
+	GrantNameAccess(ctx context.Context, in *MsgGrantNameAccess, opts ...grpc.CallOption) (*MsgGrantNameAccessResponse, error)
+	// RevokeNameAccess revokes a name access grant
+	RevokeNameAccess(ctx context.Context, in *MsgRevokeNameAccess, opts ...grpc.CallOption) (*MsgRevokeNameAccessResponse, error)
+	// RegisterSchema registers or updates a record type schema
+	RegisterSchema(ctx context.Context, in *MsgRegisterSchema, opts ...grpc.CallOption) (*MsgRegisterSchemaResponse, error)
 }
 
 type msgClient struct {
@@ -74,6 +106,15 @@ func (c *msgClient) SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) UpdateRecord(ctx context.Context, in *MsgUpdateRecord, opts ...grpc.CallOption) (*MsgUpdateRecordResponse, error) {
+	out := new(MsgUpdateRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error) {
 	out := new(MsgRenewRecordResponse)
 	err := c.cc.Invoke(ctx, Msg_RenewRecord_FullMethodName, in, out, opts...)
@@ -83,6 +124,24 @@ func (c *msgClient) RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ..
 	return out, nil
 }
 
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferRecordOwnership(ctx context.Context, in *MsgTransferRecordOwnership, opts ...grpc.CallOption) (*MsgTransferRecordOwnershipResponse, error) {
+	out := new(MsgTransferRecordOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferRecordOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error) {
 	out := new(MsgAssociateBondResponse)
 	err := c.cc.Invoke(ctx, Msg_AssociateBond_FullMethodName, in, out, opts...)
@@ -155,14 +214,76 @@ func (c *msgClient) SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBon
 	return out, nil
 }
 
+func (c *msgClient) TransferAuthority(ctx context.Context, in *MsgTransferAuthority, opts ...grpc.CallOption) (*MsgTransferAuthorityResponse, error) {
+	out := new(MsgTransferAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error) {
+	out := new(MsgAcceptAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewAuthority(ctx context.Context, in *MsgRenewAuthority, opts ...grpc.CallOption) (*MsgRenewAuthorityResponse, error) {
+	out := new(MsgRenewAuthorityResponse)
+	err := c.cc.Invoke(ctx, Msg_RenewAuthority_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantNameAccess(ctx context.Context, in *MsgGrantNameAccess, opts ...grpc.CallOption) (*MsgGrantNameAccessResponse, error) {
+	out := new(MsgGrantNameAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantNameAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeNameAccess(ctx context.Context, in *MsgRevokeNameAccess, opts ...grpc.CallOption) (*MsgRevokeNameAccessResponse, error) {
+	out := new(MsgRevokeNameAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeNameAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSchema(ctx context.Context, in *MsgRegisterSchema, opts ...grpc.CallOption) (*MsgRegisterSchemaResponse, error) {
+	out := new(MsgRegisterSchemaResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// SetRecord records a new record with given payload and bond id
 	SetRecord(context.Context, *MsgSetRecord) (*MsgSetRecordResponse, error)
-	// Renew Record renews an expired record
+	// UpdateRecord creates a new version of a record, linked to the previous one
+	UpdateRecord(context.Context, *MsgUpdateRecord) (*MsgUpdateRecordResponse, error)
+	// Renew Record renews an expired record, or extends a record by prepaying
+	// rent periods
 	RenewRecord(context.Context, *MsgRenewRecord) (*MsgRenewRecordResponse, error)
+	// DeleteRecord deletes a record and refunds the unused rent to its bond
+	DeleteRecord(context.Context, *MsgDeleteRecord) (*MsgDeleteRecordResponse, error)
+	// TransferRecordOwnership replaces the owners of a record, approved by a
+	// threshold of its current owners
+	TransferRecordOwnership(context.Context, *MsgTransferRecordOwnership) (*MsgTransferRecordOwnershipResponse, error)
 	// AssociateBond
 	AssociateBond(context.Context, *MsgAssociateBond) (*MsgAssociateBondResponse, error)
 	// DissociateBond
@@ -179,6 +300,21 @@ type MsgServer interface {
 	ReserveAuthority(context.Context, *MsgReserveAuthority) (*MsgReserveAuthorityResponse, error)
 	// SetAuthorityBond
 	SetAuthorityBond(context.Context, *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error)
+	// TransferAuthority hands a name authority over to a new owner, or proposes
+	// the transfer to be accepted by the new owner (see AcceptAuthority)
+	TransferAuthority(context.Context, *MsgTransferAuthority) (*MsgTransferAuthorityResponse, error)
+	// AcceptAuthority completes a pending name authority transfer
+	AcceptAuthority(context.Context, *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error)
+	// RenewAuthority prepays the rent of a name authority for a number of rent
+	// periods, bringing it back to active if it's in its grace period
+	RenewAuthority(context.Context, *MsgRenewAuthority) (*MsgRenewAuthorityResponse, error)
+	// GrantNameAccess lets another address set and delete names matching an LRN
+	// prefix within a name authority
+	GrantNameAccess(context.Context, *MsgGrantNameAccess) (*MsgGrantNameAccessResponse, error)
+	// RevokeNameAccess revokes a name access grant
+	RevokeNameAccess(context.Context, *MsgRevokeNameAccess) (*MsgRevokeNameAccessResponse, error)
+	// RegisterSchema registers or updates a record type schema
+	RegisterSchema(context.Context, *MsgRegisterSchema) (*MsgRegisterSchemaResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -189,9 +325,18 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) SetRecord(context.Context, *MsgSetRecord) (*MsgSetRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRecord not implemented")
 }
+func (UnimplementedMsgServer) UpdateRecord(context.Context, *MsgUpdateRecord) (*MsgUpdateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
+}
 func (UnimplementedMsgServer) RenewRecord(context.Context, *MsgRenewRecord) (*MsgRenewRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenewRecord not implemented")
 }
+func (UnimplementedMsgServer) DeleteRecord(context.Context, *MsgDeleteRecord) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (UnimplementedMsgServer) TransferRecordOwnership(context.Context, *MsgTransferRecordOwnership) (*MsgTransferRecordOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordOwnership not implemented")
+}
 func (UnimplementedMsgServer) AssociateBond(context.Context, *MsgAssociateBond) (*MsgAssociateBondResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssociateBond not implemented")
 }
@@ -216,6 +361,24 @@ func (UnimplementedMsgServer) ReserveAuthority(context.Context, *MsgReserveAutho
 func (UnimplementedMsgServer) SetAuthorityBond(context.Context, *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAuthorityBond not implemented")
 }
+func (UnimplementedMsgServer) TransferAuthority(context.Context, *MsgTransferAuthority) (*MsgTransferAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAuthority not implemented")
+}
+func (UnimplementedMsgServer) AcceptAuthority(context.Context, *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAuthority not implemented")
+}
+func (UnimplementedMsgServer) RenewAuthority(context.Context, *MsgRenewAuthority) (*MsgRenewAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAuthority not implemented")
+}
+func (UnimplementedMsgServer) GrantNameAccess(context.Context, *MsgGrantNameAccess) (*MsgGrantNameAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantNameAccess not implemented")
+}
+func (UnimplementedMsgServer) RevokeNameAccess(context.Context, *MsgRevokeNameAccess) (*MsgRevokeNameAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNameAccess not implemented")
+}
+func (UnimplementedMsgServer) RegisterSchema(context.Context, *MsgRegisterSchema) (*MsgRegisterSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSchema not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +410,24 @@ func _Msg_SetRecord_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRecord(ctx, req.(*MsgUpdateRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RenewRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRenewRecord)
 	if err := dec(in); err != nil {
@@ -265,6 +446,42 @@ func _Msg_RenewRecord_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferRecordOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRecordOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferRecordOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferRecordOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferRecordOwnership(ctx, req.(*MsgTransferRecordOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AssociateBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAssociateBond)
 	if err := dec(in); err != nil {
@@ -409,6 +626,114 @@ func _Msg_SetAuthorityBond_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAuthority(ctx, req.(*MsgTransferAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAuthority(ctx, req.(*MsgAcceptAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RenewAuthority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewAuthority(ctx, req.(*MsgRenewAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantNameAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantNameAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantNameAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantNameAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantNameAccess(ctx, req.(*MsgGrantNameAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeNameAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeNameAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeNameAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeNameAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeNameAccess(ctx, req.(*MsgRevokeNameAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSchema(ctx, req.(*MsgRegisterSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -420,10 +745,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetRecord",
 			Handler:    _Msg_SetRecord_Handler,
 		},
+		{
+			MethodName: "UpdateRecord",
+			Handler:    _Msg_UpdateRecord_Handler,
+		},
 		{
 			MethodName: "RenewRecord",
 			Handler:    _Msg_RenewRecord_Handler,
 		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "TransferRecordOwnership",
+			Handler:    _Msg_TransferRecordOwnership_Handler,
+		},
 		{
 			MethodName: "AssociateBond",
 			Handler:    _Msg_AssociateBond_Handler,
@@ -456,6 +793,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAuthorityBond",
 			Handler:    _Msg_SetAuthorityBond_Handler,
 		},
+		{
+			MethodName: "TransferAuthority",
+			Handler:    _Msg_TransferAuthority_Handler,
+		},
+		{
+			MethodName: "AcceptAuthority",
+			Handler:    _Msg_AcceptAuthority_Handler,
+		},
+		{
+			MethodName: "RenewAuthority",
+			Handler:    _Msg_RenewAuthority_Handler,
+		},
+		{
+			MethodName: "GrantNameAccess",
+			Handler:    _Msg_GrantNameAccess_Handler,
+		},
+		{
+			MethodName: "RevokeNameAccess",
+			Handler:    _Msg_RevokeNameAccess_Handler,
+		},
+		{
+			MethodName: "RegisterSchema",
+			Handler:    _Msg_RegisterSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cerc/registry/v1/tx.proto",
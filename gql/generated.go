@@ -37,6 +37,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	Query() QueryResolver
+	Record() RecordResolver
 }
 
 type DirectiveRoot struct {
@@ -132,6 +133,13 @@ type ComplexityRoot struct {
 		Value func(childComplexity int) int
 	}
 
+	NameEntry struct {
+		Deleted func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Record  func(childComplexity int) int
+		Stale   func(childComplexity int) int
+	}
+
 	NameRecord struct {
 		History func(childComplexity int) int
 		Latest  func(childComplexity int) int
@@ -172,24 +180,27 @@ type ComplexityRoot struct {
 		GetBondsByIds     func(childComplexity int, ids []string) int
 		GetParticipants   func(childComplexity int) int
 		GetRecordsByIds   func(childComplexity int, ids []string) int
+		GetRecordsByOwner func(childComplexity int, owner string, limit *int, offset *int) int
 		GetStatus         func(childComplexity int) int
+		ListNames         func(childComplexity int, prefix string, limit *int, offset *int) int
 		LookupAuthorities func(childComplexity int, names []string) int
-		LookupNames       func(childComplexity int, names []string) int
+		LookupNames       func(childComplexity int, names []string, height *int) int
 		QueryBonds        func(childComplexity int, attributes []*KeyValueInput) int
 		QueryBondsByOwner func(childComplexity int, ownerAddresses []string) int
-		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, all *bool) int
-		ResolveNames      func(childComplexity int, names []string) int
+		QueryRecords      func(childComplexity int, attributes []*KeyValueInput, filter *RecordFilter, all *bool, limit *int, offset *int) int
+		ResolveNames      func(childComplexity int, names []string, height *int) int
 	}
 
 	Record struct {
-		Attributes func(childComplexity int) int
-		BondID     func(childComplexity int) int
-		CreateTime func(childComplexity int) int
-		ExpiryTime func(childComplexity int) int
-		ID         func(childComplexity int) int
-		Names      func(childComplexity int) int
-		Owners     func(childComplexity int) int
-		References func(childComplexity int) int
+		Attributes   func(childComplexity int) int
+		BondID       func(childComplexity int) int
+		CreateTime   func(childComplexity int) int
+		ExpiryTime   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Names        func(childComplexity int) int
+		Owners       func(childComplexity int) int
+		ReferencedBy func(childComplexity int) int
+		References   func(childComplexity int) int
 	}
 
 	Status struct {
@@ -228,13 +239,18 @@ type QueryResolver interface {
 	QueryBonds(ctx context.Context, attributes []*KeyValueInput) ([]*Bond, error)
 	QueryBondsByOwner(ctx context.Context, ownerAddresses []string) ([]*OwnerBonds, error)
 	GetRecordsByIds(ctx context.Context, ids []string) ([]*Record, error)
-	QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error)
+	QueryRecords(ctx context.Context, attributes []*KeyValueInput, filter *RecordFilter, all *bool, limit *int, offset *int) ([]*Record, error)
+	GetRecordsByOwner(ctx context.Context, owner string, limit *int, offset *int) ([]*Record, error)
 	LookupAuthorities(ctx context.Context, names []string) ([]*AuthorityRecord, error)
-	LookupNames(ctx context.Context, names []string) ([]*NameRecord, error)
-	ResolveNames(ctx context.Context, names []string) ([]*Record, error)
+	LookupNames(ctx context.Context, names []string, height *int) ([]*NameRecord, error)
+	ListNames(ctx context.Context, prefix string, limit *int, offset *int) ([]*NameEntry, error)
+	ResolveNames(ctx context.Context, names []string, height *int) ([]*Record, error)
 	GetAuctionsByIds(ctx context.Context, ids []string) ([]*Auction, error)
 	GetParticipants(ctx context.Context) ([]*Participant, error)
 }
+type RecordResolver interface {
+	ReferencedBy(ctx context.Context, obj *Record) ([]*Record, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -580,6 +596,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MapValue.Value(childComplexity), true
 
+	case "NameEntry.deleted":
+		if e.complexity.NameEntry.Deleted == nil {
+			break
+		}
+
+		return e.complexity.NameEntry.Deleted(childComplexity), true
+
+	case "NameEntry.name":
+		if e.complexity.NameEntry.Name == nil {
+			break
+		}
+
+		return e.complexity.NameEntry.Name(childComplexity), true
+
+	case "NameEntry.record":
+		if e.complexity.NameEntry.Record == nil {
+			break
+		}
+
+		return e.complexity.NameEntry.Record(childComplexity), true
+
+	case "NameEntry.stale":
+		if e.complexity.NameEntry.Stale == nil {
+			break
+		}
+
+		return e.complexity.NameEntry.Stale(childComplexity), true
+
 	case "NameRecord.history":
 		if e.complexity.NameRecord.History == nil {
 			break
@@ -747,6 +791,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetRecordsByIds(childComplexity, args["ids"].([]string)), true
 
+	case "Query.getRecordsByOwner":
+		if e.complexity.Query.GetRecordsByOwner == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getRecordsByOwner_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetRecordsByOwner(childComplexity, args["owner"].(string), args["limit"].(*int), args["offset"].(*int)), true
+
 	case "Query.getStatus":
 		if e.complexity.Query.GetStatus == nil {
 			break
@@ -754,6 +810,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetStatus(childComplexity), true
 
+	case "Query.listNames":
+		if e.complexity.Query.ListNames == nil {
+			break
+		}
+
+		args, err := ec.field_Query_listNames_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ListNames(childComplexity, args["prefix"].(string), args["limit"].(*int), args["offset"].(*int)), true
+
 	case "Query.lookupAuthorities":
 		if e.complexity.Query.LookupAuthorities == nil {
 			break
@@ -776,7 +844,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.LookupNames(childComplexity, args["names"].([]string)), true
+		return e.complexity.Query.LookupNames(childComplexity, args["names"].([]string), args["height"].(*int)), true
 
 	case "Query.queryBonds":
 		if e.complexity.Query.QueryBonds == nil {
@@ -812,7 +880,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["all"].(*bool)), true
+		return e.complexity.Query.QueryRecords(childComplexity, args["attributes"].([]*KeyValueInput), args["filter"].(*RecordFilter), args["all"].(*bool), args["limit"].(*int), args["offset"].(*int)), true
 
 	case "Query.resolveNames":
 		if e.complexity.Query.ResolveNames == nil {
@@ -824,7 +892,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.ResolveNames(childComplexity, args["names"].([]string)), true
+		return e.complexity.Query.ResolveNames(childComplexity, args["names"].([]string), args["height"].(*int)), true
 
 	case "Record.attributes":
 		if e.complexity.Record.Attributes == nil {
@@ -875,6 +943,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Record.Owners(childComplexity), true
 
+	case "Record.referencedBy":
+		if e.complexity.Record.ReferencedBy == nil {
+			break
+		}
+
+		return e.complexity.Record.ReferencedBy(childComplexity), true
+
 	case "Record.references":
 		if e.complexity.Record.References == nil {
 			break
@@ -1003,6 +1078,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputKeyValueInput,
+		ec.unmarshalInputRecordFilter,
 		ec.unmarshalInputValueInput,
 	)
 	first := true
@@ -1143,6 +1219,72 @@ func (ec *executionContext) field_Query_getRecordsByIds_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getRecordsByOwner_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["owner"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("owner"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["owner"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_listNames_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["prefix"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("prefix"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["prefix"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_lookupAuthorities_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1170,6 +1312,15 @@ func (ec *executionContext) field_Query_lookupNames_args(ctx context.Context, ra
 		}
 	}
 	args["names"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["height"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
 	return args, nil
 }
 
@@ -1215,15 +1366,42 @@ func (ec *executionContext) field_Query_queryRecords_args(ctx context.Context, r
 		}
 	}
 	args["attributes"] = arg0
-	var arg1 *bool
+	var arg1 *RecordFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg1, err = ec.unmarshalORecordFilter2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg1
+	var arg2 *bool
 	if tmp, ok := rawArgs["all"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("all"))
-		arg1, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		arg2, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["all"] = arg2
+	var arg3 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg3, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["offset"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("offset"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["all"] = arg1
+	args["offset"] = arg4
 	return args, nil
 }
 
@@ -1239,6 +1417,15 @@ func (ec *executionContext) field_Query_resolveNames_args(ctx context.Context, r
 		}
 	}
 	args["names"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["height"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("height"))
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["height"] = arg1
 	return args, nil
 }
 
@@ -3442,6 +3629,188 @@ func (ec *executionContext) fieldContext_MapValue_value(ctx context.Context, fie
 	return fc, nil
 }
 
+func (ec *executionContext) _NameEntry_name(ctx context.Context, field graphql.CollectedField, obj *NameEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameEntry_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NameEntry_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NameEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NameEntry_record(ctx context.Context, field graphql.CollectedField, obj *NameEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameEntry_record(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Record, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*NameRecord)
+	fc.Result = res
+	return ec.marshalNNameRecord2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NameEntry_record(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NameEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latest":
+				return ec.fieldContext_NameRecord_latest(ctx, field)
+			case "history":
+				return ec.fieldContext_NameRecord_history(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NameRecord", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NameEntry_stale(ctx context.Context, field graphql.CollectedField, obj *NameEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameEntry_stale(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Stale, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NameEntry_stale(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NameEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _NameEntry_deleted(ctx context.Context, field graphql.CollectedField, obj *NameEntry) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameEntry_deleted(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Deleted, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_NameEntry_deleted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "NameEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _NameRecord_latest(ctx context.Context, field graphql.CollectedField, obj *NameRecord) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_NameRecord_latest(ctx, field)
 	if err != nil {
@@ -4524,6 +4893,8 @@ func (ec *executionContext) fieldContext_Query_getRecordsByIds(ctx context.Conte
 				return ec.fieldContext_Record_attributes(ctx, field)
 			case "references":
 				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -4556,7 +4927,7 @@ func (ec *executionContext) _Query_queryRecords(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryRecords(rctx, fc.Args["attributes"].([]*KeyValueInput), fc.Args["all"].(*bool))
+		return ec.resolvers.Query().QueryRecords(rctx, fc.Args["attributes"].([]*KeyValueInput), fc.Args["filter"].(*RecordFilter), fc.Args["all"].(*bool), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4594,6 +4965,8 @@ func (ec *executionContext) fieldContext_Query_queryRecords(ctx context.Context,
 				return ec.fieldContext_Record_attributes(ctx, field)
 			case "references":
 				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -4612,6 +4985,78 @@ func (ec *executionContext) fieldContext_Query_queryRecords(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_getRecordsByOwner(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRecordsByOwner(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetRecordsByOwner(rctx, fc.Args["owner"].(string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	fc.Result = res
+	return ec.marshalORecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getRecordsByOwner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "names":
+				return ec.fieldContext_Record_names(ctx, field)
+			case "bondId":
+				return ec.fieldContext_Record_bondId(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Record_createTime(ctx, field)
+			case "expiryTime":
+				return ec.fieldContext_Record_expiryTime(ctx, field)
+			case "owners":
+				return ec.fieldContext_Record_owners(ctx, field)
+			case "attributes":
+				return ec.fieldContext_Record_attributes(ctx, field)
+			case "references":
+				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRecordsByOwner_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_lookupAuthorities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_lookupAuthorities(ctx, field)
 	if err != nil {
@@ -4697,7 +5142,7 @@ func (ec *executionContext) _Query_lookupNames(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().LookupNames(rctx, fc.Args["names"].([]string))
+		return ec.resolvers.Query().LookupNames(rctx, fc.Args["names"].([]string), fc.Args["height"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4744,6 +5189,71 @@ func (ec *executionContext) fieldContext_Query_lookupNames(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_listNames(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_listNames(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().ListNames(rctx, fc.Args["prefix"].(string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*NameEntry)
+	fc.Result = res
+	return ec.marshalNNameEntry2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_listNames(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_NameEntry_name(ctx, field)
+			case "record":
+				return ec.fieldContext_NameEntry_record(ctx, field)
+			case "stale":
+				return ec.fieldContext_NameEntry_stale(ctx, field)
+			case "deleted":
+				return ec.fieldContext_NameEntry_deleted(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NameEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_listNames_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_resolveNames(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query_resolveNames(ctx, field)
 	if err != nil {
@@ -4758,7 +5268,7 @@ func (ec *executionContext) _Query_resolveNames(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ResolveNames(rctx, fc.Args["names"].([]string))
+		return ec.resolvers.Query().ResolveNames(rctx, fc.Args["names"].([]string), fc.Args["height"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4799,6 +5309,8 @@ func (ec *executionContext) fieldContext_Query_resolveNames(ctx context.Context,
 				return ec.fieldContext_Record_attributes(ctx, field)
 			case "references":
 				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -5379,14 +5891,75 @@ func (ec *executionContext) fieldContext_Record_attributes(ctx context.Context,
 			case "value":
 				return ec.fieldContext_Attribute_value(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Attribute", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Attribute", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Record_references(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Record_references(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.References, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Record)
+	fc.Result = res
+	return ec.marshalORecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecord(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Record_references(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Record",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Record_id(ctx, field)
+			case "names":
+				return ec.fieldContext_Record_names(ctx, field)
+			case "bondId":
+				return ec.fieldContext_Record_bondId(ctx, field)
+			case "createTime":
+				return ec.fieldContext_Record_createTime(ctx, field)
+			case "expiryTime":
+				return ec.fieldContext_Record_expiryTime(ctx, field)
+			case "owners":
+				return ec.fieldContext_Record_owners(ctx, field)
+			case "attributes":
+				return ec.fieldContext_Record_attributes(ctx, field)
+			case "references":
+				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Record_references(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Record_references(ctx, field)
+func (ec *executionContext) _Record_referencedBy(ctx context.Context, field graphql.CollectedField, obj *Record) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Record_referencedBy(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5399,7 +5972,7 @@ func (ec *executionContext) _Record_references(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.References, nil
+		return ec.resolvers.Record().ReferencedBy(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5413,12 +5986,12 @@ func (ec *executionContext) _Record_references(ctx context.Context, field graphq
 	return ec.marshalORecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecord(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Record_references(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Record_referencedBy(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Record",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "id":
@@ -5437,6 +6010,8 @@ func (ec *executionContext) fieldContext_Record_references(ctx context.Context,
 				return ec.fieldContext_Record_attributes(ctx, field)
 			case "references":
 				return ec.fieldContext_Record_references(ctx, field)
+			case "referencedBy":
+				return ec.fieldContext_Record_referencedBy(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Record", field.Name)
 		},
@@ -7961,7 +8536,7 @@ func (ec *executionContext) unmarshalInputKeyValueInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"key", "value"}
+	fieldsInOrder := [...]string{"key", "value", "op"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -7984,6 +8559,66 @@ func (ec *executionContext) unmarshalInputKeyValueInput(ctx context.Context, obj
 			if err != nil {
 				return it, err
 			}
+		case "op":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("op"))
+			it.Op, err = ec.unmarshalOOperator2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐOperator(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRecordFilter(ctx context.Context, obj interface{}) (RecordFilter, error) {
+	var it RecordFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"condition", "and", "or", "not"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "condition":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("condition"))
+			it.Condition, err = ec.unmarshalOKeyValueInput2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐKeyValueInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "and":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("and"))
+			it.And, err = ec.unmarshalORecordFilter2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilterᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "or":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("or"))
+			it.Or, err = ec.unmarshalORecordFilter2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilterᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "not":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("not"))
+			it.Not, err = ec.unmarshalORecordFilter2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilter(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -8744,6 +9379,55 @@ func (ec *executionContext) _MapValue(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var nameEntryImplementors = []string{"NameEntry"}
+
+func (ec *executionContext) _NameEntry(ctx context.Context, sel ast.SelectionSet, obj *NameEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nameEntryImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NameEntry")
+		case "name":
+
+			out.Values[i] = ec._NameEntry_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "record":
+
+			out.Values[i] = ec._NameEntry_record(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "stale":
+
+			out.Values[i] = ec._NameEntry_stale(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "deleted":
+
+			out.Values[i] = ec._NameEntry_deleted(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var nameRecordImplementors = []string{"NameRecord"}
 
 func (ec *executionContext) _NameRecord(ctx context.Context, sel ast.SelectionSet, obj *NameRecord) graphql.Marshaler {
@@ -9135,6 +9819,26 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getRecordsByOwner":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRecordsByOwner(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -9181,6 +9885,29 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "listNames":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_listNames(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -9288,7 +10015,7 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = ec._Record_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "names":
 
@@ -9299,21 +10026,21 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 			out.Values[i] = ec._Record_bondId(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "createTime":
 
 			out.Values[i] = ec._Record_createTime(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "expiryTime":
 
 			out.Values[i] = ec._Record_expiryTime(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
-				invalids++
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "owners":
 
@@ -9327,6 +10054,23 @@ func (ec *executionContext) _Record(ctx context.Context, sel ast.SelectionSet, o
 
 			out.Values[i] = ec._Record_references(ctx, field, obj)
 
+		case "referencedBy":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Record_referencedBy(ctx, field, obj)
+				return res
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return innerFunc(ctx)
+
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -10015,6 +10759,44 @@ func (ec *executionContext) marshalNLink2gitᚗvdbᚗtoᚋcercᚑioᚋlaconicd
 	return graphql.WrapContextMarshaler(ctx, v)
 }
 
+func (ec *executionContext) marshalNNameEntry2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameEntry(ctx context.Context, sel ast.SelectionSet, v []*NameEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalONameEntry2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	return ret
+}
+
 func (ec *executionContext) marshalNNameRecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameRecord(ctx context.Context, sel ast.SelectionSet, v []*NameRecord) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -10053,6 +10835,16 @@ func (ec *executionContext) marshalNNameRecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑio
 	return ret
 }
 
+func (ec *executionContext) marshalNNameRecord2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameRecord(ctx context.Context, sel ast.SelectionSet, v *NameRecord) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._NameRecord(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNNameRecordEntry2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameRecordEntry(ctx context.Context, sel ast.SelectionSet, v *NameRecordEntry) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -10149,6 +10941,11 @@ func (ec *executionContext) marshalNRecord2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋla
 	return ret
 }
 
+func (ec *executionContext) unmarshalNRecordFilter2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilter(ctx context.Context, v interface{}) (*RecordFilter, error) {
+	res, err := ec.unmarshalInputRecordFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNStatus2gitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐStatus(ctx context.Context, sel ast.SelectionSet, v Status) graphql.Marshaler {
 	return ec._Status(ctx, sel, &v)
 }
@@ -10935,6 +11732,14 @@ func (ec *executionContext) unmarshalOKeyValueInput2ᚕᚖgitᚗvdbᚗtoᚋcerc
 	return res, nil
 }
 
+func (ec *executionContext) unmarshalOKeyValueInput2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐKeyValueInput(ctx context.Context, v interface{}) (*KeyValueInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputKeyValueInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOLink2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐLink(ctx context.Context, v interface{}) (*Link, error) {
 	if v == nil {
 		return nil, nil
@@ -10951,6 +11756,13 @@ func (ec *executionContext) marshalOLink2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconic
 	return graphql.WrapContextMarshaler(ctx, v)
 }
 
+func (ec *executionContext) marshalONameEntry2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameEntry(ctx context.Context, sel ast.SelectionSet, v *NameEntry) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._NameEntry(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalONameRecord2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐNameRecord(ctx context.Context, sel ast.SelectionSet, v *NameRecord) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11006,6 +11818,22 @@ func (ec *executionContext) marshalONameRecordEntry2ᚖgitᚗvdbᚗtoᚋcercᚑi
 	return ec._NameRecordEntry(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOOperator2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐOperator(ctx context.Context, v interface{}) (*Operator, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(Operator)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOOperator2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐOperator(ctx context.Context, sel ast.SelectionSet, v *Operator) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOOwnerBonds2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐOwnerBonds(ctx context.Context, sel ast.SelectionSet, v []*OwnerBonds) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -11157,6 +11985,34 @@ func (ec *executionContext) marshalORecord2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlacon
 	return ec._Record(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORecordFilter2ᚕᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilterᚄ(ctx context.Context, v interface{}) ([]*RecordFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*RecordFilter, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNRecordFilter2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilter(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) unmarshalORecordFilter2ᚖgitᚗvdbᚗtoᚋcercᚑioᚋlaconicdᚋgqlᚐRecordFilter(ctx context.Context, v interface{}) (*RecordFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRecordFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -2,6 +2,12 @@
 
 package gql
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type Value interface {
 	IsValue()
 }
@@ -100,6 +106,7 @@ func (IntValue) IsValue() {}
 type KeyValueInput struct {
 	Key   string      `json:"key"`
 	Value *ValueInput `json:"value"`
+	Op    *Operator   `json:"op"`
 }
 
 type LinkValue struct {
@@ -114,6 +121,13 @@ type MapValue struct {
 
 func (MapValue) IsValue() {}
 
+type NameEntry struct {
+	Name    string      `json:"name"`
+	Record  *NameRecord `json:"record"`
+	Stale   bool        `json:"stale"`
+	Deleted bool        `json:"deleted"`
+}
+
 type NameRecord struct {
 	Latest  *NameRecordEntry   `json:"latest"`
 	History []*NameRecordEntry `json:"history"`
@@ -149,14 +163,22 @@ type PeerInfo struct {
 }
 
 type Record struct {
-	ID         string       `json:"id"`
-	Names      []string     `json:"names"`
-	BondID     string       `json:"bondId"`
-	CreateTime string       `json:"createTime"`
-	ExpiryTime string       `json:"expiryTime"`
-	Owners     []string     `json:"owners"`
-	Attributes []*Attribute `json:"attributes"`
-	References []*Record    `json:"references"`
+	ID           string       `json:"id"`
+	Names        []string     `json:"names"`
+	BondID       string       `json:"bondId"`
+	CreateTime   string       `json:"createTime"`
+	ExpiryTime   string       `json:"expiryTime"`
+	Owners       []string     `json:"owners"`
+	Attributes   []*Attribute `json:"attributes"`
+	References   []*Record    `json:"references"`
+	ReferencedBy []*Record    `json:"referencedBy"`
+}
+
+type RecordFilter struct {
+	Condition *KeyValueInput  `json:"condition"`
+	And       []*RecordFilter `json:"and"`
+	Or        []*RecordFilter `json:"or"`
+	Not       *RecordFilter   `json:"not"`
 }
 
 type Status struct {
@@ -198,3 +220,52 @@ type ValueInput struct {
 	Array   []*ValueInput    `json:"array"`
 	Map     []*KeyValueInput `json:"map"`
 }
+
+type Operator string
+
+const (
+	OperatorEq     Operator = "EQ"
+	OperatorGt     Operator = "GT"
+	OperatorGte    Operator = "GTE"
+	OperatorLt     Operator = "LT"
+	OperatorLte    Operator = "LTE"
+	OperatorPrefix Operator = "PREFIX"
+)
+
+var AllOperator = []Operator{
+	OperatorEq,
+	OperatorGt,
+	OperatorGte,
+	OperatorLt,
+	OperatorLte,
+	OperatorPrefix,
+}
+
+func (e Operator) IsValid() bool {
+	switch e {
+	case OperatorEq, OperatorGt, OperatorGte, OperatorLt, OperatorLte, OperatorPrefix:
+		return true
+	}
+	return false
+}
+
+func (e Operator) String() string {
+	return string(e)
+}
+
+func (e *Operator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Operator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Operator", str)
+	}
+	return nil
+}
+
+func (e Operator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -35,6 +35,39 @@ func (r *Resolver) Query() QueryResolver {
 
 type queryResolver struct{ *Resolver }
 
+// Record is the entry point to record field resolution.
+func (r *Resolver) Record() RecordResolver {
+	return &recordResolver{r}
+}
+
+type recordResolver struct{ *Resolver }
+
+// ReferencedBy resolves the records with a link to a record, on demand
+// (as resolving them along with the record could recurse indefinitely).
+func (r recordResolver) ReferencedBy(ctx context.Context, obj *Record) ([]*Record, error) {
+	nsQueryClient := registrytypes.NewQueryClient(r.ctx)
+
+	res, err := nsQueryClient.GetReferencingRecords(
+		context.Background(),
+		&registrytypes.QueryGetReferencingRecordsRequest{Id: obj.ID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	records := res.GetRecords()
+	gqlResponse := make([]*Record, len(records))
+	for i, record := range records {
+		gqlRecord, err := getGQLRecord(ctx, queryResolver{r.Resolver}, record)
+		if err != nil {
+			return nil, err
+		}
+		gqlResponse[i] = gqlRecord
+	}
+
+	return gqlResponse, nil
+}
+
 func (q queryResolver) LookupAuthorities(ctx context.Context, names []string) ([]*AuthorityRecord, error) {
 	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
 	auctionQueryClient := auctiontypes.NewQueryClient(q.ctx)
@@ -81,11 +114,12 @@ func (q queryResolver) LookupAuthorities(ctx context.Context, names []string) ([
 	return gqlResponse, nil
 }
 
-func (q queryResolver) ResolveNames(ctx context.Context, names []string) ([]*Record, error) {
+func (q queryResolver) ResolveNames(ctx context.Context, names []string, height *int) ([]*Record, error) {
+	nameHeight := toNameHeight(height)
 	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
 	var gqlResponse []*Record
 	for _, name := range names {
-		res, err := nsQueryClient.ResolveLrn(context.Background(), &registrytypes.QueryResolveLrnRequest{Lrn: name})
+		res, err := nsQueryClient.ResolveLrn(context.Background(), &registrytypes.QueryResolveLrnRequest{Lrn: name, Height: nameHeight})
 		if err != nil {
 			// Return nil for record not found.
 			gqlResponse = append(gqlResponse, nil)
@@ -102,12 +136,13 @@ func (q queryResolver) ResolveNames(ctx context.Context, names []string) ([]*Rec
 	return gqlResponse, nil
 }
 
-func (q queryResolver) LookupNames(ctx context.Context, names []string) ([]*NameRecord, error) {
+func (q queryResolver) LookupNames(ctx context.Context, names []string, height *int) ([]*NameRecord, error) {
+	nameHeight := toNameHeight(height)
 	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
 	var gqlResponse []*NameRecord
 
 	for _, name := range names {
-		res, err := nsQueryClient.LookupLrn(context.Background(), &registrytypes.QueryLookupLrnRequest{Lrn: name})
+		res, err := nsQueryClient.LookupLrn(context.Background(), &registrytypes.QueryLookupLrnRequest{Lrn: name, Height: nameHeight})
 		if err != nil {
 			// Return nil for name not found.
 			gqlResponse = append(gqlResponse, nil)
@@ -124,14 +159,84 @@ func (q queryResolver) LookupNames(ctx context.Context, names []string) ([]*Name
 	return gqlResponse, nil
 }
 
-func (q queryResolver) QueryRecords(ctx context.Context, attributes []*KeyValueInput, all *bool) ([]*Record, error) {
+func (q queryResolver) ListNames(ctx context.Context, prefix string, limit *int, offset *int) ([]*NameEntry, error) {
 	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
 
+	res, err := nsQueryClient.NamesByPrefix(
+		context.Background(),
+		&registrytypes.QueryNamesByPrefixRequest{
+			Prefix:     prefix,
+			Pagination: toPageRequest(limit, offset),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := res.GetNames()
+	gqlResponse := make([]*NameEntry, len(names))
+
+	for i, name := range names {
+		gqlName, err := getGQLNameEntry(name)
+		if err != nil {
+			return nil, err
+		}
+		gqlResponse[i] = gqlName
+	}
+
+	return gqlResponse, nil
+}
+
+func (q queryResolver) QueryRecords(
+	ctx context.Context,
+	attributes []*KeyValueInput,
+	filter *RecordFilter,
+	all *bool,
+	limit *int,
+	offset *int,
+) ([]*Record, error) {
+	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
+
+	rpcFilter, err := toRPCExpression(filter)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := nsQueryClient.Records(
 		context.Background(),
 		&registrytypes.QueryRecordsRequest{
 			Attributes: toRPCAttributes(attributes),
+			Filter:     rpcFilter,
 			All:        (all != nil && *all),
+			Pagination: toPageRequest(limit, offset),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	records := res.GetRecords()
+	gqlResponse := make([]*Record, len(records))
+
+	for i, record := range records {
+		gqlRecord, err := getGQLRecord(context.Background(), q, record)
+		if err != nil {
+			return nil, err
+		}
+		gqlResponse[i] = gqlRecord
+	}
+
+	return gqlResponse, nil
+}
+
+func (q queryResolver) GetRecordsByOwner(ctx context.Context, owner string, limit *int, offset *int) ([]*Record, error) {
+	nsQueryClient := registrytypes.NewQueryClient(q.ctx)
+
+	res, err := nsQueryClient.GetRecordsByOwner(
+		context.Background(),
+		&registrytypes.QueryGetRecordsByOwnerRequest{
+			Owner:      owner,
+			Pagination: toPageRequest(limit, offset),
 		},
 	)
 	if err != nil {
@@ -6,8 +6,8 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ipld/go-ipld-prime"
-	"github.com/ipld/go-ipld-prime/codec/dagjson"
 
 	auctiontypes "git.vdb.to/cerc-io/laconicd/x/auction"
 	bondtypes "git.vdb.to/cerc-io/laconicd/x/bond"
@@ -62,7 +62,7 @@ func getGQLRecord(ctx context.Context, resolver QueryResolver, record registryty
 		return nil, nil
 	}
 
-	node, err := ipld.Decode(record.Attributes, dagjson.Decode)
+	node, err := registrytypes.DecodeAttributesNode(record.Attributes)
 	if err != nil {
 		return nil, err
 	}
@@ -194,6 +194,20 @@ func getGQLNameRecord(record *registrytypes.NameRecord) (*NameRecord, error) {
 	}, nil
 }
 
+func getGQLNameEntry(entry registrytypes.NamePrefixEntry) (*NameEntry, error) {
+	record, err := getGQLNameRecord(entry.Entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NameEntry{
+		Name:    entry.Name,
+		Record:  record,
+		Stale:   entry.Stale,
+		Deleted: entry.Deleted,
+	}, nil
+}
+
 func getNameRecordEntry(record *registrytypes.NameRecordEntry) *NameRecordEntry {
 	return &NameRecordEntry{
 		ID:     record.Id,
@@ -289,17 +303,120 @@ func toRPCValue(value *ValueInput) *registrytypes.QueryRecordsRequest_ValueInput
 	return &rpcval
 }
 
+func toRPCOperator(op *Operator) registrytypes.QueryRecordsRequest_Operator {
+	if op == nil {
+		return registrytypes.QueryRecordsRequest_OPERATOR_UNSPECIFIED
+	}
+
+	switch *op {
+	case OperatorGt:
+		return registrytypes.QueryRecordsRequest_OPERATOR_GT
+	case OperatorGte:
+		return registrytypes.QueryRecordsRequest_OPERATOR_GTE
+	case OperatorLt:
+		return registrytypes.QueryRecordsRequest_OPERATOR_LT
+	case OperatorLte:
+		return registrytypes.QueryRecordsRequest_OPERATOR_LTE
+	case OperatorPrefix:
+		return registrytypes.QueryRecordsRequest_OPERATOR_PREFIX
+	default:
+		return registrytypes.QueryRecordsRequest_OPERATOR_UNSPECIFIED
+	}
+}
+
 func toRPCAttributes(attrs []*KeyValueInput) []*registrytypes.QueryRecordsRequest_KeyValueInput {
 	kvPairs := []*registrytypes.QueryRecordsRequest_KeyValueInput{}
 
 	for _, value := range attrs {
 		parsedValue := toRPCValue(value.Value)
 		kvPair := &registrytypes.QueryRecordsRequest_KeyValueInput{
-			Key:   value.Key,
-			Value: parsedValue,
+			Key:      value.Key,
+			Value:    parsedValue,
+			Operator: toRPCOperator(value.Op),
 		}
 		kvPairs = append(kvPairs, kvPair)
 	}
 
 	return kvPairs
 }
+
+func toRPCExpression(filter *RecordFilter) (*registrytypes.QueryRecordsRequest_Expression, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	var nodes []registrytypes.QueryRecordsRequest_Expression
+	if filter.Condition != nil {
+		nodes = append(nodes, registrytypes.QueryRecordsRequest_Expression{
+			Node: &registrytypes.QueryRecordsRequest_Expression_Condition{Condition: toRPCAttributes([]*KeyValueInput{filter.Condition})[0]},
+		})
+	}
+	if filter.And != nil {
+		list, err := toRPCExpressionList(filter.And)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, registrytypes.QueryRecordsRequest_Expression{
+			Node: &registrytypes.QueryRecordsRequest_Expression_And{And: list},
+		})
+	}
+	if filter.Or != nil {
+		list, err := toRPCExpressionList(filter.Or)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, registrytypes.QueryRecordsRequest_Expression{
+			Node: &registrytypes.QueryRecordsRequest_Expression_Or{Or: list},
+		})
+	}
+	if filter.Not != nil {
+		expr, err := toRPCExpression(filter.Not)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, registrytypes.QueryRecordsRequest_Expression{
+			Node: &registrytypes.QueryRecordsRequest_Expression_Not{Not: expr},
+		})
+	}
+
+	if len(nodes) != 1 {
+		return nil, fmt.Errorf("record filter must have exactly one of condition, and, or, not")
+	}
+
+	return &nodes[0], nil
+}
+
+func toRPCExpressionList(filters []*RecordFilter) (*registrytypes.QueryRecordsRequest_ExpressionList, error) {
+	list := &registrytypes.QueryRecordsRequest_ExpressionList{}
+	for _, filter := range filters {
+		expr, err := toRPCExpression(filter)
+		if err != nil {
+			return nil, err
+		}
+		list.Expressions = append(list.Expressions, expr)
+	}
+
+	return list, nil
+}
+
+func toPageRequest(limit *int, offset *int) *query.PageRequest {
+	pageReq := &query.PageRequest{}
+
+	if limit != nil && *limit > 0 {
+		pageReq.Limit = uint64(*limit)
+	}
+
+	if offset != nil && *offset > 0 {
+		pageReq.Offset = uint64(*offset)
+	}
+
+	return pageReq
+}
+
+func toNameHeight(height *int) uint64 {
+	if height != nil && *height > 0 {
+		return uint64(*height)
+	}
+
+	return 0
+}
@@ -255,6 +255,7 @@ func (ets *E2ETestSuite) createRecord(bondId string) {
 func (ets *E2ETestSuite) updateParams(params *registrytypes.Params) {
 	params.RecordRent = sdk.NewCoin(ets.cfg.BondDenom, math.NewInt(1000))
 	params.RecordRentDuration = 10 * time.Second
+	params.RecordRentPerByte = sdk.NewCoin(ets.cfg.BondDenom, math.NewInt(1))
 
 	params.AuthorityRent = sdk.NewCoin(ets.cfg.BondDenom, math.NewInt(1000))
 	params.AuthorityGracePeriod = 10 * time.Second
@@ -1,13 +1,17 @@
 package registry
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
 	"git.vdb.to/cerc-io/laconicd/x/registry/client/cli"
 )
 
@@ -68,3 +72,93 @@ func (ets *E2ETestSuite) TestGetCmdSetRecord() {
 		})
 	}
 }
+
+func (ets *E2ETestSuite) TestGetCmdSignAndVerifyPayload() {
+	val := ets.network.Validators[0]
+	sr := ets.Require()
+
+	payloadFilePath, err := filepath.Abs("../../data/examples/general_record_example.yml")
+	sr.NoError(err)
+	signedFilePath := filepath.Join(ets.T().TempDir(), "signed_payload.yml")
+
+	// Signing again with the same key replaces its signature.
+	for _, payloadPath := range []string{payloadFilePath, signedFilePath} {
+		_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdSignPayload(), []string{
+			payloadPath,
+			fmt.Sprintf("--%s=%s,%s", flags.FlagFrom, ets.accountName, val.Address.String()),
+			fmt.Sprintf("--%s=%s", flags.FlagOutputDocument, signedFilePath),
+		})
+		sr.NoError(err)
+	}
+
+	payload, err := cli.GetPayloadFromFile(signedFilePath)
+	sr.NoError(err)
+	sr.Equal(2, len(payload.Signatures))
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdVerifyPayload(), []string{
+		signedFilePath,
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	})
+	sr.NoError(err)
+
+	var verified struct {
+		Cid    string   `json:"cid"`
+		Owners []string `json:"owners"`
+	}
+	sr.NoError(json.Unmarshal(out.Bytes(), &verified))
+
+	record := registrytypes.ReadableRecord{Attributes: payload.RecordAttributes}
+	cid, err := record.GetCid()
+	sr.NoError(err)
+	sr.Equal(cid, verified.Cid)
+
+	accountAddress, err := sdk.AccAddressFromBech32(ets.accountAddress)
+	sr.NoError(err)
+	sr.ElementsMatch([]string{
+		crypto.Address(accountAddress).String(),
+		crypto.Address(val.Address).String(),
+	}, verified.Owners)
+}
+
+func (ets *E2ETestSuite) TestGetCmdExportAndImportCAR() {
+	val := ets.network.Validators[0]
+	sr := ets.Require()
+
+	ets.createRecord(ets.bondId)
+	height, err := ets.network.LatestHeight()
+	sr.NoError(err)
+
+	carFilePath := filepath.Join(ets.T().TempDir(), "registry.car")
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdExportCAR(), []string{
+		carFilePath,
+		fmt.Sprintf("--%s=%d", flags.FlagHeight, height),
+		fmt.Sprintf("--%s=true", cli.FlagNames),
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	})
+	sr.NoError(err)
+
+	var exported cli.CARSummary
+	sr.NoError(json.Unmarshal(out.Bytes(), &exported))
+	sr.Equal(height, exported.Height)
+	sr.NotZero(exported.Records)
+
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdImportCAR(), []string{
+		carFilePath,
+		fmt.Sprintf("--%s=json", flags.FlagOutput),
+	})
+	sr.NoError(err)
+
+	var imported cli.CARSummary
+	sr.NoError(json.Unmarshal(out.Bytes(), &imported))
+	exported.Height = 0
+	sr.Equal(exported, imported)
+
+	// Tampering with a block is detected.
+	car, err := os.ReadFile(carFilePath)
+	sr.NoError(err)
+	car[len(car)-1] ^= 0xff
+	sr.NoError(os.WriteFile(carFilePath, car, 0o600))
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdImportCAR(), []string{carFilePath})
+	sr.ErrorContains(err, "block data does not match CID")
+}
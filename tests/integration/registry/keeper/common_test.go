@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +14,7 @@ import (
 	integrationTest "git.vdb.to/cerc-io/laconicd/tests/integration"
 	bondTypes "git.vdb.to/cerc-io/laconicd/x/bond"
 	types "git.vdb.to/cerc-io/laconicd/x/registry"
+	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
 )
 
 type KeeperTestSuite struct {
@@ -58,3 +61,70 @@ func (kts *KeeperTestSuite) createBond() (*bondTypes.Bond, error) {
 
 	return bond, nil
 }
+
+// signedPayload creates a record payload signed by the given keys.
+func (kts *KeeperTestSuite) signedPayload(attributes types.AttributeMap, keys ...*secp256k1.PrivKey) types.Payload {
+	record := types.ReadableRecord{Attributes: attributes}
+	signBytes, _ := record.GetSignBytes()
+
+	payload := types.ReadablePayload{RecordAttributes: attributes}
+	for _, key := range keys {
+		sig, err := key.Sign(signBytes)
+		kts.Require().NoError(err)
+
+		payload.Signatures = append(payload.Signatures, types.Signature{
+			Sig:    helpers.BytesToBase64(sig),
+			PubKey: helpers.BytesToBase64(legacy.Cdc.MustMarshal(key.PubKey())),
+		})
+	}
+
+	return payload.ToPayload()
+}
+
+// setRecord creates a record with the given attributes, signed by the given keys, on the suite bond.
+func (kts *KeeperTestSuite) setRecord(attributes types.AttributeMap, keys ...*secp256k1.PrivKey) *types.ReadableRecord {
+	record, err := kts.RegistryKeeper.SetRecord(kts.SdkCtx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: kts.signedPayload(attributes, keys...),
+	})
+	kts.Require().NoError(err)
+
+	return record
+}
+
+// signUpdate signs the update of a record to a version with the given attributes, with the given keys.
+func (kts *KeeperTestSuite) signUpdate(recordId string, attributes types.AttributeMap, keys ...*secp256k1.PrivKey) []types.Signature {
+	record, err := kts.RegistryKeeper.Records.Get(kts.SdkCtx, recordId)
+	kts.Require().NoError(err)
+
+	version := types.ReadableRecord{Attributes: attributes}
+	versionId, err := version.GetCid()
+	kts.Require().NoError(err)
+
+	op := types.RecordOperation{
+		Action:    sdk.MsgTypeURL(&types.MsgUpdateRecord{}),
+		ChainId:   kts.SdkCtx.ChainID(),
+		RecordId:  recordId,
+		Nonce:     record.Nonce,
+		VersionId: versionId,
+	}
+	signatures := make([]types.Signature, len(keys))
+	for i, key := range keys {
+		signatures[i] = kts.signOperation(op, key)
+	}
+
+	return signatures
+}
+
+// signOperation signs a record operation with the given key.
+func (kts *KeeperTestSuite) signOperation(op types.RecordOperation, key *secp256k1.PrivKey) types.Signature {
+	signBytes, _ := op.GetSignBytes()
+	sig, err := key.Sign(signBytes)
+	kts.Require().NoError(err)
+
+	return types.Signature{
+		Sig:    helpers.BytesToBase64(sig),
+		PubKey: helpers.BytesToBase64(legacy.Cdc.MustMarshal(key.PubKey())),
+	}
+}
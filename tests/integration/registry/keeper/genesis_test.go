@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+
+	integrationTest "git.vdb.to/cerc-io/laconicd/tests/integration"
+	types "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+func (kts *KeeperTestSuite) TestGenesisExportImport() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	ownerKey := secp256k1.GenPrivKey()
+	signer := kts.accounts[0].String()
+
+	v1, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  signer,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "GenesisRecord", "version": "v1"}, ownerKey),
+	})
+	sr.NoError(err)
+	v2Attributes := types.AttributeMap{"type": "GenesisRecord", "version": "v2"}
+	v2, err := k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId:   v1.Id,
+		Signer:     signer,
+		Payload:    kts.signedPayload(v2Attributes),
+		Signatures: kts.signUpdate(v1.Id, v2Attributes, ownerKey),
+	})
+	sr.NoError(err)
+
+	// A record that expired with its bond out of funds.
+	expired, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  signer,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "ExpiredGenesisRecord"}, ownerKey),
+	})
+	sr.NoError(err)
+	expiredRecord, err := k.Records.Get(ctx, expired.Id)
+	sr.NoError(err)
+	expiredRecord.Deleted = true
+	sr.NoError(k.Records.Set(ctx, expired.Id, expiredRecord))
+
+	for _, name := range []string{"genesis", "expired-genesis"} {
+		sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: name, Signer: signer, Owner: signer}))
+		sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: name, BondId: kts.bond.GetId(), Signer: signer}))
+	}
+	sr.NoError(k.SetName(ctx.WithBlockHeight(10), types.MsgSetName{Lrn: "lrn://genesis/app", Cid: v1.Id, Signer: signer}))
+	sr.NoError(k.SetName(ctx.WithBlockHeight(20), types.MsgSetName{Lrn: "lrn://genesis/app", Cid: v2.Id, Signer: signer}))
+	sr.NoError(k.GrantNameAccess(ctx, types.MsgGrantNameAccess{LrnPrefix: "lrn://genesis/*", Grantee: signer, Signer: signer}))
+
+	expiredAuthority, err := k.GetNameAuthority(ctx, "expired-genesis")
+	sr.NoError(err)
+	expiredAuthority.Status = types.AuthorityExpired
+	sr.NoError(k.SaveNameAuthority(ctx, "expired-genesis", &expiredAuthority))
+
+	genesis, err := k.ExportGenesis(ctx)
+	sr.NoError(err)
+	sr.NoError(genesis.Validate())
+
+	bondGenesis, err := kts.BondKeeper.ExportGenesis(ctx)
+	sr.NoError(err)
+
+	// Import into a new chain.
+	var fixture integrationTest.TestFixture
+	sr.NoError(fixture.Setup())
+	newCtx, newKeeper := fixture.SdkCtx, fixture.RegistryKeeper
+	sr.NoError(fixture.BondKeeper.InitGenesis(newCtx, bondGenesis))
+	sr.NoError(newKeeper.InitGenesis(newCtx, genesis))
+
+	exported, err := newKeeper.ExportGenesis(newCtx)
+	sr.NoError(err)
+	sr.Equal(genesis, exported)
+
+	nameRecord, err := newKeeper.GetNameRecord(newCtx, "lrn://genesis/app")
+	sr.NoError(err)
+	sr.Equal(&types.NameRecordEntry{Id: v2.Id, Height: 20}, nameRecord.Latest)
+	sr.Equal([]*types.NameRecordEntry{{Id: v1.Id, Height: 10}}, nameRecord.History)
+
+	authority, err := newKeeper.GetNameAuthority(newCtx, "expired-genesis")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityExpired, authority.Status)
+
+	grants, err := newKeeper.ListNameAccessGrants(newCtx, "genesis", signer)
+	sr.NoError(err)
+	sr.Equal(1, len(grants))
+
+	// Secondary indexes are rebuilt, for active records only.
+	queryClient := types.NewQueryClient(fixture.App.QueryHelper())
+	resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+		Attributes: []*types.QueryRecordsRequest_KeyValueInput{{
+			Key:   "type",
+			Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "GenesisRecord"}},
+		}},
+	})
+	sr.NoError(err)
+	sr.Equal(1, len(resp.GetRecords()))
+	sr.Equal(v2.Id, resp.GetRecords()[0].Id)
+
+	// Deleted records stay associated with the bond, but aren't listed.
+	records, err := newKeeper.GetRecordsByBondId(newCtx, kts.bond.GetId())
+	sr.NoError(err)
+	sr.Equal(3, len(records))
+
+	bondResp, err := queryClient.GetRecordsByBondId(context.Background(), &types.QueryGetRecordsByBondIdRequest{Id: kts.bond.GetId()})
+	sr.NoError(err)
+	sr.Equal(1, len(bondResp.GetRecords()))
+	sr.Equal(v2.Id, bondResp.GetRecords()[0].Id)
+
+	// Deleted records are requeued to be renewed from their bond, unless a newer version replaced them.
+	var queued []string
+	err = newKeeper.RecordExpiryQueue.Walk(newCtx, nil, func(_ time.Time, value types.ExpiryQueue) (bool, error) {
+		queued = append(queued, value.Value...)
+		return false, nil
+	})
+	sr.NoError(err)
+	sr.ElementsMatch([]string{v2.Id, expired.Id}, queued)
+}
+
+func (kts *KeeperTestSuite) TestValidateGenesis() {
+	sr := kts.Require()
+
+	validGenesis := func() *types.GenesisState {
+		genesis := types.DefaultGenesisState()
+		genesis.Records = []types.Record{
+			{Id: "v1", CreateTime: "2024-01-01T00:00:00Z", ExpiryTime: "2025-01-01T00:00:00Z", Deleted: true},
+			{Id: "v2", CreateTime: "2024-01-02T00:00:00Z", ExpiryTime: "2025-01-01T00:00:00Z", PreviousId: "v1"},
+		}
+		genesis.Authorities = []types.AuthorityEntry{
+			{Name: "genesis", Entry: &types.NameAuthority{Status: types.AuthorityActive}},
+		}
+		genesis.Names = []types.NameEntry{
+			{
+				Name: "lrn://genesis/app",
+				Entry: &types.NameRecord{
+					Latest:  &types.NameRecordEntry{Id: "v2", Height: 20},
+					History: []*types.NameRecordEntry{{Id: "v1", Height: 10}},
+				},
+			},
+			{
+				Name: "lrn://genesis/deleted",
+				Entry: &types.NameRecord{
+					Latest:  &types.NameRecordEntry{Height: 20},
+					History: []*types.NameRecordEntry{{Id: "v1", Height: 10}},
+				},
+			},
+		}
+		return genesis
+	}
+	sr.NoError(validGenesis().Validate())
+
+	testCases := []struct {
+		msg    string
+		mutate func(*types.GenesisState)
+	}{
+		{
+			"Duplicate record",
+			func(gs *types.GenesisState) { gs.Records = append(gs.Records, gs.Records[0]) },
+		},
+		{
+			"Missing previous record version",
+			func(gs *types.GenesisState) { gs.Records = gs.Records[1:] },
+		},
+		{
+			"Invalid record expiry time",
+			func(gs *types.GenesisState) { gs.Records[0].ExpiryTime = "never" },
+		},
+		{
+			"Authority under auction without an auction",
+			func(gs *types.GenesisState) { gs.Authorities[0].Entry.Status = types.AuthorityUnderAuction },
+		},
+		{
+			"Name without an authority",
+			func(gs *types.GenesisState) { gs.Authorities = nil },
+		},
+		{
+			"Name history out of order",
+			func(gs *types.GenesisState) { gs.Names[0].Entry.History[0].Height = 30 },
+		},
+		{
+			"Name of an unknown record",
+			func(gs *types.GenesisState) { gs.Names[0].Entry.Latest.Id = "unknown" },
+		},
+		{
+			"Name history of an unknown record",
+			func(gs *types.GenesisState) { gs.Names[1].Entry.History[0].Id = "unknown" },
+		},
+		{
+			"Schema without an authority",
+			func(gs *types.GenesisState) {
+				gs.Schemas = []types.Schema{{RecordType: "unknown/GenesisRecord", Authority: "unknown"}}
+			},
+		},
+		{
+			"Schema of a record type outside its authority namespace",
+			func(gs *types.GenesisState) {
+				gs.Schemas = []types.Schema{{RecordType: "GenesisRecord", Authority: "genesis"}}
+			},
+		},
+		{
+			"Name access grant without an authority",
+			func(gs *types.GenesisState) {
+				gs.NameAccessGrants = []types.NameAccessGrant{{LrnPrefix: "lrn://unknown/*", Grantee: "grantee", Granter: "granter"}}
+			},
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(test.msg, func() {
+			genesis := validGenesis()
+			test.mutate(genesis)
+			sr.Error(genesis.Validate())
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipfs/go-cid"
+
+	types "git.vdb.to/cerc-io/laconicd/x/registry"
+	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
+	registryKeeper "git.vdb.to/cerc-io/laconicd/x/registry/keeper"
+)
+
+func (kts *KeeperTestSuite) TestMigrate1to2() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	target := "bafyreiek4hnoqmits66bjyxswapplweuoqe4en2ux6u772o4y3askpd3ny"
+	activeAttributes := types.AttributeMap{
+		"type":    "MigratedRecord",
+		"version": 42,
+		"ratio":   0.5,
+		"a.b":     1,
+		"x500":    map[string]any{"country": "US"},
+		"repo":    map[string]any{"/": target},
+		"builds":  []any{[]any{map[string]any{"/": target}}},
+	}
+
+	var recordIds []string
+	for _, attributes := range []types.AttributeMap{activeAttributes, {"type": "DeletedRecord"}} {
+		record, err := k.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: kts.signedPayload(attributes, secp256k1.GenPrivKey()),
+		})
+		sr.NoError(err)
+		recordIds = append(recordIds, record.Id)
+	}
+	activeId, deletedId := recordIds[0], recordIds[1]
+
+	activeRecord, err := k.Records.Get(ctx, activeId)
+	sr.NoError(err)
+
+	// Mark a record deleted leaving its index entries behind.
+	deletedRecord, err := k.Records.Get(ctx, deletedId)
+	sr.NoError(err)
+	deletedRecord.Deleted = true
+	sr.NoError(k.Records.Set(ctx, deletedId, deletedRecord))
+
+	// Version 1 stored attributes as JSON, indexed attributes by unescaped paths, and had no owner, link or
+	// previous version indexes. Also leave a bond index entry behind that the records don't reference.
+	kts.setJSONAttributes(activeId, activeAttributes)
+	kts.setJSONAttributes(deletedId, types.AttributeMap{"type": "DeletedRecord"})
+	sr.NoError(k.AttributesMap.Remove(ctx, collections.Join(`a\.b`, "1")))
+	sr.NoError(k.AttributesMap.Set(ctx, collections.Join("a.b", "1"), types.RecordsList{Value: []string{activeId}}))
+	sr.NoError(k.AttributesMap.Set(ctx, collections.Join("x500country", `"US"`), types.RecordsList{Value: []string{activeId}}))
+	sr.NoError(k.RecordLinksIndex.Clear(ctx, nil))
+	for _, record := range []types.Record{activeRecord, deletedRecord} {
+		sr.NoError(k.Records.Indexes.Owner.Unreference(ctx, record.Id, func() (types.Record, error) { return record, nil }))
+	}
+	sr.NoError(k.Records.Indexes.BondId.Reference(ctx, deletedId, types.Record{Id: deletedId, BondId: "stale-bond"}, func() (types.Record, error) {
+		return types.Record{}, collections.ErrNotFound
+	}))
+
+	sr.NoError(registryKeeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// Attributes are the DAG-CBOR block addressed by the record id.
+	migrated, err := k.Records.Get(ctx, activeId)
+	sr.NoError(err)
+	sr.Equal(activeRecord.Attributes, migrated.Attributes)
+	id, err := cid.Decode(activeId)
+	sr.NoError(err)
+	sum, err := id.Prefix().Sum(migrated.Attributes)
+	sr.NoError(err)
+	sr.True(sum.Equals(id))
+
+	readableRecord, err := migrated.ToReadableRecord()
+	sr.NoError(err)
+	sr.Equal(int64(42), readableRecord.Attributes["version"])
+	sr.Equal(0.5, readableRecord.Attributes["ratio"])
+	sr.Equal(activeAttributes["repo"], readableRecord.Attributes["repo"])
+
+	// Attributes are indexed by escaped full paths, and deleted records aren't indexed.
+	err = k.AttributesMap.Walk(ctx, nil, func(key collections.Pair[string, string], value types.RecordsList) (bool, error) {
+		sr.NotEqual("x500country", key.K1())
+		sr.NotEqual("a.b", key.K1())
+		sr.NotContains(value.Value, deletedId, key)
+		return false, nil
+	})
+	sr.NoError(err)
+
+	country, err := k.AttributesMap.Get(ctx, collections.Join("x500.country", `"US"`))
+	sr.NoError(err)
+	sr.Equal([]string{activeId}, country.Value)
+
+	escaped, err := k.AttributesMap.Get(ctx, collections.Join(`a\.b`, "1"))
+	sr.NoError(err)
+	sr.Equal([]string{activeId}, escaped.Value)
+
+	records, _, err := k.RecordsFromAttributes(ctx, []*types.QueryRecordsRequest_KeyValueInput{{
+		Key:   "type",
+		Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "MigratedRecord"}},
+	}}, nil, true, nil)
+	sr.NoError(err)
+	sr.Equal(1, len(records))
+
+	// Links are indexed, including those nested in arrays.
+	has, err := k.RecordLinksIndex.Has(ctx, collections.Join(target, activeId))
+	sr.NoError(err)
+	sr.True(has)
+
+	// Records are indexed by owner.
+	ownerResp, err := kts.queryClient.GetRecordsByOwner(context.Background(), &types.QueryGetRecordsByOwnerRequest{Owner: activeRecord.Owners[0]})
+	sr.NoError(err)
+	sr.Equal(1, len(ownerResp.GetRecords()))
+	sr.Equal(activeId, ownerResp.GetRecords()[0].Id)
+
+	// Stale bond index entries are removed, and deleted records stay associated with their bond.
+	records, err = k.GetRecordsByBondId(ctx, "stale-bond")
+	sr.NoError(err)
+	sr.Empty(records)
+
+	records, err = k.GetRecordsByBondId(ctx, kts.bond.GetId())
+	sr.NoError(err)
+	sr.Equal(2, len(records))
+
+	bondResp, err := kts.queryClient.GetRecordsByBondId(context.Background(), &types.QueryGetRecordsByBondIdRequest{Id: kts.bond.GetId()})
+	sr.NoError(err)
+	sr.Equal(1, len(bondResp.GetRecords()))
+	sr.Equal(activeId, bondResp.GetRecords()[0].Id)
+}
+
+func (kts *KeeperTestSuite) TestMigrate1to2Params() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	// Params stored before version 2.
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+	params.MaxRecordLifetime = 0
+	params.RecordRentPerByte = sdk.Coin{}
+	params.MaxRecordAttributesSize = 0
+	params.MaxRecordAttributesDepth = 0
+	params.MaxRecordIndexedAttributes = 0
+	params.MaxSchemaSize = 0
+	sr.NoError(k.Params.Set(ctx, *params))
+
+	sr.NoError(registryKeeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params, err = k.GetParams(ctx)
+	sr.NoError(err)
+	sr.Equal(types.DefaultMaxRecordLifetime, params.MaxRecordLifetime)
+	// Existing chains keep their current record pricing.
+	sr.Equal(sdk.NewCoin(params.RecordRent.Denom, math.ZeroInt()), params.RecordRentPerByte)
+	sr.Equal(types.DefaultMaxRecordAttributesSize, params.MaxRecordAttributesSize)
+	sr.Equal(types.DefaultMaxRecordAttributesDepth, params.MaxRecordAttributesDepth)
+	sr.Equal(types.DefaultMaxRecordIndexedAttributes, params.MaxRecordIndexedAttributes)
+	sr.Equal(types.DefaultMaxSchemaSize, params.MaxSchemaSize)
+}
+
+// setJSONAttributes stores the attributes of a record as JSON, as before version 2.
+func (kts *KeeperTestSuite) setJSONAttributes(id string, attributes types.AttributeMap) {
+	record, err := kts.RegistryKeeper.Records.Get(kts.SdkCtx, id)
+	kts.Require().NoError(err)
+	record.Attributes = helpers.MustMarshalJSON(attributes)
+	kts.Require().NoError(kts.RegistryKeeper.Records.Set(kts.SdkCtx, id, record))
+}
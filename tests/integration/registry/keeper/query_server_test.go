@@ -5,7 +5,25 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"strings"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	nitrocrypto "github.com/statechannels/go-nitro/crypto"
+
+	integrationTest "git.vdb.to/cerc-io/laconicd/tests/integration"
 	types "git.vdb.to/cerc-io/laconicd/x/registry"
 	"git.vdb.to/cerc-io/laconicd/x/registry/client/cli"
 	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
@@ -79,35 +97,45 @@ func (kts *KeeperTestSuite) TestGrpcGetRecordLists() {
 			false,
 			1,
 		},
-		// Skip the following test as querying with recursive values not supported (PR https://git.vdb.to/cerc-io/laconicd/pulls/112)
-		// See function RecordsFromAttributes (QueryValueToJSON call) in the registry keeper implementation (x/registry/keeper/keeper.go)
-		// {
-		// 	"Filter with tag (extant) (https://git.vdb.to/cerc-io/laconicd/issues/129)",
-		// 	&types.QueryRecordsRequest{
-		// 		Attributes: []*types.QueryRecordsRequest_KeyValueInput{
-		// 			{
-		// 				Key: "tags",
-		// 				// Value: &types.QueryRecordsRequest_ValueInput{
-		// 				// 	Value: &types.QueryRecordsRequest_ValueInput_String_{"tagA"},
-		// 				// },
-		// 				Value: &types.QueryRecordsRequest_ValueInput{
-		// 					Value: &types.QueryRecordsRequest_ValueInput_Array{Array: &types.QueryRecordsRequest_ArrayInput{
-		// 						Values: []*types.QueryRecordsRequest_ValueInput{
-		// 							{
-		// 								Value: &types.QueryRecordsRequest_ValueInput_String_{"tagA"},
-		// 							},
-		// 						},
-		// 					}},
-		// 				},
-		// 				// Throws: "Recursive query values are not supported"
-		// 			},
-		// 		},
-		// 		All: true,
-		// 	},
-		// 	true,
-		// 	false,
-		// 	1,
-		// },
+		{
+			"Filter with tag (extant) (https://git.vdb.to/cerc-io/laconicd/issues/129)",
+			&types.QueryRecordsRequest{
+				Attributes: []*types.QueryRecordsRequest_KeyValueInput{
+					{
+						Key: "tags",
+						Value: &types.QueryRecordsRequest_ValueInput{
+							Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "tagA"},
+						},
+					},
+				},
+				All: true,
+			},
+			true,
+			false,
+			1,
+		},
+		{
+			"Filter with tags array",
+			&types.QueryRecordsRequest{
+				Attributes: []*types.QueryRecordsRequest_KeyValueInput{
+					{
+						Key: "tags",
+						Value: &types.QueryRecordsRequest_ValueInput{
+							Value: &types.QueryRecordsRequest_ValueInput_Array{Array: &types.QueryRecordsRequest_ArrayInput{
+								Values: []*types.QueryRecordsRequest_ValueInput{
+									{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "tagA"}},
+									{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "tagB"}},
+								},
+							}},
+						},
+					},
+				},
+				All: true,
+			},
+			true,
+			false,
+			1,
+		},
 		{
 			"Filter with tag (non-existent) (https://git.vdb.to/cerc-io/laconicd/issues/129)",
 			&types.QueryRecordsRequest{
@@ -147,7 +175,7 @@ func (kts *KeeperTestSuite) TestGrpcGetRecordLists() {
 			&types.QueryRecordsRequest{
 				Attributes: []*types.QueryRecordsRequest_KeyValueInput{
 					{
-						Key: "x500state_name",
+						Key: "x500.state_name",
 						Value: &types.QueryRecordsRequest_ValueInput{
 							Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "california"},
 						},
@@ -192,31 +220,35 @@ func (kts *KeeperTestSuite) TestGrpcGetRecordLists() {
 					sr.Equal(resp.GetRecords()[0].GetBondId(), kts.bond.GetId())
 
 					for _, record := range resp.GetRecords() {
-						recAttr := helpers.MustUnmarshalJSON[types.AttributeMap](record.Attributes)
+						attrsJSON, err := types.AttributesJSON(record.Attributes)
+						sr.NoError(err)
+						recAttr := helpers.MustUnmarshalJSON[types.AttributeMap](attrsJSON)
 
 						for _, attr := range test.req.GetAttributes() {
 							enc, err := registryKeeper.QueryValueToJSON(attr.Value)
 							sr.NoError(err)
 							av := helpers.MustUnmarshalJSON[any](enc)
 
-							if nil != av && nil != recAttr[attr.Key] &&
-								reflect.Slice == reflect.TypeOf(recAttr[attr.Key]).Kind() &&
+							// Resolve the (dotted) attribute path.
+							var recValue any = map[string]any(recAttr)
+							for _, key := range types.SplitAttributePath(attr.Key) {
+								recValue = recValue.(map[string]any)[key]
+							}
+
+							if nil != av && nil != recValue &&
+								reflect.Slice == reflect.TypeOf(recValue).Kind() &&
 								reflect.Slice != reflect.TypeOf(av).Kind() {
 								found := false
-								allValues := recAttr[attr.Key].([]interface{})
+								allValues := recValue.([]interface{})
 								for i := range allValues {
 									if av == allValues[i] {
-										fmt.Printf("Found %s in %s", allValues[i], recAttr[attr.Key])
+										fmt.Printf("Found %s in %s", allValues[i], recValue)
 										found = true
 									}
 								}
-								sr.Equal(true, found, fmt.Sprintf("Unable to find %s in %s", av, recAttr[attr.Key]))
+								sr.Equal(true, found, fmt.Sprintf("Unable to find %s in %s", av, recValue))
 							} else {
-								if attr.Key[:4] == "x500" {
-									sr.Equal(av, recAttr["x500"].(map[string]interface{})[attr.Key[4:]])
-								} else {
-									sr.Equal(av, recAttr[attr.Key])
-								}
+								sr.Equal(av, recValue)
 							}
 						}
 					}
@@ -419,3 +451,2572 @@ func (kts *KeeperTestSuite) TestGrpcQueryWhoIs() {
 		})
 	}
 }
+
+func (kts *KeeperTestSuite) TestGrpcQuerySchemas() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+	authorityName := "TestGrpcQuerySchemas"
+	recordType := authorityName + "/WebsiteRegistrationRecord"
+
+	err := kts.RegistryKeeper.ReserveAuthority(ctx, types.MsgReserveAuthority{
+		Name:   authorityName,
+		Signer: kts.accounts[0].String(),
+		Owner:  kts.accounts[0].String(),
+	})
+	sr.NoError(err)
+
+	filePath, err := filepath.Abs("../../../data/examples/website_registration_example.yml")
+	sr.NoError(err)
+	payloadType, err := cli.GetPayloadFromFile(filePath)
+	sr.NoError(err)
+	payloadType.RecordAttributes[types.RecordTypeAttribute] = recordType
+
+	// Record types are namespaced by their authority, so common type names can't be claimed.
+	for _, unscoped := range []string{"WebsiteRegistrationRecord", "other/WebsiteRegistrationRecord", authorityName + "/"} {
+		msg := types.MsgRegisterSchema{
+			RecordType: unscoped,
+			Authority:  authorityName,
+			Format:     types.SchemaFormatJSON,
+			Definition: `{}`,
+			Signer:     kts.accounts[0].String(),
+		}
+		sr.ErrorContains(msg.ValidateBasic(), "record type must be namespaced by its authority")
+		sr.ErrorContains(kts.RegistryKeeper.RegisterSchema(ctx, msg), "Record type must be namespaced by its authority")
+	}
+
+	testCases := []struct {
+		msg        string
+		schema     types.MsgRegisterSchema
+		expErr     bool
+		expValid   bool
+		noOfSchema int
+	}{
+		{
+			"Invalid JSON schema definition",
+			types.MsgRegisterSchema{Format: types.SchemaFormatJSON, Definition: `{"type": 1}`},
+			true,
+			false,
+			0,
+		},
+		{
+			"Remote references are rejected",
+			types.MsgRegisterSchema{Format: types.SchemaFormatJSON, Definition: `{"$ref": "https://example.com/schema.json"}`},
+			true,
+			false,
+			0,
+		},
+		{
+			"IPLD schema without the record type",
+			types.MsgRegisterSchema{Format: types.SchemaFormatIPLD, Definition: `type Other struct { url String }`},
+			true,
+			false,
+			0,
+		},
+		{
+			"Definition exceeding the max schema size",
+			types.MsgRegisterSchema{
+				Format:     types.SchemaFormatJSON,
+				Definition: fmt.Sprintf(`{"description": "%s"}`, strings.Repeat("x", int(types.DefaultMaxSchemaSize))),
+			},
+			true,
+			false,
+			0,
+		},
+		{
+			"Record not matching JSON schema",
+			types.MsgRegisterSchema{
+				Format:     types.SchemaFormatJSON,
+				Definition: `{"type": "object", "required": ["type", "url", "description"]}`,
+			},
+			false,
+			false,
+			1,
+		},
+		{
+			"Record not matching IPLD schema",
+			types.MsgRegisterSchema{
+				Format:     types.SchemaFormatIPLD,
+				Definition: `type WebsiteRegistrationRecord struct { type String url Int }`,
+			},
+			false,
+			false,
+			1,
+		},
+		{
+			"Record matching JSON schema",
+			types.MsgRegisterSchema{
+				Format:     types.SchemaFormatJSON,
+				Definition: `{"type": "object", "required": ["type", "url"], "properties": {"url": {"type": "string"}}}`,
+			},
+			false,
+			true,
+			1,
+		},
+	}
+	var definitions []string
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			test.schema.RecordType = recordType
+			test.schema.Authority = authorityName
+			test.schema.Signer = kts.accounts[0].String()
+
+			err := kts.RegistryKeeper.RegisterSchema(ctx, test.schema)
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+			sr.NoError(err)
+			definitions = append(definitions, test.schema.Definition)
+
+			resp, err := queryClient.GetSchema(context.Background(), &types.QueryGetSchemaRequest{RecordType: recordType})
+			sr.NoError(err)
+			sr.Equal(test.schema.Definition, resp.GetSchema().Definition)
+			sr.Equal(authorityName, resp.GetSchema().Authority)
+			sr.Equal(uint64(len(definitions)), resp.GetSchema().Version)
+
+			listResp, err := queryClient.Schemas(context.Background(), &types.QuerySchemasRequest{})
+			sr.NoError(err)
+			sr.Equal(test.noOfSchema, len(listResp.GetSchemas()))
+
+			record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+				BondId:  kts.bond.GetId(),
+				Signer:  kts.accounts[0].String(),
+				Payload: payloadType.ToPayload(),
+			})
+			if test.expValid {
+				sr.NoError(err)
+				sr.Equal(recordType, record.Type)
+				sr.Equal(uint64(len(definitions)), record.SchemaVersion)
+			} else {
+				sr.Error(err)
+			}
+		})
+	}
+
+	// Earlier versions are kept as registered.
+	for i, definition := range definitions {
+		resp, err := queryClient.GetSchema(context.Background(), &types.QueryGetSchemaRequest{RecordType: recordType, Version: uint64(i + 1)})
+		sr.NoError(err)
+		sr.Equal(definition, resp.GetSchema().Definition)
+	}
+}
+
+func (kts *KeeperTestSuite) TestGrpcGetRecordHistory() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	ownerKey := secp256k1.GenPrivKey()
+	record := kts.setRecord(types.AttributeMap{"type": "GeneralRecord", "version": "1.0.0"}, ownerKey)
+	firstId := record.Id
+
+	testCases := []struct {
+		msg          string
+		attributes   types.AttributeMap
+		key          *secp256k1.PrivKey
+		recordId     string
+		expErr       bool
+		noOfVersions int
+	}{
+		{
+			"Update by a different owner",
+			types.AttributeMap{"type": "GeneralRecord", "version": "2.0.0"},
+			secp256k1.GenPrivKey(),
+			firstId,
+			true,
+			1,
+		},
+		{
+			"Update with the same owner",
+			types.AttributeMap{"type": "GeneralRecord", "version": "2.0.0"},
+			ownerKey,
+			firstId,
+			false,
+			2,
+		},
+		{
+			"Update a replaced version",
+			types.AttributeMap{"type": "GeneralRecord", "version": "3.0.0"},
+			ownerKey,
+			firstId,
+			true,
+			2,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			newRecord, err := kts.RegistryKeeper.UpdateRecord(ctx, types.MsgUpdateRecord{
+				RecordId:   test.recordId,
+				Signer:     kts.accounts[0].String(),
+				Payload:    kts.signedPayload(test.attributes),
+				Signatures: kts.signUpdate(test.recordId, test.attributes, test.key),
+			})
+			if test.expErr {
+				sr.Error(err)
+			} else {
+				sr.NoError(err)
+				sr.Equal(firstId, newRecord.PreviousId)
+				sr.Equal(record.ExpiryTime, newRecord.ExpiryTime)
+			}
+
+			resp, err := queryClient.GetRecordHistory(context.Background(), &types.QueryGetRecordHistoryRequest{Id: firstId})
+			sr.NoError(err)
+			sr.Equal(test.noOfVersions, len(resp.GetRecords()))
+			sr.Equal(firstId, resp.GetRecords()[len(resp.GetRecords())-1].Id)
+			if !test.expErr {
+				sr.Equal(newRecord.Id, resp.GetRecords()[0].Id)
+				sr.True(resp.GetRecords()[1].Deleted)
+			}
+		})
+	}
+}
+
+func (kts *KeeperTestSuite) TestUpdateRecord() {
+	queryClient, ctx, k := kts.queryClient, kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+	ownerKey := secp256k1.GenPrivKey()
+	firstAttributes := types.AttributeMap{"type": "GeneralRecord", "version": "1.0.0"}
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(firstAttributes, ownerKey),
+	})
+	sr.NoError(err)
+	firstId := record.Id
+
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "update", Signer: owner, Owner: owner}))
+	sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "update", BondId: kts.bond.GetId(), Signer: owner}))
+	sr.NoError(k.SetName(ctx, types.MsgSetName{Lrn: "lrn://update/app", Cid: firstId, Signer: owner}))
+
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+
+	// Half of the rent period is left.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RecordRentDuration / 2))
+
+	// A larger version pays the rent of the size difference for the remaining period.
+	first, err := k.Records.Get(ctx, firstId)
+	sr.NoError(err)
+	bondBefore, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+	sr.NoError(err)
+	secondAttributes := types.AttributeMap{"type": "GeneralRecord", "version": "2.0.0", "description": strings.Repeat("x", 100)}
+	record, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId:   firstId,
+		Signer:     owner,
+		Payload:    kts.signedPayload(secondAttributes),
+		Signatures: kts.signUpdate(firstId, secondAttributes, ownerKey),
+	})
+	sr.NoError(err)
+	secondId := record.Id
+
+	second, err := k.Records.Get(ctx, secondId)
+	sr.NoError(err)
+	sizeRent := params.RecordRentPerByte.Amount.MulRaw(int64(len(second.Attributes) - len(first.Attributes))).QuoRaw(2)
+	bondAfter, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+	sr.NoError(err)
+	sr.Equal(sizeRent, bondBefore.Balance.AmountOf(params.RecordRent.Denom).Sub(bondAfter.Balance.AmountOf(params.RecordRent.Denom)))
+	sr.Equal(first.ExpiryTime, second.ExpiryTime)
+
+	// Names set on a previous version resolve to, and are listed with, the latest version.
+	resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+		Attributes: []*types.QueryRecordsRequest_KeyValueInput{{
+			Key:   "type",
+			Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "GeneralRecord"}},
+		}},
+	})
+	sr.NoError(err)
+	sr.Equal(1, len(resp.GetRecords()))
+	sr.Equal(secondId, resp.GetRecords()[0].Id)
+	sr.Equal([]string{"lrn://update/app"}, resp.GetRecords()[0].Names)
+
+	// Rolling back to the first version restores it as the latest version.
+	record, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId:   secondId,
+		Signer:     owner,
+		Payload:    kts.signedPayload(firstAttributes),
+		Signatures: kts.signUpdate(secondId, firstAttributes, ownerKey),
+	})
+	sr.NoError(err)
+	sr.Equal(firstId, record.Id)
+
+	history, err := queryClient.GetRecordHistory(context.Background(), &types.QueryGetRecordHistoryRequest{Id: secondId})
+	sr.NoError(err)
+	sr.Equal(2, len(history.GetRecords()))
+	sr.Equal(firstId, history.GetRecords()[0].Id)
+	sr.False(history.GetRecords()[0].Deleted)
+	sr.Equal(secondId, history.GetRecords()[1].Id)
+	sr.True(history.GetRecords()[1].Deleted)
+	sr.Empty(history.GetRecords()[1].PreviousId)
+
+	resolved, err := queryClient.ResolveLrn(context.Background(), &types.QueryResolveLrnRequest{Lrn: "lrn://update/app"})
+	sr.NoError(err)
+	sr.Equal(firstId, resolved.GetRecord().Id)
+}
+
+func (kts *KeeperTestSuite) TestUpdateRecordReplay() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	signer := kts.accounts[0].String()
+	ownerKey := secp256k1.GenPrivKey()
+	firstAttributes := types.AttributeMap{"type": "GeneralRecord", "version": "1.0.0"}
+	secondAttributes := types.AttributeMap{"type": "GeneralRecord", "version": "2.0.0"}
+	firstPayload := kts.signedPayload(firstAttributes, ownerKey)
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{BondId: kts.bond.GetId(), Signer: signer, Payload: firstPayload})
+	sr.NoError(err)
+	firstId := record.Id
+
+	update := types.MsgUpdateRecord{
+		RecordId:   firstId,
+		Signer:     signer,
+		Payload:    kts.signedPayload(secondAttributes),
+		Signatures: kts.signUpdate(firstId, secondAttributes, ownerKey),
+	}
+
+	// Approvals are bound to the chain.
+	_, err = k.UpdateRecord(ctx.WithChainID("other-chain"), update)
+	sr.ErrorContains(err, "Signature mismatch")
+
+	record, err = k.UpdateRecord(ctx, update)
+	sr.NoError(err)
+	secondId := record.Id
+
+	// The owner signatures of an old version payload don't approve rolling back to it.
+	_, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{RecordId: secondId, Signer: signer, Payload: firstPayload})
+	sr.ErrorContains(err, "Record owner approval threshold not met")
+
+	rollback := types.MsgUpdateRecord{
+		RecordId:   secondId,
+		Signer:     signer,
+		Payload:    kts.signedPayload(firstAttributes),
+		Signatures: kts.signUpdate(secondId, firstAttributes, ownerKey),
+	}
+	record, err = k.UpdateRecord(ctx, rollback)
+	sr.NoError(err)
+	sr.Equal(firstId, record.Id)
+
+	// Updates approved before the rollback can't be replayed on the restored version.
+	_, err = k.UpdateRecord(ctx, update)
+	sr.ErrorContains(err, "Signature mismatch")
+
+	restored, err := k.Records.Get(ctx, firstId)
+	sr.NoError(err)
+	sr.Equal(uint64(2), restored.Nonce)
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryRecordsPagination() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	examples := []string{
+		"../../../data/examples/service_provider_example.yml",
+		"../../../data/examples/website_registration_example.yml",
+		"../../../data/examples/general_record_example.yml",
+	}
+	for _, example := range examples {
+		filePath, err := filepath.Abs(example)
+		sr.NoError(err)
+		payloadType, err := cli.GetPayloadFromFile(filePath)
+		sr.NoError(err)
+		_, err = kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: payloadType.ToPayload(),
+		})
+		sr.NoError(err)
+	}
+
+	// Attribute filter matching more than one of the example records.
+	versionAttributes := []*types.QueryRecordsRequest_KeyValueInput{
+		{
+			Key: "version",
+			Value: &types.QueryRecordsRequest_ValueInput{
+				Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "1.0.0"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		msg        string
+		attributes []*types.QueryRecordsRequest_KeyValueInput
+	}{
+		{
+			"List records",
+			nil,
+		},
+		{
+			"Filter with attributes",
+			versionAttributes,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			all, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{Attributes: test.attributes, All: true})
+			sr.NoError(err)
+			total := len(all.GetRecords())
+			sr.Greater(total, 1)
+			sr.Equal(uint64(total), all.GetPagination().GetTotal())
+
+			// Offset based.
+			resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+				Attributes: test.attributes,
+				All:        true,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+			})
+			sr.NoError(err)
+			sr.Equal(1, len(resp.GetRecords()))
+			sr.Equal(all.GetRecords()[1].Id, resp.GetRecords()[0].Id)
+			sr.Equal(uint64(total), resp.GetPagination().GetTotal())
+
+			// Key based.
+			var ids []string
+			var nextKey []byte
+			for {
+				resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+					Attributes: test.attributes,
+					All:        true,
+					Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+				})
+				sr.NoError(err)
+				sr.LessOrEqual(len(resp.GetRecords()), 1)
+				for _, record := range resp.GetRecords() {
+					ids = append(ids, record.Id)
+				}
+
+				nextKey = resp.GetPagination().GetNextKey()
+				if len(nextKey) == 0 {
+					break
+				}
+			}
+			sr.Equal(total, len(ids))
+			for i, record := range all.GetRecords() {
+				sr.Equal(record.Id, ids[i])
+			}
+		})
+	}
+
+	bondResp, err := queryClient.GetRecordsByBondId(context.Background(), &types.QueryGetRecordsByBondIdRequest{
+		Id:         kts.bond.GetId(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	sr.NoError(err)
+	sr.Equal(2, len(bondResp.GetRecords()))
+	sr.Equal(uint64(len(examples)), bondResp.GetPagination().GetTotal())
+	sr.NotEmpty(bondResp.GetPagination().GetNextKey())
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryRecordsWithOperators() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	for _, attributes := range []types.AttributeMap{
+		{"type": "ApplicationRecord", "name": "app-a", "version": "0.9.0", "score": -3, "rating": 1.5},
+		{"type": "ApplicationRecord", "name": "app-b", "version": "1.0.0", "score": 5, "rating": 2.25},
+		{"type": "ApplicationRecord", "name": "other", "version": "1.2.0", "score": 12, "rating": -0.5},
+	} {
+		payload := types.ReadablePayload{RecordAttributes: attributes}
+		_, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: payload.ToPayload(),
+		})
+		sr.NoError(err)
+	}
+
+	stringValue := func(value string) *types.QueryRecordsRequest_ValueInput {
+		return &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: value}}
+	}
+	intValue := func(value int64) *types.QueryRecordsRequest_ValueInput {
+		return &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_Int{Int: value}}
+	}
+	floatValue := func(value float64) *types.QueryRecordsRequest_ValueInput {
+		return &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_Float{Float: value}}
+	}
+
+	testCases := []struct {
+		msg         string
+		attributes  []*types.QueryRecordsRequest_KeyValueInput
+		expErr      bool
+		noOfRecords int
+	}{
+		{
+			"String greater than or equal",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "version", Value: stringValue("1.0.0"), Operator: types.QueryRecordsRequest_OPERATOR_GTE},
+			},
+			false,
+			2,
+		},
+		{
+			"String greater than",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "version", Value: stringValue("1.0.0"), Operator: types.QueryRecordsRequest_OPERATOR_GT},
+			},
+			false,
+			1,
+		},
+		{
+			"Int less than",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "score", Value: intValue(5), Operator: types.QueryRecordsRequest_OPERATOR_LT},
+			},
+			false,
+			1,
+		},
+		{
+			"Int less than or equal",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "score", Value: intValue(5), Operator: types.QueryRecordsRequest_OPERATOR_LTE},
+			},
+			false,
+			2,
+		},
+		{
+			"Int greater than a negative value",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "score", Value: intValue(-10), Operator: types.QueryRecordsRequest_OPERATOR_GT},
+			},
+			false,
+			3,
+		},
+		{
+			"Float greater than",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "rating", Value: floatValue(0), Operator: types.QueryRecordsRequest_OPERATOR_GT},
+			},
+			false,
+			2,
+		},
+		{
+			"String prefix",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "name", Value: stringValue("app-"), Operator: types.QueryRecordsRequest_OPERATOR_PREFIX},
+			},
+			false,
+			2,
+		},
+		{
+			"Combined with equality",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "type", Value: stringValue("ApplicationRecord")},
+				{Key: "score", Value: intValue(0), Operator: types.QueryRecordsRequest_OPERATOR_GTE},
+			},
+			false,
+			2,
+		},
+		{
+			"Values of a different type don't match",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "score", Value: stringValue("0"), Operator: types.QueryRecordsRequest_OPERATOR_GT},
+			},
+			false,
+			0,
+		},
+		{
+			"Prefix with an int value",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{Key: "score", Value: intValue(1), Operator: types.QueryRecordsRequest_OPERATOR_PREFIX},
+			},
+			true,
+			0,
+		},
+		{
+			"Range with a boolean value",
+			[]*types.QueryRecordsRequest_KeyValueInput{
+				{
+					Key:      "score",
+					Value:    &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_Boolean{Boolean: true}},
+					Operator: types.QueryRecordsRequest_OPERATOR_GT,
+				},
+			},
+			true,
+			0,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{Attributes: test.attributes, All: true})
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+
+			sr.NoError(err)
+			sr.Equal(test.noOfRecords, len(resp.GetRecords()))
+		})
+	}
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryRecordsWithDottedKeys() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	var recordIds []string
+	for _, attributes := range []types.AttributeMap{
+		{"type": "DottedRecord", "a.b": 1},
+		{"type": "NestedRecord", "a": map[string]any{"b": 1}},
+		{"type": "EscapedRecord", `a\`: map[string]any{"b": 1}},
+	} {
+		payload := types.ReadablePayload{RecordAttributes: attributes}
+		record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: payload.ToPayload(),
+		})
+		sr.NoError(err)
+		recordIds = append(recordIds, record.Id)
+	}
+
+	for _, test := range []struct {
+		path     string
+		keys     []string
+		recordId string
+	}{
+		{`a\.b`, []string{"a.b"}, recordIds[0]},
+		{"a.b", []string{"a", "b"}, recordIds[1]},
+		{`a\\.b`, []string{`a\`, "b"}, recordIds[2]},
+	} {
+		sr.Equal(test.keys, types.SplitAttributePath(test.path))
+
+		resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+			Attributes: []*types.QueryRecordsRequest_KeyValueInput{
+				{Key: test.path, Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_Int{Int: 1}}},
+			},
+			All: true,
+		})
+		sr.NoError(err)
+		sr.Equal(1, len(resp.GetRecords()), test.path)
+		sr.Equal(test.recordId, resp.GetRecords()[0].Id)
+	}
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryRecordsWithFilter() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	for _, attributes := range []types.AttributeMap{
+		{"type": "A", "name": "a1"},
+		{"type": "B", "name": "b1", "deprecated": true},
+		{"type": "B", "name": "b2"},
+		{"type": "C", "name": "c1"},
+	} {
+		payload := types.ReadablePayload{RecordAttributes: attributes}
+		_, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: payload.ToPayload(),
+		})
+		sr.NoError(err)
+	}
+
+	typeIs := func(recordType string) *types.QueryRecordsRequest_KeyValueInput {
+		return &types.QueryRecordsRequest_KeyValueInput{
+			Key:   "type",
+			Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: recordType}},
+		}
+	}
+	condition := func(attr *types.QueryRecordsRequest_KeyValueInput) *types.QueryRecordsRequest_Expression {
+		return &types.QueryRecordsRequest_Expression{Node: &types.QueryRecordsRequest_Expression_Condition{Condition: attr}}
+	}
+	and := func(exprs ...*types.QueryRecordsRequest_Expression) *types.QueryRecordsRequest_Expression {
+		return &types.QueryRecordsRequest_Expression{Node: &types.QueryRecordsRequest_Expression_And{
+			And: &types.QueryRecordsRequest_ExpressionList{Expressions: exprs},
+		}}
+	}
+	or := func(exprs ...*types.QueryRecordsRequest_Expression) *types.QueryRecordsRequest_Expression {
+		return &types.QueryRecordsRequest_Expression{Node: &types.QueryRecordsRequest_Expression_Or{
+			Or: &types.QueryRecordsRequest_ExpressionList{Expressions: exprs},
+		}}
+	}
+	not := func(expr *types.QueryRecordsRequest_Expression) *types.QueryRecordsRequest_Expression {
+		return &types.QueryRecordsRequest_Expression{Node: &types.QueryRecordsRequest_Expression_Not{Not: expr}}
+	}
+	deprecated := condition(&types.QueryRecordsRequest_KeyValueInput{
+		Key:   "deprecated",
+		Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_Boolean{Boolean: true}},
+	})
+
+	deepExpression := condition(typeIs("A"))
+	for i := 0; i < 20; i++ {
+		deepExpression = not(deepExpression)
+	}
+	manyConditions := []*types.QueryRecordsRequest_Expression{}
+	for i := 0; i < 100; i++ {
+		manyConditions = append(manyConditions, condition(typeIs("A")))
+	}
+
+	testCases := []struct {
+		msg        string
+		attributes []*types.QueryRecordsRequest_KeyValueInput
+		filter     *types.QueryRecordsRequest_Expression
+		expErr     bool
+		expNames   []string
+	}{
+		{
+			"Type is A or B, but not deprecated",
+			nil,
+			and(or(condition(typeIs("A")), condition(typeIs("B"))), not(deprecated)),
+			false,
+			[]string{"a1", "b2"},
+		},
+		{
+			"Negation only",
+			nil,
+			not(condition(typeIs("C"))),
+			false,
+			[]string{"a1", "b1", "b2"},
+		},
+		{
+			"Or with a negation",
+			nil,
+			or(condition(typeIs("C")), not(condition(typeIs("B")))),
+			false,
+			[]string{"a1", "c1"},
+		},
+		{
+			"And of negations",
+			nil,
+			and(not(condition(typeIs("A"))), not(condition(typeIs("C")))),
+			false,
+			[]string{"b1", "b2"},
+		},
+		{
+			"Filter combined with attributes",
+			[]*types.QueryRecordsRequest_KeyValueInput{typeIs("B")},
+			not(deprecated),
+			false,
+			[]string{"b2"},
+		},
+		{
+			"Empty expression list",
+			nil,
+			and(),
+			true,
+			nil,
+		},
+		{
+			"Empty expression",
+			nil,
+			&types.QueryRecordsRequest_Expression{},
+			true,
+			nil,
+		},
+		{
+			"Expression too deep",
+			nil,
+			deepExpression,
+			true,
+			nil,
+		},
+		{
+			"Too many terms",
+			nil,
+			or(manyConditions...),
+			true,
+			nil,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+				Attributes: test.attributes,
+				Filter:     test.filter,
+				All:        true,
+			})
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+
+			sr.NoError(err)
+			names := []string{}
+			for _, record := range resp.GetRecords() {
+				readableRecord, err := record.ToReadableRecord()
+				sr.NoError(err)
+				names = append(names, readableRecord.Attributes["name"].(string))
+			}
+			sr.ElementsMatch(test.expNames, names)
+		})
+	}
+
+	// Deleted records don't count towards the max number of records listed for a negation.
+	for i := 0; i < 10000; i++ {
+		id := fmt.Sprintf("deleted-%05d", i)
+		sr.NoError(kts.RegistryKeeper.Records.Set(ctx, id, types.Record{Id: id, Deleted: true}))
+	}
+	resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{Filter: not(condition(typeIs("C"))), All: true})
+	sr.NoError(err)
+	sr.Equal(3, len(resp.GetRecords()))
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryRecordsLifecycle() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	payload := types.ReadablePayload{RecordAttributes: types.AttributeMap{"type": "LifecycleRecord", "tags": []string{"a", "b"}}}
+	record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: payload.ToPayload(),
+	})
+	sr.NoError(err)
+
+	queryRecords := func() int {
+		resp, err := queryClient.Records(context.Background(), &types.QueryRecordsRequest{
+			Attributes: []*types.QueryRecordsRequest_KeyValueInput{
+				{
+					Key:   "tags",
+					Value: &types.QueryRecordsRequest_ValueInput{Value: &types.QueryRecordsRequest_ValueInput_String_{String_: "a"}},
+				},
+			},
+			All: true,
+		})
+		sr.NoError(err)
+
+		bondResp, err := queryClient.GetRecordsByBondId(context.Background(), &types.QueryGetRecordsByBondIdRequest{Id: kts.bond.GetId()})
+		sr.NoError(err)
+		sr.Equal(len(resp.GetRecords()), len(bondResp.GetRecords()))
+
+		return len(resp.GetRecords())
+	}
+	sr.Equal(1, queryRecords())
+
+	// Dissociate the bond and let the record expire.
+	err = kts.RegistryKeeper.DissociateBond(ctx, types.MsgDissociateBond{RecordId: record.Id, Signer: kts.accounts[0].String()})
+	sr.NoError(err)
+
+	expiryTime, err := time.Parse(time.RFC3339, record.ExpiryTime)
+	sr.NoError(err)
+	ctx = ctx.WithBlockTime(expiryTime.Add(time.Second))
+	sr.NoError(kts.RegistryKeeper.ProcessRecordExpiryQueue(ctx))
+
+	deletedRecord, err := kts.RegistryKeeper.GetRecordById(ctx, record.Id)
+	sr.NoError(err)
+	sr.True(deletedRecord.Deleted)
+	sr.Equal(0, queryRecords())
+
+	err = kts.RegistryKeeper.AttributesMap.Walk(ctx, nil, func(key collections.Pair[string, string], value types.RecordsList) (bool, error) {
+		sr.NotContains(value.Value, record.Id, key)
+		return false, nil
+	})
+	sr.NoError(err)
+
+	// Renewing the record restores the indexes.
+	err = kts.RegistryKeeper.AssociateBond(ctx, types.MsgAssociateBond{
+		RecordId: record.Id,
+		BondId:   kts.bond.GetId(),
+		Signer:   kts.accounts[0].String(),
+	})
+	sr.NoError(err)
+	err = kts.RegistryKeeper.RenewRecord(ctx, types.MsgRenewRecord{RecordId: record.Id, Signer: kts.accounts[0].String()})
+	sr.NoError(err)
+	sr.Equal(1, queryRecords())
+}
+
+func (kts *KeeperTestSuite) TestDeleteRecord() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	ownerKey := secp256k1.GenPrivKey()
+	ownerRecord := kts.setRecord(types.AttributeMap{"type": "DeletableRecord", "name": "owner"}, ownerKey)
+	bondOwnerRecord := kts.setRecord(types.AttributeMap{"type": "DeletableRecord", "name": "bond-owner"}, ownerKey)
+
+	params, err := kts.RegistryKeeper.GetParams(ctx)
+	sr.NoError(err)
+
+	// Half of the rent period is left.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RecordRentDuration / 2))
+
+	testCases := []struct {
+		msg      string
+		recordId string
+		signer   string
+		expErr   bool
+	}{
+		{
+			"Delete by an unrelated account",
+			ownerRecord.Id,
+			sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			true,
+		},
+		{
+			"Delete by a record owner",
+			ownerRecord.Id,
+			sdk.AccAddress(ownerKey.PubKey().Address()).String(),
+			false,
+		},
+		{
+			"Delete by the bond owner",
+			bondOwnerRecord.Id,
+			kts.accounts[0].String(),
+			false,
+		},
+		{
+			"Delete a deleted record",
+			ownerRecord.Id,
+			kts.accounts[0].String(),
+			true,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			bondBefore, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+			sr.NoError(err)
+			quote, err := queryClient.QuoteRecordRent(context.Background(), &types.QueryQuoteRecordRentRequest{RecordId: test.recordId})
+			sr.NoError(err)
+			expectedRefund := quote.GetRentPerPeriod().Amount.QuoRaw(2)
+
+			refund, err := kts.RegistryKeeper.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: test.recordId, Signer: test.signer})
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+			sr.NoError(err)
+			sr.Equal(expectedRefund, refund.AmountOf(params.RecordRent.Denom))
+
+			bondAfter, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+			sr.NoError(err)
+			sr.Equal(bondBefore.Balance.Add(refund...), bondAfter.Balance)
+
+			resp, err := queryClient.GetRecord(context.Background(), &types.QueryGetRecordRequest{Id: test.recordId})
+			sr.NoError(err)
+			sr.True(resp.GetRecord().Deleted)
+
+			err = kts.RegistryKeeper.RecordExpiryQueue.Walk(ctx, nil, func(_ time.Time, value types.ExpiryQueue) (bool, error) {
+				sr.NotContains(value.Value, test.recordId)
+				return false, nil
+			})
+			sr.NoError(err)
+		})
+	}
+}
+
+func (kts *KeeperTestSuite) TestDeleteRecordRefund() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+	ownerKey := secp256k1.GenPrivKey()
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+	getBondBalance := func() math.Int {
+		bond, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+		sr.NoError(err)
+		return bond.Balance.AmountOf(params.RecordRent.Denom)
+	}
+	balanceBefore := getBondBalance()
+
+	// Prepay a small record, then replace it with a large version.
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "RefundRecord"}, ownerKey),
+	})
+	sr.NoError(err)
+	sr.NoError(k.RenewRecord(ctx, types.MsgRenewRecord{RecordId: record.Id, Signer: owner, Periods: 3}))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RecordRentDuration))
+	largeAttributes := types.AttributeMap{"type": "RefundRecord", "description": strings.Repeat("x", 1000)}
+	record, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId:   record.Id,
+		Signer:     owner,
+		Payload:    kts.signedPayload(largeAttributes),
+		Signatures: kts.signUpdate(record.Id, largeAttributes, ownerKey),
+	})
+	sr.NoError(err)
+
+	stored, err := k.GetRecordById(ctx, record.Id)
+	sr.NoError(err)
+	sr.NotNil(stored.Rent)
+	sr.Equal(kts.bond.GetId(), stored.Rent.BondId)
+	sr.Equal(stored.ExpiryTime, stored.Rent.EndTime)
+	paid := balanceBefore.Sub(getBondBalance())
+
+	// Half of the remaining time is left: half of the rent still unused at the update is refunded,
+	// which is never more than the rent paid.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * params.RecordRentDuration / 2))
+	refund, err := k.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: record.Id, Signer: owner})
+	sr.NoError(err)
+	sr.Equal(stored.Rent.Amount.Amount.QuoRaw(2), refund.AmountOf(params.RecordRent.Denom))
+	sr.True(refund.AmountOf(params.RecordRent.Denom).LT(paid))
+	sr.Equal(balanceBefore.Sub(paid).Add(refund.AmountOf(params.RecordRent.Denom)), getBondBalance())
+}
+
+func (kts *KeeperTestSuite) TestBondDeletedRecords() {
+	queryClient, ctx, k := kts.queryClient, kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	// The bond owner owns the records as well, so it can move them between bonds.
+	ownerKey := secp256k1.GenPrivKey()
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address())
+	owner := ownerAddress.String()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000000000)))
+	sr.NoError(banktestutil.FundAccount(ctx, kts.BankKeeper, ownerAddress, coins.Add(coins...)))
+	createBond := func() string {
+		// Bond ids are derived from the owner account sequence.
+		account := kts.AccountKeeper.GetAccount(ctx, ownerAddress)
+		sr.NoError(account.SetSequence(account.GetSequence() + 1))
+		kts.AccountKeeper.SetAccount(ctx, account)
+
+		bond, err := kts.BondKeeper.CreateBond(ctx, ownerAddress, coins)
+		sr.NoError(err)
+		return bond.Id
+	}
+	oldBondId, newBondId := createBond(), createBond()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  oldBondId,
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "ExpiredRecord"}, ownerKey),
+	})
+	sr.NoError(err)
+	expiredId := record.Id
+	record, err = k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  oldBondId,
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "VersionedRecord", "version": "1.0.0"}, ownerKey),
+	})
+	sr.NoError(err)
+	firstId := record.Id
+	record, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId: firstId,
+		Signer:   owner,
+		Payload:  kts.signedPayload(types.AttributeMap{"type": "VersionedRecord", "version": "2.0.0"}, ownerKey),
+	})
+	sr.NoError(err)
+	latestId := record.Id
+
+	// Records expire when their bond runs out of funds.
+	bond, err := kts.BondKeeper.GetBondById(ctx, oldBondId)
+	sr.NoError(err)
+	_, err = kts.BondKeeper.WithdrawBond(ctx, oldBondId, ownerAddress, bond.Balance)
+	sr.NoError(err)
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RecordRentDuration + time.Second))
+	sr.NoError(k.ProcessRecordExpiryQueue(ctx))
+
+	getBondId := func(id string) string {
+		record, err := k.GetRecordById(ctx, id)
+		sr.NoError(err)
+		sr.True(record.Deleted)
+		return record.BondId
+	}
+	listBondRecordIds := func(bondId string) []string {
+		resp, err := queryClient.GetRecordsByBondId(context.Background(), &types.QueryGetRecordsByBondIdRequest{Id: bondId})
+		sr.NoError(err)
+		ids := []string{}
+		for _, record := range resp.GetRecords() {
+			ids = append(ids, record.Id)
+		}
+		return ids
+	}
+
+	// Deleted records are still associated with the bond, and keep it in use, but aren't listed.
+	recordKeeper := registryKeeper.NewRecordKeeper(nil, &k, kts.AuctionKeeper)
+	sr.True(recordKeeper.UsesBond(ctx, oldBondId))
+	sr.Empty(listBondRecordIds(oldBondId))
+
+	// Deleted records move to the new bond, and the latest versions are renewed from it.
+	sr.NoError(k.ReassociateRecords(ctx, types.MsgReassociateRecords{OldBondId: oldBondId, NewBondId: newBondId, Signer: owner}))
+	for _, id := range []string{expiredId, firstId, latestId} {
+		sr.Equal(newBondId, getBondId(id))
+	}
+	sr.False(recordKeeper.UsesBond(ctx, oldBondId))
+
+	sr.NoError(k.ProcessRecordExpiryQueue(ctx))
+	sr.ElementsMatch([]string{expiredId, latestId}, listBondRecordIds(newBondId))
+	first, err := k.GetRecordById(ctx, firstId)
+	sr.NoError(err)
+	sr.True(first.Deleted)
+
+	// All records, deleted ones included, are dissociated from the bond.
+	sr.NoError(k.DissociateRecords(ctx, types.MsgDissociateRecords{BondId: newBondId, Signer: owner}))
+	sr.Empty(getBondId(firstId))
+	sr.False(recordKeeper.UsesBond(ctx, newBondId))
+}
+
+func (kts *KeeperTestSuite) TestRecordLifetime() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	params, err := kts.RegistryKeeper.GetParams(ctx)
+	sr.NoError(err)
+
+	bondBalance := func() math.Int {
+		bond, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+		sr.NoError(err)
+		return bond.Balance.AmountOf(params.RecordRent.Denom)
+	}
+	expiryTime := func(id string) time.Time {
+		resp, err := queryClient.GetRecord(context.Background(), &types.QueryGetRecordRequest{Id: id})
+		sr.NoError(err)
+		expiry, err := time.Parse(time.RFC3339, resp.GetRecord().ExpiryTime)
+		sr.NoError(err)
+		return expiry
+	}
+
+	payload := types.ReadablePayload{RecordAttributes: types.AttributeMap{"type": "LongLivedRecord"}}
+	_, err = kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:   kts.bond.GetId(),
+		Signer:   kts.accounts[0].String(),
+		Payload:  payload.ToPayload(),
+		Lifetime: params.MaxRecordLifetime + time.Second,
+	})
+	sr.Error(err)
+
+	balance := bondBalance()
+	record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:   kts.bond.GetId(),
+		Signer:   kts.accounts[0].String(),
+		Payload:  payload.ToPayload(),
+		Lifetime: 2 * params.RecordRentDuration,
+	})
+	sr.NoError(err)
+	sr.Equal(ctx.BlockTime().Add(2*params.RecordRentDuration).Unix(), expiryTime(record.Id).Unix())
+	quote, err := queryClient.QuoteRecordRent(context.Background(), &types.QueryQuoteRecordRentRequest{RecordId: record.Id})
+	sr.NoError(err)
+	rentPerPeriod := quote.GetRentPerPeriod().Amount
+	sr.Equal(balance.Sub(rentPerPeriod.MulRaw(2)), bondBalance())
+
+	testCases := []struct {
+		msg         string
+		signer      string
+		periods     uint64
+		expErr      bool
+		totalPeriod int64
+	}{
+		{
+			"Renew an active record without periods",
+			kts.accounts[0].String(),
+			0,
+			true,
+			2,
+		},
+		{
+			"Prepay more than the maximum record lifetime",
+			kts.accounts[0].String(),
+			uint64(params.MaxRecordLifetime / params.RecordRentDuration),
+			true,
+			2,
+		},
+		{
+			"Prepay by an account other than the bond owner or a record owner",
+			sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			2,
+			true,
+			2,
+		},
+		{
+			"Prepay periods",
+			kts.accounts[0].String(),
+			2,
+			false,
+			4,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			balance := bondBalance()
+			err := kts.RegistryKeeper.RenewRecord(ctx, types.MsgRenewRecord{
+				RecordId: record.Id,
+				Signer:   test.signer,
+				Periods:  test.periods,
+			})
+			if test.expErr {
+				sr.Error(err)
+				sr.Equal(balance, bondBalance())
+			} else {
+				sr.NoError(err)
+				sr.Equal(balance.Sub(rentPerPeriod.MulRaw(int64(test.periods))), bondBalance())
+			}
+
+			expiry := expiryTime(record.Id)
+			sr.Equal(ctx.BlockTime().Add(time.Duration(test.totalPeriod)*params.RecordRentDuration).Unix(), expiry.Unix())
+
+			// The record is only processed once it expires.
+			queue, err := kts.RegistryKeeper.RecordExpiryQueue.Get(ctx, expiry)
+			sr.NoError(err)
+			sr.Contains(queue.Value, record.Id)
+		})
+	}
+}
+
+func (kts *KeeperTestSuite) TestGrpcQuoteRecordRent() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	params, err := kts.RegistryKeeper.GetParams(ctx)
+	sr.NoError(err)
+
+	payload := types.ReadablePayload{RecordAttributes: types.AttributeMap{"type": "SizedRecord", "data": strings.Repeat("x", 1000)}}
+	record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: payload.ToPayload(),
+	})
+	sr.NoError(err)
+	recordObj, err := record.ToRecordObj()
+	sr.NoError(err)
+	recordSize := uint64(len(recordObj.Attributes))
+
+	rentFor := func(size uint64, periods int64) math.Int {
+		return params.RecordRent.Amount.Add(params.RecordRentPerByte.Amount.MulRaw(int64(size))).MulRaw(periods)
+	}
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryQuoteRecordRentRequest
+		expErr  bool
+		expSize uint64
+		expRent math.Int
+	}{
+		{
+			"Quote by attributes size",
+			&types.QueryQuoteRecordRentRequest{AttributesSize: 100},
+			false,
+			100,
+			rentFor(100, 1),
+		},
+		{
+			"Quote by record id for multiple periods",
+			&types.QueryQuoteRecordRentRequest{RecordId: record.Id, Periods: 3},
+			false,
+			recordSize,
+			rentFor(recordSize, 3),
+		},
+		{
+			"Quote above the maximum attributes size",
+			&types.QueryQuoteRecordRentRequest{AttributesSize: params.MaxRecordAttributesSize + 1},
+			true,
+			0,
+			math.ZeroInt(),
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := queryClient.QuoteRecordRent(context.Background(), test.req)
+			if test.expErr {
+				sr.Error(err)
+				return
+			}
+			sr.NoError(err)
+			sr.Equal(test.expSize, resp.GetAttributesSize())
+			sr.Equal(test.expRent, resp.GetRent().Amount)
+		})
+	}
+
+	// Records above the maximum attributes size are rejected.
+	payload = types.ReadablePayload{RecordAttributes: types.AttributeMap{
+		"type": "SizedRecord",
+		"data": strings.Repeat("x", int(params.MaxRecordAttributesSize)),
+	}}
+	_, err = kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: payload.ToPayload(),
+	})
+	sr.Error(err)
+}
+
+func (kts *KeeperTestSuite) TestRecordIntegerAttributes() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	// Integers beyond float64 precision are signed and stored as is.
+	ownerKey := secp256k1.GenPrivKey()
+	attributes := types.AttributeMap{"type": "IntegerRecord", "count": int64(9007199254740993)}
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: kts.signedPayload(attributes, ownerKey),
+	})
+	sr.NoError(err)
+	sr.Equal([]string{ownerKey.PubKey().Address().String()}, record.Owners)
+
+	stored, err := k.GetRecordById(ctx, record.Id)
+	sr.NoError(err)
+	readableRecord, err := stored.ToReadableRecord()
+	sr.NoError(err)
+	sr.Equal(int64(9007199254740993), readableRecord.Attributes["count"])
+
+	// Invalid payload JSON is rejected with an error.
+	_, err = k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: types.Payload{Record: &types.Record{Attributes: []byte(`{"type":`)}},
+	})
+	sr.ErrorContains(err, "Invalid record JSON")
+}
+
+func (kts *KeeperTestSuite) TestRecordAttributeLimits() {
+	ctx := kts.SdkCtx
+	sr := kts.Require()
+
+	params, err := kts.RegistryKeeper.GetParams(ctx)
+	sr.NoError(err)
+	params.MaxRecordAttributesDepth = 3
+	params.MaxRecordIndexedAttributes = 5
+	sr.NoError(kts.RegistryKeeper.Params.Set(ctx, *params))
+
+	testCases := []struct {
+		msg        string
+		attributes types.AttributeMap
+		errMsg     string
+	}{
+		{
+			"within limits",
+			types.AttributeMap{"type": "LimitedRecord", "a": map[string]any{"b": []any{1, 2}}},
+			"",
+		},
+		{
+			"too deep",
+			types.AttributeMap{"type": "LimitedRecord", "a": map[string]any{"b": map[string]any{"c": []any{1}}}},
+			"maximum depth",
+		},
+		{
+			"too many indexed attributes",
+			types.AttributeMap{"type": "LimitedRecord", "tags": []any{"a", "b", "c", "d", "e"}},
+			"maximum number of indexed attributes",
+		},
+	}
+
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s", test.msg), func() {
+			bondBefore, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+			sr.NoError(err)
+
+			payload := types.ReadablePayload{RecordAttributes: test.attributes}
+			_, err = kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+				BondId:  kts.bond.GetId(),
+				Signer:  kts.accounts[0].String(),
+				Payload: payload.ToPayload(),
+			})
+			if test.errMsg == "" {
+				sr.NoError(err)
+				return
+			}
+			sr.ErrorContains(err, test.errMsg)
+
+			// No rent is charged for rejected records.
+			bondAfter, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+			sr.NoError(err)
+			sr.Equal(bondBefore.Balance, bondAfter.Balance)
+		})
+	}
+
+	// Limits are checked before signatures.
+	payload := types.ReadablePayload{
+		RecordAttributes: types.AttributeMap{"type": "LimitedRecord", "tags": []any{"a", "b", "c", "d", "e"}},
+		Signatures:       []types.Signature{{Sig: "invalid", PubKey: "invalid"}},
+	}
+	_, err = kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  kts.accounts[0].String(),
+		Payload: payload.ToPayload(),
+	})
+	sr.ErrorContains(err, "maximum number of indexed attributes")
+
+	// Attributes that are not a JSON map, or exceed the hard caps of the limits, are rejected statelessly.
+	msg := types.NewMsgSetRecord(types.Payload{Record: &types.Record{Attributes: []byte(`["a"]`)}}, kts.bond.GetId(), kts.accounts[0])
+	sr.ErrorContains(msg.ValidateBasic(), "Record attributes must be a JSON map.")
+
+	depth := int(types.MaxRecordAttributesDepthCap) + 1
+	msg.Payload.Record.Attributes = []byte(`{"a":` + strings.Repeat("[", depth) + strings.Repeat("]", depth) + `}`)
+	sr.ErrorContains(msg.ValidateBasic(), "Record attributes exceed the maximum depth.")
+	msg.Payload.Record.Attributes = []byte(`{"a":"` + strings.Repeat("[", depth) + `"}`)
+	sr.NoError(msg.ValidateBasic())
+
+	msg.Payload.Record.Attributes = []byte(`{"a":"` + strings.Repeat("x", int(types.MaxRecordAttributesSizeCap)) + `"}`)
+	sr.ErrorContains(msg.ValidateBasic(), "Record attributes exceed the maximum size.")
+
+	// Limits raised by params up to the hard caps are left to the keeper.
+	depth = int(types.DefaultMaxRecordAttributesDepth) + 1
+	msg.Payload.Record.Attributes = []byte(`{"a":` + strings.Repeat("[", depth) + strings.Repeat("]", depth) + `}`)
+	sr.NoError(msg.ValidateBasic())
+	msg.Payload.Record.Attributes = []byte(`{"a":"` + strings.Repeat("x", int(types.DefaultMaxRecordAttributesSize)) + `"}`)
+	sr.NoError(msg.ValidateBasic())
+
+	params.MaxRecordAttributesSize = types.MaxRecordAttributesSizeCap + 1
+	sr.ErrorContains(params.Validate(), "MaxRecordAttributesSize must not exceed")
+	params.MaxRecordAttributesSize = types.DefaultMaxRecordAttributesSize
+	params.MaxRecordAttributesDepth = types.MaxRecordAttributesDepthCap + 1
+	sr.ErrorContains(params.Validate(), "MaxRecordAttributesDepth must not exceed")
+
+	msg.Payload.Record = nil
+	sr.ErrorContains(msg.ValidateBasic(), "payload record is required.")
+}
+
+func (kts *KeeperTestSuite) TestGrpcGetReferencingRecords() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	targetId := kts.setRecord(types.AttributeMap{"type": "RepoRecord", "url": "https://git.vdb.to/cerc-io/laconicd"}).Id
+	link := map[string]any{"/": targetId}
+	buildId := kts.setRecord(types.AttributeMap{"type": "BuildRecord", "repo": link}).Id
+	deploymentId := kts.setRecord(types.AttributeMap{"type": "DeploymentRecord", "sources": []any{link, link}}).Id
+	matrixId := kts.setRecord(types.AttributeMap{"type": "MatrixRecord", "builds": []any{[]any{"x86", []any{link}}}}).Id
+
+	referencingIds := func() []string {
+		resp, err := queryClient.GetReferencingRecords(context.Background(), &types.QueryGetReferencingRecordsRequest{Id: targetId})
+		sr.NoError(err)
+
+		ids := []string{}
+		for _, record := range resp.GetRecords() {
+			ids = append(ids, record.Id)
+		}
+		return ids
+	}
+
+	sr.ElementsMatch([]string{buildId, deploymentId, matrixId}, referencingIds())
+
+	resp, err := queryClient.GetReferencingRecords(context.Background(), &types.QueryGetReferencingRecordsRequest{Id: buildId})
+	sr.NoError(err)
+	sr.Empty(resp.GetRecords())
+
+	for _, id := range []string{buildId, matrixId} {
+		_, err = kts.RegistryKeeper.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: id, Signer: kts.accounts[0].String()})
+		sr.NoError(err)
+	}
+	sr.Equal([]string{deploymentId}, referencingIds())
+}
+
+func (kts *KeeperTestSuite) TestGrpcGetRecordsByOwner() {
+	queryClient, ctx := kts.queryClient, kts.SdkCtx
+	sr := kts.Require()
+
+	ownerKey, coOwnerKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	var recordIds []string
+	for _, keys := range [][]*secp256k1.PrivKey{{ownerKey}, {ownerKey, coOwnerKey}} {
+		record, err := kts.RegistryKeeper.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  kts.accounts[0].String(),
+			Payload: kts.signedPayload(types.AttributeMap{"type": "OwnedRecord", "owners": int64(len(keys))}, keys...),
+		})
+		sr.NoError(err)
+		recordIds = append(recordIds, record.Id)
+	}
+
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	coOwnerHexAddress := strings.ToLower(coOwnerKey.PubKey().Address().String())
+
+	testCases := []struct {
+		msg         string
+		req         *types.QueryGetRecordsByOwnerRequest
+		expRecords  []string
+		expNextPage bool
+	}{
+		{
+			"By account address",
+			&types.QueryGetRecordsByOwnerRequest{Owner: ownerAddress},
+			recordIds,
+			false,
+		},
+		{
+			"By hex address",
+			&types.QueryGetRecordsByOwnerRequest{Owner: coOwnerHexAddress},
+			recordIds[1:],
+			false,
+		},
+		{
+			"By unknown address",
+			&types.QueryGetRecordsByOwnerRequest{Owner: kts.accounts[0].String()},
+			[]string{},
+			false,
+		},
+		{
+			"With pagination",
+			&types.QueryGetRecordsByOwnerRequest{Owner: ownerAddress, Pagination: &query.PageRequest{Limit: 1}},
+			nil,
+			true,
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := queryClient.GetRecordsByOwner(context.Background(), test.req)
+			sr.NoError(err)
+
+			if test.expNextPage {
+				sr.Equal(1, len(resp.GetRecords()))
+				sr.NotEmpty(resp.GetPagination().GetNextKey())
+				return
+			}
+
+			ids := []string{}
+			for _, record := range resp.GetRecords() {
+				ids = append(ids, record.Id)
+			}
+			sr.ElementsMatch(test.expRecords, ids)
+		})
+	}
+
+	// Deleted records are no longer listed.
+	_, err := kts.RegistryKeeper.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: recordIds[0], Signer: ownerAddress})
+	sr.NoError(err)
+
+	resp, err := queryClient.GetRecordsByOwner(context.Background(), &types.QueryGetRecordsByOwnerRequest{Owner: ownerAddress})
+	sr.NoError(err)
+	sr.Equal(1, len(resp.GetRecords()))
+	sr.Equal(recordIds[1], resp.GetRecords()[0].Id)
+}
+
+func (kts *KeeperTestSuite) TestTransferRecordOwnership() {
+	queryClient, ctx, k := kts.queryClient, kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	ownerKey, coOwnerKey, newOwnerKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	newOwnerAddress := sdk.AccAddress(newOwnerKey.PubKey().Address()).String()
+
+	attributes := types.AttributeMap{"type": "SharedRecord", "name": "2-of-2"}
+	_, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:         kts.bond.GetId(),
+		Signer:         kts.accounts[0].String(),
+		Payload:        kts.signedPayload(attributes, ownerKey, coOwnerKey),
+		OwnerThreshold: 3,
+	})
+	sr.ErrorContains(err, "Owner threshold exceeds the number of owners")
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:         kts.bond.GetId(),
+		Signer:         kts.accounts[0].String(),
+		Payload:        kts.signedPayload(attributes, ownerKey, coOwnerKey),
+		OwnerThreshold: 2,
+	})
+	sr.NoError(err)
+	sr.Equal(uint32(2), record.OwnerThreshold)
+
+	// A single owner can't update or delete the record.
+	v2Attributes := types.AttributeMap{"type": "SharedRecord", "name": "v2"}
+	_, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+		RecordId:   record.Id,
+		Signer:     ownerAddress,
+		Payload:    kts.signedPayload(v2Attributes, ownerKey),
+		Signatures: kts.signUpdate(record.Id, v2Attributes, ownerKey),
+	})
+	sr.ErrorContains(err, "Record owner approval threshold not met")
+
+	_, err = k.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: record.Id, Signer: ownerAddress})
+	sr.ErrorContains(err, "Record owner or bond owner mismatch")
+
+	newOwners := []string{newOwnerAddress}
+	transferOp := types.RecordOperation{
+		Action:         sdk.MsgTypeURL(&types.MsgTransferRecordOwnership{}),
+		ChainId:        ctx.ChainID(),
+		RecordId:       record.Id,
+		Owners:         []string{newOwnerKey.PubKey().Address().String()},
+		OwnerThreshold: 1,
+	}
+
+	testCases := []struct {
+		msg    string
+		req    types.MsgTransferRecordOwnership
+		expErr string
+	}{
+		{
+			"Transfer by a single owner",
+			types.MsgTransferRecordOwnership{RecordId: record.Id, Owners: newOwners, Signer: ownerAddress},
+			"Record owner approval threshold not met",
+		},
+		{
+			"Transfer with a co-owner signature over a different operation",
+			types.MsgTransferRecordOwnership{
+				RecordId: record.Id,
+				Owners:   newOwners,
+				Signer:   ownerAddress,
+				Signatures: []types.Signature{
+					kts.signOperation(types.RecordOperation{Action: transferOp.Action, ChainId: ctx.ChainID(), RecordId: record.Id}, coOwnerKey),
+				},
+			},
+			"Signature mismatch",
+		},
+		{
+			"Transfer with a threshold exceeding the number of owners",
+			types.MsgTransferRecordOwnership{RecordId: record.Id, Owners: newOwners, OwnerThreshold: 2, Signer: ownerAddress},
+			"Owner threshold exceeds the number of owners",
+		},
+		{
+			"Transfer with a co-owner signature",
+			types.MsgTransferRecordOwnership{
+				RecordId:   record.Id,
+				Owners:     newOwners,
+				Signer:     ownerAddress,
+				Signatures: []types.Signature{kts.signOperation(transferOp, coOwnerKey)},
+			},
+			"",
+		},
+		{
+			"Transfer by a previous owner",
+			types.MsgTransferRecordOwnership{RecordId: record.Id, Owners: []string{ownerAddress}, Signer: ownerAddress},
+			"Record owner approval threshold not met",
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			err := k.TransferRecordOwnership(ctx, test.req)
+			if test.expErr != "" {
+				sr.ErrorContains(err, test.expErr)
+				return
+			}
+			sr.NoError(err)
+		})
+	}
+
+	resp, err := queryClient.GetRecord(context.Background(), &types.QueryGetRecordRequest{Id: record.Id})
+	sr.NoError(err)
+	sr.Equal(transferOp.Owners, resp.GetRecord().Owners)
+	sr.Equal(uint32(1), resp.GetRecord().OwnerThreshold)
+
+	byOwner, err := queryClient.GetRecordsByOwner(context.Background(), &types.QueryGetRecordsByOwnerRequest{Owner: ownerAddress})
+	sr.NoError(err)
+	sr.Empty(byOwner.GetRecords())
+
+	byOwner, err = queryClient.GetRecordsByOwner(context.Background(), &types.QueryGetRecordsByOwnerRequest{Owner: newOwnerAddress})
+	sr.NoError(err)
+	sr.Equal(1, len(byOwner.GetRecords()))
+
+	// The new owner alone can now delete the record.
+	_, err = k.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: record.Id, Signer: newOwnerAddress})
+	sr.NoError(err)
+}
+
+func (kts *KeeperTestSuite) TestRecordOperationNonce() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	ownerKey, coOwnerKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+	bondOwner := kts.accounts[0].String()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:         kts.bond.GetId(),
+		Signer:         bondOwner,
+		Payload:        kts.signedPayload(types.AttributeMap{"type": "SharedRecord", "name": "nonce"}, ownerKey, coOwnerKey),
+		OwnerThreshold: 2,
+	})
+	sr.NoError(err)
+
+	dissociateOp := types.RecordOperation{
+		Action:   sdk.MsgTypeURL(&types.MsgDissociateBond{}),
+		ChainId:  ctx.ChainID(),
+		RecordId: record.Id,
+	}
+	associateOp := types.RecordOperation{
+		Action:   sdk.MsgTypeURL(&types.MsgAssociateBond{}),
+		ChainId:  ctx.ChainID(),
+		RecordId: record.Id,
+	}
+	dissociate := types.MsgDissociateBond{
+		RecordId:   record.Id,
+		Signer:     ownerAddress,
+		Signatures: []types.Signature{kts.signOperation(dissociateOp, coOwnerKey)},
+	}
+
+	// A single owner can't dissociate the record from a bond it doesn't own.
+	sr.ErrorContains(
+		k.DissociateBond(ctx, types.MsgDissociateBond{RecordId: record.Id, Signer: ownerAddress}),
+		"Record owner or bond owner mismatch",
+	)
+	sr.NoError(k.DissociateBond(ctx, dissociate))
+
+	otherChainOp := associateOp
+	otherChainOp.ChainId = "other-chain"
+	nextOp := associateOp
+	nextOp.Nonce = 1
+
+	testCases := []struct {
+		msg        string
+		signatures []types.Signature
+		expErr     string
+	}{
+		{
+			"Associate without owner approval",
+			nil,
+			"Record owner approval threshold not met",
+		},
+		{
+			"Associate with approvals of a used nonce",
+			[]types.Signature{kts.signOperation(associateOp, ownerKey), kts.signOperation(associateOp, coOwnerKey)},
+			"Signature mismatch",
+		},
+		{
+			"Associate with approvals for another chain",
+			[]types.Signature{kts.signOperation(otherChainOp, ownerKey), kts.signOperation(otherChainOp, coOwnerKey)},
+			"Signature mismatch",
+		},
+		{
+			"Associate with owner approvals",
+			[]types.Signature{kts.signOperation(nextOp, ownerKey), kts.signOperation(nextOp, coOwnerKey)},
+			"",
+		},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			err := k.AssociateBond(ctx, types.MsgAssociateBond{
+				RecordId:   record.Id,
+				BondId:     kts.bond.GetId(),
+				Signer:     bondOwner,
+				Signatures: test.signatures,
+			})
+			if test.expErr != "" {
+				sr.ErrorContains(err, test.expErr)
+				return
+			}
+			sr.NoError(err)
+		})
+	}
+
+	// Approvals can't be replayed once used.
+	sr.ErrorContains(k.DissociateBond(ctx, dissociate), "Signature mismatch")
+
+	stored, err := k.GetRecordById(ctx, record.Id)
+	sr.NoError(err)
+	sr.Equal(kts.bond.GetId(), stored.BondId)
+	sr.Equal(uint64(2), stored.Nonce)
+
+	// The bond owner can still detach all records from its bond.
+	sr.NoError(k.DissociateRecords(ctx, types.MsgDissociateRecords{BondId: kts.bond.GetId(), Signer: bondOwner}))
+
+	stored, err = k.GetRecordById(ctx, record.Id)
+	sr.NoError(err)
+	sr.Empty(stored.BondId)
+}
+
+func (kts *KeeperTestSuite) TestEthereumSignatures() {
+	queryClient, ctx, k := kts.queryClient, kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	ethKey, err := ethcrypto.GenerateKey()
+	sr.NoError(err)
+	ethAddress := ethcrypto.PubkeyToAddress(ethKey.PublicKey).String()
+
+	signEIP191 := func(doc []byte) string {
+		sig, err := nitrocrypto.SignEthereumMessage(doc, ethcrypto.FromECDSA(ethKey))
+		sr.NoError(err)
+		return sig.ToHexString()
+	}
+	signEIP712 := func(doc []byte) string {
+		hash, _, err := apitypes.TypedDataAndHash(types.EIP712TypedData(doc))
+		sr.NoError(err)
+		sig, err := ethcrypto.Sign(hash, ethKey)
+		sr.NoError(err)
+		sig[ethcrypto.RecoveryIDOffset] += 27
+		return hexutil.Encode(sig)
+	}
+
+	testCases := []struct {
+		msg    string
+		sign   func([]byte) string
+		sig    func(string) types.Signature
+		expErr string
+	}{
+		{
+			"EIP-191 signature",
+			signEIP191,
+			func(sig string) types.Signature {
+				return types.Signature{Sig: sig, Scheme: types.SignatureSchemeEIP191}
+			},
+			"",
+		},
+		{
+			"EIP-712 signature with the expected signer",
+			signEIP712,
+			func(sig string) types.Signature {
+				return types.Signature{Sig: sig, PubKey: strings.ToLower(ethAddress), Scheme: types.SignatureSchemeEIP712}
+			},
+			"",
+		},
+		{
+			"EIP-191 signature verified as EIP-712",
+			signEIP191,
+			func(sig string) types.Signature {
+				return types.Signature{Sig: sig, PubKey: ethAddress, Scheme: types.SignatureSchemeEIP712}
+			},
+			"Signature mismatch",
+		},
+		{
+			"Malformed signature",
+			func([]byte) string { return "0x1234" },
+			func(sig string) types.Signature {
+				return types.Signature{Sig: sig, Scheme: types.SignatureSchemeEIP191}
+			},
+			"Error recovering signer address",
+		},
+		{
+			"Unsupported scheme",
+			signEIP191,
+			func(sig string) types.Signature {
+				return types.Signature{Sig: sig, Scheme: "eip4361"}
+			},
+			"Unsupported signature scheme",
+		},
+	}
+	for i, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			attributes := types.AttributeMap{"type": "EthereumSignedRecord", "index": i}
+			record := types.ReadableRecord{Attributes: attributes}
+			payload := types.ReadablePayload{
+				RecordAttributes: attributes,
+				Signatures:       []types.Signature{test.sig(test.sign(record.CanonicalJSON()))},
+			}
+
+			newRecord, err := k.SetRecord(ctx, types.MsgSetRecord{
+				BondId:  kts.bond.GetId(),
+				Signer:  kts.accounts[0].String(),
+				Payload: payload.ToPayload(),
+			})
+			if test.expErr != "" {
+				sr.ErrorContains(err, test.expErr)
+				return
+			}
+			sr.NoError(err)
+			sr.Equal([]string{ethAddress}, newRecord.Owners)
+		})
+	}
+
+	resp, err := queryClient.GetRecordsByOwner(context.Background(), &types.QueryGetRecordsByOwnerRequest{Owner: strings.ToLower(ethAddress)})
+	sr.NoError(err)
+	sr.Equal(2, len(resp.GetRecords()))
+
+	// Ethereum owners approve record operations the same way.
+	deleteOp := types.RecordOperation{
+		Action:   sdk.MsgTypeURL(&types.MsgDeleteRecord{}),
+		ChainId:  ctx.ChainID(),
+		RecordId: resp.GetRecords()[0].Id,
+	}
+	_, opDoc := deleteOp.GetSignBytes()
+	_, err = k.DeleteRecord(ctx, types.MsgDeleteRecord{
+		RecordId:   deleteOp.RecordId,
+		Signer:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+		Signatures: []types.Signature{{Sig: signEIP712(opDoc), Scheme: types.SignatureSchemeEIP712}},
+	})
+	sr.NoError(err)
+}
+
+func (kts *KeeperTestSuite) TestTypedEvents() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+
+	var records []*types.ReadableRecord
+	for _, name := range []string{"renewed", "expired"} {
+		events := kts.typedEvents(ctx, func(ctx sdk.Context) error {
+			payload := types.ReadablePayload{RecordAttributes: types.AttributeMap{"type": "EventRecord", "name": name}}
+			record, err := k.SetRecord(ctx, types.MsgSetRecord{
+				BondId:  kts.bond.GetId(),
+				Signer:  kts.accounts[0].String(),
+				Payload: payload.ToPayload(),
+			})
+			records = append(records, record)
+			return err
+		})
+		record := records[len(records)-1]
+		sr.Equal(2, len(events))
+		sr.Equal(record.Id, getRentCharged(events).RecordId)
+		recordSet := events[1].(*types.EventRecordSet)
+		sr.Equal(record.Id, recordSet.RecordId)
+		sr.Equal(kts.bond.GetId(), recordSet.BondId)
+		sr.Equal(record.ExpiryTime, recordSet.ExpiryTime)
+	}
+	renewed, expired := records[0], records[1]
+
+	sr.NoError(k.DissociateBond(ctx, types.MsgDissociateBond{RecordId: expired.Id, Signer: kts.accounts[0].String()}))
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{
+		Name:   "typed-events",
+		Signer: kts.accounts[0].String(),
+		Owner:  kts.accounts[0].String(),
+	}))
+
+	// Block processing renews records with a bond and expires the others.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.RecordRentDuration + time.Second))
+	events := kts.typedEvents(ctx, k.ProcessRecordExpiryQueue)
+	renewedRecord, err := k.GetRecordById(ctx, renewed.Id)
+	sr.NoError(err)
+	rent := getRentCharged(events)
+	sr.Equal(renewed.Id, rent.RecordId)
+	sr.Equal(kts.bond.GetId(), rent.BondId)
+	sr.Contains(events, &types.EventRecordRenewed{
+		RecordId:   renewed.Id,
+		BondId:     kts.bond.GetId(),
+		ExpiryTime: renewedRecord.ExpiryTime,
+	})
+	sr.Contains(events, &types.EventRecordExpired{
+		RecordId: expired.Id,
+		Reason:   types.ExpiryReasonBondNotFound,
+	})
+
+	events = kts.typedEvents(ctx, k.ProcessAuthorityExpiryQueue)
+	sr.Equal([]proto.Message{&types.EventAuthorityExpired{
+		Name:   "typed-events",
+		Reason: types.ExpiryReasonBondNotFound,
+	}}, events)
+
+	// Message handlers emit the same events.
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.RenewRecord(ctx, types.MsgRenewRecord{RecordId: renewed.Id, Periods: 1, Signer: kts.accounts[0].String()})
+	})
+	renewedRecord, err = k.GetRecordById(ctx, renewed.Id)
+	sr.NoError(err)
+	sr.Equal(2, len(events))
+	sr.NotNil(getRentCharged(events))
+	sr.Equal(&types.EventRecordRenewed{
+		RecordId:   renewed.Id,
+		BondId:     kts.bond.GetId(),
+		ExpiryTime: renewedRecord.ExpiryTime,
+	}, events[1])
+}
+
+func (kts *KeeperTestSuite) TestMessageTypedEvents() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+	newOwner := simtestutil.AddTestAddrs(kts.BankKeeper, integrationTest.BondDenomProvider{}, ctx, 1, math.NewInt(100000000000))[0].String()
+	ownerKey, newOwnerKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	ownerAddress := sdk.AccAddress(ownerKey.PubKey().Address()).String()
+
+	var record *types.ReadableRecord
+	events := kts.typedEvents(ctx, func(ctx sdk.Context) (err error) {
+		record, err = k.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  owner,
+			Payload: kts.signedPayload(types.AttributeMap{"type": "EventRecord", "version": "1"}, ownerKey),
+		})
+		return err
+	})
+	sr.Contains(events, &types.EventRecordSet{
+		RecordId:   record.Id,
+		BondId:     kts.bond.GetId(),
+		Owners:     []string{ownerKey.PubKey().Address().String()},
+		ExpiryTime: record.ExpiryTime,
+	})
+
+	var updated *types.ReadableRecord
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) (err error) {
+		updated, err = k.UpdateRecord(ctx, types.MsgUpdateRecord{
+			RecordId: record.Id,
+			Signer:   ownerAddress,
+			Payload:  kts.signedPayload(types.AttributeMap{"type": "EventRecord", "version": "2"}, ownerKey),
+		})
+		return err
+	})
+	sr.Contains(events, &types.EventRecordUpdated{
+		RecordId:   updated.Id,
+		PreviousId: record.Id,
+		BondId:     kts.bond.GetId(),
+		ExpiryTime: updated.ExpiryTime,
+	})
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.TransferRecordOwnership(ctx, types.MsgTransferRecordOwnership{
+			RecordId: updated.Id,
+			Owners:   []string{sdk.AccAddress(newOwnerKey.PubKey().Address()).String()},
+			Signer:   ownerAddress,
+		})
+	})
+	sr.Equal([]proto.Message{&types.EventRecordOwnershipTransferred{
+		RecordId:       updated.Id,
+		Owners:         []string{newOwnerKey.PubKey().Address().String()},
+		OwnerThreshold: 1,
+	}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "message-events", Signer: owner, Owner: owner})
+	})
+	authority, err := k.GetNameAuthority(ctx, "message-events")
+	sr.NoError(err)
+	sr.Equal([]proto.Message{&types.EventAuthorityReserved{
+		Name:       "message-events",
+		Owner:      owner,
+		ExpiryTime: authority.ExpiryTime.Format(time.RFC3339),
+	}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "message-events", BondId: kts.bond.GetId(), Signer: owner})
+	})
+	sr.Equal([]proto.Message{&types.EventAuthorityBondChanged{Name: "message-events", BondId: kts.bond.GetId()}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.RegisterSchema(ctx, types.MsgRegisterSchema{
+			RecordType: "message-events/EventSchemaRecord",
+			Authority:  "message-events",
+			Format:     types.SchemaFormatJSON,
+			Definition: `{}`,
+			Signer:     owner,
+		})
+	})
+	sr.Equal([]proto.Message{&types.EventSchemaRegistered{
+		RecordType: "message-events/EventSchemaRecord",
+		Authority:  "message-events",
+		Version:    1,
+	}}, events)
+
+	grantExpiry := ctx.BlockTime().Add(time.Hour)
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.GrantNameAccess(ctx, types.MsgGrantNameAccess{
+			LrnPrefix:  "lrn://message-events/*",
+			Grantee:    newOwner,
+			ExpiryTime: &grantExpiry,
+			Signer:     owner,
+		})
+	})
+	sr.Equal([]proto.Message{&types.EventNameAccessGranted{
+		LrnPrefix:  "lrn://message-events/*",
+		Grantee:    newOwner,
+		Granter:    owner,
+		ExpiryTime: grantExpiry.Format(time.RFC3339),
+	}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.RevokeNameAccess(ctx, types.MsgRevokeNameAccess{LrnPrefix: "lrn://message-events/*", Grantee: newOwner, Signer: owner})
+	})
+	sr.Equal([]proto.Message{&types.EventNameAccessRevoked{LrnPrefix: "lrn://message-events/*", Grantee: newOwner}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.SetName(ctx, types.MsgSetName{Lrn: "lrn://message-events/app", Cid: updated.Id, Signer: owner})
+	})
+	sr.Equal([]proto.Message{&types.EventNameSet{Lrn: "lrn://message-events/app", RecordId: updated.Id}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.DeleteName(ctx, types.MsgDeleteName{Lrn: "lrn://message-events/app", Signer: owner})
+	})
+	sr.Equal([]proto.Message{&types.EventNameDeleted{Lrn: "lrn://message-events/app"}}, events)
+
+	transfer := types.MsgTransferAuthority{Name: "message-events", NewOwner: newOwner, RequireAccept: true, Signer: owner}
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		_, err := k.TransferAuthority(ctx, transfer)
+		return err
+	})
+	sr.Equal([]proto.Message{&types.EventAuthorityTransferPending{Name: "message-events", Owner: owner, NewOwner: newOwner}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		_, err := k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "message-events", NewOwner: owner, Signer: owner})
+		return err
+	})
+	sr.Equal([]proto.Message{&types.EventAuthorityTransferCancelled{Name: "message-events", Owner: owner}}, events)
+
+	_, err = k.TransferAuthority(ctx, transfer)
+	sr.NoError(err)
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.AcceptAuthority(ctx, types.MsgAcceptAuthority{Name: "message-events", Signer: newOwner})
+	})
+	sr.Equal([]proto.Message{&types.EventAuthorityTransferred{
+		Name:          "message-events",
+		PreviousOwner: owner,
+		Owner:         newOwner,
+	}}, events)
+}
+
+func (kts *KeeperTestSuite) TestRecordBondTypedEvents() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	bondOwner := kts.accounts[0]
+	bondId := kts.bond.GetId()
+
+	// Bond ids are derived from the owner account sequence.
+	account := kts.AccountKeeper.GetAccount(ctx, bondOwner)
+	sr.NoError(account.SetSequence(account.GetSequence() + 1))
+	kts.AccountKeeper.SetAccount(ctx, account)
+	newBond, err := kts.BondKeeper.CreateBond(ctx, bondOwner, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000000000))))
+	sr.NoError(err)
+
+	payload := types.ReadablePayload{RecordAttributes: types.AttributeMap{"type": "BondEventRecord"}}
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{BondId: bondId, Signer: bondOwner.String(), Payload: payload.ToPayload()})
+	sr.NoError(err)
+
+	events := kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.DissociateBond(ctx, types.MsgDissociateBond{RecordId: record.Id, Signer: bondOwner.String()})
+	})
+	sr.Equal([]proto.Message{&types.EventRecordBondChanged{RecordId: record.Id, PreviousBondId: bondId}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.AssociateBond(ctx, types.MsgAssociateBond{RecordId: record.Id, BondId: bondId, Signer: bondOwner.String()})
+	})
+	sr.Equal([]proto.Message{&types.EventRecordBondChanged{RecordId: record.Id, BondId: bondId}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.ReassociateRecords(ctx, types.MsgReassociateRecords{OldBondId: bondId, NewBondId: newBond.Id, Signer: bondOwner.String()})
+	})
+	sr.Equal([]proto.Message{&types.EventRecordBondChanged{RecordId: record.Id, PreviousBondId: bondId, BondId: newBond.Id}}, events)
+
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		return k.DissociateRecords(ctx, types.MsgDissociateRecords{BondId: newBond.Id, Signer: bondOwner.String()})
+	})
+	sr.Equal([]proto.Message{&types.EventRecordBondChanged{RecordId: record.Id, PreviousBondId: newBond.Id}}, events)
+
+	sr.NoError(k.AssociateBond(ctx, types.MsgAssociateBond{RecordId: record.Id, BondId: bondId, Signer: bondOwner.String()}))
+	events = kts.typedEvents(ctx, func(ctx sdk.Context) error {
+		_, err := k.DeleteRecord(ctx, types.MsgDeleteRecord{RecordId: record.Id, Signer: bondOwner.String()})
+		return err
+	})
+	sr.Contains(events, &types.EventRecordDeleted{RecordId: record.Id, BondId: bondId})
+}
+
+// typedEvents runs a state transition with a new event manager and returns the registry typed events it emitted.
+func (kts *KeeperTestSuite) typedEvents(ctx sdk.Context, fn func(sdk.Context) error) []proto.Message {
+	sr := kts.Require()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	sr.NoError(fn(ctx))
+
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if !strings.HasPrefix(event.Type, "cerc.registry.v1.") {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		sr.NoError(err)
+		events = append(events, msg)
+	}
+
+	return events
+}
+
+func getRentCharged(events []proto.Message) *types.EventRentCharged {
+	for _, event := range events {
+		if rent, ok := event.(*types.EventRentCharged); ok {
+			return rent
+		}
+	}
+
+	return nil
+}
+
+func (kts *KeeperTestSuite) TestTransferAuthority() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+	newOwner := simtestutil.AddTestAddrs(kts.BankKeeper, integrationTest.BondDenomProvider{}, ctx, 1, math.NewInt(100000000000))[0].String()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "TransferredRecord"}, secp256k1.GenPrivKey()),
+	})
+	sr.NoError(err)
+
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "transfer", Signer: owner, Owner: owner}))
+	sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "transfer", BondId: kts.bond.GetId(), Signer: owner}))
+	sr.NoError(k.SetName(ctx.WithBlockHeight(10), types.MsgSetName{Lrn: "lrn://transfer/app", Cid: record.Id, Signer: owner}))
+
+	_, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "transfer", NewOwner: newOwner, Signer: newOwner})
+	sr.ErrorContains(err, "Access denied.")
+
+	err = k.AcceptAuthority(ctx, types.MsgAcceptAuthority{Name: "transfer", Signer: newOwner})
+	sr.ErrorContains(err, "No pending authority transfer.")
+
+	// Two-step transfer, keeping the bond.
+	pending, err := k.TransferAuthority(ctx, types.MsgTransferAuthority{
+		Name: "transfer", NewOwner: newOwner, RequireAccept: true, KeepBond: true, Signer: owner,
+	})
+	sr.NoError(err)
+	sr.True(pending)
+
+	authority, err := k.GetNameAuthority(ctx, "transfer")
+	sr.NoError(err)
+	sr.Equal(owner, authority.OwnerAddress)
+	sr.Equal(newOwner, authority.PendingTransfer.NewOwner)
+
+	err = k.AcceptAuthority(ctx, types.MsgAcceptAuthority{Name: "transfer", Signer: owner})
+	sr.ErrorContains(err, "Access denied.")
+	sr.NoError(k.AcceptAuthority(ctx, types.MsgAcceptAuthority{Name: "transfer", Signer: newOwner}))
+
+	authority, err = k.GetNameAuthority(ctx, "transfer")
+	sr.NoError(err)
+	sr.Equal(newOwner, authority.OwnerAddress)
+	sr.Nil(authority.PendingTransfer)
+	sr.Equal(kts.bond.GetId(), authority.BondId)
+
+	resolved, err := k.ResolveLRN(ctx, "lrn://transfer/app")
+	sr.NoError(err)
+	sr.Equal(record.Id, resolved.Id)
+
+	// A pending transfer is cancelled by transferring to the current owner.
+	pending, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "transfer", NewOwner: owner, RequireAccept: true, Signer: newOwner})
+	sr.NoError(err)
+	sr.True(pending)
+	pending, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "transfer", NewOwner: newOwner, Signer: newOwner})
+	sr.NoError(err)
+	sr.False(pending)
+	err = k.AcceptAuthority(ctx, types.MsgAcceptAuthority{Name: "transfer", Signer: owner})
+	sr.ErrorContains(err, "No pending authority transfer.")
+
+	// Direct transfer, resetting the bond and invalidating existing names.
+	pending, err = k.TransferAuthority(ctx.WithBlockHeight(20), types.MsgTransferAuthority{
+		Name: "transfer", NewOwner: owner, InvalidateNames: true, Signer: newOwner,
+	})
+	sr.NoError(err)
+	sr.False(pending)
+
+	authority, err = k.GetNameAuthority(ctx, "transfer")
+	sr.NoError(err)
+	sr.Equal(owner, authority.OwnerAddress)
+	sr.Empty(authority.BondId)
+	sr.Equal(uint64(20), authority.Height)
+
+	resolved, err = k.ResolveLRN(ctx, "lrn://transfer/app")
+	sr.NoError(err)
+	sr.Nil(resolved)
+
+	// Expired authorities can't be transferred.
+	authority.Status = types.AuthorityExpired
+	sr.NoError(k.SaveNameAuthority(ctx, "transfer", &authority))
+	_, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "transfer", NewOwner: newOwner, Signer: owner})
+	sr.ErrorContains(err, "Authority is not active.")
+}
+
+func (kts *KeeperTestSuite) TestRenewAuthority() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	params, err := k.GetParams(ctx)
+	sr.NoError(err)
+
+	owner := kts.accounts[0].String()
+	other := simtestutil.AddTestAddrs(kts.BankKeeper, integrationTest.BondDenomProvider{}, ctx, 1, math.NewInt(100000000000))[0].String()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "RenewedAuthorityRecord"}, secp256k1.GenPrivKey()),
+	})
+	sr.NoError(err)
+
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "renew", Signer: owner, Owner: owner}))
+	sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "renew", BondId: kts.bond.GetId(), Signer: owner}))
+	sr.NoError(k.SetName(ctx, types.MsgSetName{Lrn: "lrn://renew/app", Cid: record.Id, Signer: owner}))
+
+	sr.ErrorContains(types.MsgRenewAuthority{Name: "renew", Signer: owner}.ValidateBasic(), "periods must be positive.")
+	err = k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "renew", Periods: 1, Signer: other})
+	sr.ErrorContains(err, "Access denied.")
+	err = k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "unknown", Periods: 1, Signer: owner})
+	sr.ErrorContains(err, "Name authority not found.")
+
+	// Prepaid periods extend the expiry time of an active authority.
+	authority, err := k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	bond, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+	sr.NoError(err)
+
+	sr.NoError(k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "renew", Periods: 2, Signer: owner}))
+
+	renewed, err := k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityActive, renewed.Status)
+	sr.Equal(authority.ExpiryTime.Add(2*params.AuthorityRentDuration), renewed.ExpiryTime)
+	renewedBond, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+	sr.NoError(err)
+	sr.Equal(params.AuthorityRent.Amount.MulRaw(2), bond.Balance.AmountOf(sdk.DefaultBondDenom).Sub(renewedBond.Balance.AmountOf(sdk.DefaultBondDenom)))
+
+	// drainBond withdraws from the bond, leaving less than the rent, and returns the amount withdrawn.
+	drainBond := func() sdk.Coins {
+		bond, err := kts.BondKeeper.GetBondById(ctx, kts.bond.GetId())
+		sr.NoError(err)
+		amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bond.Balance.AmountOf(sdk.DefaultBondDenom).Sub(params.AuthorityRent.Amount).AddRaw(1)))
+		_, err = kts.BondKeeper.WithdrawBond(ctx, kts.bond.GetId(), kts.accounts[0], amount)
+		sr.NoError(err)
+		return amount
+	}
+
+	// Missing the rent puts the authority in its grace period, in which names resolve but can't be changed.
+	withdrawn := drainBond()
+
+	ctx = ctx.WithBlockTime(renewed.ExpiryTime.Add(time.Second))
+	sr.NoError(k.ProcessAuthorityExpiryQueue(ctx))
+
+	authority, err = k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityInGracePeriod, authority.Status)
+	sr.Equal(ctx.BlockTime().Add(params.AuthorityGracePeriod), authority.ExpiryTime)
+
+	resolved, err := k.ResolveLRN(ctx, "lrn://renew/app")
+	sr.NoError(err)
+	sr.Equal(record.Id, resolved.Id)
+
+	err = k.SetName(ctx, types.MsgSetName{Lrn: "lrn://renew/other", Cid: record.Id, Signer: owner})
+	sr.ErrorContains(err, "Authority is not active.")
+	err = k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "renew", Signer: other, Owner: other})
+	sr.ErrorContains(err, "Name already reserved.")
+
+	// Renewal brings the authority back to active, for periods starting now.
+	err = k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "renew", Periods: 1, Signer: owner})
+	sr.ErrorContains(err, "Insufficient funds.")
+	_, err = kts.BondKeeper.RefillBond(ctx, kts.bond.GetId(), kts.accounts[0], withdrawn)
+	sr.NoError(err)
+	sr.NoError(k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "renew", Periods: 1, Signer: owner}))
+
+	authority, err = k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityActive, authority.Status)
+	sr.Equal(ctx.BlockTime().Add(params.AuthorityRentDuration), authority.ExpiryTime)
+
+	// The authority expires if the rent is still missing at the end of the grace period.
+	drainBond()
+
+	ctx = ctx.WithBlockTime(authority.ExpiryTime.Add(time.Second))
+	sr.NoError(k.ProcessAuthorityExpiryQueue(ctx))
+	authority, err = k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityInGracePeriod, authority.Status)
+
+	ctx = ctx.WithBlockTime(authority.ExpiryTime.Add(time.Second))
+	sr.NoError(k.ProcessAuthorityExpiryQueue(ctx))
+	authority, err = k.GetNameAuthority(ctx, "renew")
+	sr.NoError(err)
+	sr.Equal(types.AuthorityExpired, authority.Status)
+
+	resolved, err = k.ResolveLRN(ctx, "lrn://renew/app")
+	sr.NoError(err)
+	sr.Nil(resolved)
+	err = k.RenewAuthority(ctx, types.MsgRenewAuthority{Name: "renew", Periods: 1, Signer: owner})
+	sr.ErrorContains(err, "Authority is not active.")
+}
+
+func (kts *KeeperTestSuite) TestNameAccess() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+	grantee := simtestutil.AddTestAddrs(kts.BankKeeper, integrationTest.BondDenomProvider{}, ctx, 1, math.NewInt(100000000000))[0].String()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "NameAccessRecord"}, secp256k1.GenPrivKey()),
+	})
+	sr.NoError(err)
+
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "access", Signer: owner, Owner: owner}))
+	sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "access", BondId: kts.bond.GetId(), Signer: owner}))
+
+	setName := func(ctx sdk.Context, lrn string) error {
+		return k.SetName(ctx, types.MsgSetName{Lrn: lrn, Cid: record.Id, Signer: grantee})
+	}
+
+	sr.ErrorContains(setName(ctx, "lrn://access/apps/web"), "Access denied.")
+
+	for _, lrnPrefix := range []string{"access/apps/*", "lrn://access/apps*", "lrn://access/*/web", "lrn://access"} {
+		err := types.MsgGrantNameAccess{LrnPrefix: lrnPrefix, Grantee: grantee, Signer: owner}.ValidateBasic()
+		sr.Error(err, lrnPrefix)
+	}
+	err = k.GrantNameAccess(ctx, types.MsgGrantNameAccess{LrnPrefix: "lrn://access/apps/*", Grantee: grantee, Signer: grantee})
+	sr.ErrorContains(err, "Access denied.")
+	past := ctx.BlockTime().Add(-time.Hour)
+	err = k.GrantNameAccess(ctx, types.MsgGrantNameAccess{LrnPrefix: "lrn://access/apps/*", Grantee: grantee, ExpiryTime: &past, Signer: owner})
+	sr.ErrorContains(err, "Expiry time must be in the future.")
+
+	// A prefix grant covers the names under the path.
+	sr.NoError(k.GrantNameAccess(ctx, types.MsgGrantNameAccess{LrnPrefix: "lrn://access/apps/*", Grantee: grantee, Signer: owner}))
+	sr.NoError(setName(ctx, "lrn://access/apps/web"))
+	sr.NoError(setName(ctx, "lrn://access/apps/web/v2"))
+	sr.ErrorContains(setName(ctx, "lrn://access/apps"), "Access denied.")
+	sr.ErrorContains(setName(ctx, "lrn://access/other"), "Access denied.")
+	sr.NoError(k.DeleteName(ctx, types.MsgDeleteName{Lrn: "lrn://access/apps/web/v2", Signer: grantee}))
+
+	// An LRN grant only covers the LRN, until it expires.
+	expiry := ctx.BlockTime().Add(time.Hour)
+	sr.NoError(k.GrantNameAccess(ctx, types.MsgGrantNameAccess{LrnPrefix: "lrn://access/docs", Grantee: grantee, ExpiryTime: &expiry, Signer: owner}))
+	sr.NoError(setName(ctx, "lrn://access/docs"))
+	sr.ErrorContains(setName(ctx, "lrn://access/docs/v2"), "Access denied.")
+	sr.ErrorContains(setName(ctx.WithBlockTime(expiry), "lrn://access/docs"), "Access denied.")
+
+	resp, err := kts.queryClient.NameAccessGrants(context.Background(), &types.QueryNameAccessGrantsRequest{Name: "access"})
+	sr.NoError(err)
+	sr.Equal(2, len(resp.Grants))
+	resp, err = kts.queryClient.NameAccessGrants(context.Background(), &types.QueryNameAccessGrantsRequest{Name: "access", Grantee: owner})
+	sr.NoError(err)
+	sr.Empty(resp.Grants)
+
+	// Revoked grants no longer apply.
+	sr.NoError(k.RevokeNameAccess(ctx, types.MsgRevokeNameAccess{LrnPrefix: "lrn://access/docs", Grantee: grantee, Signer: owner}))
+	sr.ErrorContains(setName(ctx, "lrn://access/docs"), "Access denied.")
+	err = k.RevokeNameAccess(ctx, types.MsgRevokeNameAccess{LrnPrefix: "lrn://access/docs", Grantee: grantee, Signer: owner})
+	sr.ErrorContains(err, "Name access grant not found.")
+
+	// Grants lapse when the authority changes owner.
+	_, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "access", NewOwner: grantee, KeepBond: true, Signer: owner})
+	sr.NoError(err)
+	_, err = k.TransferAuthority(ctx, types.MsgTransferAuthority{Name: "access", NewOwner: owner, KeepBond: true, Signer: grantee})
+	sr.NoError(err)
+	sr.ErrorContains(setName(ctx, "lrn://access/apps/web"), "Access denied.")
+}
+
+func (kts *KeeperTestSuite) TestGrpcResolveLrnAt() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+
+	var recordIds []string
+	for _, version := range []string{"1.0.0", "2.0.0", "3.0.0"} {
+		record, err := k.SetRecord(ctx, types.MsgSetRecord{
+			BondId:  kts.bond.GetId(),
+			Signer:  owner,
+			Payload: kts.signedPayload(types.AttributeMap{"type": "PinnedRecord", "version": version}, secp256k1.GenPrivKey()),
+		})
+		sr.NoError(err)
+		recordIds = append(recordIds, record.Id)
+	}
+
+	sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: "pinned", Signer: owner, Owner: owner}))
+	sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: "pinned", BondId: kts.bond.GetId(), Signer: owner}))
+	for i, recordId := range recordIds {
+		height := int64(10 * (i + 1))
+		sr.NoError(k.SetName(ctx.WithBlockHeight(height), types.MsgSetName{Lrn: "lrn://pinned/app", Cid: recordId, Signer: owner}))
+	}
+	// A name that itself ends in a version suffix.
+	sr.NoError(k.SetName(ctx, types.MsgSetName{Lrn: "lrn://pinned/lib@v2", Cid: recordIds[0], Signer: owner}))
+
+	testCases := []struct {
+		msg      string
+		req      *types.QueryResolveLrnRequest
+		recordId string
+		err      string
+	}{
+		{"latest", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app"}, recordIds[2], ""},
+		{"version suffix", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v1"}, recordIds[0], ""},
+		{"version", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app", Version: 2}, recordIds[1], ""},
+		{"matching version and suffix", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v2", Version: 2}, recordIds[1], ""},
+		{"height", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app", Height: 25}, recordIds[1], ""},
+		{"exact height", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app", Height: 30}, recordIds[2], ""},
+		{"height before the name was set", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app", Height: 5}, "", "record not found."},
+		{"version not set yet", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v4"}, "", "record not found."},
+		{"version zero", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v0"}, "", "Invalid LRN version."},
+		{"mismatching version", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v1", Version: 2}, "", "LRN version doesn't match the requested version."},
+		{"height and version", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v1", Height: 25}, "", "Only one of height and version can be requested."},
+		{"name ending in a version suffix", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/lib@v2"}, recordIds[0], ""},
+		{"version of a name ending in a version suffix", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/lib@v2@v1"}, recordIds[0], ""},
+		{"name ending in a version suffix with a version", &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/lib@v2", Version: 1}, recordIds[0], ""},
+	}
+	for _, test := range testCases {
+		kts.Run(fmt.Sprintf("Case %s ", test.msg), func() {
+			resp, err := kts.queryClient.ResolveLrn(context.Background(), test.req)
+			if test.err != "" {
+				sr.ErrorContains(err, test.err)
+				return
+			}
+
+			sr.NoError(err)
+			sr.Equal(test.recordId, resp.GetRecord().Id)
+		})
+	}
+
+	lookup, err := kts.queryClient.LookupLrn(context.Background(), &types.QueryLookupLrnRequest{Lrn: "lrn://pinned/app", Height: 25})
+	sr.NoError(err)
+	sr.Equal(recordIds[1], lookup.Name.Latest.Id)
+	sr.Equal(uint64(20), lookup.Name.Latest.Height)
+	sr.Len(lookup.Name.History, 1)
+	sr.Equal(recordIds[0], lookup.Name.History[0].Id)
+
+	lookup, err = kts.queryClient.LookupLrn(context.Background(), &types.QueryLookupLrnRequest{Lrn: "lrn://pinned/lib@v2"})
+	sr.NoError(err)
+	sr.Equal(recordIds[0], lookup.Name.Latest.Id)
+
+	// Versions set before the authority was re-registered are stale.
+	authority, err := k.GetNameAuthority(ctx, "pinned")
+	sr.NoError(err)
+	authority.Height = 20
+	sr.NoError(k.SaveNameAuthority(ctx, "pinned", &authority))
+
+	_, err = kts.queryClient.ResolveLrn(context.Background(), &types.QueryResolveLrnRequest{Lrn: "lrn://pinned/app@v1"})
+	sr.ErrorContains(err, "record not found.")
+	_, err = kts.queryClient.LookupLrn(context.Background(), &types.QueryLookupLrnRequest{Lrn: "lrn://pinned/app", Version: 1})
+	sr.ErrorContains(err, "name record not found.")
+
+	resolved, err := k.ResolveLRNAt(ctx, "lrn://pinned/app", 0, 2)
+	sr.NoError(err)
+	sr.Equal(recordIds[1], resolved.Id)
+}
+
+func (kts *KeeperTestSuite) TestGrpcQueryNamesByPrefix() {
+	ctx, k := kts.SdkCtx, kts.RegistryKeeper
+	sr := kts.Require()
+
+	owner := kts.accounts[0].String()
+
+	record, err := k.SetRecord(ctx, types.MsgSetRecord{
+		BondId:  kts.bond.GetId(),
+		Signer:  owner,
+		Payload: kts.signedPayload(types.AttributeMap{"type": "ListedRecord"}, secp256k1.GenPrivKey()),
+	})
+	sr.NoError(err)
+
+	for _, name := range []string{"listed", "listedx"} {
+		sr.NoError(k.ReserveAuthority(ctx, types.MsgReserveAuthority{Name: name, Signer: owner, Owner: owner}))
+		sr.NoError(k.SetAuthorityBond(ctx, types.MsgSetAuthorityBond{Name: name, BondId: kts.bond.GetId(), Signer: owner}))
+	}
+
+	setName := func(height int64, lrn string) {
+		sr.NoError(k.SetName(ctx.WithBlockHeight(height), types.MsgSetName{Lrn: lrn, Cid: record.Id, Signer: owner}))
+	}
+	setName(10, "lrn://listed/app")
+	setName(10, "lrn://listed/apps/api")
+	setName(10, "lrn://listed/apps/web")
+	setName(10, "lrn://listedx/app")
+	sr.NoError(k.DeleteName(ctx.WithBlockHeight(20), types.MsgDeleteName{Lrn: "lrn://listed/apps/web", Signer: owner}))
+
+	// Names set before the authority was re-registered are stale.
+	authority, err := k.GetNameAuthority(ctx, "listed")
+	sr.NoError(err)
+	authority.Height = 15
+	sr.NoError(k.SaveNameAuthority(ctx, "listed", &authority))
+	setName(30, "lrn://listed/apps/api")
+
+	resp, err := kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{Prefix: "lrn://listed/"})
+	sr.NoError(err)
+	sr.Equal(uint64(3), resp.Pagination.Total)
+
+	names := map[string]types.NamePrefixEntry{}
+	for _, name := range resp.Names {
+		names[name.Name] = name
+	}
+	sr.Len(names, 3)
+	sr.True(names["lrn://listed/app"].Stale)
+	sr.False(names["lrn://listed/app"].Deleted)
+	sr.False(names["lrn://listed/apps/api"].Stale)
+	sr.False(names["lrn://listed/apps/api"].Deleted)
+	sr.Equal(record.Id, names["lrn://listed/apps/api"].Entry.Latest.Id)
+	sr.False(names["lrn://listed/apps/web"].Stale)
+	sr.True(names["lrn://listed/apps/web"].Deleted)
+
+	// A bare authority prefix doesn't match other authorities, and prefixes are paginated by key.
+	resp, err = kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{
+		Prefix:     "lrn://listed",
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	sr.NoError(err)
+	sr.Len(resp.Names, 2)
+	sr.Equal("lrn://listed/app", resp.Names[0].Name)
+	sr.NotEmpty(resp.Pagination.NextKey)
+
+	resp, err = kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{
+		Prefix:     "lrn://listed",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	sr.NoError(err)
+	sr.Len(resp.Names, 1)
+	sr.Equal("lrn://listed/apps/web", resp.Names[0].Name)
+	sr.Empty(resp.Pagination.NextKey)
+
+	// Names of expired authorities are stale.
+	authority, err = k.GetNameAuthority(ctx, "listedx")
+	sr.NoError(err)
+	authority.Status = types.AuthorityExpired
+	sr.NoError(k.SaveNameAuthority(ctx, "listedx", &authority))
+	resp, err = kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{Prefix: "lrn://listedx"})
+	sr.NoError(err)
+	sr.Len(resp.Names, 1)
+	sr.Equal("lrn://listedx/app", resp.Names[0].Name)
+	sr.True(resp.Names[0].Stale)
+
+	resp, err = kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{Prefix: "lrn://listed/apps/"})
+	sr.NoError(err)
+	sr.Len(resp.Names, 2)
+
+	_, err = kts.queryClient.NamesByPrefix(context.Background(), &types.QueryNamesByPrefixRequest{Prefix: "listed/"})
+	sr.ErrorContains(err, "Invalid LRN prefix.")
+}
@@ -0,0 +1,256 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/fluent/qp"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+// carVersion is the version of the CAR archives read and written (CARv1).
+const carVersion = 1
+
+// maxCARSectionSize bounds the size of a single CAR section (header or block) when reading.
+const maxCARSectionSize = 32 << 20
+
+// dagCBORPrefix is the CID prefix of dag-cbor blocks, same as used by CIDFromJSONBytes.
+var dagCBORPrefix = cid.Prefix{
+	Version:  1,
+	Codec:    cid.DagCBOR,
+	MhType:   0x12,
+	MhLength: 32,
+}
+
+// Block is a content addressed block of data.
+type Block struct {
+	Cid  cid.Cid
+	Data []byte
+}
+
+// BlockFromJSONBytes returns the dag-cbor block for json (as bytes),
+// addressed by the same CID as returned by CIDFromJSONBytes.
+func BlockFromJSONBytes(content []byte) (Block, error) {
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagjson.Decode(nb, bytes.NewReader(content)); err != nil {
+		return Block{}, err
+	}
+
+	return BlockFromNode(nb.Build())
+}
+
+// BlockFromNode encodes an IPLD node as a dag-cbor block.
+func BlockFromNode(n datamodel.Node) (Block, error) {
+	var buf bytes.Buffer
+	if err := dagcbor.Encode(n, &buf); err != nil {
+		return Block{}, err
+	}
+
+	return NewBlock(buf.Bytes())
+}
+
+// NewBlock addresses dag-cbor encoded data as a block.
+func NewBlock(data []byte) (Block, error) {
+	c, err := dagCBORPrefix.Sum(data)
+	if err != nil {
+		return Block{}, err
+	}
+
+	return Block{Cid: c, Data: data}, nil
+}
+
+// DecodeBlock decodes a dag-cbor block into an IPLD node.
+func DecodeBlock(block Block) (datamodel.Node, error) {
+	if block.Cid.Prefix().Codec != cid.DagCBOR {
+		return nil, fmt.Errorf("block %s is not dag-cbor", block.Cid)
+	}
+
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagcbor.Decode(nb, bytes.NewReader(block.Data)); err != nil {
+		return nil, err
+	}
+
+	return nb.Build(), nil
+}
+
+// WriteCAR writes a CARv1 archive with the given roots and blocks.
+// See: https://ipld.io/specs/transport/car/carv1/
+func WriteCAR(w io.Writer, roots []cid.Cid, blocks []Block) error {
+	header, err := qp.BuildMap(basicnode.Prototype.Any, 2, func(ma datamodel.MapAssembler) {
+		qp.MapEntry(ma, "roots", qp.List(int64(len(roots)), func(la datamodel.ListAssembler) {
+			for _, root := range roots {
+				qp.ListEntry(la, qp.Link(cidlink.Link{Cid: root}))
+			}
+		}))
+		qp.MapEntry(ma, "version", qp.Int(carVersion))
+	})
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := dagcbor.Encode(header, &buf); err != nil {
+		return err
+	}
+	if err := writeCARSection(w, buf.Bytes()); err != nil {
+		return err
+	}
+
+	for _, block := range blocks {
+		if err := writeCARSection(w, block.Cid.Bytes(), block.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadCAR reads a CARv1 archive, verifying that the data of each block hashes to its CID.
+func ReadCAR(r io.Reader) ([]cid.Cid, []Block, error) {
+	br := bufio.NewReader(r)
+
+	headerBytes, err := readCARSection(br)
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil, errors.New("missing CAR header")
+		}
+		return nil, nil, err
+	}
+
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagcbor.Decode(nb, bytes.NewReader(headerBytes)); err != nil {
+		return nil, nil, fmt.Errorf("invalid CAR header: %w", err)
+	}
+	header := nb.Build()
+
+	versionNode, err := header.LookupByString("version")
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CAR header: %w", err)
+	}
+	if version, err := versionNode.AsInt(); err != nil || version != carVersion {
+		return nil, nil, fmt.Errorf("unsupported CAR version")
+	}
+
+	rootsNode, err := header.LookupByString("roots")
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CAR header: %w", err)
+	}
+	roots, err := NodeLinks(rootsNode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CAR roots: %w", err)
+	}
+
+	var blocks []Block
+	for {
+		section, err := readCARSection(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		n, c, err := cid.CidFromBytes(section)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid block CID: %w", err)
+		}
+
+		data := section[n:]
+		sum, err := c.Prefix().Sum(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !sum.Equals(c) {
+			return nil, nil, fmt.Errorf("block data does not match CID %s", c)
+		}
+
+		blocks = append(blocks, Block{Cid: c, Data: data})
+	}
+
+	return roots, blocks, nil
+}
+
+// NodeLinks returns the CIDs of a list of links.
+func NodeLinks(n datamodel.Node) ([]cid.Cid, error) {
+	if n.Kind() != datamodel.Kind_List {
+		return nil, errors.New("not a list")
+	}
+
+	cids := make([]cid.Cid, 0, n.Length())
+	for it := n.ListIterator(); !it.Done(); {
+		_, v, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := NodeLink(v)
+		if err != nil {
+			return nil, err
+		}
+		cids = append(cids, c)
+	}
+
+	return cids, nil
+}
+
+// NodeLink returns the CID of a link node.
+func NodeLink(n datamodel.Node) (cid.Cid, error) {
+	lnk, err := n.AsLink()
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	cl, ok := lnk.(cidlink.Link)
+	if !ok {
+		return cid.Undef, errors.New("not a CID link")
+	}
+
+	return cl.Cid, nil
+}
+
+func writeCARSection(w io.Writer, parts ...[]byte) error {
+	size := 0
+	for _, part := range parts {
+		size += len(part)
+	}
+
+	if _, err := w.Write(binary.AppendUvarint(nil, uint64(size))); err != nil {
+		return err
+	}
+	for _, part := range parts {
+		if _, err := w.Write(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readCARSection(br *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(br)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("invalid CAR section: %w", err)
+	}
+	if size == 0 || size > maxCARSectionSize {
+		return nil, fmt.Errorf("invalid CAR section size: %d", size)
+	}
+
+	section := make([]byte, size)
+	if _, err := io.ReadFull(br, section); err != nil {
+		return nil, fmt.Errorf("truncated CAR section: %w", err)
+	}
+
+	return section, nil
+}
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCARRoundTrip(t *testing.T) {
+	content := []byte("{\"type\":\"WebsiteRegistrationRecord\",\"url\":\"https://cerc.io\",\"version\":\"0.0.1\"}")
+
+	block, err := BlockFromJSONBytes(content)
+	require.NoError(t, err)
+
+	expected, err := CIDFromJSONBytes(content)
+	require.NoError(t, err)
+	require.Equal(t, expected, block.Cid.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCAR(&buf, []cid.Cid{block.Cid}, []Block{block}))
+
+	roots, blocks, err := ReadCAR(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{block.Cid}, roots)
+	require.Equal(t, []Block{block}, blocks)
+
+	// Block data not matching its CID is rejected.
+	car := buf.Bytes()
+	car[len(car)-1] ^= 0xff
+	_, _, err = ReadCAR(bytes.NewReader(car))
+	require.ErrorContains(t, err, "block data does not match CID")
+}
@@ -1,18 +1,61 @@
 package utils
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	"github.com/statechannels/go-nitro/crypto"
 )
 
 func DecodeEthereumAddress(message []byte, sig string) (string, error) {
-	if len(sig) > 2 && sig[:2] == "0x" {
-		sig = sig[2:]
+	sigBytes, err := decodeEthereumSignature(sig)
+	if err != nil {
+		return "", err
 	}
 
-	signature := crypto.SplitSignature(common.Hex2Bytes(sig))
+	signature := crypto.SplitSignature(sigBytes)
 	ethereumAddress, err := crypto.RecoverEthereumMessageSigner(message, signature)
 
 	return ethereumAddress.String(), err
 }
+
+// DecodeEIP712Address recovers the (checksummed) address of the signer of EIP-712 typed data.
+func DecodeEIP712Address(typedData apitypes.TypedData, sig string) (string, error) {
+	sigBytes, err := decodeEthereumSignature(sig)
+	if err != nil {
+		return "", err
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return "", err
+	}
+
+	// Compatibility with the ecrecover precompile.
+	if sigBytes[ethcrypto.RecoveryIDOffset] >= 27 {
+		sigBytes[ethcrypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := ethcrypto.SigToPub(hash, sigBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return ethcrypto.PubkeyToAddress(*pubKey).String(), nil
+}
+
+func decodeEthereumSignature(sig string) ([]byte, error) {
+	if len(sig) > 2 && sig[:2] == "0x" {
+		sig = sig[2:]
+	}
+
+	sigBytes := common.Hex2Bytes(sig)
+	if len(sigBytes) != ethcrypto.SignatureLength {
+		return nil, errors.New("invalid signature length")
+	}
+
+	return sigBytes, nil
+}
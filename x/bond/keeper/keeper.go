@@ -409,3 +409,30 @@ func (k Keeper) TransferCoinsToModuleAccount(ctx sdk.Context, id, moduleAccount
 
 	return err
 }
+
+// TransferCoinsToBond moves funds from a module account back to a bond.
+func (k Keeper) TransferCoinsToBond(ctx sdk.Context, id, moduleAccount string, coins sdk.Coins) error {
+	if has, err := k.HasBond(ctx, id); !has {
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Bond not found.")
+	}
+
+	bond, err := k.GetBondById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Move funds from the module account to bond module.
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, moduleAccount, bondtypes.ModuleName, coins)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Error transferring funds.")
+	}
+
+	// Update bond balance.
+	bond.Balance = bond.Balance.Add(coins...)
+	err = k.SaveBond(ctx, &bond)
+
+	return err
+}
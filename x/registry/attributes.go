@@ -0,0 +1,201 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gibson042/canonicaljson-go"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+
+	"git.vdb.to/cerc-io/laconicd/utils"
+)
+
+// Record attributes are stored as canonical DAG-CBOR, i.e. the block the record id (CID) addresses.
+// Record payloads carry attributes as JSON, which is converted through its canonical form (as signed by owners).
+
+// EncodeAttributes encodes record attributes as canonical DAG-CBOR.
+func EncodeAttributes(attrs AttributeMap) ([]byte, error) {
+	content, err := canonicaljson.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := utils.BlockFromJSONBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return block.Data, nil
+}
+
+// AttributesFromJSON encodes JSON record attributes (as in record payloads) as canonical DAG-CBOR.
+func AttributesFromJSON(content []byte) ([]byte, error) {
+	attrs, err := DecodeJSONAttributes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return EncodeAttributes(attrs)
+}
+
+// DecodeJSONAttributes decodes JSON record attributes (as in record payloads) through DAG-JSON,
+// so that integers are kept as int64 rather than converted to float64, as by encoding/json.
+func DecodeJSONAttributes(content []byte) (AttributeMap, error) {
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagjson.Decode(nb, bytes.NewReader(content)); err != nil {
+		return nil, fmt.Errorf("failed to decode attributes: %w", err)
+	}
+
+	return attributeMap(nb.Build())
+}
+
+// IsJSONAttributes reports whether encoded record attributes are JSON (as stored before
+// consensus version 2) rather than DAG-CBOR. A CBOR map never starts with '{'.
+func IsJSONAttributes(data []byte) bool {
+	return len(data) > 0 && data[0] == '{'
+}
+
+// jsonDepth returns the nesting depth of objects and arrays in JSON, without decoding it.
+func jsonDepth(content []byte) int {
+	depth, maxDepth := 0, 0
+	inString, escaped := false, false
+	for _, c := range content {
+		switch {
+		case inString:
+			if escaped {
+				escaped = false
+			} else if c == '\\' {
+				escaped = true
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '{' || c == '[':
+			depth++
+			maxDepth = max(maxDepth, depth)
+		case c == '}' || c == ']':
+			depth--
+		}
+	}
+
+	return maxDepth
+}
+
+// DecodeAttributesNode decodes DAG-CBOR record attributes into an IPLD node.
+func DecodeAttributesNode(data []byte) (ipld.Node, error) {
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagcbor.Decode(nb, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("failed to decode attributes: %w", err)
+	}
+
+	return nb.Build(), nil
+}
+
+// DecodeAttributes decodes DAG-CBOR record attributes. Integers are decoded as int64, and
+// bytes and links are represented as in DAG-JSON, so that the attributes hash to the same CID.
+func DecodeAttributes(data []byte) (AttributeMap, error) {
+	n, err := DecodeAttributesNode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return attributeMap(n)
+}
+
+// attributeMap converts a decoded attributes node to a map.
+func attributeMap(n ipld.Node) (AttributeMap, error) {
+	if n.Kind() == ipld.Kind_Null {
+		return nil, nil
+	}
+	if n.Kind() != ipld.Kind_Map {
+		return nil, fmt.Errorf("record attributes must be a map, not %s", n.Kind())
+	}
+
+	value, err := attributeValue(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return AttributeMap(value.(map[string]interface{})), nil
+}
+
+// AttributesJSON returns the DAG-JSON representation of DAG-CBOR record attributes.
+func AttributesJSON(data []byte) ([]byte, error) {
+	n, err := DecodeAttributesNode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := dagjson.Encode(n, &buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func attributeValue(n ipld.Node) (interface{}, error) {
+	switch n.Kind() {
+	case ipld.Kind_Null:
+		return nil, nil
+	case ipld.Kind_Bool:
+		return n.AsBool()
+	case ipld.Kind_Int:
+		return n.AsInt()
+	case ipld.Kind_Float:
+		return n.AsFloat()
+	case ipld.Kind_String:
+		return n.AsString()
+	case ipld.Kind_Bytes:
+		b, err := n.AsBytes()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"/": map[string]interface{}{"bytes": base64.RawStdEncoding.EncodeToString(b)}}, nil
+	case ipld.Kind_Link:
+		link, err := n.AsLink()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"/": link.String()}, nil
+	case ipld.Kind_List:
+		values := make([]interface{}, 0, n.Length())
+		for it := n.ListIterator(); !it.Done(); {
+			_, elem, err := it.Next()
+			if err != nil {
+				return nil, err
+			}
+			value, err := attributeValue(elem)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case ipld.Kind_Map:
+		values := make(map[string]interface{}, n.Length())
+		for it := n.MapIterator(); !it.Done(); {
+			k, v, err := it.Next()
+			if err != nil {
+				return nil, err
+			}
+			key, err := k.AsString()
+			if err != nil {
+				return nil, err
+			}
+			value, err := attributeValue(v)
+			if err != nil {
+				return nil, err
+			}
+			values[key] = value
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unexpected attribute kind %s", n.Kind())
+	}
+}
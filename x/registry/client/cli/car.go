@@ -0,0 +1,320 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/fluent/qp"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/spf13/cobra"
+
+	"git.vdb.to/cerc-io/laconicd/utils"
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+const (
+	FlagNames = "names"
+
+	// Page size used to list records and names for export.
+	carExportPageSize = 1000
+)
+
+// CAR archive root block fields: a list of links to the record blocks,
+// and (optionally) a map from names to links to the record blocks they resolve to.
+const (
+	carRootRecords = "records"
+	carRootNames   = "names"
+)
+
+// CARSummary describes the contents of a registry CAR archive.
+type CARSummary struct {
+	Root    string `json:"root"`
+	Height  int64  `json:"height,omitempty"`
+	Records int    `json:"records"`
+	Names   int    `json:"names"`
+}
+
+// GetCmdExportCAR exports the live registry records (and optionally names) as a CAR archive.
+func GetCmdExportCAR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-car [output-file-path]",
+		Short: "Export registry records as a CAR archive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the live registry records as a CARv1 archive of dag-cbor blocks, addressed by the record ids.
+The archive root lists the records, and with --names also maps names to the records they resolve to.
+Use --height to export the registry contents at a given block height.
+Example:
+$ %s query %s export-car registry.car --height 1000 --names
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withNames, err := cmd.Flags().GetBool(FlagNames)
+			if err != nil {
+				return err
+			}
+
+			queryClient := registrytypes.NewQueryClient(clientCtx)
+
+			var records []registrytypes.Record
+			pageReq := &query.PageRequest{Limit: carExportPageSize}
+			for {
+				res, err := queryClient.Records(cmd.Context(), &registrytypes.QueryRecordsRequest{Pagination: pageReq})
+				if err != nil {
+					return err
+				}
+
+				records = append(records, res.GetRecords()...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: carExportPageSize}
+			}
+
+			var names []registrytypes.NameEntry
+			if withNames {
+				pageReq := &query.PageRequest{Limit: carExportPageSize}
+				for {
+					res, err := queryClient.NameRecords(cmd.Context(), &registrytypes.QueryNameRecordsRequest{Pagination: pageReq})
+					if err != nil {
+						return err
+					}
+
+					names = append(names, res.GetNames()...)
+					if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+						break
+					}
+					pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: carExportPageSize}
+				}
+			}
+
+			file, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			summary, err := WriteRegistryCAR(file, records, names, withNames)
+			if err != nil {
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+			summary.Height = clientCtx.Height
+
+			bytesResult, err := json.Marshal(summary)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bytesResult)
+		},
+	}
+
+	cmd.Flags().Bool(FlagNames, false, "Include the map from names to records in the archive.")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdImportCAR verifies a registry CAR archive, without importing it anywhere.
+func GetCmdImportCAR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-car [car-file-path]",
+		Short: "Verify a registry CAR archive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify a registry CAR archive (see export-car): every block must hash to its CID,
+and every record and name linked from the root must be in the archive.
+Example:
+$ %s query %s import-car registry.car
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			summary, err := VerifyRegistryCAR(file)
+			if err != nil {
+				return err
+			}
+
+			bytesResult, err := json.Marshal(summary)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bytesResult)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// WriteRegistryCAR writes the live (non deleted) records as a CAR archive, with a root block
+// linking to them and, if withNames is set, mapping names to the live records they resolve to.
+func WriteRegistryCAR(w io.Writer, records []registrytypes.Record, names []registrytypes.NameEntry, withNames bool) (*CARSummary, error) {
+	blocks := make([]utils.Block, 0, len(records)+1)
+	live := make(map[string]cid.Cid, len(records))
+	for _, record := range records {
+		if record.Deleted {
+			continue
+		}
+
+		block, err := utils.NewBlock(record.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		if block.Cid.String() != record.Id {
+			return nil, fmt.Errorf("record %s attributes hash to %s", record.Id, block.Cid)
+		}
+
+		blocks = append(blocks, block)
+		live[record.Id] = block.Cid
+	}
+
+	var namedRecords []registrytypes.NameEntry
+	for _, name := range names {
+		if name.Entry == nil || name.Entry.Latest == nil {
+			continue
+		}
+		if _, ok := live[name.Entry.Latest.Id]; ok {
+			namedRecords = append(namedRecords, name)
+		}
+	}
+
+	root, err := qp.BuildMap(basicnode.Prototype.Any, 2, func(ma datamodel.MapAssembler) {
+		qp.MapEntry(ma, carRootRecords, qp.List(int64(len(blocks)), func(la datamodel.ListAssembler) {
+			for _, block := range blocks {
+				qp.ListEntry(la, qp.Link(cidlink.Link{Cid: block.Cid}))
+			}
+		}))
+		if withNames {
+			qp.MapEntry(ma, carRootNames, qp.Map(int64(len(namedRecords)), func(ma datamodel.MapAssembler) {
+				for _, name := range namedRecords {
+					qp.MapEntry(ma, name.Name, qp.Link(cidlink.Link{Cid: live[name.Entry.Latest.Id]}))
+				}
+			}))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rootBlock, err := utils.BlockFromNode(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.WriteCAR(w, []cid.Cid{rootBlock.Cid}, append([]utils.Block{rootBlock}, blocks...)); err != nil {
+		return nil, err
+	}
+
+	return &CARSummary{Root: rootBlock.Cid.String(), Records: len(blocks), Names: len(namedRecords)}, nil
+}
+
+// VerifyRegistryCAR reads a registry CAR archive, verifying its blocks and root block links.
+func VerifyRegistryCAR(r io.Reader) (*CARSummary, error) {
+	roots, blocks, err := utils.ReadCAR(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, fmt.Errorf("expected a single root, got %d", len(roots))
+	}
+
+	blocksByCid := make(map[cid.Cid]utils.Block, len(blocks))
+	for _, block := range blocks {
+		blocksByCid[block.Cid] = block
+	}
+
+	rootBlock, ok := blocksByCid[roots[0]]
+	if !ok {
+		return nil, fmt.Errorf("root block %s not found", roots[0])
+	}
+	root, err := utils.DecodeBlock(rootBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	recordsNode, err := root.LookupByString(carRootRecords)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root block: %w", err)
+	}
+	records, err := utils.NodeLinks(recordsNode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root block records: %w", err)
+	}
+	for _, c := range records {
+		block, ok := blocksByCid[c]
+		if !ok {
+			return nil, fmt.Errorf("record block %s not found", c)
+		}
+		if _, err := utils.DecodeBlock(block); err != nil {
+			return nil, fmt.Errorf("invalid record block %s: %w", c, err)
+		}
+	}
+
+	summary := &CARSummary{Root: roots[0].String(), Records: len(records)}
+
+	namesNode, err := root.LookupByString(carRootNames)
+	if err != nil {
+		if _, ok := err.(datamodel.ErrNotExists); ok {
+			return summary, nil
+		}
+		return nil, fmt.Errorf("invalid root block: %w", err)
+	}
+	if namesNode.Kind() != datamodel.Kind_Map {
+		return nil, fmt.Errorf("invalid root block names")
+	}
+	for it := namesNode.MapIterator(); !it.Done(); {
+		k, v, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := k.AsString()
+		if err != nil {
+			return nil, err
+		}
+		c, err := utils.NodeLink(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid link for name %s: %w", name, err)
+		}
+		if _, ok := blocksByCid[c]; !ok {
+			return nil, fmt.Errorf("record block %s of name %s not found", c, name)
+		}
+
+		summary.Names++
+	}
+
+	return summary, nil
+}
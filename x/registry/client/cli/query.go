@@ -13,6 +13,71 @@ import (
 	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
 )
 
+// GetQueryCmd returns the query commands (not generated by autocli) for this module.
+func GetQueryCmd() *cobra.Command {
+	registryQueryCmd := &cobra.Command{
+		Use:                        registrytypes.ModuleName,
+		Short:                      "Querying commands for the registry module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	registryQueryCmd.AddCommand(
+		GetCmdVerifyPayload(),
+		GetCmdExportCAR(),
+		GetCmdImportCAR(),
+	)
+
+	return registryQueryCmd
+}
+
+// GetCmdVerifyPayload verifies the signatures of a record payload, without broadcasting.
+func GetCmdVerifyPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-payload [payload-file-path]",
+		Short: "Verify record payload signatures",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify the signatures of a record payload, and print the record CID and the owners recovered from them.
+Example:
+$ %s query %s verify-payload payload.yml
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := GetPayloadFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			cid, owners, err := payload.VerifyPayload()
+			if err != nil {
+				return err
+			}
+
+			bytesResult, err := json.Marshal(struct {
+				Cid    string   `json:"cid"`
+				Owners []string `json:"owners"`
+			}{cid, owners})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(bytesResult)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdList queries all records.
 func GetCmdList() *cobra.Command {
 	cmd := &cobra.Command{
@@ -42,7 +107,9 @@ $ %s query %s list
 			recordsList := res.GetRecords()
 			records := make([]registrytypes.ReadableRecord, len(recordsList))
 			for i, record := range res.GetRecords() {
-				records[i] = record.ToReadableRecord()
+				if records[i], err = record.ToReadableRecord(); err != nil {
+					return err
+				}
 			}
 			bytesResult, err := json.Marshal(records)
 			if err != nil {
@@ -1,20 +1,26 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"gopkg.in/yaml.v3"
 
 	"github.com/spf13/cobra"
 
 	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
 )
 
 // GetTxCmd returns transaction commands for this module.
@@ -29,6 +35,9 @@ func GetTxCmd() *cobra.Command {
 
 	registryTxCmd.AddCommand(
 		GetCmdSetRecord(),
+		GetCmdUpdateRecord(),
+		GetCmdRegisterSchema(),
+		GetCmdSignPayload(),
 	)
 
 	return registryTxCmd
@@ -54,6 +63,72 @@ func GetCmdSetRecord() *cobra.Command {
 			payload := payloadType.ToPayload()
 
 			msg := registrytypes.NewMsgSetRecord(payload, args[1], clientCtx.GetFromAddress())
+			msg.Lifetime, err = cmd.Flags().GetDuration("lifetime")
+			if err != nil {
+				return err
+			}
+			msg.OwnerThreshold, err = cmd.Flags().GetUint32("owner-threshold")
+			if err != nil {
+				return err
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration("lifetime", 0, "Initial record lifetime paid for upfront (defaults to the record rent duration).")
+	cmd.Flags().Uint32("owner-threshold", 0, "Number of owners required to approve record changes (defaults to 1).")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateRecord is the CLI command for creating a new version of an existing record.
+func GetCmdUpdateRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-record [record-id] [payload-file-path]",
+		Short: "Update record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a new version of an existing record, linked to it through its previous id.
+The update must be approved by a threshold of the owners of the existing record: the signer, if an owner,
+and owners signing the update operation (see RecordOperation), given as JSON signatures.
+Example:
+$ %s tx %s update-record [record-id] payload.yml --signatures '{"sig":"...","pub_key":"..."}'
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadType, err := GetPayloadFromFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			payload := payloadType.ToPayload()
+
+			sigs, err := cmd.Flags().GetStringArray("signatures")
+			if err != nil {
+				return err
+			}
+			signatures := make([]registrytypes.Signature, len(sigs))
+			for i, sig := range sigs {
+				if err := json.Unmarshal([]byte(sig), &signatures[i]); err != nil {
+					return fmt.Errorf("invalid signature %q: %w", sig, err)
+				}
+			}
+
+			msg := registrytypes.NewMsgUpdateRecord(args[0], payload, signatures, clientCtx.GetFromAddress())
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -63,11 +138,164 @@ func GetCmdSetRecord() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringArray("signatures", nil, "Approvals of record owners other than the signer, as JSON signatures.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// GetCmdRegisterSchema is the CLI command for registering a record type schema.
+func GetCmdRegisterSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-schema [record-type] [authority] [schema-file-path]",
+		Short: "Register (or update) a record type schema",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register a JSON Schema or IPLD schema (DSL) for a record type, owned by a name authority.
+Record types are namespaced by their authority (<authority>/<Type>), and IPLD schemas define the type by its name.
+Records whose type attribute names the record type are validated against the schema.
+Example:
+$ %s tx %s register-schema cerc-io/WebsiteRegistrationRecord cerc-io schema.json --format json
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+
+			definition, err := os.ReadFile(args[2]) // #nosec G304
+			if err != nil {
+				return err
+			}
+
+			msg := registrytypes.NewMsgRegisterSchema(args[0], args[1], format, string(definition), clientCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String("format", registrytypes.SchemaFormatJSON, "Schema format (json or ipld).")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSignPayload is the CLI command for (offline) signing of a record payload with keyring keys.
+func GetCmdSignPayload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-payload [payload-file-path]",
+		Short: "Sign record payload",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add signatures by one or more keyring keys to a record payload, without broadcasting.
+Existing signatures are kept (and replaced, if made by the same key), so that the record owners can sign in turn.
+Example:
+$ %s tx %s sign-payload payload.yml --from alice,bob --output-document payload.yml
+`,
+				version.AppName, registrytypes.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			keys, err := cmd.Flags().GetStringSlice(flags.FlagFrom)
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 {
+				return fmt.Errorf("at least one key is required (--%s)", flags.FlagFrom)
+			}
+
+			payload, err := GetPayloadFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			if err := SignPayload(clientCtx.Keyring, payload, keys...); err != nil {
+				return err
+			}
+
+			out, err := yaml.Marshal(payload)
+			if err != nil {
+				return err
+			}
+
+			outputDoc, err := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if err != nil {
+				return err
+			}
+			if outputDoc != "" {
+				return os.WriteFile(outputDoc, out, 0o600)
+			}
+
+			_, err = cmd.OutOrStdout().Write(out)
+			return err
+		},
+	}
+
+	cmd.Flags().StringSlice(flags.FlagFrom, nil, "Names or addresses of the keyring keys to sign with.")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the signed payload to the given file instead of STDOUT.")
+	flags.AddKeyringFlags(cmd.Flags())
+
+	return cmd
+}
+
+// SignPayload adds signatures by the given keyring keys (names or addresses) to a record payload.
+func SignPayload(kr keyring.Keyring, payload *registrytypes.ReadablePayload, keys ...string) error {
+	record := registrytypes.ReadableRecord{Attributes: payload.RecordAttributes}
+	signBytes, _ := record.GetSignBytes()
+
+	for _, key := range keys {
+		keyRecord, err := kr.Key(key)
+		if err != nil {
+			address, addrErr := sdk.AccAddressFromBech32(key)
+			if addrErr != nil {
+				return err
+			}
+			if keyRecord, err = kr.KeyByAddress(address); err != nil {
+				return err
+			}
+		}
+
+		sig, pubKey, err := kr.Sign(keyRecord.Name, signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+		if err != nil {
+			return err
+		}
+
+		signature := registrytypes.Signature{
+			Sig:    helpers.BytesToBase64(sig),
+			PubKey: helpers.BytesToBase64(legacy.Cdc.MustMarshal(pubKey)),
+		}
+
+		i := slices.IndexFunc(payload.Signatures, func(s registrytypes.Signature) bool {
+			return s.PubKey == signature.PubKey
+		})
+		if i >= 0 {
+			payload.Signatures[i] = signature
+		} else {
+			payload.Signatures = append(payload.Signatures, signature)
+		}
+	}
+
+	return nil
+}
+
 // GetPayloadFromFile loads payload object from YAML file.
 func GetPayloadFromFile(filePath string) (*registrytypes.ReadablePayload, error) {
 	var payload registrytypes.ReadablePayload
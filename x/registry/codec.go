@@ -12,9 +12,18 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgReserveAuthority{},
 		&MsgDeleteName{},
 		&MsgSetAuthorityBond{},
+		&MsgTransferAuthority{},
+		&MsgAcceptAuthority{},
+		&MsgRenewAuthority{},
+		&MsgGrantNameAccess{},
+		&MsgRevokeNameAccess{},
+		&MsgRegisterSchema{},
 
 		&MsgSetRecord{},
+		&MsgUpdateRecord{},
 		&MsgRenewRecord{},
+		&MsgDeleteRecord{},
+		&MsgTransferRecordOwnership{},
 		&MsgAssociateBond{},
 		&MsgDissociateBond{},
 		&MsgDissociateRecords{},
@@ -1,15 +1,24 @@
 package registry
 
 const (
-	EventTypeSetRecord          = "set"
-	EventTypeDeleteName         = "delete-name"
-	EventTypeReserveAuthority   = "reserve-authority"
-	EventTypeAuthorityBond      = "authority-bond"
-	EventTypeRenewRecord        = "renew-record"
-	EventTypeAssociateBond      = "associate-bond"
-	EventTypeDissociateBond     = "dissociate-bond"
-	EventTypeDissociateRecords  = "dissociate-record"
-	EventTypeReassociateRecords = "re-associate-records"
+	EventTypeSetRecord               = "set"
+	EventTypeDeleteName              = "delete-name"
+	EventTypeReserveAuthority        = "reserve-authority"
+	EventTypeAuthorityBond           = "authority-bond"
+	EventTypeRenewRecord             = "renew-record"
+	EventTypeAssociateBond           = "associate-bond"
+	EventTypeDissociateBond          = "dissociate-bond"
+	EventTypeDissociateRecords       = "dissociate-record"
+	EventTypeReassociateRecords      = "re-associate-records"
+	EventTypeRegisterSchema          = "register-schema"
+	EventTypeUpdateRecord            = "update-record"
+	EventTypeDeleteRecord            = "delete-record"
+	EventTypeTransferRecordOwnership = "transfer-record-ownership"
+	EventTypeTransferAuthority       = "transfer-authority"
+	EventTypeAcceptAuthority         = "accept-authority"
+	EventTypeRenewAuthority          = "renew-authority"
+	EventTypeGrantNameAccess         = "grant-name-access"
+	EventTypeRevokeNameAccess        = "revoke-name-access"
 
 	AttributeKeySigner     = "signer"
 	AttributeKeyOwner      = "owner"
@@ -21,5 +30,23 @@ const (
 	AttributeKeyName       = "name"
 	AttributeKeyLRN        = "lrn"
 	AttributeKeyRecordId   = "record-id"
+	AttributeKeyRecordType = "record-type"
+	AttributeKeyPreviousId = "previous-record-id"
+	AttributeKeyAuthority  = "authority"
+	AttributeKeyRefund     = "refund"
+	AttributeKeyOwners     = "owners"
+	AttributeKeyThreshold  = "owner-threshold"
+	AttributeKeyNewOwner   = "new-owner"
+	AttributeKeyPending    = "pending"
+	AttributeKeyPeriods    = "periods"
+	AttributeKeyLRNPrefix  = "lrn-prefix"
+	AttributeKeyGrantee    = "grantee"
 	AttributeValueCategory = ModuleName
 )
+
+// Reasons for expiring records and authorities, in typed events.
+const (
+	ExpiryReasonBondNotFound      = "bond not found"
+	ExpiryReasonInsufficientFunds = "insufficient funds"
+	ExpiryReasonNoAuctionWinner   = "no auction winner"
+)
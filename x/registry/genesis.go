@@ -1,30 +1,175 @@
 package registry
 
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:      DefaultParams(),
-		Records:     []Record{},
-		Authorities: []AuthorityEntry{},
-		Names:       []NameEntry{},
+		Params:           DefaultParams(),
+		Records:          []Record{},
+		Authorities:      []AuthorityEntry{},
+		Names:            []NameEntry{},
+		Schemas:          []Schema{},
+		NameAccessGrants: []NameAccessGrant{},
 	}
 }
 
-func NewGenesisState(params Params, records []Record, authorities []AuthorityEntry, names []NameEntry) GenesisState {
+func NewGenesisState(
+	params Params,
+	records []Record,
+	authorities []AuthorityEntry,
+	names []NameEntry,
+	schemas []Schema,
+	nameAccessGrants []NameAccessGrant,
+) GenesisState {
 	return GenesisState{
-		Params:      params,
-		Records:     records,
-		Authorities: authorities,
-		Names:       names,
+		Params:           params,
+		Records:          records,
+		Authorities:      authorities,
+		Names:            names,
+		Schemas:          schemas,
+		NameAccessGrants: nameAccessGrants,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
+// failure, including cross-references between records, authorities and names.
+// References to other modules, i.e. the bonds of (non-deleted) records and the auctions
+// of authorities, are checked by InitGenesis against their keepers.
 func (gs *GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
 
+	records := make(map[string]bool, len(gs.Records))
+	for _, record := range gs.Records {
+		if record.Id == "" {
+			return fmt.Errorf("record id is required")
+		}
+		if records[record.Id] {
+			return fmt.Errorf("duplicate record: %s", record.Id)
+		}
+		records[record.Id] = true
+
+		for _, t := range []string{record.CreateTime, record.ExpiryTime} {
+			if _, err := time.Parse(time.RFC3339, t); err != nil {
+				return fmt.Errorf("invalid time for record %s: %w", record.Id, err)
+			}
+		}
+		if int(record.OwnerThreshold) > len(record.Owners) {
+			return fmt.Errorf("owner threshold exceeds the number of owners of record %s", record.Id)
+		}
+	}
+
+	for _, record := range gs.Records {
+		if record.PreviousId != "" && !records[record.PreviousId] {
+			return fmt.Errorf("previous version %s of record %s not found", record.PreviousId, record.Id)
+		}
+	}
+
+	authorities := make(map[string]*NameAuthority, len(gs.Authorities))
+	for _, authority := range gs.Authorities {
+		if authority.Entry == nil {
+			return fmt.Errorf("authority entry is required: %s", authority.Name)
+		}
+		if _, ok := authorities[authority.Name]; ok {
+			return fmt.Errorf("duplicate authority: %s", authority.Name)
+		}
+		authorities[authority.Name] = authority.Entry
+
+		switch authority.Entry.Status {
+		case AuthorityActive, AuthorityInGracePeriod, AuthorityExpired:
+		case AuthorityUnderAuction:
+			if authority.Entry.AuctionId == "" {
+				return fmt.Errorf("auction id is required for authority under auction: %s", authority.Name)
+			}
+		default:
+			return fmt.Errorf("invalid status %q for authority %s", authority.Entry.Status, authority.Name)
+		}
+
+		if authority.Entry.PendingTransfer != nil && authority.Entry.PendingTransfer.NewOwner == "" {
+			return fmt.Errorf("new owner is required for pending transfer of authority %s", authority.Name)
+		}
+	}
+
+	names := make(map[string]bool, len(gs.Names))
+	for _, name := range gs.Names {
+		if name.Entry == nil || name.Entry.Latest == nil {
+			return fmt.Errorf("latest name record entry is required: %s", name.Name)
+		}
+		if names[name.Name] {
+			return fmt.Errorf("duplicate name: %s", name.Name)
+		}
+		names[name.Name] = true
+
+		lrn, err := url.Parse(name.Name)
+		if err != nil {
+			return fmt.Errorf("invalid name %s: %w", name.Name, err)
+		}
+		if _, ok := authorities[lrn.Host]; !ok {
+			return fmt.Errorf("authority %s of name %s not found", lrn.Host, name.Name)
+		}
+
+		height := uint64(0)
+		for _, entry := range name.Entry.History {
+			if entry == nil || entry.Height < height {
+				return fmt.Errorf("name history is not ordered by height: %s", name.Name)
+			}
+			height = entry.Height
+		}
+		if name.Entry.Latest.Height < height {
+			return fmt.Errorf("name history is not ordered by height: %s", name.Name)
+		}
+
+		// Deleted names have an empty record id.
+		entries := append([]*NameRecordEntry{name.Entry.Latest}, name.Entry.History...)
+		for _, entry := range entries {
+			if entry.Id != "" && !records[entry.Id] {
+				return fmt.Errorf("record %s of name %s not found", entry.Id, name.Name)
+			}
+		}
+	}
+
+	schemas := make(map[string]bool, len(gs.Schemas))
+	for _, schema := range gs.Schemas {
+		key := fmt.Sprintf("%s@%d", schema.RecordType, max(schema.Version, 1))
+		if schemas[key] {
+			return fmt.Errorf("duplicate schema: %s", key)
+		}
+		schemas[key] = true
+
+		if authority, _, err := ParseRecordType(schema.RecordType); err != nil || authority != schema.Authority {
+			return fmt.Errorf("record type %s of schema is not namespaced by its authority %s", schema.RecordType, schema.Authority)
+		}
+		if _, ok := authorities[schema.Authority]; !ok {
+			return fmt.Errorf("authority %s of schema %s not found", schema.Authority, schema.RecordType)
+		}
+	}
+
+	grants := make(map[string]bool, len(gs.NameAccessGrants))
+	for _, grant := range gs.NameAccessGrants {
+		name, err := ParseLRNPrefix(grant.LrnPrefix)
+		if err != nil {
+			return fmt.Errorf("invalid LRN prefix %s of name access grant: %w", grant.LrnPrefix, err)
+		}
+		if _, ok := authorities[name]; !ok {
+			return fmt.Errorf("authority %s of name access grant %s not found", name, grant.LrnPrefix)
+		}
+		if grant.Grantee == "" || grant.Granter == "" {
+			return fmt.Errorf("grantee and granter are required for name access grant %s", grant.LrnPrefix)
+		}
+
+		key := grant.Grantee + " " + grant.LrnPrefix
+		if grants[key] {
+			return fmt.Errorf("duplicate name access grant %s to %s", grant.LrnPrefix, grant.Grantee)
+		}
+		grants[key] = true
+	}
+
 	return nil
 }
@@ -33,6 +33,10 @@ type GenesisState struct {
 	Authorities []AuthorityEntry `protobuf:"bytes,3,rep,name=authorities,proto3" json:"authorities" json:"authorities" yaml:"authorities"`
 	// names
 	Names []NameEntry `protobuf:"bytes,4,rep,name=names,proto3" json:"names" json:"names" yaml:"names"`
+	// schemas
+	Schemas []Schema `protobuf:"bytes,5,rep,name=schemas,proto3" json:"schemas" json:"schemas" yaml:"schemas"`
+	// name access grants
+	NameAccessGrants []NameAccessGrant `protobuf:"bytes,6,rep,name=name_access_grants,json=nameAccessGrants,proto3" json:"name_access_grants" json:"name_access_grants" yaml:"name_access_grants"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +100,20 @@ func (m *GenesisState) GetNames() []NameEntry {
 	return nil
 }
 
+func (m *GenesisState) GetSchemas() []Schema {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNameAccessGrants() []NameAccessGrant {
+	if m != nil {
+		return m.NameAccessGrants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cerc.registry.v1.GenesisState")
 }
@@ -103,28 +121,33 @@ func init() {
 func init() { proto.RegisterFile("cerc/registry/v1/genesis.proto", fileDescriptor_6554596e454ab97d) }
 
 var fileDescriptor_6554596e454ab97d = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
-	0x18, 0x85, 0x93, 0xb6, 0xb7, 0x57, 0x72, 0x19, 0x50, 0xc4, 0x10, 0x8a, 0x70, 0x43, 0xa5, 0x22,
-	0x96, 0xda, 0x2a, 0x48, 0x0c, 0x4c, 0x10, 0x09, 0xb1, 0x21, 0x14, 0x58, 0x60, 0x73, 0x53, 0x2b,
-	0x18, 0x35, 0x71, 0xb1, 0x4d, 0x45, 0xde, 0x82, 0xc7, 0xea, 0xd8, 0x91, 0xa9, 0x42, 0xcd, 0xc6,
-	0xc8, 0x13, 0xa0, 0xd8, 0x49, 0x89, 0x08, 0x5b, 0x9c, 0x73, 0xce, 0xf7, 0x0d, 0x3f, 0x80, 0x21,
-	0x15, 0x21, 0x16, 0x34, 0x62, 0x52, 0x89, 0x14, 0xcf, 0x47, 0x38, 0xa2, 0x09, 0x95, 0x4c, 0xa2,
-	0x99, 0xe0, 0x8a, 0x3b, 0xdb, 0x79, 0x8e, 0xca, 0x1c, 0xcd, 0x47, 0xdd, 0x9d, 0x88, 0x47, 0x5c,
-	0x87, 0x38, 0xff, 0x32, 0xbd, 0x6e, 0xaf, 0xc6, 0xd9, 0x6c, 0x74, 0xa1, 0xff, 0xd9, 0x00, 0x5b,
-	0x57, 0x06, 0x7d, 0xab, 0x88, 0xa2, 0xce, 0x29, 0x68, 0xcf, 0x88, 0x20, 0xb1, 0x74, 0x6d, 0xcf,
-	0x3e, 0xea, 0x1c, 0xbb, 0xe8, 0xb7, 0x0a, 0xdd, 0xe8, 0xdc, 0x6f, 0x2d, 0x56, 0x3d, 0x2b, 0x28,
-	0xda, 0xce, 0x3d, 0xf8, 0x2f, 0x68, 0xc8, 0xc5, 0x44, 0xba, 0x0d, 0xaf, 0xf9, 0xf7, 0x30, 0xd0,
-	0x05, 0x7f, 0x90, 0x0f, 0xbf, 0x56, 0xbd, 0xfd, 0x27, 0xc9, 0x93, 0xb3, 0x7e, 0x31, 0xeb, 0x7b,
-	0x29, 0x89, 0xa7, 0x3f, 0xcf, 0xa0, 0xe4, 0x39, 0xcf, 0xa0, 0x43, 0x5e, 0xd4, 0x23, 0x17, 0x4c,
-	0x31, 0x2a, 0xdd, 0xa6, 0xc6, 0x7b, 0x75, 0xfc, 0x45, 0x51, 0x4a, 0x2f, 0x13, 0x25, 0x52, 0x7f,
-	0x58, 0x68, 0x06, 0x46, 0x53, 0x41, 0x94, 0xaa, 0xea, 0xaf, 0xa0, 0xea, 0x70, 0xee, 0xc0, 0xbf,
-	0x84, 0xc4, 0x54, 0xba, 0x2d, 0x2d, 0xdb, 0xab, 0xcb, 0xae, 0x49, 0x4c, 0x8d, 0xe7, 0xa0, 0xf0,
-	0xec, 0x1a, 0x8f, 0xde, 0x95, 0x06, 0xf3, 0x08, 0x0c, 0xcc, 0x3f, 0x5f, 0xac, 0xa1, 0xbd, 0x5c,
-	0x43, 0xfb, 0x63, 0x0d, 0xed, 0xb7, 0x0c, 0x5a, 0xcb, 0x0c, 0x5a, 0xef, 0x19, 0xb4, 0x1e, 0x0e,
-	0x23, 0xa6, 0xd0, 0x7c, 0x32, 0x46, 0x8a, 0xe3, 0x5c, 0x35, 0x64, 0x1c, 0x4f, 0x49, 0xc8, 0x13,
-	0x16, 0x4e, 0xf0, 0xeb, 0xe6, 0x68, 0xe3, 0xb6, 0xbe, 0xda, 0xc9, 0x77, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x5c, 0x17, 0x08, 0x0a, 0x20, 0x02, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4e, 0xea, 0x40,
+	0x18, 0x86, 0xdb, 0xc3, 0xcf, 0x49, 0xca, 0x59, 0x90, 0xe6, 0x2c, 0x2a, 0xc6, 0x52, 0x48, 0x30,
+	0x6e, 0x68, 0x83, 0x24, 0x2e, 0x74, 0x23, 0x4d, 0x0c, 0x3b, 0x63, 0x8a, 0x1b, 0xdd, 0x90, 0x61,
+	0x98, 0x94, 0x1a, 0xda, 0xc1, 0xf9, 0x46, 0x62, 0xef, 0xc1, 0x85, 0x97, 0xc5, 0x92, 0x85, 0x0b,
+	0x57, 0xc4, 0xc0, 0x1d, 0x78, 0x05, 0xa6, 0xd3, 0x16, 0xca, 0x8f, 0xbb, 0x4e, 0xbf, 0xf7, 0x7d,
+	0x9e, 0x99, 0xc9, 0x28, 0x3a, 0x26, 0x0c, 0x5b, 0x8c, 0xb8, 0x1e, 0x70, 0x16, 0x5a, 0xd3, 0x96,
+	0xe5, 0x92, 0x80, 0x80, 0x07, 0xe6, 0x84, 0x51, 0x4e, 0xd5, 0x72, 0x34, 0x37, 0xd3, 0xb9, 0x39,
+	0x6d, 0x55, 0xfe, 0xbb, 0xd4, 0xa5, 0x62, 0x68, 0x45, 0x5f, 0x71, 0xae, 0x52, 0xdd, 0xe3, 0xac,
+	0x3b, 0x22, 0x50, 0xff, 0xc8, 0x2b, 0xff, 0xba, 0x31, 0xba, 0xc7, 0x11, 0x27, 0xea, 0x85, 0x52,
+	0x9c, 0x20, 0x86, 0x7c, 0xd0, 0x64, 0x43, 0x3e, 0x2b, 0x9d, 0x6b, 0xe6, 0xae, 0xca, 0xbc, 0x13,
+	0x73, 0x3b, 0x3f, 0x5b, 0x54, 0x25, 0x27, 0x49, 0xab, 0x0f, 0xca, 0x5f, 0x46, 0x30, 0x65, 0x43,
+	0xd0, 0xfe, 0x18, 0xb9, 0xc3, 0x45, 0x47, 0x04, 0xec, 0x46, 0x54, 0xfc, 0x5e, 0x54, 0x4f, 0x9e,
+	0x80, 0x06, 0x97, 0xf5, 0xa4, 0x56, 0x37, 0x42, 0xe4, 0x8f, 0x37, 0x4b, 0x27, 0xe5, 0xa9, 0xcf,
+	0x4a, 0x09, 0xbd, 0xf0, 0x11, 0x65, 0x1e, 0xf7, 0x08, 0x68, 0x39, 0x81, 0x37, 0xf6, 0xf1, 0x9d,
+	0x24, 0x14, 0xde, 0x04, 0x9c, 0x85, 0x76, 0x33, 0xd1, 0x34, 0x62, 0x4d, 0x06, 0x91, 0xaa, 0xb2,
+	0xbf, 0x9c, 0xac, 0x43, 0xbd, 0x57, 0x0a, 0x01, 0xf2, 0x09, 0x68, 0x79, 0x21, 0x3b, 0xde, 0x97,
+	0xdd, 0x22, 0x9f, 0xc4, 0x9e, 0x5a, 0xe2, 0x39, 0x8a, 0x3d, 0xa2, 0x97, 0x1a, 0xe2, 0x85, 0x13,
+	0xc3, 0xa2, 0x3b, 0x02, 0x3c, 0x22, 0x3e, 0x02, 0xad, 0xf0, 0xdb, 0x1d, 0xf5, 0x44, 0x60, 0xf7,
+	0x8e, 0x92, 0x5a, 0x8a, 0x4d, 0x97, 0x4e, 0xca, 0x53, 0xdf, 0x64, 0x45, 0x8d, 0x24, 0x7d, 0x84,
+	0x31, 0x01, 0xe8, 0xbb, 0x0c, 0x05, 0x1c, 0xb4, 0xa2, 0xd0, 0xd4, 0x0e, 0x6f, 0xbf, 0x23, 0xa2,
+	0xdd, 0x28, 0x69, 0x5f, 0x25, 0xbe, 0xf6, 0xe6, 0x10, 0xdb, 0xa8, 0xec, 0x89, 0x76, 0x26, 0x4e,
+	0x39, 0xd8, 0xa6, 0x81, 0x7d, 0x3d, 0x5b, 0xea, 0xf2, 0x7c, 0xa9, 0xcb, 0x5f, 0x4b, 0x5d, 0x7e,
+	0x5f, 0xe9, 0xd2, 0x7c, 0xa5, 0x4b, 0x9f, 0x2b, 0x5d, 0x7a, 0x3c, 0x75, 0x3d, 0x6e, 0x4e, 0x87,
+	0x03, 0x93, 0x53, 0x2b, 0xda, 0x55, 0xd3, 0xa3, 0xd6, 0x18, 0x61, 0x1a, 0x78, 0x78, 0x68, 0xbd,
+	0xae, 0x9f, 0xe7, 0xa0, 0x28, 0xde, 0x67, 0xfb, 0x67, 0x00, 0xfb, 0xfc, 0x9b, 0x21, 0x0a, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -147,6 +170,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NameAccessGrants) > 0 {
+		for iNdEx := len(m.NameAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NameAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Names) > 0 {
 		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -239,6 +290,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NameAccessGrants) > 0 {
+		for _, e := range m.NameAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -412,6 +475,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, Schema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameAccessGrants = append(m.NameAccessGrants, NameAccessGrant{})
+			if err := m.NameAccessGrants[len(m.NameAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ipld/go-ipld-prime"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// Int, float and string attribute values are also kept in an order-preserving index
+// (path, encoded value, record id) to support range and prefix queries.
+// Encoded values are a type tag followed by an encoding that sorts the same way as the values,
+// so that values of different types are never compared with each other.
+const (
+	rangeValueTagInt    = "i"
+	rangeValueTagFloat  = "f"
+	rangeValueTagString = "s"
+)
+
+// maxQueryRecordIds is the max number of record ids listed by iterating over a store in a records query,
+// e.g. for a range condition.
+const maxQueryRecordIds = 10000
+
+// errTooManyRecordIds is returned when a records query term matches more than maxQueryRecordIds records.
+func errTooManyRecordIds() error {
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Query matches more than %d records, narrow it down with more conditions.", maxQueryRecordIds)
+}
+
+func encodeRangeInt(value int64) string {
+	// Flip the sign bit so that negative values sort before positive ones.
+	return rangeValueTagInt + fmt.Sprintf("%016x", uint64(value)^(1<<63))
+}
+
+func encodeRangeFloat(value float64) (string, bool) {
+	if math.IsNaN(value) {
+		return "", false
+	}
+
+	// Flip the sign bit of positive values, and all the bits of negative values.
+	bits := math.Float64bits(value)
+	if bits&(1<<63) == 0 {
+		bits ^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+
+	return rangeValueTagFloat + fmt.Sprintf("%016x", bits), true
+}
+
+func encodeRangeString(value string) (string, bool) {
+	// Index keys can't contain null bytes.
+	if strings.ContainsRune(value, 0) {
+		return "", false
+	}
+
+	return rangeValueTagString + value, true
+}
+
+// rangeValueFromNode returns the encoded range index value of an attribute value, if it can be range indexed.
+func rangeValueFromNode(n ipld.Node) (string, bool, error) {
+	switch n.Kind() {
+	case ipld.Kind_Int:
+		value, err := n.AsInt()
+		if err != nil {
+			return "", false, err
+		}
+		return encodeRangeInt(value), true, nil
+	case ipld.Kind_Float:
+		value, err := n.AsFloat()
+		if err != nil {
+			return "", false, err
+		}
+		encoded, ok := encodeRangeFloat(value)
+		return encoded, ok, nil
+	case ipld.Kind_String:
+		value, err := n.AsString()
+		if err != nil {
+			return "", false, err
+		}
+		encoded, ok := encodeRangeString(value)
+		return encoded, ok, nil
+	default:
+		return "", false, nil
+	}
+}
+
+// rangeValueFromQuery returns the encoded range index value of a query value.
+func rangeValueFromQuery(input *registrytypes.QueryRecordsRequest_ValueInput) (string, error) {
+	var encoded string
+	ok := true
+
+	switch value := input.GetValue().(type) {
+	case *registrytypes.QueryRecordsRequest_ValueInput_Int:
+		encoded = encodeRangeInt(value.Int)
+	case *registrytypes.QueryRecordsRequest_ValueInput_Float:
+		encoded, ok = encodeRangeFloat(value.Float)
+	case *registrytypes.QueryRecordsRequest_ValueInput_String_:
+		encoded, ok = encodeRangeString(value.String_)
+	default:
+		return "", fmt.Errorf("range and prefix operators are only supported for int, float and string values")
+	}
+
+	if !ok {
+		return "", fmt.Errorf("invalid range query value")
+	}
+
+	return encoded, nil
+}
+
+func (k Keeper) setAttributeRangeMapping(ctx sdk.Context, n ipld.Node, recordId string, path string) error {
+	value, ok, err := rangeValueFromNode(n)
+	if err != nil || !ok {
+		return err
+	}
+
+	return k.AttributesRangeIndex.Set(ctx, collections.Join3(path, value, recordId))
+}
+
+func (k Keeper) removeAttributeRangeMapping(ctx sdk.Context, n ipld.Node, recordId string, path string) error {
+	value, ok, err := rangeValueFromNode(n)
+	if err != nil || !ok {
+		return err
+	}
+
+	return k.AttributesRangeIndex.Remove(ctx, collections.Join3(path, value, recordId))
+}
+
+// getAttributeRangeMapping gets the ids of the records with an attribute value at the given path
+// that satisfies the (range or prefix) operator of the query attribute, up to maxQueryRecordIds.
+func (k Keeper) getAttributeRangeMapping(ctx sdk.Context, attr *registrytypes.QueryRecordsRequest_KeyValueInput) ([]string, error) {
+	value, err := rangeValueFromQuery(attr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	pathPrefix, err := encodeNonTerminalKeys(attr.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys for values of the same type, and for the query value itself.
+	typePrefix := append(append([]byte{}, pathPrefix...), value[:1]...)
+	valuePrefix, err := encodeNonTerminalKeys(attr.Key, value)
+	if err != nil {
+		return nil, err
+	}
+
+	var start, end []byte
+	switch attr.Operator {
+	case registrytypes.QueryRecordsRequest_OPERATOR_GT:
+		start, end = storetypes.PrefixEndBytes(valuePrefix), storetypes.PrefixEndBytes(typePrefix)
+	case registrytypes.QueryRecordsRequest_OPERATOR_GTE:
+		start, end = valuePrefix, storetypes.PrefixEndBytes(typePrefix)
+	case registrytypes.QueryRecordsRequest_OPERATOR_LT:
+		start, end = typePrefix, valuePrefix
+	case registrytypes.QueryRecordsRequest_OPERATOR_LTE:
+		start, end = typePrefix, storetypes.PrefixEndBytes(valuePrefix)
+	case registrytypes.QueryRecordsRequest_OPERATOR_PREFIX:
+		if !strings.HasPrefix(value, rangeValueTagString) {
+			return nil, fmt.Errorf("prefix operator is only supported for string values")
+		}
+		// Match the encoded value without its terminator.
+		start = append(append([]byte{}, pathPrefix...), value...)
+		end = storetypes.PrefixEndBytes(start)
+	default:
+		return nil, fmt.Errorf("unexpected operator %s", attr.Operator)
+	}
+
+	iter, err := k.AttributesRangeIndex.IterateRaw(ctx, start, end, collections.OrderAscending)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	// A record can match more than once (e.g. through the elements of an array).
+	recordIds := []string{}
+	seen := make(map[string]bool)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+
+		if id := key.K3(); !seen[id] {
+			if len(recordIds) == maxQueryRecordIds {
+				return nil, errTooManyRecordIds()
+			}
+			seen[id] = true
+			recordIds = append(recordIds, id)
+		}
+	}
+
+	return recordIds, nil
+}
+
+func encodeNonTerminalKeys(keys ...string) ([]byte, error) {
+	var encoded []byte
+	for _, key := range keys {
+		buf := make([]byte, collections.StringKey.SizeNonTerminal(key))
+		if _, err := collections.StringKey.EncodeNonTerminal(buf, key); err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, buf...)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+const (
+	// maxExpressionDepth is the max nesting depth of the filter expression of a records query.
+	maxExpressionDepth = 16
+
+	// maxExpressionTerms is the max number of terms (conditions and operators) of a records query.
+	maxExpressionTerms = 64
+)
+
+// validateRecordsQuery checks the size of a records query, before it's evaluated.
+func validateRecordsQuery(
+	attributes []*registrytypes.QueryRecordsRequest_KeyValueInput,
+	filter *registrytypes.QueryRecordsRequest_Expression,
+) error {
+	terms := len(attributes)
+	if filter != nil {
+		filterTerms, err := countExpressionTerms(filter, 1)
+		if err != nil {
+			return err
+		}
+		terms += filterTerms
+	}
+
+	if terms > maxExpressionTerms {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Records query has %d terms, exceeding the max of %d.", terms, maxExpressionTerms)
+	}
+
+	return nil
+}
+
+func countExpressionTerms(expr *registrytypes.QueryRecordsRequest_Expression, depth int) (int, error) {
+	if depth > maxExpressionDepth {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Filter expression exceeds the max depth of %d.", maxExpressionDepth)
+	}
+
+	var list []*registrytypes.QueryRecordsRequest_Expression
+	switch node := expr.GetNode().(type) {
+	case *registrytypes.QueryRecordsRequest_Expression_Not:
+		list = []*registrytypes.QueryRecordsRequest_Expression{node.Not}
+	case *registrytypes.QueryRecordsRequest_Expression_And:
+		list = node.And.GetExpressions()
+	case *registrytypes.QueryRecordsRequest_Expression_Or:
+		list = node.Or.GetExpressions()
+	}
+
+	terms := 1
+	for _, child := range list {
+		childTerms, err := countExpressionTerms(child, depth+1)
+		if err != nil {
+			return 0, err
+		}
+
+		terms += childTerms
+		if terms > maxExpressionTerms {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Filter expression exceeds the max of %d terms.", maxExpressionTerms)
+		}
+	}
+
+	return terms, nil
+}
+
+// idSet is a sorted list of record ids, or its complement (all other record ids) if negated.
+// Keeping negations symbolic means the full list of record ids is only needed when
+// the expression as a whole is negated.
+type idSet struct {
+	ids     []string
+	negated bool
+}
+
+// recordIdsFromExpression gets the (sorted) ids of the records matching a boolean expression
+// over attribute conditions.
+func (k Keeper) recordIdsFromExpression(ctx sdk.Context, expr *registrytypes.QueryRecordsRequest_Expression) ([]string, error) {
+	result, err := k.evaluateExpression(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.negated {
+		return result.ids, nil
+	}
+
+	return k.listRecordIdsExcept(ctx, result.ids)
+}
+
+// listRecordIdsExcept gets the (sorted) ids of all live records but the given (sorted) ones,
+// streaming the records store up to maxQueryRecordIds results. Deleted records don't count towards the limit.
+func (k Keeper) listRecordIdsExcept(ctx sdk.Context, excluded []string) ([]string, error) {
+	iter, err := k.Records.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	ids := []string{}
+	for j := 0; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		id := kv.Key
+
+		for j < len(excluded) && excluded[j] < id {
+			j++
+		}
+		if j < len(excluded) && excluded[j] == id {
+			continue
+		}
+
+		if kv.Value.Deleted {
+			continue
+		}
+
+		if len(ids) == maxQueryRecordIds {
+			return nil, errTooManyRecordIds()
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (k Keeper) evaluateExpression(ctx sdk.Context, expr *registrytypes.QueryRecordsRequest_Expression) (idSet, error) {
+	switch node := expr.GetNode().(type) {
+	case *registrytypes.QueryRecordsRequest_Expression_Condition:
+		ids, err := k.getAttributeRecordIds(ctx, node.Condition)
+		if err != nil {
+			return idSet{}, err
+		}
+
+		sorted := make([]string, len(ids))
+		copy(sorted, ids)
+		sort.Strings(sorted)
+
+		return idSet{ids: sorted}, nil
+	case *registrytypes.QueryRecordsRequest_Expression_Not:
+		result, err := k.evaluateExpression(ctx, node.Not)
+		if err != nil {
+			return idSet{}, err
+		}
+
+		return idSet{ids: result.ids, negated: !result.negated}, nil
+	case *registrytypes.QueryRecordsRequest_Expression_And:
+		positive, negative, err := k.evaluateExpressionList(ctx, node.And)
+		if err != nil {
+			return idSet{}, err
+		}
+
+		// a AND b AND NOT c AND NOT d = (a AND b) - (c OR d)
+		negativeUnion := sortedUnion(negative...)
+		if len(positive) == 0 {
+			return idSet{ids: negativeUnion, negated: true}, nil
+		}
+
+		return idSet{ids: sortedDifference(sortedIntersection(positive...), negativeUnion)}, nil
+	case *registrytypes.QueryRecordsRequest_Expression_Or:
+		positive, negative, err := k.evaluateExpressionList(ctx, node.Or)
+		if err != nil {
+			return idSet{}, err
+		}
+
+		// a OR b OR NOT c OR NOT d = NOT ((c AND d) - (a OR b))
+		positiveUnion := sortedUnion(positive...)
+		if len(negative) == 0 {
+			return idSet{ids: positiveUnion}, nil
+		}
+
+		return idSet{ids: sortedDifference(sortedIntersection(negative...), positiveUnion), negated: true}, nil
+	default:
+		return idSet{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid filter expression.")
+	}
+}
+
+// evaluateExpressionList evaluates a list of expressions, split into the ids of the plain and of the negated ones.
+func (k Keeper) evaluateExpressionList(
+	ctx sdk.Context,
+	list *registrytypes.QueryRecordsRequest_ExpressionList,
+) (positive [][]string, negative [][]string, err error) {
+	if len(list.GetExpressions()) == 0 {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Empty filter expression list.")
+	}
+
+	for _, expr := range list.GetExpressions() {
+		result, err := k.evaluateExpression(ctx, expr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if result.negated {
+			negative = append(negative, result.ids)
+		} else {
+			positive = append(positive, result.ids)
+		}
+	}
+
+	return positive, negative, nil
+}
+
+// listRecordIds gets the (sorted) ids of all records.
+func (k Keeper) listRecordIds(ctx sdk.Context) ([]string, error) {
+	iter, err := k.Records.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Keys()
+}
+
+// sortedIntersection merges sorted lists of ids, keeping the ids present in all of them.
+func sortedIntersection(lists ...[]string) []string {
+	if len(lists) == 0 {
+		return []string{}
+	}
+
+	result := lists[0]
+	for _, list := range lists[1:] {
+		merged := []string{}
+		for i, j := 0, 0; i < len(result) && j < len(list); {
+			switch {
+			case result[i] < list[j]:
+				i++
+			case result[i] > list[j]:
+				j++
+			default:
+				merged = append(merged, result[i])
+				i++
+				j++
+			}
+		}
+		result = merged
+	}
+
+	return result
+}
+
+// sortedUnion merges sorted lists of ids, keeping the ids present in any of them.
+func sortedUnion(lists ...[]string) []string {
+	result := []string{}
+	for _, list := range lists {
+		merged := make([]string, 0, len(result)+len(list))
+		i, j := 0, 0
+		for i < len(result) && j < len(list) {
+			switch {
+			case result[i] < list[j]:
+				merged = append(merged, result[i])
+				i++
+			case result[i] > list[j]:
+				merged = append(merged, list[j])
+				j++
+			default:
+				merged = append(merged, result[i])
+				i++
+				j++
+			}
+		}
+		merged = append(merged, result[i:]...)
+		merged = append(merged, list[j:]...)
+		result = merged
+	}
+
+	return result
+}
+
+// sortedDifference merges two sorted lists of ids, keeping the ids of the first one not present in the second one.
+func sortedDifference(a []string, b []string) []string {
+	result := []string{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			result = append(result, a[i])
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return append(result, a[i:]...)
+}
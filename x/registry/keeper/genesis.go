@@ -1,7 +1,7 @@
 package keeper
 
 import (
-	"time"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -9,37 +9,89 @@ import (
 )
 
 // InitGenesis initializes the module state from a genesis state.
+// Records, authorities (in any state) and names (with their history) are imported as is,
+// and the expiry queues and attribute indexes are rebuilt from them. Deleted records that are the latest
+// version and still have a bond are requeued, so that they're renewed from it.
 func (k *Keeper) InitGenesis(ctx sdk.Context, data *registry.GenesisState) error {
 	if err := k.Params.Set(ctx, data.Params); err != nil {
 		return err
 	}
 
+	var deletedRecords []registry.Record
 	for _, record := range data.Records {
+		// Record names are derived from the name records.
+		record.Names = nil
+
+		// Attributes exported before consensus version 2 are JSON.
+		if registry.IsJSONAttributes(record.Attributes) {
+			attributes, err := registry.AttributesFromJSON(record.Attributes)
+			if err != nil {
+				return err
+			}
+			record.Attributes = attributes
+		}
 		if err := k.SaveRecord(ctx, record); err != nil {
 			return err
 		}
 
-		// Add to record expiry queue if expiry time is in the future.
-		expiryTime, err := time.Parse(time.RFC3339, record.ExpiryTime)
-		if err != nil {
+		// Deleted records are out of the attribute indexes, and requeued once all versions are imported.
+		if record.Deleted {
+			deletedRecords = append(deletedRecords, record)
+			continue
+		}
+
+		if record.BondId != "" {
+			if has, err := k.bondKeeper.HasBond(ctx, record.BondId); !has {
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("bond %s of record %s not found", record.BondId, record.Id)
+			}
+		}
+
+		if err := k.insertRecordExpiryQueue(ctx, record); err != nil {
+			return err
+		}
+
+		if err := k.processAttributes(ctx, record.Attributes, record.Id); err != nil {
 			return err
 		}
+	}
 
-		if expiryTime.After(ctx.BlockTime()) {
-			if err := k.insertRecordExpiryQueue(ctx, record); err != nil {
+	// Deleted records still associated with a bond are requeued, so that they're renewed from it
+	// the same way as when they're associated with a bond.
+	for _, record := range deletedRecords {
+		if record.BondId == "" {
+			continue
+		}
+		if has, err := k.bondKeeper.HasBond(ctx, record.BondId); !has {
+			if err != nil {
 				return err
 			}
+			continue
+		}
+
+		if err := k.requeueDeletedRecord(ctx, record); err != nil {
+			return err
 		}
 	}
 
 	for _, authority := range data.Authorities {
-		// Only import authorities that are marked active.
-		if authority.Entry.Status == registry.AuthorityActive {
-			if err := k.SaveNameAuthority(ctx, authority.Name, authority.Entry); err != nil {
-				return err
+		if authority.Entry.AuctionId != "" {
+			if has, err := k.auctionKeeper.HasAuction(ctx, authority.Entry.AuctionId); !has {
+				if err != nil {
+					return err
+				}
+				return fmt.Errorf("auction %s of authority %s not found", authority.Entry.AuctionId, authority.Name)
 			}
+		}
 
-			// Add authority name to expiry queue.
+		if err := k.SaveNameAuthority(ctx, authority.Name, authority.Entry); err != nil {
+			return err
+		}
+
+		// Expired authorities are out of the expiry queue.
+		if authority.Entry.Status != registry.AuthorityExpired {
 			if err := k.insertAuthorityExpiryQueue(ctx, authority.Name, authority.Entry.ExpiryTime); err != nil {
 				return err
 			}
@@ -47,7 +99,23 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data *registry.GenesisState) error
 	}
 
 	for _, nameEntry := range data.Names {
-		if err := k.SaveNameRecord(ctx, nameEntry.Name, nameEntry.Entry.Latest.Id); err != nil {
+		if err := k.NameRecords.Set(ctx, nameEntry.Name, *nameEntry.Entry); err != nil {
+			return err
+		}
+	}
+
+	for _, recordSchema := range data.Schemas {
+		// Schemas exported before schema versioning have no version.
+		if recordSchema.Version == 0 {
+			recordSchema.Version = 1
+		}
+		if err := k.SaveSchema(ctx, recordSchema); err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range data.NameAccessGrants {
+		if err := k.SaveNameAccessGrant(ctx, grant); err != nil {
 			return err
 		}
 	}
@@ -77,10 +145,22 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) (*registry.GenesisState, error)
 		return nil, err
 	}
 
+	schemas, err := k.ListSchemaVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nameAccessGrants, err := k.ListNameAccessGrants(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
 	return &registry.GenesisState{
-		Params:      params,
-		Records:     records,
-		Authorities: authorityEntries,
-		Names:       names,
+		Params:           params,
+		Records:          records,
+		Authorities:      authorityEntries,
+		Names:            names,
+		Schemas:          schemas,
+		NameAccessGrants: nameAccessGrants,
 	}, nil
 }
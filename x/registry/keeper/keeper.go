@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"time"
 
@@ -12,13 +13,14 @@ import (
 	storetypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/keeper"
-	"github.com/gibson042/canonicaljson-go"
+	lru "github.com/hashicorp/golang-lru"
 	cid "github.com/ipfs/go-cid"
 	"github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/codec/dagjson"
@@ -28,26 +30,35 @@ import (
 	auctionkeeper "git.vdb.to/cerc-io/laconicd/x/auction/keeper"
 	bondkeeper "git.vdb.to/cerc-io/laconicd/x/bond/keeper"
 	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
-	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
 )
 
 type RecordsIndexes struct {
-	BondId *indexes.Multi[string, string, registrytypes.Record]
+	BondId     *RecordsIndex
+	PreviousId *indexes.Multi[string, string, registrytypes.Record]
+	Owner      *RecordsIndex
 }
 
 func (b RecordsIndexes) IndexesList() []collections.Index[string, registrytypes.Record] {
-	return []collections.Index[string, registrytypes.Record]{b.BondId}
+	return []collections.Index[string, registrytypes.Record]{b.BondId, b.PreviousId, b.Owner}
 }
 
 func newRecordIndexes(sb *collections.SchemaBuilder) RecordsIndexes {
 	return RecordsIndexes{
-		BondId: indexes.NewMulti(
+		BondId: newRecordsIndex(
 			sb, registrytypes.RecordsByBondIdIndexPrefix, "records_by_bond_id",
+			func(v registrytypes.Record) []string {
+				// Deleted records stay in their bond's index, as they can be renewed from the bond.
+				return []string{v.BondId}
+			},
+		),
+		PreviousId: indexes.NewMulti(
+			sb, registrytypes.RecordsByPreviousIdIndexPrefix, "records_by_previous_id",
 			collections.StringKey, collections.StringKey,
 			func(_ string, v registrytypes.Record) (string, error) {
-				return v.BondId, nil
+				return v.PreviousId, nil
 			},
 		),
+		Owner: newRecordsIndex(sb, registrytypes.RecordsByOwnerIndexPrefix, "records_by_owner", recordOwnersKeys),
 	}
 }
 
@@ -92,7 +103,8 @@ func newNameRecordIndexes(sb *collections.SchemaBuilder) NameRecordsIndexes {
 }
 
 type Keeper struct {
-	cdc codec.BinaryCodec
+	cdc          codec.BinaryCodec
+	storeService storetypes.KVStoreService
 
 	accountKeeper auth.AccountKeeper
 	bankKeeper    bank.Keeper
@@ -108,6 +120,17 @@ type Keeper struct {
 	RecordExpiryQueue    collections.Map[time.Time, registrytypes.ExpiryQueue]
 	AuthorityExpiryQueue collections.Map[time.Time, registrytypes.ExpiryQueue]
 	AttributesMap        collections.Map[collections.Pair[string, string], registrytypes.RecordsList]
+	AttributesRangeIndex collections.KeySet[collections.Triple[string, string, string]]
+	RecordLinksIndex     collections.KeySet[collections.Pair[string, string]]
+	// Latest schema version by record type
+	Schemas collections.Map[string, registrytypes.Schema]
+	// All schema versions by (record type, version)
+	SchemaVersions collections.Map[collections.Pair[string, uint64], registrytypes.Schema]
+	// Name access grants by (authority, grantee, LRN prefix)
+	NameAccessGrants collections.Map[collections.Triple[string, string, string], registrytypes.NameAccessGrant]
+
+	// Compiled schema validators by (record type, version), see validateRecordType
+	schemaValidators *lru.Cache
 }
 
 // NewKeeper creates a new Keeper instance
@@ -122,6 +145,7 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc:           cdc,
+		storeService:  storeService,
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		bondKeeper:    bondKeeper,
@@ -154,8 +178,35 @@ func NewKeeper(
 			sb, registrytypes.AttributesMapPrefix, "attributes_map",
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[registrytypes.RecordsList](cdc),
 		),
+		AttributesRangeIndex: collections.NewKeySet(
+			sb, registrytypes.AttributesRangeIndexPrefix, "attributes_range_index",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+		),
+		RecordLinksIndex: collections.NewKeySet(
+			sb, registrytypes.RecordLinksIndexPrefix, "record_links_index",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		Schemas: collections.NewMap(
+			sb, registrytypes.SchemasPrefix, "schemas",
+			collections.StringKey, codec.CollValue[registrytypes.Schema](cdc),
+		),
+		SchemaVersions: collections.NewMap(
+			sb, registrytypes.SchemaVersionsPrefix, "schema_versions",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[registrytypes.Schema](cdc),
+		),
+		NameAccessGrants: collections.NewMap(
+			sb, registrytypes.NameAccessGrantsPrefix, "name_access_grants",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[registrytypes.NameAccessGrant](cdc),
+		),
 	}
 
+	schemaValidators, err := lru.New(schemaValidatorsCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	k.schemaValidators = schemaValidators
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
@@ -218,7 +269,7 @@ func (k Keeper) GetRecordById(ctx sdk.Context, id string) (registrytypes.Record,
 	return record, nil
 }
 
-// GetRecordsByBondId - gets a record from the store.
+// GetRecordsByBondId gets the records associated with a bond, including deleted ones.
 func (k Keeper) GetRecordsByBondId(ctx sdk.Context, bondId string) ([]registrytypes.Record, error) {
 	var records []registrytypes.Record
 
@@ -242,88 +293,62 @@ func (k Keeper) GetRecordsByBondId(ctx sdk.Context, bondId string) ([]registryty
 	return records, nil
 }
 
-// RecordsFromAttributes gets a list of records whose attributes match all provided values
+// RecordsFromAttributes gets a (paginated) list of records whose attributes match all provided conditions,
+// as well as the (optional) filter expression.
 func (k Keeper) RecordsFromAttributes(
 	ctx sdk.Context,
 	attributes []*registrytypes.QueryRecordsRequest_KeyValueInput,
+	filter *registrytypes.QueryRecordsRequest_Expression,
 	all bool,
-) ([]registrytypes.Record, error) {
-	resultRecordIds := []string{}
-	for i, attr := range attributes {
-		suffix, err := QueryValueToJSON(attr.Value)
-		if err != nil {
-			return nil, err
-		}
-		mapKey := collections.Join(attr.Key, string(suffix))
-		recordIds, err := k.getAttributeMapping(ctx, mapKey)
-		if err != nil {
-			return nil, err
-		}
+	pagination *query.PageRequest,
+) ([]registrytypes.Record, *query.PageResponse, error) {
+	expressions := make([]*registrytypes.QueryRecordsRequest_Expression, 0, len(attributes)+1)
+	for _, attr := range attributes {
+		expressions = append(expressions, &registrytypes.QueryRecordsRequest_Expression{
+			Node: &registrytypes.QueryRecordsRequest_Expression_Condition{Condition: attr},
+		})
+	}
+	if filter != nil {
+		expressions = append(expressions, filter)
+	}
 
-		if i == 0 {
-			resultRecordIds = recordIds
-		} else {
-			resultRecordIds = getIntersection(recordIds, resultRecordIds)
-		}
+	resultRecordIds, err := k.recordIdsFromExpression(ctx, &registrytypes.QueryRecordsRequest_Expression{
+		Node: &registrytypes.QueryRecordsRequest_Expression_And{
+			And: &registrytypes.QueryRecordsRequest_ExpressionList{Expressions: expressions},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
-	records := []registrytypes.Record{}
-	for _, id := range resultRecordIds {
-		record, err := k.GetRecordById(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		if record.Deleted {
-			continue
-		}
-		if !all && len(record.Names) == 0 {
-			continue
-		}
-		records = append(records, record)
+	return k.paginateRecordIds(ctx, resultRecordIds, pagination, func(record registrytypes.Record) bool {
+		return !record.Deleted && (all || len(record.Names) > 0)
+	})
+}
+
+// getAttributeRecordIds gets the ids of the records matching a single attribute condition.
+func (k Keeper) getAttributeRecordIds(ctx sdk.Context, attr *registrytypes.QueryRecordsRequest_KeyValueInput) ([]string, error) {
+	if attr.Operator != registrytypes.QueryRecordsRequest_OPERATOR_UNSPECIFIED {
+		return k.getAttributeRangeMapping(ctx, attr)
 	}
 
-	return records, nil
+	suffix, err := QueryValueToJSON(attr.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.getAttributeMapping(ctx, collections.Join(attr.Key, string(suffix)))
 }
 
-// TODO not recursive, and only should be if we want to support querying with whole sub-objects,
-// which seems unnecessary.
+// QueryValueToJSON encodes a query value the same way attribute values are encoded in the attribute index.
+// Array values match array attributes exactly, while scalar values also match the elements of array attributes.
+// Nested map attributes are only indexed by the paths of their leaves, so map values are not supported.
 func QueryValueToJSON(input *registrytypes.QueryRecordsRequest_ValueInput) ([]byte, error) {
 	np := basicnode.Prototype.Any
 	nb := np.NewBuilder()
 
-	switch value := input.GetValue().(type) {
-	case *registrytypes.QueryRecordsRequest_ValueInput_String_:
-		err := nb.AssignString(value.String_)
-		if err != nil {
-			return nil, err
-		}
-	case *registrytypes.QueryRecordsRequest_ValueInput_Int:
-		err := nb.AssignInt(value.Int)
-		if err != nil {
-			return nil, err
-		}
-	case *registrytypes.QueryRecordsRequest_ValueInput_Float:
-		err := nb.AssignFloat(value.Float)
-		if err != nil {
-			return nil, err
-		}
-	case *registrytypes.QueryRecordsRequest_ValueInput_Boolean:
-		err := nb.AssignBool(value.Boolean)
-		if err != nil {
-			return nil, err
-		}
-	case *registrytypes.QueryRecordsRequest_ValueInput_Link:
-		link := cidlink.Link{Cid: cid.MustParse(value.Link)}
-		err := nb.AssignLink(link)
-		if err != nil {
-			return nil, err
-		}
-	case *registrytypes.QueryRecordsRequest_ValueInput_Array:
-		return nil, fmt.Errorf("recursive query values are not supported")
-	case *registrytypes.QueryRecordsRequest_ValueInput_Map:
-		return nil, fmt.Errorf("recursive query values are not supported")
-	default:
-		return nil, fmt.Errorf("value has unexpected type %T", value)
+	if err := assignQueryValue(nb, input); err != nil {
+		return nil, err
 	}
 
 	n := nb.Build()
@@ -339,13 +364,35 @@ func (k Keeper) SaveRecord(ctx sdk.Context, record registrytypes.Record) error {
 	return k.Records.Set(ctx, record.Id, record)
 }
 
+// markRecordDeleted marks a record as deleted and removes it from the attribute indexes.
+func (k Keeper) markRecordDeleted(ctx sdk.Context, record registrytypes.Record) error {
+	record.Deleted = true
+	if err := k.SaveRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return k.removeAttributes(ctx, record.Attributes, record.Id)
+}
+
 // ProcessSetRecord creates a record.
 func (k Keeper) SetRecord(ctx sdk.Context, msg registrytypes.MsgSetRecord) (*registrytypes.ReadableRecord, error) {
-	payload := msg.Payload.ToReadablePayload()
+	payload, err := msg.Payload.ToReadablePayload()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid record JSON")
+	}
 	record := registrytypes.ReadableRecord{Attributes: payload.RecordAttributes, BondId: msg.BondId}
 
+	// Check the attribute limits before any signature or schema validation work.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRecordAttributes(params, &record); err != nil {
+		return nil, err
+	}
+
 	// Check signatures.
-	resourceSignBytes, _ := record.GetSignBytes()
+	resourceSignBytes, resourceSignDoc := record.GetSignBytes()
 	cid, err := record.GetCid()
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid record JSON")
@@ -362,45 +409,350 @@ func (k Keeper) SetRecord(ctx sdk.Context, msg registrytypes.MsgSetRecord) (*reg
 		return &record, nil
 	}
 
+	if err := k.setRecordOwners(&record, resourceSignBytes, resourceSignDoc, payload.Signatures); err != nil {
+		return nil, err
+	}
+
+	record.OwnerThreshold = msg.OwnerThreshold
+	if int(record.OwnerThreshold) > len(record.Owners) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Owner threshold exceeds the number of owners.")
+	}
+
+	// Validate the record against the schema registered for its type, if any.
+	record.Type = record.RecordType()
+	if err := k.validateRecordType(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	sdkErr := k.processRecord(ctx, &record, msg.Lifetime)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordSet{
+		RecordId:   record.Id,
+		BondId:     record.BondId,
+		Owners:     record.Owners,
+		ExpiryTime: record.ExpiryTime,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// setRecordOwners verifies the payload signatures and sets the (sorted) record owners from them.
+func (k Keeper) setRecordOwners(
+	record *registrytypes.ReadableRecord,
+	signBytes []byte,
+	signDoc []byte,
+	signatures []registrytypes.Signature,
+) error {
 	record.Owners = []string{}
-	for _, sig := range payload.Signatures {
-		pubKey, err := legacy.PubKeyFromBytes(helpers.BytesFromBase64(sig.PubKey))
+	for _, sig := range signatures {
+		owner, err := registrytypes.VerifySignature(signBytes, signDoc, sig)
 		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Error decoding pubKey from bytes: ", err))
-		}
-
-		sigOK := pubKey.VerifySignature(resourceSignBytes, helpers.BytesFromBase64(sig.Sig))
-		if !sigOK {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Signature mismatch: ", sig.PubKey))
+			return err
 		}
-		record.Owners = append(record.Owners, pubKey.Address().String())
+		record.Owners = append(record.Owners, owner)
 	}
 
 	// Sort owners list.
 	sort.Strings(record.Owners)
-	sdkErr := k.processRecord(ctx, &record)
-	if sdkErr != nil {
-		return nil, sdkErr
+
+	return nil
+}
+
+// UpdateRecord creates a new version of an existing record.
+// The new version is linked to the existing one through its previous id and takes over
+// its bond and remaining rent period, while the existing version is marked as deleted.
+// The rent of the size difference for the remaining period is charged to the bond, if the new version is larger.
+// Updating a record to the content of one of its previous versions moves that version to the head of the history.
+func (k Keeper) UpdateRecord(ctx sdk.Context, msg registrytypes.MsgUpdateRecord) (*registrytypes.ReadableRecord, error) {
+	if has, err := k.HasRecord(ctx, msg.RecordId); !has {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
+	}
+
+	prevRecord, err := k.GetRecordById(ctx, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if prevRecord.Deleted {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record is deleted.")
+	}
+
+	nextId, err := k.getNextRecordVersion(ctx, prevRecord.Id)
+	if err != nil {
+		return nil, err
+	}
+	if nextId != "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record already has a newer version.")
+	}
+
+	payload, err := msg.Payload.ToReadablePayload()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid record JSON")
+	}
+	record := registrytypes.ReadableRecord{
+		Attributes: payload.RecordAttributes,
+		BondId:     prevRecord.BondId,
+		PreviousId: prevRecord.Id,
+	}
+
+	// Check the attribute limits before any signature or schema validation work.
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRecordAttributes(params, &record); err != nil {
+		return nil, err
+	}
+
+	cid, err := record.GetCid()
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid record JSON")
+	}
+
+	record.Id = cid
+
+	has, err := k.HasRecord(ctx, record.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rolling back to the content of a previous version restores that version.
+	rollback := false
+	if has {
+		rollback, err = k.isPreviousRecordVersion(ctx, prevRecord, record.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !rollback {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record already exists.")
+		}
+	}
+
+	// The new version needs to be approved by a threshold of the current owners, and keeps them.
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	op := registrytypes.RecordOperation{Action: sdk.MsgTypeURL(&msg), RecordId: prevRecord.Id, VersionId: record.Id}
+	approved, err := hasOwnerApproval(ctx, &prevRecord, signer, op, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+	if !approved {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner approval threshold not met.")
+	}
+	record.Owners = prevRecord.Owners
+	record.OwnerThreshold = prevRecord.OwnerThreshold
+
+	record.Type = record.RecordType()
+	if err := k.validateRecordType(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	// Carry over the remaining rent period.
+	record.CreateTime = ctx.BlockHeader().Time.Format(time.RFC3339)
+	record.ExpiryTime = prevRecord.ExpiryTime
+	record.Deleted = false
+
+	recordObj, err := record.ToRecordObj()
+	if err != nil {
+		return nil, err
+	}
+	// The nonce carries over, so that approvals of earlier operations can't be replayed on the new version.
+	recordObj.Nonce = prevRecord.Nonce
+
+	// Rent is charged by size, so a larger version pays the difference for the remaining rent period.
+	expiryTime, err := time.Parse(time.RFC3339, prevRecord.ExpiryTime)
+	if err != nil {
+		return nil, err
+	}
+	charged := sdk.NewCoin(params.RecordRent.Denom, math.ZeroInt())
+	if remaining := expiryTime.Sub(ctx.BlockHeader().Time); remaining > 0 {
+		prevRent := getRecordRent(params, len(prevRecord.Attributes), remaining)
+		rent := getRecordRent(params, len(recordObj.Attributes), remaining)
+		if prevRent.IsLT(rent) {
+			charged = rent.Sub(prevRent)
+			if err = k.bondKeeper.TransferCoinsToModuleAccount(
+				ctx, recordObj.BondId, registrytypes.RecordRentModuleAccountName, sdk.NewCoins(charged),
+			); err != nil {
+				return nil, err
+			}
+			if err := emitRecordRentCharged(ctx, recordObj, charged); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// The rent paid for the previous version carries over.
+	if recordObj.Rent, err = newRecordRent(ctx, prevRecord.Rent, recordObj.BondId, recordObj.ExpiryTime, charged); err != nil {
+		return nil, err
+	}
+
+	if rollback {
+		restored, err := k.Records.Get(ctx, record.Id)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.unlinkRecordVersion(ctx, restored); err != nil {
+			return nil, err
+		}
+
+		// The previous version may have been relinked.
+		if prevRecord, err = k.Records.Get(ctx, prevRecord.Id); err != nil {
+			return nil, err
+		}
+		prevRecord.Nonce = recordObj.Nonce
+	}
+
+	if err = k.SaveRecord(ctx, recordObj); err != nil {
+		return nil, err
+	}
+
+	if err := k.processAttributes(ctx, recordObj.Attributes, record.Id); err != nil {
+		return nil, err
+	}
+
+	if err := k.insertRecordExpiryQueue(ctx, recordObj); err != nil {
+		return nil, err
+	}
+
+	// Retire the previous version.
+	if err := k.deleteRecordExpiryQueue(ctx, prevRecord); err != nil {
+		return nil, err
+	}
+
+	if err := k.markRecordDeleted(ctx, prevRecord); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordUpdated{
+		RecordId:   record.Id,
+		PreviousId: record.PreviousId,
+		BondId:     record.BondId,
+		ExpiryTime: record.ExpiryTime,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &record, nil
 }
 
-func (k Keeper) processRecord(ctx sdk.Context, record *registrytypes.ReadableRecord) error {
-	params, err := k.GetParams(ctx)
+// isPreviousRecordVersion checks if the record with the given id is a previous (superseded) version of a record.
+func (k Keeper) isPreviousRecordVersion(ctx sdk.Context, record registrytypes.Record, id string) (bool, error) {
+	for recordId := record.PreviousId; recordId != ""; {
+		prevRecord, err := k.Records.Get(ctx, recordId)
+		if err != nil {
+			return false, err
+		}
+
+		if prevRecord.Id == id {
+			return prevRecord.Deleted, nil
+		}
+
+		recordId = prevRecord.PreviousId
+	}
+
+	return false, nil
+}
+
+// unlinkRecordVersion removes a previous version from the history of a record, before it's restored
+// as the latest version. The version that replaced it is linked to the version it replaced instead.
+func (k Keeper) unlinkRecordVersion(ctx sdk.Context, record registrytypes.Record) error {
+	nextId, err := k.getNextRecordVersion(ctx, record.Id)
+	if err != nil || nextId == "" {
+		return err
+	}
+
+	nextRecord, err := k.Records.Get(ctx, nextId)
 	if err != nil {
 		return err
 	}
+	nextRecord.PreviousId = record.PreviousId
 
-	rent := params.RecordRent
-	if err = k.bondKeeper.TransferCoinsToModuleAccount(
-		ctx, record.BondId, registrytypes.RecordRentModuleAccountName, sdk.NewCoins(rent),
-	); err != nil {
+	return k.SaveRecord(ctx, nextRecord)
+}
+
+// GetRecordHistory returns all versions of the record with the given id, latest first.
+func (k Keeper) GetRecordHistory(ctx sdk.Context, id string) ([]registrytypes.Record, error) {
+	latestId, err := k.getLatestRecordVersion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []registrytypes.Record
+	for recordId := latestId; recordId != ""; {
+		record, err := k.GetRecordById(ctx, recordId)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		recordId = record.PreviousId
+	}
+
+	return records, nil
+}
+
+// getLatestRecordVersion follows newer versions of the given record and returns the id of the latest one.
+func (k Keeper) getLatestRecordVersion(ctx sdk.Context, id string) (string, error) {
+	latestId := id
+	for {
+		nextId, err := k.getNextRecordVersion(ctx, latestId)
+		if err != nil {
+			return "", err
+		}
+		if nextId == "" {
+			return latestId, nil
+		}
+
+		latestId = nextId
+	}
+}
+
+// getNextRecordVersion returns the id of the record that replaced the given one, if any.
+func (k Keeper) getNextRecordVersion(ctx sdk.Context, id string) (string, error) {
+	iter, err := k.Records.Indexes.PreviousId.MatchExact(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		return "", err
+	}
+
+	if len(ids) == 0 {
+		return "", nil
+	}
+
+	return ids[0], nil
+}
+
+// processRecord takes the rent for the given lifetime (one rent period if not set) from the record bond
+// and saves the record.
+func (k Keeper) processRecord(ctx sdk.Context, record *registrytypes.ReadableRecord, lifetime time.Duration) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
 		return err
 	}
 
+	if lifetime == 0 {
+		lifetime = params.RecordRentDuration
+	}
+	if lifetime > params.MaxRecordLifetime {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record lifetime exceeds the maximum record lifetime.")
+	}
+
 	record.CreateTime = ctx.BlockHeader().Time.Format(time.RFC3339)
-	record.ExpiryTime = ctx.BlockHeader().Time.Add(params.RecordRentDuration).Format(time.RFC3339)
+	record.ExpiryTime = ctx.BlockHeader().Time.Add(lifetime).Format(time.RFC3339)
 	record.Deleted = false
 
 	recordObj, err := record.ToRecordObj()
@@ -408,43 +760,170 @@ func (k Keeper) processRecord(ctx sdk.Context, record *registrytypes.ReadableRec
 		return err
 	}
 
+	rent := getRecordRent(params, len(recordObj.Attributes), lifetime)
+	if err = k.bondKeeper.TransferCoinsToModuleAccount(
+		ctx, record.BondId, registrytypes.RecordRentModuleAccountName, sdk.NewCoins(rent),
+	); err != nil {
+		return err
+	}
+	if err := emitRecordRentCharged(ctx, recordObj, rent); err != nil {
+		return err
+	}
+	if recordObj.Rent, err = newRecordRent(ctx, nil, recordObj.BondId, recordObj.ExpiryTime, rent); err != nil {
+		return err
+	}
+
 	// Save record in store.
 	if err = k.SaveRecord(ctx, recordObj); err != nil {
 		return err
 	}
 
-	// TODO look up/validate record type here
-
-	if err := k.processAttributes(ctx, record.Attributes, record.Id); err != nil {
+	if err := k.processAttributes(ctx, recordObj.Attributes, record.Id); err != nil {
 		return err
 	}
 
 	return k.insertRecordExpiryQueue(ctx, recordObj)
 }
 
-func (k Keeper) processAttributes(ctx sdk.Context, attrs registrytypes.AttributeMap, id string) error {
-	np := basicnode.Prototype.Map
-	nb := np.NewBuilder()
-	encAttrs, err := canonicaljson.Marshal(attrs)
+// getRecordRentPerPeriod gets the rent of a record for 1 time period, based on the size of its attributes.
+func getRecordRentPerPeriod(params *registrytypes.Params, attributesSize int) sdk.Coin {
+	sizeRent := params.RecordRentPerByte.Amount.Mul(math.NewInt(int64(attributesSize)))
+
+	return sdk.NewCoin(params.RecordRent.Denom, params.RecordRent.Amount.Add(sizeRent))
+}
+
+// getRecordRent gets the rent of a record for a lifetime, prorated on the rent per time period (rounded up).
+func getRecordRent(params *registrytypes.Params, attributesSize int, lifetime time.Duration) sdk.Coin {
+	rent := getRecordRentPerPeriod(params, attributesSize)
+	duration := math.NewInt(int64(params.RecordRentDuration))
+	amount := rent.Amount.Mul(math.NewInt(int64(lifetime))).Add(duration).SubRaw(1).Quo(duration)
+
+	return sdk.NewCoin(rent.Denom, amount)
+}
+
+// validateRecordAttributes checks the record attributes against the max attributes size, depth
+// and indexed attributes params, bounding the index entries written for the record.
+func validateRecordAttributes(params *registrytypes.Params, record *registrytypes.ReadableRecord) error {
+	recordObj, err := record.ToRecordObj()
 	if err != nil {
 		return err
 	}
-	if len(attrs) == 0 {
-		encAttrs = []byte("{}")
+
+	if uint64(len(recordObj.Attributes)) > params.MaxRecordAttributesSize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum size.")
 	}
-	err = dagjson.Decode(nb, bytes.NewReader(encAttrs))
+
+	n, err := registrytypes.DecodeAttributesNode(recordObj.Attributes)
 	if err != nil {
-		return fmt.Errorf("failed to decode attributes: %w", err)
+		return err
+	}
+	if uint64(attributesDepth(n)) > params.MaxRecordAttributesDepth {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum depth.")
+	}
+
+	indexed := uint64(0)
+	return walkAttributes(recordObj.Attributes, func(string, ipld.Node) error {
+		indexed++
+		if indexed > params.MaxRecordIndexedAttributes {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum number of indexed attributes.")
+		}
+		return nil
+	})
+}
+
+// attributesDepth returns the nesting depth of maps and lists in an attribute value.
+func attributesDepth(n ipld.Node) int {
+	depth := 0
+	switch n.Kind() {
+	case ipld.Kind_Map:
+		for it := n.MapIterator(); !it.Done(); {
+			_, v, err := it.Next()
+			if err != nil {
+				break
+			}
+			depth = max(depth, attributesDepth(v))
+		}
+	case ipld.Kind_List:
+		for it := n.ListIterator(); !it.Done(); {
+			_, v, err := it.Next()
+			if err != nil {
+				break
+			}
+			depth = max(depth, attributesDepth(v))
+		}
+	default:
+		return 0
+	}
+
+	return depth + 1
+}
+
+func assignQueryValue(na ipld.NodeAssembler, input *registrytypes.QueryRecordsRequest_ValueInput) error {
+	switch value := input.GetValue().(type) {
+	case *registrytypes.QueryRecordsRequest_ValueInput_String_:
+		return na.AssignString(value.String_)
+	case *registrytypes.QueryRecordsRequest_ValueInput_Int:
+		return na.AssignInt(value.Int)
+	case *registrytypes.QueryRecordsRequest_ValueInput_Float:
+		return na.AssignFloat(value.Float)
+	case *registrytypes.QueryRecordsRequest_ValueInput_Boolean:
+		return na.AssignBool(value.Boolean)
+	case *registrytypes.QueryRecordsRequest_ValueInput_Link:
+		c, err := cid.Parse(value.Link)
+		if err != nil {
+			return fmt.Errorf("invalid link value: %w", err)
+		}
+		return na.AssignLink(cidlink.Link{Cid: c})
+	case *registrytypes.QueryRecordsRequest_ValueInput_Array:
+		values := value.Array.GetValues()
+		la, err := na.BeginList(int64(len(values)))
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			if err := assignQueryValue(la.AssembleValue(), v); err != nil {
+				return err
+			}
+		}
+		return la.Finish()
+	case *registrytypes.QueryRecordsRequest_ValueInput_Map:
+		return fmt.Errorf("map query values are not supported, query nested attributes by path instead")
+	default:
+		return fmt.Errorf("value has unexpected type %T", value)
+	}
+}
+
+// processAttributes adds a record to the attribute indexes, given its (DAG-CBOR) attributes.
+func (k Keeper) processAttributes(ctx sdk.Context, attrs []byte, id string) error {
+	return walkAttributes(attrs, func(path string, n ipld.Node) error {
+		return k.indexAttributeValue(ctx, n, id, path)
+	})
+}
+
+// removeAttributes removes a record from the attribute indexes, given its (DAG-CBOR) attributes.
+func (k Keeper) removeAttributes(ctx sdk.Context, attrs []byte, id string) error {
+	return walkAttributes(attrs, func(path string, n ipld.Node) error {
+		return k.unindexAttributeValue(ctx, n, id, path)
+	})
+}
+
+// walkAttributes calls fn for each attribute value to be indexed, along with its (dotted) path.
+func walkAttributes(attrs []byte, fn func(path string, n ipld.Node) error) error {
+	n, err := registrytypes.DecodeAttributesNode(attrs)
+	if err != nil {
+		return err
+	}
+	if n.Kind() == ipld.Kind_Null {
+		return nil
 	}
-	n := nb.Build()
 	if n.Kind() != ipld.Kind_Map {
-		return fmt.Errorf("record attributes must be a map, not %T", n.Kind())
+		return fmt.Errorf("record attributes must be a map, not %s", n.Kind())
 	}
 
-	return k.processAttributeMap(ctx, n, id, "")
+	return walkAttributeMap(n, "", fn)
 }
 
-func (k Keeper) processAttributeMap(ctx sdk.Context, n ipld.Node, id string, prefix string) error {
+func walkAttributeMap(n ipld.Node, prefix string, fn func(path string, n ipld.Node) error) error {
 	for it := n.MapIterator(); !it.Done(); {
 		//nolint:misspell
 		keynode, valuenode, err := it.Next()
@@ -456,25 +935,82 @@ func (k Keeper) processAttributeMap(ctx sdk.Context, n ipld.Node, id string, pre
 			return err
 		}
 
-		if valuenode.Kind() == ipld.Kind_Map {
-			err := k.processAttributeMap(ctx, valuenode, id, key)
+		if err := walkAttributeValue(valuenode, registrytypes.AttributePath(prefix, key), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkAttributeValue walks an attribute value at the given (dotted) path.
+// Nested maps are indexed by the full paths of their leaves. Arrays are indexed as a whole,
+// and each of their elements is also indexed under the path of the array (membership semantics).
+func walkAttributeValue(n ipld.Node, path string, fn func(path string, n ipld.Node) error) error {
+	switch n.Kind() {
+	case ipld.Kind_Map:
+		return walkAttributeMap(n, path, fn)
+	case ipld.Kind_List:
+		if err := fn(path, n); err != nil {
+			return err
+		}
+
+		for it := n.ListIterator(); !it.Done(); {
+			_, elem, err := it.Next()
 			if err != nil {
 				return err
 			}
-		} else {
-			var buf bytes.Buffer
-			if err := dagjson.Encode(valuenode, &buf); err != nil {
-				return err
-			}
 
-			value := buf.Bytes()
-			mapKey := collections.Join(prefix+key, string(value))
-			if err := k.setAttributeMapping(ctx, mapKey, id); err != nil {
+			if elem.Kind() == ipld.Kind_List {
+				// Nested arrays are only matched as a whole.
+				err = fn(path, elem)
+			} else {
+				err = walkAttributeValue(elem, path, fn)
+			}
+			if err != nil {
 				return err
 			}
 		}
+
+		return nil
+	default:
+		return fn(path, n)
 	}
-	return nil
+}
+
+func (k Keeper) indexAttributeValue(ctx sdk.Context, n ipld.Node, id string, path string) error {
+	var buf bytes.Buffer
+	if err := dagjson.Encode(n, &buf); err != nil {
+		return err
+	}
+
+	mapKey := collections.Join(path, buf.String())
+	if err := k.setAttributeMapping(ctx, mapKey, id); err != nil {
+		return err
+	}
+
+	if err := k.setAttributeRangeMapping(ctx, n, id, path); err != nil {
+		return err
+	}
+
+	return k.setRecordLinkMapping(ctx, n, id)
+}
+
+func (k Keeper) unindexAttributeValue(ctx sdk.Context, n ipld.Node, id string, path string) error {
+	var buf bytes.Buffer
+	if err := dagjson.Encode(n, &buf); err != nil {
+		return err
+	}
+
+	mapKey := collections.Join(path, buf.String())
+	if err := k.removeAttributeMapping(ctx, mapKey, id); err != nil {
+		return err
+	}
+
+	if err := k.removeAttributeRangeMapping(ctx, n, id, path); err != nil {
+		return err
+	}
+
+	return k.removeRecordLinkMapping(ctx, n, id)
 }
 
 func (k Keeper) setAttributeMapping(ctx sdk.Context, key collections.Pair[string, string], recordId string) error {
@@ -492,11 +1028,33 @@ func (k Keeper) setAttributeMapping(ctx sdk.Context, key collections.Pair[string
 		recordIds = value.Value
 	}
 
+	// The same value can occur more than once in a record (e.g. as elements of an array).
+	if slices.Contains(recordIds, recordId) {
+		return nil
+	}
+
 	recordIds = append(recordIds, recordId)
 
 	return k.AttributesMap.Set(ctx, key, registrytypes.RecordsList{Value: recordIds})
 }
 
+func (k Keeper) removeAttributeMapping(ctx sdk.Context, key collections.Pair[string, string], recordId string) error {
+	value, err := k.AttributesMap.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	recordIds := slices.DeleteFunc(value.Value, func(id string) bool { return id == recordId })
+	if len(recordIds) == 0 {
+		return k.AttributesMap.Remove(ctx, key)
+	}
+
+	return k.AttributesMap.Set(ctx, key, registrytypes.RecordsList{Value: recordIds})
+}
+
 func (k Keeper) getAttributeMapping(ctx sdk.Context, key collections.Pair[string, string]) ([]string, error) {
 	if has, err := k.AttributesMap.Has(ctx, key); !has {
 		if err != nil {
@@ -515,21 +1073,51 @@ func (k Keeper) getAttributeMapping(ctx sdk.Context, key collections.Pair[string
 	return value.Value, nil
 }
 
+// populateRecordNames sets the names pointing to a record. Names resolve to the latest version of the record
+// they point to, so the latest version of a record also gets the names of its previous versions.
 func (k Keeper) populateRecordNames(ctx sdk.Context, record *registrytypes.Record) error {
-	iter, err := k.NameRecords.Indexes.Cid.MatchExact(ctx, record.Id)
+	names, err := k.getRecordNames(ctx, record.Id)
 	if err != nil {
 		return err
 	}
 
-	names, err := iter.PrimaryKeys()
-	if err != nil {
-		return err
+	if record.PreviousId != "" {
+		nextId, err := k.getNextRecordVersion(ctx, record.Id)
+		if err != nil {
+			return err
+		}
+
+		for recordId := record.PreviousId; nextId == "" && recordId != ""; {
+			prevNames, err := k.getRecordNames(ctx, recordId)
+			if err != nil {
+				return err
+			}
+			names = append(names, prevNames...)
+
+			prevRecord, err := k.Records.Get(ctx, recordId)
+			if err != nil {
+				return err
+			}
+			recordId = prevRecord.PreviousId
+		}
+		slices.Sort(names)
 	}
+
 	record.Names = names
 
 	return nil
 }
 
+// getRecordNames gets the names pointing directly to a record (version).
+func (k Keeper) getRecordNames(ctx sdk.Context, id string) ([]string, error) {
+	iter, err := k.NameRecords.Indexes.Cid.MatchExact(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.PrimaryKeys()
+}
+
 // GetModuleBalances gets the registry module account(s) balances.
 func (k Keeper) GetModuleBalances(ctx sdk.Context) []*registrytypes.AccountBalance {
 	var balances []*registrytypes.AccountBalance
@@ -577,14 +1165,23 @@ func (k Keeper) ProcessRecordExpiryQueue(ctx sdk.Context) error {
 
 		// If record doesn't have an associated bond or if bond no longer exists, mark it deleted.
 		if !bondExists {
-			record.Deleted = true
-			if err := k.SaveRecord(ctx, record); err != nil {
+			if err := k.markRecordDeleted(ctx, record); err != nil {
 				return err
 			}
 
 			if err := k.deleteRecordExpiryQueue(ctx, record); err != nil {
 				return err
 			}
+
+			if err := ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordExpired{
+				RecordId: record.Id,
+				BondId:   record.BondId,
+				Reason:   registrytypes.ExpiryReasonBondNotFound,
+			}); err != nil {
+				return err
+			}
+
+			continue
 		}
 
 		// Try to renew the record by taking rent.
@@ -646,6 +1243,9 @@ func (k Keeper) deleteRecordExpiryQueue(ctx sdk.Context, record registrytypes.Re
 
 	existingRecordsList, err := k.RecordExpiryQueue.Get(ctx, expiryTime)
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
 		return err
 	}
 
@@ -656,7 +1256,7 @@ func (k Keeper) deleteRecordExpiryQueue(ctx sdk.Context, record registrytypes.Re
 		}
 	}
 
-	if len(existingRecordsList.Value) == 0 {
+	if len(newRecordsSlice) == 0 {
 		return k.RecordExpiryQueue.Remove(ctx, expiryTime)
 	} else {
 		existingRecordsList.Value = newRecordsSlice
@@ -671,16 +1271,27 @@ func (k Keeper) tryTakeRecordRent(ctx sdk.Context, record registrytypes.Record)
 		return err
 	}
 
-	rent := params.RecordRent
+	rent := getRecordRentPerPeriod(params, len(record.Attributes))
 	sdkErr := k.bondKeeper.TransferCoinsToModuleAccount(ctx, record.BondId, registrytypes.RecordRentModuleAccountName, sdk.NewCoins(rent))
 	if sdkErr != nil {
 		// Insufficient funds, mark record as deleted.
-		record.Deleted = true
-		if err := k.SaveRecord(ctx, record); err != nil {
+		if err := k.markRecordDeleted(ctx, record); err != nil {
+			return err
+		}
+
+		if err := k.deleteRecordExpiryQueue(ctx, record); err != nil {
 			return err
 		}
 
-		return k.deleteRecordExpiryQueue(ctx, record)
+		return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordExpired{
+			RecordId: record.Id,
+			BondId:   record.BondId,
+			Reason:   registrytypes.ExpiryReasonInsufficientFunds,
+		})
+	}
+
+	if err := emitRecordRentCharged(ctx, record, rent); err != nil {
+		return err
 	}
 
 	// Delete old expiry queue entry, create new one.
@@ -693,34 +1304,50 @@ func (k Keeper) tryTakeRecordRent(ctx sdk.Context, record registrytypes.Record)
 		return err
 	}
 
+	if record.Rent, err = newRecordRent(ctx, record.Rent, record.BondId, record.ExpiryTime, rent); err != nil {
+		return err
+	}
+
 	// Save record.
+	wasDeleted := record.Deleted
 	record.Deleted = false
-	return k.SaveRecord(ctx, record)
-}
+	if err := k.SaveRecord(ctx, record); err != nil {
+		return err
+	}
 
-func getIntersection(a []string, b []string) []string {
-	result := []string{}
-	if len(a) < len(b) {
-		for _, str := range a {
-			if contains(b, str) {
-				result = append(result, str)
-			}
-		}
-	} else {
-		for _, str := range b {
-			if contains(a, str) {
-				result = append(result, str)
-			}
+	// Restore the attribute indexes of renewed (previously deleted) records.
+	if wasDeleted {
+		if err := k.processAttributes(ctx, record.Attributes, record.Id); err != nil {
+			return err
 		}
 	}
-	return result
+
+	return emitRecordRenewed(ctx, record)
 }
 
-func contains(arr []string, str string) bool {
-	for _, s := range arr {
-		if s == str {
-			return true
-		}
-	}
-	return false
+// emitRecordRentCharged emits a typed event for the rent taken from the bond of a record.
+func emitRecordRentCharged(ctx sdk.Context, record registrytypes.Record, rent sdk.Coin) error {
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRentCharged{
+		BondId:   record.BondId,
+		RecordId: record.Id,
+		Rent:     rent,
+	})
+}
+
+// emitRecordRenewed emits a typed event for the new expiry time of a renewed record.
+func emitRecordRenewed(ctx sdk.Context, record registrytypes.Record) error {
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordRenewed{
+		RecordId:   record.Id,
+		BondId:     record.BondId,
+		ExpiryTime: record.ExpiryTime,
+	})
+}
+
+// emitRecordBondChanged emits a typed event for the bond of a record changed from the previous bond.
+func emitRecordBondChanged(ctx sdk.Context, record registrytypes.Record, previousBondId string) error {
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordBondChanged{
+		RecordId:       record.Id,
+		PreviousBondId: previousBondId,
+		BondId:         record.BondId,
+	})
 }
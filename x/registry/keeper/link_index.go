@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipld/go-ipld-prime"
+)
+
+// Links in record attributes are kept in a reverse index (linked CID, record id),
+// to look up the records referencing a given record.
+
+// walkLinks calls fn for the CID of each link in an attribute value, including the links nested in arrays and maps.
+func walkLinks(n ipld.Node, fn func(target string) error) error {
+	switch n.Kind() {
+	case ipld.Kind_Link:
+		link, err := n.AsLink()
+		if err != nil {
+			return err
+		}
+
+		return fn(link.String())
+	case ipld.Kind_List:
+		for it := n.ListIterator(); !it.Done(); {
+			_, elem, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := walkLinks(elem, fn); err != nil {
+				return err
+			}
+		}
+	case ipld.Kind_Map:
+		for it := n.MapIterator(); !it.Done(); {
+			_, value, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := walkLinks(value, fn); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setRecordLinkMapping(ctx sdk.Context, n ipld.Node, recordId string) error {
+	return walkLinks(n, func(target string) error {
+		return k.RecordLinksIndex.Set(ctx, collections.Join(target, recordId))
+	})
+}
+
+func (k Keeper) removeRecordLinkMapping(ctx sdk.Context, n ipld.Node, recordId string) error {
+	return walkLinks(n, func(target string) error {
+		return k.RecordLinksIndex.Remove(ctx, collections.Join(target, recordId))
+	})
+}
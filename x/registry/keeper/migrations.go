@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipld/go-ipld-prime"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the registry from version 1 to 2 in a single pass. It sets the params added in
+// version 2, converts record attributes from JSON to canonical DAG-CBOR (the block that the record id
+// addresses), and rebuilds the record bond, previous version, owner, attribute and link indexes.
+// Attribute and link index entries of deleted records are pruned; deleted records stay indexed by bond.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	if err := m.migrateParams(ctx); err != nil {
+		return err
+	}
+
+	if err := k.AttributesMap.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := k.AttributesRangeIndex.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	if err := k.RecordLinksIndex.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// Stale bond index entries can't be unreferenced through the records map, so clear the index store directly.
+	bondIndexStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), registrytypes.RecordsByBondIdIndexPrefix)
+	iter := bondIndexStore.Iterator(nil, nil)
+	var bondIndexKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		bondIndexKeys = append(bondIndexKeys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range bondIndexKeys {
+		bondIndexStore.Delete(key)
+	}
+
+	recordIds, err := k.listRecordIds(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range recordIds {
+		record, err := k.Records.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if registrytypes.IsJSONAttributes(record.Attributes) {
+			record.Attributes, err = registrytypes.AttributesFromJSON(record.Attributes)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Saving the record again rebuilds its bond, previous version and owner index entries.
+		if err := k.SaveRecord(ctx, record); err != nil {
+			return err
+		}
+
+		if record.Deleted {
+			continue
+		}
+
+		if err := k.processAttributes(ctx, record.Attributes, record.Id); err != nil {
+			return err
+		}
+		err = walkAttributes(record.Attributes, func(_ string, n ipld.Node) error {
+			return k.setRecordLinkMapping(ctx, n, record.Id)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateParams sets the params added in version 2 to their defaults. The max record lifetime is at least
+// the rent duration, and rent per byte is set to zero, so existing chains keep their current record pricing.
+func (m Migrator) migrateParams(ctx sdk.Context) error {
+	k := m.keeper
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxRecordLifetime == 0 {
+		params.MaxRecordLifetime = max(registrytypes.DefaultMaxRecordLifetime, params.RecordRentDuration)
+	}
+	if params.RecordRentPerByte.Denom == "" {
+		params.RecordRentPerByte = sdk.NewCoin(params.RecordRent.Denom, math.ZeroInt())
+	}
+	if params.MaxRecordAttributesSize == 0 {
+		params.MaxRecordAttributesSize = registrytypes.DefaultMaxRecordAttributesSize
+	}
+	if params.MaxRecordAttributesDepth == 0 {
+		params.MaxRecordAttributesDepth = registrytypes.DefaultMaxRecordAttributesDepth
+	}
+	if params.MaxRecordIndexedAttributes == 0 {
+		params.MaxRecordIndexedAttributes = registrytypes.DefaultMaxRecordIndexedAttributes
+	}
+	if params.MaxSchemaSize == 0 {
+		params.MaxSchemaSize = registrytypes.DefaultMaxSchemaSize
+	}
+
+	return k.Params.Set(ctx, *params)
+}
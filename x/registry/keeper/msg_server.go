@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -57,6 +60,43 @@ func (ms msgServer) SetRecord(c context.Context, msg *registrytypes.MsgSetRecord
 	return &registrytypes.MsgSetRecordResponse{Id: record.Id}, nil
 }
 
+func (ms msgServer) UpdateRecord(c context.Context, msg *registrytypes.MsgUpdateRecord) (*registrytypes.MsgUpdateRecordResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := ms.k.UpdateRecord(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeUpdateRecord,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.GetSigner()),
+			sdk.NewAttribute(registrytypes.AttributeKeyPreviousId, msg.GetRecordId()),
+			sdk.NewAttribute(registrytypes.AttributeKeyPayload, record.Id),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "UpdateRecord")
+
+	return &registrytypes.MsgUpdateRecordResponse{Id: record.Id}, nil
+}
+
 // nolint: all
 func (ms msgServer) SetName(c context.Context, msg *registrytypes.MsgSetName) (*registrytypes.MsgSetNameResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
@@ -174,6 +214,191 @@ func (ms msgServer) SetAuthorityBond(c context.Context, msg *registrytypes.MsgSe
 	return &registrytypes.MsgSetAuthorityBondResponse{}, nil
 }
 
+func (ms msgServer) TransferAuthority(c context.Context, msg *registrytypes.MsgTransferAuthority) (*registrytypes.MsgTransferAuthorityResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := ms.k.TransferAuthority(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeTransferAuthority,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(registrytypes.AttributeKeyNewOwner, msg.NewOwner),
+			sdk.NewAttribute(registrytypes.AttributeKeyPending, strconv.FormatBool(pending)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "TransferAuthority")
+
+	return &registrytypes.MsgTransferAuthorityResponse{}, nil
+}
+
+func (ms msgServer) AcceptAuthority(c context.Context, msg *registrytypes.MsgAcceptAuthority) (*registrytypes.MsgAcceptAuthorityResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.AcceptAuthority(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeAcceptAuthority,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyName, msg.Name),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "AcceptAuthority")
+
+	return &registrytypes.MsgAcceptAuthorityResponse{}, nil
+}
+
+func (ms msgServer) RenewAuthority(c context.Context, msg *registrytypes.MsgRenewAuthority) (*registrytypes.MsgRenewAuthorityResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.RenewAuthority(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeRenewAuthority,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(registrytypes.AttributeKeyPeriods, strconv.FormatUint(msg.Periods, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "RenewAuthority")
+
+	return &registrytypes.MsgRenewAuthorityResponse{}, nil
+}
+
+func (ms msgServer) GrantNameAccess(c context.Context, msg *registrytypes.MsgGrantNameAccess) (*registrytypes.MsgGrantNameAccessResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.GrantNameAccess(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeGrantNameAccess,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyLRNPrefix, msg.LrnPrefix),
+			sdk.NewAttribute(registrytypes.AttributeKeyGrantee, msg.Grantee),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "GrantNameAccess")
+
+	return &registrytypes.MsgGrantNameAccessResponse{}, nil
+}
+
+func (ms msgServer) RevokeNameAccess(c context.Context, msg *registrytypes.MsgRevokeNameAccess) (*registrytypes.MsgRevokeNameAccessResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.RevokeNameAccess(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeRevokeNameAccess,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyLRNPrefix, msg.LrnPrefix),
+			sdk.NewAttribute(registrytypes.AttributeKeyGrantee, msg.Grantee),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "RevokeNameAccess")
+
+	return &registrytypes.MsgRevokeNameAccessResponse{}, nil
+}
+
 func (ms msgServer) DeleteName(c context.Context, msg *registrytypes.MsgDeleteName) (*registrytypes.MsgDeleteNameResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -246,6 +471,86 @@ func (ms msgServer) RenewRecord(c context.Context, msg *registrytypes.MsgRenewRe
 	return &registrytypes.MsgRenewRecordResponse{}, nil
 }
 
+// DeleteRecord deletes a record and refunds the unused rent to its bond.
+func (ms msgServer) DeleteRecord(c context.Context, msg *registrytypes.MsgDeleteRecord) (*registrytypes.MsgDeleteRecordResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := ms.k.DeleteRecord(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeDeleteRecord,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyRecordId, msg.RecordId),
+			sdk.NewAttribute(registrytypes.AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "DeleteRecord")
+
+	return &registrytypes.MsgDeleteRecordResponse{}, nil
+}
+
+// TransferRecordOwnership replaces the owners of a record.
+func (ms msgServer) TransferRecordOwnership(
+	c context.Context,
+	msg *registrytypes.MsgTransferRecordOwnership,
+) (*registrytypes.MsgTransferRecordOwnershipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.TransferRecordOwnership(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeTransferRecordOwnership,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyRecordId, msg.RecordId),
+			sdk.NewAttribute(registrytypes.AttributeKeyOwners, strings.Join(msg.Owners, ",")),
+			sdk.NewAttribute(registrytypes.AttributeKeyThreshold, fmt.Sprint(msg.OwnerThreshold)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "TransferRecordOwnership")
+
+	return &registrytypes.MsgTransferRecordOwnershipResponse{}, nil
+}
+
 // nolint: all
 func (ms msgServer) AssociateBond(c context.Context, msg *registrytypes.MsgAssociateBond) (*registrytypes.MsgAssociateBondResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
@@ -395,3 +700,40 @@ func (ms msgServer) ReassociateRecords(c context.Context, msg *registrytypes.Msg
 
 	return &registrytypes.MsgReassociateRecordsResponse{}, nil
 }
+
+func (ms msgServer) RegisterSchema(c context.Context, msg *registrytypes.MsgRegisterSchema) (*registrytypes.MsgRegisterSchemaResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = *utils.CtxWithCustomKVGasConfig(&ctx)
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ms.k.RegisterSchema(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			registrytypes.EventTypeRegisterSchema,
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(registrytypes.AttributeKeyRecordType, msg.RecordType),
+			sdk.NewAttribute(registrytypes.AttributeKeyAuthority, msg.Authority),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, registrytypes.AttributeValueCategory),
+			sdk.NewAttribute(registrytypes.AttributeKeySigner, msg.Signer),
+		),
+	})
+
+	utils.LogTxGasConsumed(ctx, ms.k.Logger(ctx), "RegisterSchema")
+
+	return &registrytypes.MsgRegisterSchemaResponse{}, nil
+}
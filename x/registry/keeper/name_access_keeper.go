@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// SaveNameAccessGrant - saves a name access grant to the store.
+func (k Keeper) SaveNameAccessGrant(ctx sdk.Context, grant registrytypes.NameAccessGrant) error {
+	name, err := registrytypes.ParseLRNPrefix(grant.LrnPrefix)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN prefix.")
+	}
+
+	return k.NameAccessGrants.Set(ctx, collections.Join3(name, grant.Grantee, grant.LrnPrefix), grant)
+}
+
+// ListNameAccessGrants - gets the name access grants of an authority (optionally to a grantee),
+// or all of them if no authority is given.
+func (k Keeper) ListNameAccessGrants(ctx sdk.Context, name string, grantee string) ([]registrytypes.NameAccessGrant, error) {
+	var rng collections.Ranger[collections.Triple[string, string, string]]
+	if name != "" {
+		if grantee != "" {
+			rng = collections.NewSuperPrefixedTripleRange[string, string, string](name, grantee)
+		} else {
+			rng = collections.NewPrefixedTripleRange[string, string, string](name)
+		}
+	}
+
+	var grants []registrytypes.NameAccessGrant
+	err := k.NameAccessGrants.Walk(ctx, rng, func(_ collections.Triple[string, string, string], grant registrytypes.NameAccessGrant) (bool, error) {
+		grants = append(grants, grant)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grants, nil
+}
+
+// GrantNameAccess lets the grantee set and delete the names matching an LRN prefix, on behalf of the authority owner.
+func (k Keeper) GrantNameAccess(ctx sdk.Context, msg registrytypes.MsgGrantNameAccess) error {
+	name, err := registrytypes.ParseLRNPrefix(msg.LrnPrefix)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN prefix.")
+	}
+
+	authority, err := k.getOwnedAuthority(ctx, name, msg.Signer)
+	if err != nil {
+		return err
+	}
+
+	if authority.Status != registrytypes.AuthorityActive {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Authority is not active.")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "Invalid grantee address.")
+	}
+
+	if msg.ExpiryTime != nil && !msg.ExpiryTime.After(ctx.BlockTime()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Expiry time must be in the future.")
+	}
+
+	grant := registrytypes.NameAccessGrant{
+		LrnPrefix:  msg.LrnPrefix,
+		Grantee:    msg.Grantee,
+		Granter:    msg.Signer,
+		ExpiryTime: msg.ExpiryTime,
+	}
+	if err := k.SaveNameAccessGrant(ctx, grant); err != nil {
+		return err
+	}
+
+	var expiryTime string
+	if grant.ExpiryTime != nil {
+		expiryTime = grant.ExpiryTime.Format(time.RFC3339)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventNameAccessGranted{
+		LrnPrefix:  grant.LrnPrefix,
+		Grantee:    grant.Grantee,
+		Granter:    grant.Granter,
+		ExpiryTime: expiryTime,
+	})
+}
+
+// RevokeNameAccess deletes a name access grant.
+func (k Keeper) RevokeNameAccess(ctx sdk.Context, msg registrytypes.MsgRevokeNameAccess) error {
+	name, err := registrytypes.ParseLRNPrefix(msg.LrnPrefix)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN prefix.")
+	}
+
+	if _, err := k.getOwnedAuthority(ctx, name, msg.Signer); err != nil {
+		return err
+	}
+
+	key := collections.Join3(name, msg.Grantee, msg.LrnPrefix)
+	if has, err := k.NameAccessGrants.Has(ctx, key); !has {
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Name access grant not found.")
+	}
+
+	if err := k.NameAccessGrants.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventNameAccessRevoked{
+		LrnPrefix: msg.LrnPrefix,
+		Grantee:   msg.Grantee,
+	})
+}
+
+// clearNameAccessGrants deletes the name access grants of an authority, e.g. as its owner changes.
+func (k Keeper) clearNameAccessGrants(ctx sdk.Context, name string) error {
+	return k.NameAccessGrants.Clear(ctx, collections.NewPrefixedTripleRange[string, string, string](name))
+}
+
+// hasNameAccess checks if the grantee has been granted access to an LRN by the current authority owner,
+// through a grant that hasn't expired. Grants are cleared as the owner changes, the granter is checked regardless.
+func (k Keeper) hasNameAccess(
+	ctx sdk.Context,
+	name string,
+	authority *registrytypes.NameAuthority,
+	grantee string,
+	lrn string,
+) (bool, error) {
+	found := false
+	rng := collections.NewSuperPrefixedTripleRange[string, string, string](name, grantee)
+	err := k.NameAccessGrants.Walk(ctx, rng, func(_ collections.Triple[string, string, string], grant registrytypes.NameAccessGrant) (bool, error) {
+		if grant.Granter != authority.OwnerAddress {
+			return false, nil
+		}
+		if grant.ExpiryTime != nil && !ctx.BlockTime().Before(*grant.ExpiryTime) {
+			return false, nil
+		}
+
+		found = registrytypes.MatchesLRNPrefix(grant.LrnPrefix, lrn)
+		return found, nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// getOwnedAuthority gets a name authority, checking that the signer owns it.
+func (k Keeper) getOwnedAuthority(ctx sdk.Context, name string, signer string) (registrytypes.NameAuthority, error) {
+	if has, err := k.HasNameAuthority(ctx, name); !has {
+		if err != nil {
+			return registrytypes.NameAuthority{}, err
+		}
+
+		return registrytypes.NameAuthority{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	authority, err := k.GetNameAuthority(ctx, name)
+	if err != nil {
+		return registrytypes.NameAuthority{}, err
+	}
+
+	if authority.OwnerAddress != signer {
+		return registrytypes.NameAuthority{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	return authority, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	auctiontypes "git.vdb.to/cerc-io/laconicd/x/auction"
 	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
@@ -85,10 +87,22 @@ func (k Keeper) GetNameRecord(ctx sdk.Context, lrn string) (*registrytypes.NameR
 	return &nameRecord, nil
 }
 
+// authorityResolves reports whether names under an authority resolve, i.e. whether it's active or in its
+// grace period (still resolving, so that missed rent doesn't take its names down before it expires).
+func authorityResolves(authority *registrytypes.NameAuthority) bool {
+	return authority.Status == registrytypes.AuthorityActive || authority.Status == registrytypes.AuthorityInGracePeriod
+}
+
 // LookupNameRecord - gets a name record which is not stale and under active authority.
 func (k Keeper) LookupNameRecord(ctx sdk.Context, lrn string) (*registrytypes.NameRecord, error) {
+	return k.LookupNameRecordAt(ctx, lrn, 0, 0)
+}
+
+// LookupNameRecordAt - gets a name record as of a block height or a version of the name (see NameRecord.AsOf),
+// which is not stale and under active authority.
+func (k Keeper) LookupNameRecordAt(ctx sdk.Context, lrn string, height uint64, version uint64) (*registrytypes.NameRecord, error) {
 	_, _, authority, err := k.getAuthority(ctx, lrn)
-	if err != nil || authority.Status != registrytypes.AuthorityActive {
+	if err != nil || !authorityResolves(authority) {
 		// If authority is not active (or any other error), lookup fails.
 		return nil, nil
 	}
@@ -100,6 +114,12 @@ func (k Keeper) LookupNameRecord(ctx sdk.Context, lrn string) (*registrytypes.Na
 		return nil, err
 	}
 
+	// Name record may not exist at the given height or version.
+	nameRecord = nameRecord.AsOf(height, version)
+	if nameRecord == nil {
+		return nil, nil
+	}
+
 	// Name lookup should fail if the name record is stale.
 	// i.e. authority was registered later than the name.
 	if authority.Height > nameRecord.Latest.Height {
@@ -125,6 +145,70 @@ func (k Keeper) ListNameRecords(ctx sdk.Context) ([]registrytypes.NameEntry, err
 	return nameEntries, err
 }
 
+// ListNameRecordsByPrefix - gets a page of the name records under an LRN prefix, marking the stale and deleted ones.
+func (k Keeper) ListNameRecordsByPrefix(
+	ctx sdk.Context,
+	lrnPrefix string,
+	pagination *query.PageRequest,
+) ([]registrytypes.NamePrefixEntry, *query.PageResponse, error) {
+	parsedPrefix, err := url.Parse(lrnPrefix)
+	if err != nil || parsedPrefix.Scheme != "lrn" || parsedPrefix.Host == "" {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN prefix.")
+	}
+
+	// A bare authority prefix lists the names of the authority, not of other authorities it prefixes.
+	if parsedPrefix.Path == "" {
+		lrnPrefix += "/"
+	}
+
+	// Names under a prefix mostly share the same authority, nil if it doesn't exist.
+	authorities := map[string]*registrytypes.NameAuthority{}
+	getAuthority := func(name string) (*registrytypes.NameAuthority, error) {
+		if authority, ok := authorities[name]; ok {
+			return authority, nil
+		}
+
+		var authority *registrytypes.NameAuthority
+		if has, err := k.HasNameAuthority(ctx, name); err != nil {
+			return nil, err
+		} else if has {
+			existingAuthority, err := k.GetNameAuthority(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			authority = &existingAuthority
+		}
+		authorities[name] = authority
+
+		return authority, nil
+	}
+
+	return query.CollectionPaginate(ctx, k.NameRecords, pagination,
+		func(lrn string, nameRecord registrytypes.NameRecord) (registrytypes.NamePrefixEntry, error) {
+			parsedLRN, err := url.Parse(lrn)
+			if err != nil {
+				return registrytypes.NamePrefixEntry{}, err
+			}
+
+			authority, err := getAuthority(parsedLRN.Host)
+			if err != nil {
+				return registrytypes.NamePrefixEntry{}, err
+			}
+
+			return registrytypes.NamePrefixEntry{
+				Name:    lrn,
+				Entry:   &nameRecord,
+				Stale:   authority == nil || !authorityResolves(authority) || authority.Height > nameRecord.Latest.Height,
+				Deleted: nameRecord.Latest.Id == "",
+			}, nil
+		},
+		// Name record keys are encoded as is, so the prefix of the keys is the LRN prefix.
+		func(o *query.CollectionsPaginateOptions[string]) {
+			o.Prefix = &lrnPrefix
+		},
+	)
+}
+
 // SaveNameRecord - sets a name record.
 func (k Keeper) SaveNameRecord(ctx sdk.Context, lrn string, id string) error {
 	var nameRecord registrytypes.NameRecord
@@ -165,7 +249,11 @@ func (k Keeper) SetName(ctx sdk.Context, msg registrytypes.MsgSetName) error {
 		return nil
 	}
 
-	return k.SaveNameRecord(ctx, msg.Lrn, msg.Cid)
+	if err := k.SaveNameRecord(ctx, msg.Lrn, msg.Cid); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventNameSet{Lrn: msg.Lrn, RecordId: msg.Cid})
 }
 
 // SaveNameAuthority creates the NameAuthority record.
@@ -306,12 +394,26 @@ func (k Keeper) createAuthority(ctx sdk.Context, name string, owner string, isRo
 		authority.ExpiryTime = auction.RevealsEndTime.Add(moduleParams.AuthorityGracePeriod)
 	}
 
+	// Clear name access grants of the expired authority, if any.
+	if err = k.clearNameAccessGrants(ctx, name); err != nil {
+		return err
+	}
+
 	// Save name authority in store.
 	if err = k.SaveNameAuthority(ctx, name, &authority); err != nil {
 		return err
 	}
 
-	return k.insertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime)
+	if err = k.insertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityReserved{
+		Name:       name,
+		Owner:      authority.OwnerAddress,
+		AuctionId:  authority.AuctionId,
+		ExpiryTime: authority.ExpiryTime.Format(time.RFC3339),
+	})
 }
 
 func (k Keeper) SetAuthorityBond(ctx sdk.Context, msg registrytypes.MsgSetAuthorityBond) error {
@@ -355,12 +457,222 @@ func (k Keeper) SetAuthorityBond(ctx sdk.Context, msg registrytypes.MsgSetAuthor
 	}
 
 	// Update bond id and save name authority in store.
+	previousBondId := authority.BondId
 	authority.BondId = bond.Id
 	if err = k.SaveNameAuthority(ctx, name, &authority); err != nil {
 		return err
 	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityBondChanged{
+		Name:           name,
+		PreviousBondId: previousBondId,
+		BondId:         authority.BondId,
+	})
+}
+
+// RenewAuthority prepays the rent of an (active or in grace period) authority for msg.Periods rent periods,
+// from its bond. The periods extend the current expiry time of an active authority, and start now otherwise.
+func (k Keeper) RenewAuthority(ctx sdk.Context, msg registrytypes.MsgRenewAuthority) error {
+	name := msg.GetName()
+
+	if has, err := k.HasNameAuthority(ctx, name); !has {
+		if err != nil {
+			return err
+		}
+
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	authority, err := k.GetNameAuthority(ctx, name)
+	if err != nil {
+		return err
+	}
+	if authority.OwnerAddress != msg.GetSigner() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != registrytypes.AuthorityActive && authority.Status != registrytypes.AuthorityInGracePeriod {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Authority is not active.")
+	}
+
+	if authority.BondId == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Authority bond not found.")
+	}
+	if has, err := k.bondKeeper.HasBond(ctx, authority.BondId); !has {
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Bond not found.")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Bound the number of periods, so that the renewal duration doesn't overflow.
+	if msg.Periods > uint64(math.MaxInt64/params.AuthorityRentDuration) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Too many rent periods.")
+	}
+
+	now := ctx.BlockTime()
+	start := now
+	if authority.Status == registrytypes.AuthorityActive && authority.ExpiryTime.After(now) {
+		start = authority.ExpiryTime
+	}
+
+	rent := params.AuthorityRent
+	rent.Amount = rent.Amount.MulRaw(int64(msg.Periods))
+	if err := k.bondKeeper.TransferCoinsToModuleAccount(
+		ctx, authority.BondId, registrytypes.AuthorityRentModuleAccountName, sdk.NewCoins(rent),
+	); err != nil {
+		return err
+	}
+
+	return k.renewAuthority(ctx, name, authority, rent, start.Add(time.Duration(msg.Periods)*params.AuthorityRentDuration))
+}
+
+// TransferAuthority transfers a name authority to a new owner, or records the transfer as pending
+// acceptance by the new owner, and returns whether it is pending. Sub-authorities are transferred
+// the same way by their own owners, and are not affected by transfers of their parent authority.
+func (k Keeper) TransferAuthority(ctx sdk.Context, msg registrytypes.MsgTransferAuthority) (bool, error) {
+	name := msg.GetName()
+
+	authority, err := k.getTransferableAuthority(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	if authority.OwnerAddress != msg.Signer {
+		return false, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid new owner address.")
+	}
+
+	// Transferring to the current owner cancels a pending transfer.
+	if msg.NewOwner == authority.OwnerAddress {
+		authority.PendingTransfer = nil
+		if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+			return false, err
+		}
+
+		return false, ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityTransferCancelled{
+			Name:  name,
+			Owner: authority.OwnerAddress,
+		})
+	}
+
+	transfer := registrytypes.AuthorityTransfer{
+		NewOwner:        msg.NewOwner,
+		KeepBond:        msg.KeepBond,
+		InvalidateNames: msg.InvalidateNames,
+	}
+
+	if msg.RequireAccept {
+		authority.PendingTransfer = &transfer
+		if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+			return false, err
+		}
+
+		return true, ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityTransferPending{
+			Name:     name,
+			Owner:    authority.OwnerAddress,
+			NewOwner: transfer.NewOwner,
+		})
+	}
+
+	return false, k.completeAuthorityTransfer(ctx, name, authority, transfer)
+}
+
+// AcceptAuthority completes the pending transfer of a name authority to the signer.
+func (k Keeper) AcceptAuthority(ctx sdk.Context, msg registrytypes.MsgAcceptAuthority) error {
+	name := msg.GetName()
+
+	authority, err := k.getTransferableAuthority(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	transfer := authority.PendingTransfer
+	if transfer == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "No pending authority transfer.")
+	}
+	if transfer.NewOwner != msg.Signer {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	return k.completeAuthorityTransfer(ctx, name, authority, *transfer)
+}
+
+// getTransferableAuthority gets a name authority that can be transferred, i.e. an active one.
+func (k Keeper) getTransferableAuthority(ctx sdk.Context, name string) (registrytypes.NameAuthority, error) {
+	if has, err := k.HasNameAuthority(ctx, name); !has {
+		if err != nil {
+			return registrytypes.NameAuthority{}, err
+		}
+
+		return registrytypes.NameAuthority{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Name authority not found.")
+	}
+
+	authority, err := k.GetNameAuthority(ctx, name)
+	if err != nil {
+		return registrytypes.NameAuthority{}, err
+	}
+
+	if authority.Status != registrytypes.AuthorityActive {
+		return registrytypes.NameAuthority{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Authority is not active.")
+	}
+
+	return authority, nil
+}
+
+// completeAuthorityTransfer sets the new owner of a name authority. The bond is reset unless kept, in which case
+// rent keeps being charged to it, and the height is bumped if existing names are to be invalidated.
+func (k Keeper) completeAuthorityTransfer(
+	ctx sdk.Context,
+	name string,
+	authority registrytypes.NameAuthority,
+	transfer registrytypes.AuthorityTransfer,
+) error {
+	ownerAddress, err := sdk.AccAddressFromBech32(transfer.NewOwner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid new owner address.")
+	}
+	ownerAccount := k.accountKeeper.GetAccount(ctx, ownerAddress)
+	if ownerAccount == nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownAddress, "Owner account not found.")
+	}
+
+	previousOwner := authority.OwnerAddress
+	authority.OwnerAddress = transfer.NewOwner
+	authority.OwnerPublicKey = getAuthorityPubKey(ownerAccount.GetPubKey())
+	authority.PendingTransfer = nil
+
+	if !transfer.KeepBond {
+		authority.BondId = ""
+	}
+
+	if transfer.InvalidateNames {
+		authority.Height = uint64(ctx.BlockHeight())
+	}
+
+	// Name access grants were given by the previous owner.
+	if err := k.clearNameAccessGrants(ctx, name); err != nil {
+		return err
+	}
+
+	if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityTransferred{
+		Name:            name,
+		PreviousOwner:   previousOwner,
+		Owner:           authority.OwnerAddress,
+		BondId:          authority.BondId,
+		InvalidateNames: transfer.InvalidateNames,
+	})
 }
 
 // DeleteName removes a LRN -> Record ID mapping.
@@ -383,13 +695,42 @@ func (k Keeper) DeleteName(ctx sdk.Context, msg registrytypes.MsgDeleteName) err
 	}
 
 	// Set CID to empty string.
-	return k.SaveNameRecord(ctx, msg.Lrn, "")
+	if err := k.SaveNameRecord(ctx, msg.Lrn, ""); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventNameDeleted{Lrn: msg.Lrn})
 }
 
 // ResolveLRN resolves a LRN to a record.
 func (k Keeper) ResolveLRN(ctx sdk.Context, lrn string) (*registrytypes.Record, error) {
+	return k.ResolveLRNAt(ctx, lrn, 0, 0)
+}
+
+// ResolveLRNAt resolves a LRN to a record as of a block height or a version of the name (see NameRecord.AsOf).
+// Unlike the latest version of a name, which resolves to the latest version of its record,
+// these resolve to the record version the name was set to, for reproducibility.
+func (k Keeper) ResolveLRNAt(ctx sdk.Context, lrn string, height uint64, version uint64) (*registrytypes.Record, error) {
+	if height != 0 || version != 0 {
+		nameRecord, err := k.LookupNameRecordAt(ctx, lrn, height, version)
+		if nameRecord == nil || nameRecord.Latest.Id == "" {
+			return nil, err
+		}
+
+		if has, err := k.HasRecord(ctx, nameRecord.Latest.Id); !has {
+			return nil, err
+		}
+
+		record, err := k.GetRecordById(ctx, nameRecord.Latest.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		return &record, nil
+	}
+
 	_, _, authority, err := k.getAuthority(ctx, lrn)
-	if err != nil || authority.Status != registrytypes.AuthorityActive {
+	if err != nil || !authorityResolves(authority) {
 		// If authority is not active (or any other error), resolution fails.
 		return nil, err
 	}
@@ -400,7 +741,7 @@ func (k Keeper) ResolveLRN(ctx sdk.Context, lrn string) (*registrytypes.Record,
 	if err != nil {
 		return nil, err
 	}
-	if authority.Height > nameRecord.Latest.Height {
+	if nameRecord == nil || authority.Height > nameRecord.Latest.Height {
 		return nil, nil
 	}
 
@@ -418,6 +759,12 @@ func (k Keeper) resolveLRNRecord(ctx sdk.Context, lrn string) (*registrytypes.Re
 		return nil, nameRecord, nil
 	}
 
+	// Names resolve to the latest version of the record they point to.
+	latestRecordId, err = k.getLatestRecordVersion(ctx, latestRecordId)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if has, err := k.HasRecord(ctx, latestRecordId); !has {
 		if err != nil {
 			return nil, nil, err
@@ -468,8 +815,16 @@ func (k Keeper) checkLRNAccess(ctx sdk.Context, signer sdk.AccAddress, lrn strin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN.")
 	}
 
-	if authority.OwnerAddress != signer.String() {
-		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	// Other signers need access granted by the owner (see MsgGrantNameAccess).
+	isOwner := authority.OwnerAddress == signer.String()
+	if !isOwner {
+		granted, err := k.hasNameAccess(ctx, name, authority, signer.String(), lrn)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+		}
 	}
 
 	if authority.Status != registrytypes.AuthorityActive {
@@ -480,7 +835,7 @@ func (k Keeper) checkLRNAccess(ctx sdk.Context, signer sdk.AccAddress, lrn strin
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Authority bond not found.")
 	}
 
-	if authority.OwnerPublicKey == "" {
+	if isOwner && authority.OwnerPublicKey == "" {
 		// Try to set owner public key if account has it available now.
 		ownerAccount := k.accountKeeper.GetAccount(ctx, signer)
 		pubKey := ownerAccount.GetPubKey()
@@ -509,27 +864,27 @@ func (k Keeper) ProcessAuthorityExpiryQueue(ctx sdk.Context) error {
 			return err
 		}
 
-		bondExists := false
-		if authority.BondId != "" {
-			bondExists, err = k.bondKeeper.HasBond(ctx, authority.BondId)
-			if err != nil {
+		// An authority without an associated bond (e.g. not set since it was reserved) has no rent to miss, mark it expired.
+		if authority.BondId == "" {
+			if err := k.expireAuthority(ctx, name, authority, registrytypes.ExpiryReasonBondNotFound); err != nil {
 				return err
 			}
+
+			// Continue with the loop
+			continue
 		}
 
-		// If authority doesn't have an associated bond or if bond no longer exists, mark it expired.
-		if !bondExists {
-			authority.Status = registrytypes.AuthorityExpired
-			if err = k.SaveNameAuthority(ctx, name, &authority); err != nil {
-				return err
-			}
+		bondExists, err := k.bondKeeper.HasBond(ctx, authority.BondId)
+		if err != nil {
+			return err
+		}
 
-			if err = k.deleteAuthorityExpiryQueue(ctx, name, authority); err != nil {
+		// If the bond no longer exists, the rent can't be paid.
+		if !bondExists {
+			if err := k.missAuthorityRent(ctx, name, authority, registrytypes.ExpiryReasonBondNotFound); err != nil {
 				return err
 			}
 
-			k.Logger(ctx).Info(fmt.Sprintf("Marking authority expired as no bond present: %s", name))
-
 			// Continue with the loop
 			continue
 		}
@@ -617,15 +972,34 @@ func (k Keeper) tryTakeAuthorityRent(ctx sdk.Context, name string, authority reg
 	rent := params.AuthorityRent
 	sdkErr := k.bondKeeper.TransferCoinsToModuleAccount(ctx, authority.BondId, registrytypes.AuthorityRentModuleAccountName, sdk.NewCoins(rent))
 	if sdkErr != nil {
-		// Insufficient funds, mark authority as expired.
-		authority.Status = registrytypes.AuthorityExpired
-		if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
-			return err
-		}
+		k.Logger(ctx).Info(fmt.Sprintf("Insufficient funds in owner account to pay authority rent: %s", name))
+
+		return k.missAuthorityRent(ctx, name, authority, registrytypes.ExpiryReasonInsufficientFunds)
+	}
 
-		k.Logger(ctx).Info(fmt.Sprintf("Insufficient funds in owner account to pay authority rent, marking as expired: %s", name))
+	if err := k.renewAuthority(ctx, name, authority, rent, ctx.BlockTime().Add(params.AuthorityRentDuration)); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Authority rent paid successfully: %s", name))
 
-		return k.deleteAuthorityExpiryQueue(ctx, name, authority)
+	return nil
+}
+
+// renewAuthority marks an authority active until expiryTime, once rent has been taken from its bond.
+func (k Keeper) renewAuthority(
+	ctx sdk.Context,
+	name string,
+	authority registrytypes.NameAuthority,
+	rent sdk.Coin,
+	expiryTime time.Time,
+) error {
+	if err := ctx.EventManager().EmitTypedEvent(&registrytypes.EventRentCharged{
+		BondId:    authority.BondId,
+		Authority: name,
+		Rent:      rent,
+	}); err != nil {
+		return err
 	}
 
 	// Delete old expiry queue entry, create new one.
@@ -633,17 +1007,78 @@ func (k Keeper) tryTakeAuthorityRent(ctx sdk.Context, name string, authority reg
 		return err
 	}
 
-	authority.ExpiryTime = ctx.BlockTime().Add(params.AuthorityRentDuration)
+	authority.ExpiryTime = expiryTime
 	if err := k.insertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime); err != nil {
 		return err
 	}
 
 	// Save authority.
 	authority.Status = registrytypes.AuthorityActive
+	if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+		return err
+	}
 
-	k.Logger(ctx).Info(fmt.Sprintf("Authority rent paid successfully: %s", name))
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityRenewed{
+		Name:       name,
+		BondId:     authority.BondId,
+		ExpiryTime: authority.ExpiryTime.Format(time.RFC3339),
+	})
+}
+
+// missAuthorityRent puts an active authority whose rent couldn't be taken in its grace period, during which
+// its names still resolve but can't be changed, until it's renewed or expires. Other authorities are expired.
+func (k Keeper) missAuthorityRent(ctx sdk.Context, name string, authority registrytypes.NameAuthority, reason string) error {
+	if authority.Status != registrytypes.AuthorityActive {
+		return k.expireAuthority(ctx, name, authority, reason)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.deleteAuthorityExpiryQueue(ctx, name, authority); err != nil {
+		return err
+	}
+
+	authority.Status = registrytypes.AuthorityInGracePeriod
+	authority.ExpiryTime = ctx.BlockTime().Add(params.AuthorityGracePeriod)
+	if err := k.insertAuthorityExpiryQueue(ctx, name, authority.ExpiryTime); err != nil {
+		return err
+	}
+
+	if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Authority rent not paid, marking as in grace period: %s", name))
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityGracePeriod{
+		Name:       name,
+		BondId:     authority.BondId,
+		Reason:     reason,
+		ExpiryTime: authority.ExpiryTime.Format(time.RFC3339),
+	})
+}
 
-	return k.SaveNameAuthority(ctx, name, &authority)
+// expireAuthority marks an authority expired and takes it out of the expiry queue.
+func (k Keeper) expireAuthority(ctx sdk.Context, name string, authority registrytypes.NameAuthority, reason string) error {
+	authority.Status = registrytypes.AuthorityExpired
+	if err := k.SaveNameAuthority(ctx, name, &authority); err != nil {
+		return err
+	}
+
+	if err := k.deleteAuthorityExpiryQueue(ctx, name, authority); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Marking authority expired (%s): %s", reason, name))
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityExpired{
+		Name:   name,
+		BondId: authority.BondId,
+		Reason: reason,
+	})
 }
 
 func getAuthorityPubKey(pubKey cryptotypes.PubKey) string {
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// normalizeOwnerAddress converts an owner address to the (hex) format of record owners.
+// Account (bech32), hex and Ethereum (0x) addresses are accepted.
+func normalizeOwnerAddress(owner string) string {
+	if address, err := sdk.AccAddressFromBech32(owner); err == nil {
+		return crypto.Address(address).String()
+	}
+
+	// Ethereum addresses are stored checksummed.
+	if strings.HasPrefix(owner, "0x") && common.IsHexAddress(owner) {
+		return common.HexToAddress(owner).String()
+	}
+
+	return strings.ToUpper(owner)
+}
+
+// recordOwnersKeys returns the keys of a record in the owner index, deleted records are kept out of the index.
+func recordOwnersKeys(record registrytypes.Record) []string {
+	if record.Deleted {
+		return nil
+	}
+
+	return record.Owners
+}
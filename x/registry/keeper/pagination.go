@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// paginateRecordsIndex pages through the records referenced under the given key of a (key, record id)
+// index, iterating over the index itself so that only the records on the page are loaded.
+// The keys in the request and response are record ids, as with paginateRecordIds.
+// Records rejected by the (optional) predicate are skipped, but still count towards the limit in key based pagination.
+func paginateRecordsIndex[C query.Collection[collections.Pair[string, string], collections.NoValue]](
+	ctx sdk.Context,
+	k Keeper,
+	index C,
+	key string,
+	pageReq *query.PageRequest,
+	predicate func(record registrytypes.Record) bool,
+) ([]registrytypes.Record, *query.PageResponse, error) {
+	getRecord := func(key collections.Pair[string, string], _ collections.NoValue) (registrytypes.Record, error) {
+		return k.GetRecordById(ctx, key.K2())
+	}
+	if predicate == nil {
+		return query.CollectionPaginate(ctx, index, pageReq, getRecord, query.WithCollectionPaginationPairPrefix[string, string](key))
+	}
+
+	// The record loaded by the predicate is the one transformed next, if included.
+	var record registrytypes.Record
+	return query.CollectionFilteredPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[string, string], value collections.NoValue) (bool, error) {
+			var err error
+			if record, err = getRecord(key, value); err != nil {
+				return false, err
+			}
+
+			return predicate(record), nil
+		},
+		func(collections.Pair[string, string], collections.NoValue) (registrytypes.Record, error) {
+			return record, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](key),
+	)
+}
+
+// paginateRecordIds pages through the records with the given ids, in id order,
+// following the same semantics as query.CollectionPaginate: either a key or an offset can be
+// used to set the start of the page, and the keys in the request and response are record ids.
+// Records rejected by the predicate are skipped and don't count towards the limit or total.
+func (k Keeper) paginateRecordIds(
+	ctx sdk.Context,
+	ids []string,
+	pageReq *query.PageRequest,
+	predicate func(record registrytypes.Record) bool,
+) ([]registrytypes.Record, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	sortedIds := make([]string, len(ids))
+	copy(sortedIds, ids)
+	sort.Strings(sortedIds)
+	if pageReq.Reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(sortedIds)))
+	}
+
+	// Skip the ids before the start key.
+	start := 0
+	if len(pageReq.Key) != 0 {
+		key := string(pageReq.Key)
+		start = sort.Search(len(sortedIds), func(i int) bool {
+			if pageReq.Reverse {
+				return sortedIds[i] <= key
+			}
+			return sortedIds[i] >= key
+		})
+	}
+
+	records := []registrytypes.Record{}
+	pageRes := &query.PageResponse{}
+	var count uint64
+	for _, id := range sortedIds[start:] {
+		record, err := k.GetRecordById(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if predicate != nil && !predicate(record) {
+			continue
+		}
+
+		count++
+		switch {
+		case count <= pageReq.Offset:
+			continue
+		case count <= pageReq.Offset+limit:
+			records = append(records, record)
+			continue
+		case count == pageReq.Offset+limit+1:
+			pageRes.NextKey = []byte(id)
+		}
+
+		// The total is only computed for offset based pagination.
+		if len(pageReq.Key) != 0 || !countTotal {
+			break
+		}
+	}
+
+	if len(pageReq.Key) == 0 && countTotal {
+		pageRes.Total = count
+	}
+
+	return records, pageRes, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
 )
@@ -39,20 +41,33 @@ func (qs queryServer) Records(c context.Context, req *registrytypes.QueryRecords
 	all := req.GetAll()
 
 	var records []registrytypes.Record
+	var pageRes *query.PageResponse
 	var err error
-	if len(attributes) > 0 {
-		records, err = qs.k.RecordsFromAttributes(ctx, attributes, all)
+	if len(attributes) > 0 || req.GetFilter() != nil {
+		if err := validateRecordsQuery(attributes, req.GetFilter()); err != nil {
+			return nil, err
+		}
+
+		records, pageRes, err = qs.k.RecordsFromAttributes(ctx, attributes, req.GetFilter(), all, req.GetPagination())
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		records, err = qs.k.ListRecords(ctx)
+		records, pageRes, err = query.CollectionPaginate(ctx, qs.k.Records, req.GetPagination(),
+			func(_ string, record registrytypes.Record) (registrytypes.Record, error) {
+				if err := qs.k.populateRecordNames(ctx, &record); err != nil {
+					return registrytypes.Record{}, err
+				}
+
+				return record, nil
+			},
+		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	return &registrytypes.QueryRecordsResponse{Records: records}, nil
+	return &registrytypes.QueryRecordsResponse{Records: records, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) GetRecord(c context.Context, req *registrytypes.QueryGetRecordRequest) (*registrytypes.QueryGetRecordResponse, error) {
@@ -75,18 +90,120 @@ func (qs queryServer) GetRecord(c context.Context, req *registrytypes.QueryGetRe
 	return &registrytypes.QueryGetRecordResponse{Record: record}, nil
 }
 
+func (qs queryServer) GetRecordHistory(
+	c context.Context,
+	req *registrytypes.QueryGetRecordHistoryRequest,
+) (*registrytypes.QueryGetRecordHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if has, err := qs.k.HasRecord(ctx, req.GetId()); !has {
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "Record not found.")
+	}
+
+	records, err := qs.k.GetRecordHistory(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryGetRecordHistoryResponse{Records: records}, nil
+}
+
+func (qs queryServer) GetRecordsByOwner(
+	c context.Context,
+	req *registrytypes.QueryGetRecordsByOwnerRequest,
+) (*registrytypes.QueryGetRecordsByOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageRes, err := paginateRecordsIndex(
+		ctx, qs.k, qs.k.Records.Indexes.Owner, normalizeOwnerAddress(req.GetOwner()), req.GetPagination(), nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryGetRecordsByOwnerResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetReferencingRecords(
+	c context.Context,
+	req *registrytypes.QueryGetReferencingRecordsRequest,
+) (*registrytypes.QueryGetReferencingRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Links of deleted records are removed from the index.
+	records, pageRes, err := paginateRecordsIndex(ctx, qs.k, qs.k.RecordLinksIndex, req.GetId(), req.GetPagination(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryGetReferencingRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) QuoteRecordRent(
+	c context.Context,
+	req *registrytypes.QueryQuoteRecordRentRequest,
+) (*registrytypes.QueryQuoteRecordRentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params, err := qs.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attributesSize := req.GetAttributesSize()
+	if req.GetRecordId() != "" {
+		if has, err := qs.k.HasRecord(ctx, req.GetRecordId()); !has {
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "Record not found.")
+		}
+
+		record, err := qs.k.GetRecordById(ctx, req.GetRecordId())
+		if err != nil {
+			return nil, err
+		}
+		attributesSize = uint64(len(record.Attributes))
+	} else if attributesSize > params.MaxRecordAttributesSize {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum size.")
+	}
+
+	periods := req.GetPeriods()
+	if periods == 0 {
+		periods = 1
+	}
+
+	rentPerPeriod := getRecordRentPerPeriod(params, int(attributesSize))
+	rent := sdk.NewCoin(rentPerPeriod.Denom, rentPerPeriod.Amount.Mul(math.NewIntFromUint64(periods)))
+
+	return &registrytypes.QueryQuoteRecordRentResponse{
+		AttributesSize: attributesSize,
+		RentPerPeriod:  rentPerPeriod,
+		Rent:           rent,
+	}, nil
+}
+
 func (qs queryServer) GetRecordsByBondId(
 	c context.Context,
 	req *registrytypes.QueryGetRecordsByBondIdRequest,
 ) (*registrytypes.QueryGetRecordsByBondIdResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	records, err := qs.k.GetRecordsByBondId(ctx, req.GetId())
+	// Deleted records stay associated with their bond, but aren't listed.
+	records, pageRes, err := paginateRecordsIndex(
+		ctx, qs.k, qs.k.Records.Indexes.BondId, req.GetId(), req.GetPagination(),
+		func(record registrytypes.Record) bool { return !record.Deleted },
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &registrytypes.QueryGetRecordsByBondIdResponse{Records: records}, nil
+	return &registrytypes.QueryGetRecordsByBondIdResponse{Records: records, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) GetRegistryModuleBalance(c context.Context,
@@ -101,15 +218,33 @@ func (qs queryServer) GetRegistryModuleBalance(c context.Context,
 	}, nil
 }
 
-func (qs queryServer) NameRecords(c context.Context, _ *registrytypes.QueryNameRecordsRequest) (*registrytypes.QueryNameRecordsResponse, error) {
+func (qs queryServer) NameRecords(c context.Context, req *registrytypes.QueryNameRecordsRequest) (*registrytypes.QueryNameRecordsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	nameRecords, err := qs.k.ListNameRecords(ctx)
+	nameRecords, pageRes, err := query.CollectionPaginate(ctx, qs.k.NameRecords, req.GetPagination(),
+		func(name string, nameRecord registrytypes.NameRecord) (registrytypes.NameEntry, error) {
+			return registrytypes.NameEntry{Name: name, Entry: &nameRecord}, nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &registrytypes.QueryNameRecordsResponse{Names: nameRecords}, nil
+	return &registrytypes.QueryNameRecordsResponse{Names: nameRecords, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) NamesByPrefix(
+	c context.Context,
+	req *registrytypes.QueryNamesByPrefixRequest,
+) (*registrytypes.QueryNamesByPrefixResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	names, pageRes, err := qs.k.ListNameRecordsByPrefix(ctx, req.GetPrefix(), req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryNamesByPrefixResponse{Names: names, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) Whois(c context.Context, req *registrytypes.QueryWhoisRequest) (*registrytypes.QueryWhoisResponse, error) {
@@ -123,20 +258,48 @@ func (qs queryServer) Whois(c context.Context, req *registrytypes.QueryWhoisRequ
 	return &registrytypes.QueryWhoisResponse{NameAuthority: nameAuthority}, nil
 }
 
+func (qs queryServer) NameAccessGrants(c context.Context, req *registrytypes.QueryNameAccessGrantsRequest) (*registrytypes.QueryNameAccessGrantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.GetName() == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Name is required.")
+	}
+
+	grants, err := qs.k.ListNameAccessGrants(ctx, req.GetName(), req.GetGrantee())
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryNameAccessGrantsResponse{Grants: grants}, nil
+}
+
 func (qs queryServer) Authorities(c context.Context, req *registrytypes.QueryAuthoritiesRequest) (*registrytypes.QueryAuthoritiesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	authorityEntries, err := qs.k.ListNameAuthorityRecords(ctx, req.GetOwner())
+	owner := req.GetOwner()
+	authorityEntries, pageRes, err := query.CollectionFilteredPaginate(ctx, qs.k.Authorities, req.GetPagination(),
+		func(_ string, authority registrytypes.NameAuthority) (bool, error) {
+			// If owner is not empty, skip if authority is not owned by owner
+			return owner == "" || owner == authority.OwnerAddress, nil
+		},
+		func(name string, authority registrytypes.NameAuthority) (registrytypes.AuthorityEntry, error) {
+			return registrytypes.AuthorityEntry{Name: name, Entry: &authority}, nil
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &registrytypes.QueryAuthoritiesResponse{Authorities: authorityEntries}, nil
+	return &registrytypes.QueryAuthoritiesResponse{Authorities: authorityEntries, Pagination: pageRes}, nil
 }
 
 func (qs queryServer) LookupLrn(c context.Context, req *registrytypes.QueryLookupLrnRequest) (*registrytypes.QueryLookupLrnResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	lrn := req.GetLrn()
+
+	lrn, version, err := qs.getLRNVersion(ctx, req.GetLrn(), req.GetHeight(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
 
 	lrnExists, err := qs.k.HasNameRecord(ctx, lrn)
 	if err != nil {
@@ -146,7 +309,7 @@ func (qs queryServer) LookupLrn(c context.Context, req *registrytypes.QueryLooku
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "LRN not found.")
 	}
 
-	nameRecord, err := qs.k.LookupNameRecord(ctx, lrn)
+	nameRecord, err := qs.k.LookupNameRecordAt(ctx, lrn, req.GetHeight(), version)
 	if nameRecord == nil {
 		if err != nil {
 			return nil, err
@@ -161,8 +324,12 @@ func (qs queryServer) LookupLrn(c context.Context, req *registrytypes.QueryLooku
 func (qs queryServer) ResolveLrn(c context.Context, req *registrytypes.QueryResolveLrnRequest) (*registrytypes.QueryResolveLrnResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	lrn := req.GetLrn()
-	record, err := qs.k.ResolveLRN(ctx, lrn)
+	lrn, version, err := qs.getLRNVersion(ctx, req.GetLrn(), req.GetHeight(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := qs.k.ResolveLRNAt(ctx, lrn, req.GetHeight(), version)
 	if record == nil {
 		if err != nil {
 			return nil, err
@@ -173,3 +340,73 @@ func (qs queryServer) ResolveLrn(c context.Context, req *registrytypes.QueryReso
 
 	return &registrytypes.QueryResolveLrnResponse{Record: record}, nil
 }
+
+// getLRNVersion splits the version suffix off a requested LRN, and checks it against the requested height and version.
+// Names that end in what looks like a version suffix (e.g. `lrn://foo/bar@v2`) are matched exactly before the suffix is stripped.
+func (qs queryServer) getLRNVersion(ctx sdk.Context, lrn string, height uint64, version uint64) (string, uint64, error) {
+	exactMatch, err := qs.k.HasNameRecord(ctx, lrn)
+	if err != nil {
+		return "", 0, err
+	}
+
+	suffixVersion := uint64(0)
+	if !exactMatch {
+		lrn, suffixVersion, err = registrytypes.ParseLRNVersion(lrn)
+		if err != nil {
+			return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Invalid LRN version.")
+		}
+	}
+
+	if suffixVersion != 0 {
+		if version != 0 && version != suffixVersion {
+			return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "LRN version doesn't match the requested version.")
+		}
+		version = suffixVersion
+	}
+
+	if height != 0 && version != 0 {
+		return "", 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Only one of height and version can be requested.")
+	}
+
+	return lrn, version, nil
+}
+
+func (qs queryServer) Schemas(c context.Context, req *registrytypes.QuerySchemasRequest) (*registrytypes.QuerySchemasResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schemas, pageRes, err := query.CollectionPaginate(ctx, qs.k.Schemas, req.GetPagination(),
+		func(_ string, recordSchema registrytypes.Schema) (registrytypes.Schema, error) {
+			return recordSchema, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QuerySchemasResponse{Schemas: schemas, Pagination: pageRes}, nil
+}
+
+func (qs queryServer) GetSchema(c context.Context, req *registrytypes.QueryGetSchemaRequest) (*registrytypes.QueryGetSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if has, err := qs.k.HasSchema(ctx, req.GetRecordType()); !has {
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "Schema not found.")
+	}
+
+	var recordSchema registrytypes.Schema
+	var err error
+	if req.GetVersion() != 0 {
+		recordSchema, err = qs.k.GetSchemaVersion(ctx, req.GetRecordType(), req.GetVersion())
+	} else {
+		recordSchema, err = qs.k.GetSchema(ctx, req.GetRecordType())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &registrytypes.QueryGetSchemaResponse{Schema: recordSchema}, nil
+}
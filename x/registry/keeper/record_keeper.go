@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -107,6 +108,11 @@ func (rk RecordKeeper) OnAuctionWinnerSelected(ctx sdk.Context, auctionId string
 			authority.Height = uint64(ctx.BlockHeight())
 
 			logger(ctx).Info(fmt.Sprintf("Winner selected, marking authority as active: %s", name))
+			err = ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityWon{
+				Name:      name,
+				AuctionId: auctionId,
+				Owner:     auctionObj.WinnerAddress,
+			})
 		} else {
 			// Mark as expired.
 			authority.Status = registrytypes.AuthorityExpired
@@ -116,6 +122,15 @@ func (rk RecordKeeper) OnAuctionWinnerSelected(ctx sdk.Context, auctionId string
 			if err = rk.k.deleteAuthorityExpiryQueue(ctx, name, authority); err != nil {
 				logger(ctx).Error("Unable to delete expiry queue entry", err)
 			}
+
+			err = ctx.EventManager().EmitTypedEvent(&registrytypes.EventAuthorityExpired{
+				Name:   name,
+				BondId: authority.BondId,
+				Reason: registrytypes.ExpiryReasonNoAuctionWinner,
+			})
+		}
+		if err != nil {
+			panic(err)
 		}
 
 		// Forget about this auction now, we no longer need it.
@@ -129,17 +144,27 @@ func (rk RecordKeeper) OnAuctionWinnerSelected(ctx sdk.Context, auctionId string
 	}
 }
 
-// UsesBond returns true if the bond has associated records.
+// UsesBond returns true if the bond has associated records, including deleted ones (which can be renewed from the bond).
 func (rk RecordKeeper) UsesBond(ctx sdk.Context, bondId string) bool {
-	iter, err := rk.k.Records.Indexes.BondId.MatchExact(ctx, bondId)
+	used := false
+	err := rk.k.Records.Indexes.BondId.Walk(
+		ctx, collections.NewPrefixedPairRange[string, string](bondId),
+		func(string, string) (bool, error) {
+			used = true
+			return true, nil
+		},
+	)
 	if err != nil {
 		panic(err)
 	}
 
-	return iter.Valid()
+	return used
 }
 
 // RenewRecord renews a record.
+// Without a number of periods, only an expired record (marked as deleted) is renewed for one rent period.
+// Otherwise the rent for the given number of periods is taken upfront from the record bond and
+// the record expiry time is extended accordingly, up to the maximum record lifetime.
 func (k Keeper) RenewRecord(ctx sdk.Context, msg registrytypes.MsgRenewRecord) error {
 	if has, err := k.HasRecord(ctx, msg.RecordId); !has {
 		if err != nil {
@@ -148,12 +173,36 @@ func (k Keeper) RenewRecord(ctx sdk.Context, msg registrytypes.MsgRenewRecord) e
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
 	}
 
-	// Check if renewal is required (i.e. expired record marked as deleted).
 	record, err := k.GetRecordById(ctx, msg.RecordId)
 	if err != nil {
 		return err
 	}
 
+	// Versions replaced by a newer one can't be brought back.
+	nextId, err := k.getNextRecordVersion(ctx, record.Id)
+	if err != nil {
+		return err
+	}
+	if nextId != "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record has a newer version.")
+	}
+
+	if msg.Periods > 0 {
+		// Rent is taken from the record bond, so only its owner or a threshold of the record owners can prepay.
+		approved, err := k.hasBondOwnerOrOwnerApproval(
+			ctx, &record, msg.Signer, registrytypes.RecordOperation{Action: sdk.MsgTypeURL(&msg), RecordId: record.Id}, msg.Signatures,
+		)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner or bond owner mismatch.")
+		}
+
+		return k.prepayRecordRent(ctx, record, msg.Periods)
+	}
+
+	// Check if renewal is required (i.e. expired record marked as deleted).
 	expiryTime, err := time.Parse(time.RFC3339, record.ExpiryTime)
 	if err != nil {
 		return err
@@ -163,8 +212,245 @@ func (k Keeper) RenewRecord(ctx sdk.Context, msg registrytypes.MsgRenewRecord) e
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Renewal not required.")
 	}
 
-	readableRecord := record.ToReadableRecord()
-	return k.processRecord(ctx, &readableRecord)
+	readableRecord, err := record.ToReadableRecord()
+	if err != nil {
+		return err
+	}
+	if err := k.processRecord(ctx, &readableRecord, 0); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordRenewed{
+		RecordId:   readableRecord.Id,
+		BondId:     readableRecord.BondId,
+		ExpiryTime: readableRecord.ExpiryTime,
+	})
+}
+
+// prepayRecordRent takes the rent for a number of periods from the record bond and extends the record expiry time,
+// from the current expiry time for an active record or from now for a deleted one.
+func (k Keeper) prepayRecordRent(ctx sdk.Context, record registrytypes.Record, periods uint64) error {
+	if len(record.BondId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Bond not found.")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if periods > uint64(params.MaxRecordLifetime/params.RecordRentDuration) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record lifetime exceeds the maximum record lifetime.")
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, record.ExpiryTime)
+	if err != nil {
+		return err
+	}
+
+	now := ctx.BlockTime()
+	start := now
+	if !record.Deleted && expiryTime.After(now) {
+		start = expiryTime
+	}
+
+	newExpiryTime := start.Add(time.Duration(periods) * params.RecordRentDuration)
+	if newExpiryTime.Sub(now) > params.MaxRecordLifetime {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record lifetime exceeds the maximum record lifetime.")
+	}
+
+	rent := getRecordRentPerPeriod(params, len(record.Attributes))
+	rent.Amount = rent.Amount.Mul(math.NewIntFromUint64(periods))
+	if err := k.bondKeeper.TransferCoinsToModuleAccount(
+		ctx, record.BondId, registrytypes.RecordRentModuleAccountName, sdk.NewCoins(rent),
+	); err != nil {
+		return err
+	}
+	if err := emitRecordRentCharged(ctx, record, rent); err != nil {
+		return err
+	}
+
+	if err := k.deleteRecordExpiryQueue(ctx, record); err != nil {
+		return err
+	}
+
+	record.ExpiryTime = newExpiryTime.Format(time.RFC3339)
+	if err := k.insertRecordExpiryQueue(ctx, record); err != nil {
+		return err
+	}
+
+	if record.Rent, err = newRecordRent(ctx, record.Rent, record.BondId, record.ExpiryTime, rent); err != nil {
+		return err
+	}
+
+	wasDeleted := record.Deleted
+	record.Deleted = false
+	if err := k.SaveRecord(ctx, record); err != nil {
+		return err
+	}
+
+	if wasDeleted {
+		if err := k.processAttributes(ctx, record.Attributes, record.Id); err != nil {
+			return err
+		}
+	}
+
+	return emitRecordRenewed(ctx, record)
+}
+
+// DeleteRecord deletes a record on behalf of one of its owners or its bond owner.
+// The unused part of the rent paid for the record is refunded to the bond that paid it.
+func (k Keeper) DeleteRecord(ctx sdk.Context, msg registrytypes.MsgDeleteRecord) (sdk.Coins, error) {
+	if has, err := k.HasRecord(ctx, msg.RecordId); !has {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
+	}
+
+	record, err := k.GetRecordById(ctx, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Deleted {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record is deleted.")
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	var bond *bondtypes.Bond
+	if len(record.BondId) != 0 {
+		if has, err := k.bondKeeper.HasBond(ctx, record.BondId); err != nil {
+			return nil, err
+		} else if has {
+			b, err := k.bondKeeper.GetBondById(ctx, record.BondId)
+			if err != nil {
+				return nil, err
+			}
+			bond = &b
+		}
+	}
+
+	// Only a threshold of the record owners or the bond owner can delete a record.
+	if bond == nil || msg.Signer != bond.Owner {
+		op := registrytypes.RecordOperation{Action: sdk.MsgTypeURL(&msg), RecordId: record.Id}
+		approved, err := hasOwnerApproval(ctx, &record, signer, op, msg.Signatures)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner or bond owner mismatch.")
+		}
+	} else {
+		record.Nonce++
+	}
+
+	refund, err := k.getRecordRentRefund(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	if !refund.IsZero() {
+		if err := k.bondKeeper.TransferCoinsToBond(ctx, record.Rent.BondId, registrytypes.RecordRentModuleAccountName, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.deleteRecordExpiryQueue(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// Records deleted on purpose are dissociated from their bond, so that they're not renewed from it.
+	bondId := record.BondId
+	record.BondId = ""
+	if err := k.markRecordDeleted(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordDeleted{
+		RecordId: record.Id,
+		BondId:   bondId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// getRecordRentRefund gets the unused part of the rent paid for a record, if the bond that paid it still exists,
+// capped by the record rent module account balance.
+func (k Keeper) getRecordRentRefund(ctx sdk.Context, record registrytypes.Record) (sdk.Coins, error) {
+	if record.Rent == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	if has, err := k.bondKeeper.HasBond(ctx, record.Rent.BondId); !has {
+		return sdk.NewCoins(), err
+	}
+
+	unused, err := getUnusedRecordRent(ctx, record.Rent)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(registrytypes.RecordRentModuleAccountName)
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddress, unused.Denom)
+	amount := math.MinInt(unused.Amount, balance.Amount)
+
+	return sdk.NewCoins(sdk.NewCoin(unused.Denom, amount)), nil
+}
+
+// getUnusedRecordRent gets the part of the rent paid for a record for the time left until the end of the paid time,
+// prorated on the paid time (and at most the paid rent).
+func getUnusedRecordRent(ctx sdk.Context, rent *registrytypes.RecordRent) (sdk.Coin, error) {
+	startTime, err := time.Parse(time.RFC3339, rent.StartTime)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	endTime, err := time.Parse(time.RFC3339, rent.EndTime)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	remaining, paidTime := endTime.Sub(ctx.BlockTime()), endTime.Sub(startTime)
+	if remaining <= 0 || paidTime <= 0 {
+		return sdk.NewCoin(rent.Amount.Denom, math.ZeroInt()), nil
+	}
+
+	amount := rent.Amount.Amount.Mul(math.NewInt(int64(remaining))).Quo(math.NewInt(int64(paidTime)))
+
+	return sdk.NewCoin(rent.Amount.Denom, math.MinInt(amount, rent.Amount.Amount)), nil
+}
+
+// newRecordRent gets the rent paid by a bond for a record from now until the record expiry time,
+// adding up the unused part of the rent previously paid for the record by the same bond.
+// The unused rent paid by another bond is forfeited.
+func newRecordRent(
+	ctx sdk.Context,
+	prevRent *registrytypes.RecordRent,
+	bondId string,
+	expiryTime string,
+	paid sdk.Coin,
+) (*registrytypes.RecordRent, error) {
+	if prevRent != nil && prevRent.BondId == bondId {
+		unused, err := getUnusedRecordRent(ctx, prevRent)
+		if err != nil {
+			return nil, err
+		}
+		if unused.Denom == paid.Denom {
+			paid = paid.Add(unused)
+		}
+	}
+
+	return &registrytypes.RecordRent{
+		Amount:    paid,
+		BondId:    bondId,
+		StartTime: ctx.BlockTime().Format(time.RFC3339),
+		EndTime:   expiryTime,
+	}, nil
 }
 
 // AssociateBond associates a record with a bond.
@@ -202,6 +488,12 @@ func (k Keeper) AssociateBond(ctx sdk.Context, msg registrytypes.MsgAssociateBon
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Bond owner mismatch.")
 	}
 
+	// A threshold of the record owners needs to approve the bond as well.
+	op := registrytypes.RecordOperation{Action: sdk.MsgTypeURL(&msg), RecordId: record.Id}
+	if err := checkBondChangeApproval(ctx, &record, msg.Signer, op, msg.Signatures); err != nil {
+		return err
+	}
+
 	record.BondId = msg.BondId
 	if err = k.SaveRecord(ctx, record); err != nil {
 		return err
@@ -209,10 +501,12 @@ func (k Keeper) AssociateBond(ctx sdk.Context, msg registrytypes.MsgAssociateBon
 
 	// Required so that renewal is triggered (with new bond ID) for expired records.
 	if record.Deleted {
-		return k.insertRecordExpiryQueue(ctx, record)
+		if err := k.requeueDeletedRecord(ctx, record); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return emitRecordBondChanged(ctx, record, "")
 }
 
 // DissociateBond dissociates a record from its bond.
@@ -235,18 +529,24 @@ func (k Keeper) DissociateBond(ctx sdk.Context, msg registrytypes.MsgDissociateB
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Bond not found.")
 	}
 
-	// Only the bond owner can dissociate a record with the bond.
-	bond, err := k.bondKeeper.GetBondById(ctx, bondId)
+	// Only the bond owner or a threshold of the record owners can dissociate a record from the bond.
+	approved, err := k.hasBondOwnerOrOwnerApproval(
+		ctx, &record, msg.Signer, registrytypes.RecordOperation{Action: sdk.MsgTypeURL(&msg), RecordId: record.Id}, msg.Signatures,
+	)
 	if err != nil {
 		return err
 	}
-	if msg.Signer != bond.Owner {
-		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Bond owner mismatch.")
+	if !approved {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner or bond owner mismatch.")
 	}
 
 	// Clear bond Id.
 	record.BondId = ""
-	return k.SaveRecord(ctx, record)
+	if err := k.SaveRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return emitRecordBondChanged(ctx, record, bondId)
 }
 
 // DissociateRecords dissociates all records associated with a given bond.
@@ -279,6 +579,10 @@ func (k Keeper) DissociateRecords(ctx sdk.Context, msg registrytypes.MsgDissocia
 		if err = k.SaveRecord(ctx, record); err != nil {
 			return err
 		}
+
+		if err := emitRecordBondChanged(ctx, record, msg.BondId); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -332,11 +636,26 @@ func (k Keeper) ReassociateRecords(ctx sdk.Context, msg registrytypes.MsgReassoc
 
 		// Required so that renewal is triggered (with new bond ID) for expired records.
 		if record.Deleted {
-			if err = k.insertRecordExpiryQueue(ctx, record); err != nil {
+			if err = k.requeueDeletedRecord(ctx, record); err != nil {
 				return err
 			}
 		}
+
+		if err := emitRecordBondChanged(ctx, record, msg.OldBondId); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// requeueDeletedRecord adds a deleted record back to the expiry queue, so that it's renewed from its bond.
+// Versions replaced by a newer one are left deleted.
+func (k Keeper) requeueDeletedRecord(ctx sdk.Context, record registrytypes.Record) error {
+	nextId, err := k.getNextRecordVersion(ctx, record.Id)
+	if err != nil || nextId != "" {
+		return err
+	}
+
+	return k.insertRecordExpiryQueue(ctx, record)
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"slices"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// recordOwnerThreshold gets the number of owner approvals required for record-scoped messages.
+func recordOwnerThreshold(record registrytypes.Record) int {
+	return max(int(record.OwnerThreshold), 1)
+}
+
+// countOwnerApprovals counts the distinct record owners among the given (hex) addresses and the tx signer.
+func countOwnerApprovals(record registrytypes.Record, signer sdk.AccAddress, approvers []string) int {
+	approved := make(map[string]bool)
+	for _, approver := range append(approvers, crypto.Address(signer).String()) {
+		if slices.Contains(record.Owners, approver) {
+			approved[approver] = true
+		}
+	}
+
+	return len(approved)
+}
+
+// hasOwnerApproval checks if a threshold of the record owners approved a record operation,
+// either as the tx signer or through signatures over the operation, bound to the chain id and the record nonce.
+// The record nonce is incremented if the operation is approved, to be saved with the record.
+func hasOwnerApproval(
+	ctx sdk.Context,
+	record *registrytypes.Record,
+	signer sdk.AccAddress,
+	op registrytypes.RecordOperation,
+	signatures []registrytypes.Signature,
+) (bool, error) {
+	if len(record.Owners) == 0 {
+		return false, nil
+	}
+
+	op.ChainId = ctx.ChainID()
+	op.Nonce = record.Nonce
+	signBytes, signDoc := op.GetSignBytes()
+	approvers := make([]string, len(signatures))
+	for i, sig := range signatures {
+		approver, err := registrytypes.VerifySignature(signBytes, signDoc, sig)
+		if err != nil {
+			return false, err
+		}
+		approvers[i] = approver
+	}
+
+	if countOwnerApprovals(*record, signer, approvers) < recordOwnerThreshold(*record) {
+		return false, nil
+	}
+
+	record.Nonce++
+	return true, nil
+}
+
+// hasBondOwnerOrOwnerApproval checks if the signer owns the record bond,
+// or else if a threshold of the record owners approved the record operation.
+// The record nonce is incremented if the operation is approved, to be saved with the record.
+func (k Keeper) hasBondOwnerOrOwnerApproval(
+	ctx sdk.Context,
+	record *registrytypes.Record,
+	signer string,
+	op registrytypes.RecordOperation,
+	signatures []registrytypes.Signature,
+) (bool, error) {
+	if len(record.BondId) != 0 {
+		if has, err := k.bondKeeper.HasBond(ctx, record.BondId); err != nil {
+			return false, err
+		} else if has {
+			bond, err := k.bondKeeper.GetBondById(ctx, record.BondId)
+			if err != nil {
+				return false, err
+			}
+			if signer == bond.Owner {
+				record.Nonce++
+				return true, nil
+			}
+		}
+	}
+
+	signerAddress, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return false, err
+	}
+
+	return hasOwnerApproval(ctx, record, signerAddress, op, signatures)
+}
+
+// checkBondChangeApproval checks that a threshold of the record owners approved moving a record
+// to or from a bond. Records without owners are left to the bond owners.
+// The record nonce is incremented if the operation is approved, to be saved with the record.
+func checkBondChangeApproval(
+	ctx sdk.Context,
+	record *registrytypes.Record,
+	signer string,
+	op registrytypes.RecordOperation,
+	signatures []registrytypes.Signature,
+) error {
+	if len(record.Owners) == 0 {
+		return nil
+	}
+
+	signerAddress, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return err
+	}
+
+	approved, err := hasOwnerApproval(ctx, record, signerAddress, op, signatures)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner approval threshold not met.")
+	}
+
+	return nil
+}
+
+// TransferRecordOwnership replaces the owners of a record, approved by a threshold of its current owners.
+func (k Keeper) TransferRecordOwnership(ctx sdk.Context, msg registrytypes.MsgTransferRecordOwnership) error {
+	if has, err := k.HasRecord(ctx, msg.RecordId); !has {
+		if err != nil {
+			return err
+		}
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record not found.")
+	}
+
+	record, err := k.GetRecordById(ctx, msg.RecordId)
+	if err != nil {
+		return err
+	}
+
+	if record.Deleted {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record is deleted.")
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return err
+	}
+
+	owners := make([]string, 0, len(msg.Owners))
+	for _, owner := range msg.Owners {
+		owners = append(owners, normalizeOwnerAddress(owner))
+	}
+	sort.Strings(owners)
+	owners = slices.Compact(owners)
+
+	threshold := max(msg.OwnerThreshold, 1)
+	if int(threshold) > len(owners) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Owner threshold exceeds the number of owners.")
+	}
+
+	op := registrytypes.RecordOperation{
+		Action:         sdk.MsgTypeURL(&msg),
+		RecordId:       record.Id,
+		Owners:         owners,
+		OwnerThreshold: threshold,
+	}
+	approved, err := hasOwnerApproval(ctx, &record, signer, op, msg.Signatures)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Record owner approval threshold not met.")
+	}
+
+	record.Owners = owners
+	record.OwnerThreshold = threshold
+	if err := k.SaveRecord(ctx, record); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventRecordOwnershipTransferred{
+		RecordId:       record.Id,
+		Owners:         record.Owners,
+		OwnerThreshold: record.OwnerThreshold,
+	})
+}
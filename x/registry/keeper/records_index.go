@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+var _ collections.Index[string, registrytypes.Record] = &RecordsIndex{}
+
+// RecordsIndex is a multi-index of records (key, record id), e.g. by bond id or owner.
+// Unlike indexes.Multi, a record can be referenced under more than one key, and the index
+// can be paginated by key prefix with query.CollectionPaginate.
+type RecordsIndex struct {
+	refKeys collections.KeySet[collections.Pair[string, string]]
+	getKeys func(record registrytypes.Record) []string
+}
+
+func newRecordsIndex(
+	sb *collections.SchemaBuilder,
+	prefix collections.Prefix,
+	name string,
+	getKeys func(record registrytypes.Record) []string,
+) *RecordsIndex {
+	return &RecordsIndex{
+		refKeys: collections.NewKeySet(sb, prefix, name, collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		getKeys: getKeys,
+	}
+}
+
+func (i *RecordsIndex) Reference(
+	ctx context.Context,
+	pk string,
+	newValue registrytypes.Record,
+	lazyOldValue func() (registrytypes.Record, error),
+) error {
+	if err := i.Unreference(ctx, pk, lazyOldValue); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	for _, key := range i.getKeys(newValue) {
+		if err := i.refKeys.Set(ctx, collections.Join(key, pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *RecordsIndex) Unreference(ctx context.Context, pk string, getValue func() (registrytypes.Record, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range i.getKeys(value) {
+		if err := i.refKeys.Remove(ctx, collections.Join(key, pk)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Walk iterates over the (key, record id) pairs of the index in the given range.
+func (i *RecordsIndex) Walk(
+	ctx context.Context,
+	ranger collections.Ranger[collections.Pair[string, string]],
+	walkFunc func(key string, recordId string) (stop bool, err error),
+) error {
+	return i.refKeys.Walk(ctx, ranger, func(key collections.Pair[string, string]) (bool, error) {
+		return walkFunc(key.K1(), key.K2())
+	})
+}
+
+// IterateRaw iterates over the (key, record id) pairs of the index between the given raw keys.
+func (i *RecordsIndex) IterateRaw(
+	ctx context.Context,
+	start, end []byte,
+	order collections.Order,
+) (collections.Iterator[collections.Pair[string, string], collections.NoValue], error) {
+	return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (i *RecordsIndex) KeyCodec() codec.KeyCodec[collections.Pair[string, string]] {
+	return i.refKeys.KeyCodec()
+}
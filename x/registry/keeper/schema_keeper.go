@@ -0,0 +1,355 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/xeipuuv/gojsonschema"
+
+	registrytypes "git.vdb.to/cerc-io/laconicd/x/registry"
+)
+
+// schemaValidatorsCacheSize is the number of compiled schema validators kept in memory.
+const schemaValidatorsCacheSize = 256
+
+// HasSchema - checks if a schema is registered for the given record type.
+func (k Keeper) HasSchema(ctx sdk.Context, recordType string) (bool, error) {
+	return k.Schemas.Has(ctx, recordType)
+}
+
+// GetSchema - gets the latest version of the schema registered for the given record type.
+func (k Keeper) GetSchema(ctx sdk.Context, recordType string) (registrytypes.Schema, error) {
+	recordSchema, err := k.Schemas.Get(ctx, recordType)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return registrytypes.Schema{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Schema not found.")
+		}
+		return registrytypes.Schema{}, err
+	}
+
+	return recordSchema, nil
+}
+
+// GetSchemaVersion - gets the given version of the schema registered for a record type.
+func (k Keeper) GetSchemaVersion(ctx sdk.Context, recordType string, version uint64) (registrytypes.Schema, error) {
+	recordSchema, err := k.SchemaVersions.Get(ctx, collections.Join(recordType, version))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return registrytypes.Schema{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Schema not found.")
+		}
+		return registrytypes.Schema{}, err
+	}
+
+	return recordSchema, nil
+}
+
+// ListSchemas - get the latest version of all record type schemas.
+func (k Keeper) ListSchemas(ctx sdk.Context) ([]registrytypes.Schema, error) {
+	var schemas []registrytypes.Schema
+
+	err := k.Schemas.Walk(ctx, nil, func(key string, value registrytypes.Schema) (bool, error) {
+		schemas = append(schemas, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// ListSchemaVersions - get all versions of all record type schemas.
+func (k Keeper) ListSchemaVersions(ctx sdk.Context) ([]registrytypes.Schema, error) {
+	var schemas []registrytypes.Schema
+
+	err := k.SchemaVersions.Walk(ctx, nil, func(key collections.Pair[string, uint64], value registrytypes.Schema) (bool, error) {
+		schemas = append(schemas, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// SaveSchema - saves a record type schema version to the store, and makes it the latest one unless a later version exists.
+func (k Keeper) SaveSchema(ctx sdk.Context, recordSchema registrytypes.Schema) error {
+	if err := k.SchemaVersions.Set(ctx, collections.Join(recordSchema.RecordType, recordSchema.Version), recordSchema); err != nil {
+		return err
+	}
+
+	latest, err := k.Schemas.Get(ctx, recordSchema.RecordType)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && latest.Version > recordSchema.Version {
+		return nil
+	}
+
+	return k.Schemas.Set(ctx, recordSchema.RecordType, recordSchema)
+}
+
+// RegisterSchema registers a new version of the schema for a record type.
+// Record types are namespaced by the name authority the schema is registered under (<authority>/<Type>),
+// and the signer needs to own the (active) authority.
+// Registered versions are never overwritten: records keep the version they were validated against,
+// and a new version only applies to records set after it.
+func (k Keeper) RegisterSchema(ctx sdk.Context, msg registrytypes.MsgRegisterSchema) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(msg.Definition)) > params.MaxSchemaSize {
+		return errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("Schema definition size %d exceeds the max schema size %d.", len(msg.Definition), params.MaxSchemaSize),
+		)
+	}
+
+	if authorityName, _, err := registrytypes.ParseRecordType(msg.RecordType); err != nil || authorityName != msg.Authority {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record type must be namespaced by its authority (<authority>/<Type>).")
+	}
+
+	authority, err := k.GetNameAuthority(ctx, msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	if authority.OwnerAddress != msg.Signer {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Access denied.")
+	}
+
+	if authority.Status != registrytypes.AuthorityActive {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Authority is not active.")
+	}
+
+	version := uint64(1)
+	has, err := k.HasSchema(ctx, msg.RecordType)
+	if err != nil {
+		return err
+	}
+	if has {
+		existing, err := k.GetSchema(ctx, msg.RecordType)
+		if err != nil {
+			return err
+		}
+
+		version = existing.Version + 1
+	}
+
+	recordSchema := registrytypes.Schema{
+		RecordType: msg.RecordType,
+		Authority:  msg.Authority,
+		Format:     msg.Format,
+		Definition: msg.Definition,
+		Height:     uint64(ctx.BlockHeight()),
+		Version:    version,
+	}
+
+	// Make sure the definition compiles before storing it.
+	if _, err := newRecordValidator(recordSchema); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprint("Invalid schema definition: ", err))
+	}
+
+	if err := k.SaveSchema(ctx, recordSchema); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&registrytypes.EventSchemaRegistered{
+		RecordType: recordSchema.RecordType,
+		Authority:  recordSchema.Authority,
+		Version:    recordSchema.Version,
+	})
+}
+
+// validateRecordType checks the record attributes against the latest schema registered for the record type,
+// and sets the schema version of the record.
+// Records without a type or with a type that has no registered schema are accepted as is.
+func (k Keeper) validateRecordType(ctx sdk.Context, record *registrytypes.ReadableRecord) error {
+	record.SchemaVersion = 0
+	if record.Type == "" {
+		return nil
+	}
+
+	has, err := k.HasSchema(ctx, record.Type)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return nil
+	}
+
+	recordSchema, err := k.GetSchema(ctx, record.Type)
+	if err != nil {
+		return err
+	}
+
+	validate, err := k.getRecordValidator(recordSchema)
+	if err != nil {
+		return err
+	}
+
+	if err := validate(record.CanonicalJSON()); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("Record does not match %s schema: %s", record.Type, err))
+	}
+
+	record.SchemaVersion = recordSchema.Version
+
+	return nil
+}
+
+// schemaValidatorKey identifies a compiled schema version. The digest of the definition is part of the key
+// as versions registered in (check) state that is later discarded may differ from the committed ones.
+type schemaValidatorKey struct {
+	recordType string
+	version    uint64
+	digest     [sha256.Size]byte
+}
+
+// getRecordValidator returns the compiled validator of a schema version.
+// Schema versions are immutable, so validators are cached rather than compiled for each record.
+func (k Keeper) getRecordValidator(recordSchema registrytypes.Schema) (recordValidator, error) {
+	key := schemaValidatorKey{
+		recordType: recordSchema.RecordType,
+		version:    recordSchema.Version,
+		digest:     sha256.Sum256([]byte(recordSchema.Format + "\x00" + recordSchema.Definition)),
+	}
+	if validate, ok := k.schemaValidators.Get(key); ok {
+		return validate.(recordValidator), nil
+	}
+
+	validate, err := newRecordValidator(recordSchema)
+	if err != nil {
+		return nil, err
+	}
+	k.schemaValidators.Add(key, validate)
+
+	return validate, nil
+}
+
+// recordValidator validates canonical record attributes JSON.
+type recordValidator func(attrs []byte) error
+
+func newRecordValidator(recordSchema registrytypes.Schema) (recordValidator, error) {
+	switch recordSchema.Format {
+	case registrytypes.SchemaFormatJSON:
+		return newJSONSchemaValidator(recordSchema.Definition)
+	case registrytypes.SchemaFormatIPLD:
+		// IPLD schemas define the record type by its name, without the authority.
+		_, typeName, err := registrytypes.ParseRecordType(recordSchema.RecordType)
+		if err != nil {
+			return nil, err
+		}
+		return newIPLDSchemaValidator(typeName, recordSchema.Definition)
+	default:
+		return nil, fmt.Errorf("unknown schema format: %s", recordSchema.Format)
+	}
+}
+
+func newJSONSchemaValidator(definition string) (recordValidator, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(definition), &doc); err != nil {
+		return nil, err
+	}
+
+	// Resolving remote references would make validation depend on the network.
+	if err := checkLocalRefs(doc); err != nil {
+		return nil, err
+	}
+
+	jsonSchema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(attrs []byte) error {
+		result, err := jsonSchema.Validate(gojsonschema.NewBytesLoader(attrs))
+		if err != nil {
+			return err
+		}
+
+		if !result.Valid() {
+			var errs []string
+			for _, resultErr := range result.Errors() {
+				errs = append(errs, resultErr.String())
+			}
+
+			return errors.New(strings.Join(errs, "; "))
+		}
+
+		return nil
+	}, nil
+}
+
+func checkLocalRefs(node interface{}) error {
+	switch value := node.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if ref, ok := child.(string); ok && key == "$ref" && !strings.HasPrefix(ref, "#") {
+				return fmt.Errorf("only local $ref values are supported: %s", ref)
+			}
+
+			if err := checkLocalRefs(child); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, child := range value {
+			if err := checkLocalRefs(child); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// newIPLDSchemaValidator compiles an IPLD schema (DSL) which must define a type named after the record type.
+func newIPLDSchemaValidator(recordType string, definition string) (validator recordValidator, err error) {
+	// bindnode panics on schema types it can't bind to.
+	defer func() {
+		if r := recover(); r != nil {
+			validator, err = nil, fmt.Errorf("unsupported IPLD schema: %v", r)
+		}
+	}()
+
+	ts, err := ipld.LoadSchemaBytes([]byte(definition))
+	if err != nil {
+		return nil, err
+	}
+
+	rootType := ts.TypeByName(recordType)
+	if rootType == nil {
+		return nil, fmt.Errorf("IPLD schema does not define type %s", recordType)
+	}
+
+	proto := bindnode.Prototype(nil, rootType)
+
+	return func(attrs []byte) error {
+		return decodeUsingPrototype(attrs, proto)
+	}, nil
+}
+
+func decodeUsingPrototype(attrs []byte, proto schema.TypedPrototype) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	_, err = ipld.DecodeUsingPrototype(attrs, dagjson.Decode, proto.Representation())
+	return err
+}
@@ -35,4 +35,18 @@ var (
 	AuthorityExpiryQueuePrefix = collections.NewPrefix(9)
 
 	AttributesMapPrefix = collections.NewPrefix(10)
+
+	SchemasPrefix = collections.NewPrefix(11)
+
+	RecordsByPreviousIdIndexPrefix = collections.NewPrefix(12)
+
+	AttributesRangeIndexPrefix = collections.NewPrefix(13)
+
+	RecordLinksIndexPrefix = collections.NewPrefix(14)
+
+	RecordsByOwnerIndexPrefix = collections.NewPrefix(15)
+
+	NameAccessGrantsPrefix = collections.NewPrefix(16)
+
+	SchemaVersionsPrefix = collections.NewPrefix(17)
 )
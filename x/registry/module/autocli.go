@@ -35,6 +35,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "GetReferencingRecords",
+					Use:       "get-referencing-records [record-id]",
+					Short:     "Get records with a link to a record",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod:      "QuoteRecordRent",
+					Use:            "quote-rent",
+					Short:          "Get the rent of a new record (--attributes-size) or an existing one (--record-id)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
+				},
 				{
 					RpcMethod: "GetRecordsByBondId",
 					Use:       "get-records-by-bond-id [bond-id]",
@@ -43,6 +57,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "id"},
 					},
 				},
+				{
+					RpcMethod: "GetRecordsByOwner",
+					Use:       "get-records-by-owner [owner]",
+					Short:     "Get records by owner (account or hex) address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 				{
 					RpcMethod: "Whois",
 					Use:       "whois [name]",
@@ -51,6 +73,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "name"},
 					},
 				},
+				{
+					RpcMethod: "NameAccessGrants",
+					Use:       "name-access [name]",
+					Short:     "List the name access grants of an authority (optionally to a grantee)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
 				{
 					RpcMethod: "Authorities",
 					Use:       "list-authorities",
@@ -69,21 +99,64 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "List name records",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
+				{
+					RpcMethod: "NamesByPrefix",
+					Use:       "names-by-prefix [prefix]",
+					Short:     "List the name records under an LRN prefix (e.g. lrn://cerc-io/), marking stale and deleted names",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "prefix"},
+					},
+				},
 				{
 					RpcMethod: "LookupLrn",
 					Use:       "lookup [lrn]",
-					Short:     "Get naming info for LRN",
+					Short:     "Get naming info for LRN (optionally at a name version, e.g. lrn://cerc-io/app@v3, or --name-height)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "lrn"},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						// --height is the query height.
+						"height":  {Name: "name-height", Usage: "Look up the name as of this block height"},
+						"version": {Usage: "Look up this version of the name (1 for the first one set)"},
+					},
 				},
 				{
 					RpcMethod: "ResolveLrn",
 					Use:       "resolve [lrn]",
-					Short:     "Resolve LRN to record",
+					Short:     "Resolve LRN to record (optionally at a name version, e.g. lrn://cerc-io/app@v3, or --name-height)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "lrn"},
 					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						// --height is the query height.
+						"height":  {Name: "name-height", Usage: "Resolve the name as of this block height"},
+						"version": {Usage: "Resolve this version of the name (1 for the first one set)"},
+					},
+				},
+				{
+					RpcMethod:      "Schemas",
+					Use:            "schemas",
+					Short:          "List record type schemas",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
+				},
+				{
+					RpcMethod: "GetRecordHistory",
+					Use:       "get-record-history [record-id]",
+					Short:     "Get all versions of a record, latest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "GetSchema",
+					Use:       "get-schema [record-type]",
+					Short:     "Get schema for a record type (the latest version, or --version)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_type"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"version": {Usage: "Get this version of the schema (1 for the first one registered)"},
+					},
 				},
 				{
 					RpcMethod:      "GetRegistryModuleBalance",
@@ -92,6 +165,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
 			},
+			EnhanceCustomCommand: true, // Allow additional manual commands
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: registryv1.Msg_ServiceDesc.ServiceName,
@@ -99,9 +173,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "RenewRecord",
 					Use:       "renew-record [record-id]",
-					Short:     "Renew (expired) record",
+					Short:     "Renew (expired) record, or prepay rent periods (--periods)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "DeleteRecord",
+					Use:       "delete-record [record-id]",
+					Short:     "Delete record and refund unused rent to its bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
+				{
+					RpcMethod: "TransferRecordOwnership",
+					Use:       "transfer-record-ownership [record-id] [owners...]",
+					Short:     "Replace record owners (approved by a threshold of the current owners, see --signatures)",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "record_id"},
+						{ProtoField: "owners", Varargs: true},
 					},
 				},
 				{
@@ -122,6 +213,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "bond_id"},
 					},
 				},
+				{
+					RpcMethod: "TransferAuthority",
+					Use:       "transfer-authority [name] [new-owner]",
+					Short:     "Transfer authority to a new owner (see --require-accept, --keep-bond and --invalidate-names)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "new_owner"},
+					},
+				},
+				{
+					RpcMethod: "AcceptAuthority",
+					Use:       "accept-authority [name]",
+					Short:     "Accept a pending authority transfer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "RenewAuthority",
+					Use:       "renew-authority [name] [periods]",
+					Short:     "Prepay authority rent periods from its bond (also ends the grace period)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "periods"},
+					},
+				},
+				{
+					RpcMethod: "GrantNameAccess",
+					Use:       "grant-name-access [lrn-prefix] [grantee]",
+					Short:     "Let grantee set and delete names matching an LRN (or LRN prefix ending with /*), optionally until --expiry-time",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "lrn_prefix"},
+						{ProtoField: "grantee"},
+					},
+				},
+				{
+					RpcMethod: "RevokeNameAccess",
+					Use:       "revoke-name-access [lrn-prefix] [grantee]",
+					Short:     "Revoke a name access grant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "lrn_prefix"},
+						{ProtoField: "grantee"},
+					},
+				},
 				{
 					RpcMethod: "SetName",
 					Use:       "set-name [lrn] [cid]",
@@ -139,6 +274,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "lrn"},
 					},
 				},
+				{
+					RpcMethod: "UpdateRecord",
+					Skip:      true, // Skipped because we have a custom command (reads payload from file)
+				},
+				{
+					RpcMethod: "RegisterSchema",
+					Skip:      true, // Skipped because we have a custom command (reads definition from file)
+				},
 				{
 					RpcMethod: "AssociateBond",
 					Use:       "associate-bond [record-id] [bond-id]",
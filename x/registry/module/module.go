@@ -31,7 +31,7 @@ var (
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 type AppModule struct {
 	cdc    codec.Codec
@@ -120,6 +120,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// Register servers
 	registrytypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	registrytypes.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	// Register in place module state migrations
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(registrytypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", registrytypes.ModuleName, err))
+	}
 }
 
 // appmodule.HasEndBlocker
@@ -134,6 +140,11 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
+// Get the root query command of this module
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // Get the root tx command of this module
 func (AppModule) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
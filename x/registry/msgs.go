@@ -22,6 +22,10 @@ func (msg MsgSetRecord) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer)
 	}
 
+	if err := validatePayloadRecord(msg.Payload); err != nil {
+		return err
+	}
+
 	owners := msg.Payload.Record.Owners
 	for _, owner := range owners {
 		if owner == "" {
@@ -33,6 +37,57 @@ func (msg MsgSetRecord) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Bond Id is required.")
 	}
 
+	if msg.Lifetime < 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record lifetime can't be negative.")
+	}
+
+	return nil
+}
+
+// NewMsgUpdateRecord is the constructor function for MsgUpdateRecord.
+func NewMsgUpdateRecord(recordId string, payload Payload, signatures []Signature, signer sdk.AccAddress) *MsgUpdateRecord {
+	return &MsgUpdateRecord{
+		RecordId:   recordId,
+		Payload:    payload,
+		Signatures: signatures,
+		Signer:     signer.String(),
+	}
+}
+
+func (msg MsgUpdateRecord) ValidateBasic() error {
+	if len(msg.RecordId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "record id is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return validatePayloadRecord(msg.Payload)
+}
+
+// validatePayloadRecord checks that the payload has a record, with attributes that are a JSON map.
+// The raw attributes are checked against the hard caps of the size and depth limits before being decoded,
+// while the attribute limits set by params are checked by the keeper.
+func validatePayloadRecord(payload Payload) error {
+	if payload.Record == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "payload record is required.")
+	}
+
+	if uint64(len(payload.Record.Attributes)) > MaxRecordAttributesSizeCap {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum size.")
+	}
+
+	// Links and bytes are maps in DAG-JSON, i.e. one level deeper than the attribute values they decode to.
+	if uint64(jsonDepth(payload.Record.Attributes)) > MaxRecordAttributesDepthCap+1 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes exceed the maximum depth.")
+	}
+
+	attributes, err := DecodeJSONAttributes(payload.Record.Attributes)
+	if err != nil || attributes == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Record attributes must be a JSON map.")
+	}
+
 	return nil
 }
 
@@ -48,6 +103,65 @@ func (msg MsgRenewRecord) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgDeleteRecord is the constructor function for MsgDeleteRecord.
+func NewMsgDeleteRecord(recordId string, signer sdk.AccAddress) *MsgDeleteRecord {
+	return &MsgDeleteRecord{
+		RecordId: recordId,
+		Signer:   signer.String(),
+	}
+}
+
+func (msg MsgDeleteRecord) ValidateBasic() error {
+	if len(msg.RecordId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "record id is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
+// NewMsgTransferRecordOwnership is the constructor function for MsgTransferRecordOwnership.
+func NewMsgTransferRecordOwnership(
+	recordId string,
+	owners []string,
+	ownerThreshold uint32,
+	signatures []Signature,
+	signer sdk.AccAddress,
+) *MsgTransferRecordOwnership {
+	return &MsgTransferRecordOwnership{
+		RecordId:       recordId,
+		Owners:         owners,
+		OwnerThreshold: ownerThreshold,
+		Signatures:     signatures,
+		Signer:         signer.String(),
+	}
+}
+
+func (msg MsgTransferRecordOwnership) ValidateBasic() error {
+	if len(msg.RecordId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "record id is required.")
+	}
+
+	if len(msg.Owners) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "owners are required.")
+	}
+
+	for _, owner := range msg.Owners {
+		if len(owner) == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "owner is required.")
+		}
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
 // NewMsgReserveAuthority is the constructor function for MsgReserveName.
 func NewMsgReserveAuthority(name string, signer sdk.AccAddress, owner sdk.AccAddress) MsgReserveAuthority {
 	return MsgReserveAuthority{
@@ -95,6 +209,126 @@ func (msg MsgSetAuthorityBond) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgTransferAuthority is the constructor function for MsgTransferAuthority.
+func NewMsgTransferAuthority(name string, newOwner sdk.AccAddress, signer sdk.AccAddress) *MsgTransferAuthority {
+	return &MsgTransferAuthority{
+		Name:     name,
+		NewOwner: newOwner.String(),
+		Signer:   signer.String(),
+	}
+}
+
+func (msg MsgTransferAuthority) ValidateBasic() error {
+	if len(msg.Name) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "name is required.")
+	}
+
+	if len(msg.NewOwner) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "new owner is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
+// NewMsgAcceptAuthority is the constructor function for MsgAcceptAuthority.
+func NewMsgAcceptAuthority(name string, signer sdk.AccAddress) *MsgAcceptAuthority {
+	return &MsgAcceptAuthority{
+		Name:   name,
+		Signer: signer.String(),
+	}
+}
+
+func (msg MsgAcceptAuthority) ValidateBasic() error {
+	if len(msg.Name) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "name is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
+// NewMsgRenewAuthority is the constructor function for MsgRenewAuthority.
+func NewMsgRenewAuthority(name string, periods uint64, signer sdk.AccAddress) *MsgRenewAuthority {
+	return &MsgRenewAuthority{
+		Name:    name,
+		Periods: periods,
+		Signer:  signer.String(),
+	}
+}
+
+func (msg MsgRenewAuthority) ValidateBasic() error {
+	if len(msg.Name) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "name is required.")
+	}
+
+	if msg.Periods == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "periods must be positive.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
+// NewMsgGrantNameAccess is the constructor function for MsgGrantNameAccess.
+func NewMsgGrantNameAccess(lrnPrefix string, grantee sdk.AccAddress, signer sdk.AccAddress) *MsgGrantNameAccess {
+	return &MsgGrantNameAccess{
+		LrnPrefix: lrnPrefix,
+		Grantee:   grantee.String(),
+		Signer:    signer.String(),
+	}
+}
+
+func (msg MsgGrantNameAccess) ValidateBasic() error {
+	if _, err := ParseLRNPrefix(msg.LrnPrefix); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.Grantee) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "grantee is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
+// NewMsgRevokeNameAccess is the constructor function for MsgRevokeNameAccess.
+func NewMsgRevokeNameAccess(lrnPrefix string, grantee sdk.AccAddress, signer sdk.AccAddress) *MsgRevokeNameAccess {
+	return &MsgRevokeNameAccess{
+		LrnPrefix: lrnPrefix,
+		Grantee:   grantee.String(),
+		Signer:    signer.String(),
+	}
+}
+
+func (msg MsgRevokeNameAccess) ValidateBasic() error {
+	if len(msg.LrnPrefix) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "LRN prefix is required.")
+	}
+
+	if len(msg.Grantee) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "grantee is required.")
+	}
+
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
 // NewMsgSetName is the constructor function for MsgSetName.
 func NewMsgSetName(lrn string, cid string, signer sdk.AccAddress) *MsgSetName {
 	return &MsgSetName{
@@ -174,6 +408,40 @@ func (msg MsgDissociateRecords) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgRegisterSchema is the constructor function for MsgRegisterSchema.
+func NewMsgRegisterSchema(recordType string, authority string, format string, definition string, signer sdk.AccAddress) MsgRegisterSchema {
+	return MsgRegisterSchema{
+		RecordType: recordType,
+		Authority:  authority,
+		Format:     format,
+		Definition: definition,
+		Signer:     signer.String(),
+	}
+}
+
+func (msg MsgRegisterSchema) ValidateBasic() error {
+	if len(msg.RecordType) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "record type is required.")
+	}
+	if len(msg.Authority) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "authority is required.")
+	}
+	if authority, _, err := ParseRecordType(msg.RecordType); err != nil || authority != msg.Authority {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "record type must be namespaced by its authority (<authority>/<Type>).")
+	}
+	if msg.Format != SchemaFormatJSON && msg.Format != SchemaFormatIPLD {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid schema format: %s", msg.Format)
+	}
+	if len(msg.Definition) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "schema definition is required.")
+	}
+	if len(msg.Signer) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid signer.")
+	}
+
+	return nil
+}
+
 func (msg MsgReassociateRecords) ValidateBasic() error {
 	if len(msg.OldBondId) == 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "old-bond-id is required.")
@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// LRNPrefixWildcard ends the LRN prefixes of name access grants that match all the names under a path,
+// e.g. `lrn://cerc-io/apps/*`. Other LRN prefixes only match the LRN itself.
+const LRNPrefixWildcard = "/*"
+
+// ParseLRNPrefix validates the LRN prefix of a name access grant and returns the name of its authority.
+func ParseLRNPrefix(lrnPrefix string) (string, error) {
+	parsed, err := url.Parse(lrnPrefix)
+	if err != nil {
+		return "", err
+	}
+
+	if parsed.Scheme != "lrn" || parsed.Host == "" {
+		return "", errors.New("LRN prefix must start with lrn://<authority>")
+	}
+
+	if fmt.Sprintf("lrn://%s%s", parsed.Host, parsed.RequestURI()) != lrnPrefix {
+		return "", errors.New("LRN prefix is not formatted as an LRN")
+	}
+
+	if strings.Contains(strings.TrimSuffix(lrnPrefix, LRNPrefixWildcard), "*") {
+		return "", fmt.Errorf("LRN prefix can only end with %s", LRNPrefixWildcard)
+	}
+
+	return parsed.Host, nil
+}
+
+// MatchesLRNPrefix reports whether an LRN matches the LRN prefix of a name access grant.
+func MatchesLRNPrefix(lrnPrefix string, lrn string) bool {
+	if path, ok := strings.CutSuffix(lrnPrefix, LRNPrefixWildcard); ok {
+		return strings.HasPrefix(lrn, path+"/")
+	}
+
+	return lrn == lrnPrefix
+}
@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// LRNVersionSeparator separates an LRN from the version of the name to look up or resolve, e.g. `lrn://cerc-io/app@v3`.
+const LRNVersionSeparator = "@v"
+
+// ParseLRNVersion splits the version suffix off an LRN, returning a zero version if there's none.
+// Callers should check for a name matching the LRN exactly first, as names may themselves end in `@v<digits>`.
+func ParseLRNVersion(lrn string) (string, uint64, error) {
+	i := strings.LastIndex(lrn, LRNVersionSeparator)
+	if i < 0 {
+		return lrn, 0, nil
+	}
+
+	suffix := lrn[i+len(LRNVersionSeparator):]
+	if suffix == "" || strings.Trim(suffix, "0123456789") != "" {
+		// Not a version suffix, but part of the name.
+		return lrn, 0, nil
+	}
+
+	version, err := strconv.ParseUint(suffix, 10, 64)
+	if err != nil || version == 0 {
+		return "", 0, errors.New("invalid LRN version")
+	}
+
+	return lrn[:i], version, nil
+}
+
+// AsOf returns the name record as of a block height, or a version of the name (1 for the first one set),
+// with the entry at that point as latest and the entries before it as history. It returns nil if the name
+// wasn't set yet at that point, and the name record itself if neither the height nor the version are set.
+func (nameRecord *NameRecord) AsOf(height uint64, version uint64) *NameRecord {
+	if height == 0 && version == 0 {
+		return nameRecord
+	}
+
+	entries := append(append([]*NameRecordEntry{}, nameRecord.History...), nameRecord.Latest)
+
+	n := uint64(len(entries))
+	if version > 0 {
+		if version > n {
+			return nil
+		}
+		n = version
+	}
+	for height > 0 && n > 0 && entries[n-1].Height > height {
+		n--
+	}
+	if n == 0 {
+		return nil
+	}
+
+	return &NameRecord{Latest: entries[n-1], History: entries[:n-1]}
+}
@@ -8,6 +8,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Hard caps of the record attribute limits, checked before decoding message payloads.
+// The limits set by params are checked by the keeper, and can't exceed these.
+const (
+	// MaxRecordAttributesSizeCap is the absolute maximum size of record attributes (1 MiB).
+	MaxRecordAttributesSizeCap uint64 = 1024 * 1024
+
+	// MaxRecordAttributesDepthCap is the absolute maximum nesting depth of record attributes.
+	MaxRecordAttributesDepthCap uint64 = 64
+)
+
 // Default parameter values.
 var (
 	// DefaultRecordRent is the default record rent for 1 time period (see expiry time).
@@ -16,6 +26,24 @@ var (
 	// DefaultRecordExpiryTime is the default record expiry time (1 year).
 	DefaultRecordExpiryTime = time.Hour * 24 * 365
 
+	// DefaultMaxRecordLifetime is the default maximum prepaid record lifetime (5 years).
+	DefaultMaxRecordLifetime = DefaultRecordExpiryTime * 5
+
+	// DefaultRecordRentPerByte is the default rent per byte of record attributes for 1 time period.
+	DefaultRecordRentPerByte = sdkmath.NewInt(100)
+
+	// DefaultMaxRecordAttributesSize is the default maximum size of record attributes (64 KiB).
+	DefaultMaxRecordAttributesSize uint64 = 64 * 1024
+
+	// DefaultMaxRecordAttributesDepth is the default maximum nesting depth of record attributes.
+	DefaultMaxRecordAttributesDepth uint64 = 16
+
+	// DefaultMaxRecordIndexedAttributes is the default maximum number of attribute index entries of a record.
+	DefaultMaxRecordIndexedAttributes uint64 = 1024
+
+	// DefaultMaxSchemaSize is the default maximum size of record type schema definitions (32 KiB).
+	DefaultMaxSchemaSize uint64 = 32 * 1024
+
 	DefaultAuthorityRent        = sdkmath.NewInt(1000000)
 	DefaultAuthorityExpiryTime  = time.Hour * 24 * 365
 	DefaultAuthorityGracePeriod = time.Hour * 24 * 2
@@ -39,6 +67,12 @@ func NewParams(
 	commitsDuration time.Duration, revealsDuration time.Duration,
 	commitFee sdk.Coin, revealFee sdk.Coin,
 	minimumBid sdk.Coin,
+	maxRecordLifetime time.Duration,
+	recordRentPerByte sdk.Coin,
+	maxRecordAttributesSize uint64,
+	maxRecordAttributesDepth uint64,
+	maxRecordIndexedAttributes uint64,
+	maxSchemaSize uint64,
 ) Params {
 	return Params{
 		RecordRent:         recordRent,
@@ -54,6 +88,14 @@ func NewParams(
 		AuthorityAuctionCommitFee:       commitFee,
 		AuthorityAuctionRevealFee:       revealFee,
 		AuthorityAuctionMinimumBid:      minimumBid,
+
+		MaxRecordLifetime:          maxRecordLifetime,
+		RecordRentPerByte:          recordRentPerByte,
+		MaxRecordAttributesSize:    maxRecordAttributesSize,
+		MaxRecordAttributesDepth:   maxRecordAttributesDepth,
+		MaxRecordIndexedAttributes: maxRecordIndexedAttributes,
+
+		MaxSchemaSize: maxSchemaSize,
 	}
 }
 
@@ -67,6 +109,12 @@ func DefaultParams() Params {
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultCommitFee),
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultRevealFee),
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinimumBid),
+		DefaultMaxRecordLifetime,
+		sdk.NewCoin(sdk.DefaultBondDenom, DefaultRecordRentPerByte),
+		DefaultMaxRecordAttributesSize,
+		DefaultMaxRecordAttributesDepth,
+		DefaultMaxRecordIndexedAttributes,
+		DefaultMaxSchemaSize,
 	)
 }
 
@@ -116,6 +164,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxRecordLifetime(p.MaxRecordLifetime); err != nil {
+		return err
+	}
+
+	if p.MaxRecordLifetime < p.RecordRentDuration {
+		return fmt.Errorf("MaxRecordLifetime can't be less than RecordRentDuration")
+	}
+
+	if err := validateRecordRentPerByte(p.RecordRentPerByte); err != nil {
+		return err
+	}
+
+	if p.RecordRentPerByte.Denom != p.RecordRent.Denom {
+		return fmt.Errorf("RecordRentPerByte and RecordRent must have the same denom")
+	}
+
+	if err := validateMaxRecordAttributesSize(p.MaxRecordAttributesSize); err != nil {
+		return err
+	}
+
+	if err := validateMaxRecordAttributesDepth(p.MaxRecordAttributesDepth); err != nil {
+		return err
+	}
+
+	if err := validateMaxRecordIndexedAttributes(p.MaxRecordIndexedAttributes); err != nil {
+		return err
+	}
+
+	if err := validateMaxSchemaSize(p.MaxSchemaSize); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -127,6 +207,30 @@ func validateRecordRentDuration(i interface{}) error {
 	return validateDuration("RecordRentDuration", i)
 }
 
+func validateMaxRecordLifetime(i interface{}) error {
+	return validateDuration("MaxRecordLifetime", i)
+}
+
+func validateRecordRentPerByte(i interface{}) error {
+	return validateAmount("RecordRentPerByte", i)
+}
+
+func validateMaxRecordAttributesSize(i interface{}) error {
+	return validateCappedLimit("MaxRecordAttributesSize", i, MaxRecordAttributesSizeCap)
+}
+
+func validateMaxRecordAttributesDepth(i interface{}) error {
+	return validateCappedLimit("MaxRecordAttributesDepth", i, MaxRecordAttributesDepthCap)
+}
+
+func validateMaxRecordIndexedAttributes(i interface{}) error {
+	return validateLimit("MaxRecordIndexedAttributes", i)
+}
+
+func validateMaxSchemaSize(i interface{}) error {
+	return validateLimit("MaxSchemaSize", i)
+}
+
 func validateAuthorityRent(i interface{}) error {
 	return validateAmount("AuthorityRent", i)
 }
@@ -181,6 +285,31 @@ func validateAmount(name string, i interface{}) error {
 	return nil
 }
 
+func validateLimit(name string, i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("%s invalid parameter type: %T", name, i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("%s must be a positive integer", name)
+	}
+
+	return nil
+}
+
+func validateCappedLimit(name string, i interface{}, limitCap uint64) error {
+	if err := validateLimit(name, i); err != nil {
+		return err
+	}
+
+	if v := i.(uint64); v > limitCap {
+		return fmt.Errorf("%s must not exceed %d", name, limitCap)
+	}
+
+	return nil
+}
+
 func validateDuration(name string, i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
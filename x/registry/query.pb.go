@@ -33,6 +33,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Comparison operator for record attribute values
+type QueryRecordsRequest_Operator int32
+
+const (
+	// Equality (default)
+	QueryRecordsRequest_OPERATOR_UNSPECIFIED QueryRecordsRequest_Operator = 0
+	// Greater than
+	QueryRecordsRequest_OPERATOR_GT QueryRecordsRequest_Operator = 1
+	// Greater than or equal
+	QueryRecordsRequest_OPERATOR_GTE QueryRecordsRequest_Operator = 2
+	// Less than
+	QueryRecordsRequest_OPERATOR_LT QueryRecordsRequest_Operator = 3
+	// Less than or equal
+	QueryRecordsRequest_OPERATOR_LTE QueryRecordsRequest_Operator = 4
+	// String prefix
+	QueryRecordsRequest_OPERATOR_PREFIX QueryRecordsRequest_Operator = 5
+)
+
+var QueryRecordsRequest_Operator_name = map[int32]string{
+	0: "OPERATOR_UNSPECIFIED",
+	1: "OPERATOR_GT",
+	2: "OPERATOR_GTE",
+	3: "OPERATOR_LT",
+	4: "OPERATOR_LTE",
+	5: "OPERATOR_PREFIX",
+}
+
+var QueryRecordsRequest_Operator_value = map[string]int32{
+	"OPERATOR_UNSPECIFIED": 0,
+	"OPERATOR_GT":          1,
+	"OPERATOR_GTE":         2,
+	"OPERATOR_LT":          3,
+	"OPERATOR_LTE":         4,
+	"OPERATOR_PREFIX":      5,
+}
+
+func (x QueryRecordsRequest_Operator) String() string {
+	return proto.EnumName(QueryRecordsRequest_Operator_name, int32(x))
+}
+
+func (QueryRecordsRequest_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{2, 0}
+}
+
 // QueryParamsRequest is request type for registry params
 type QueryParamsRequest struct {
 }
@@ -117,10 +161,14 @@ func (m *QueryParamsResponse) GetParams() *Params {
 
 // QueryRecordsRequest is request type for registry records list
 type QueryRecordsRequest struct {
+	// Attribute conditions, combined with a logical AND
 	Attributes []*QueryRecordsRequest_KeyValueInput `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	All        bool                                 `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Boolean expression over attribute conditions, combined with the attributes
+	// with a logical AND
+	Filter *QueryRecordsRequest_Expression `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *QueryRecordsRequest) Reset()         { *m = QueryRecordsRequest{} }
@@ -177,6 +225,13 @@ func (m *QueryRecordsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryRecordsRequest) GetFilter() *QueryRecordsRequest_Expression {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 // Array type attribute
 type QueryRecordsRequest_ArrayInput struct {
 	Values []*QueryRecordsRequest_ValueInput `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -422,8 +477,12 @@ func (*QueryRecordsRequest_ValueInput) XXX_OneofWrappers() []interface{} {
 
 // Type for record attribute key
 type QueryRecordsRequest_KeyValueInput struct {
+	// Attribute path, with the keys of nested attributes separated by dots (e.g. x500.country)
+	// Dots and backslashes within a key are escaped with a backslash (e.g. a\.b for the key a.b)
 	Key   string                          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value *QueryRecordsRequest_ValueInput `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Range and prefix operators apply to int, float and string values
+	Operator QueryRecordsRequest_Operator `protobuf:"varint,3,opt,name=operator,proto3,enum=cerc.registry.v1.QueryRecordsRequest_Operator" json:"operator,omitempty"`
 }
 
 func (m *QueryRecordsRequest_KeyValueInput) Reset()         { *m = QueryRecordsRequest_KeyValueInput{} }
@@ -473,6 +532,172 @@ func (m *QueryRecordsRequest_KeyValueInput) GetValue() *QueryRecordsRequest_Valu
 	return nil
 }
 
+func (m *QueryRecordsRequest_KeyValueInput) GetOperator() QueryRecordsRequest_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return QueryRecordsRequest_OPERATOR_UNSPECIFIED
+}
+
+// List of boolean expressions
+type QueryRecordsRequest_ExpressionList struct {
+	Expressions []*QueryRecordsRequest_Expression `protobuf:"bytes,1,rep,name=expressions,proto3" json:"expressions,omitempty"`
+}
+
+func (m *QueryRecordsRequest_ExpressionList) Reset()         { *m = QueryRecordsRequest_ExpressionList{} }
+func (m *QueryRecordsRequest_ExpressionList) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsRequest_ExpressionList) ProtoMessage()    {}
+func (*QueryRecordsRequest_ExpressionList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{2, 4}
+}
+func (m *QueryRecordsRequest_ExpressionList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordsRequest_ExpressionList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordsRequest_ExpressionList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordsRequest_ExpressionList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsRequest_ExpressionList.Merge(m, src)
+}
+func (m *QueryRecordsRequest_ExpressionList) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordsRequest_ExpressionList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsRequest_ExpressionList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsRequest_ExpressionList proto.InternalMessageInfo
+
+func (m *QueryRecordsRequest_ExpressionList) GetExpressions() []*QueryRecordsRequest_Expression {
+	if m != nil {
+		return m.Expressions
+	}
+	return nil
+}
+
+// Boolean expression over record attribute conditions
+type QueryRecordsRequest_Expression struct {
+	// Expression node is one of the following types
+	//
+	// Types that are valid to be assigned to Node:
+	//	*QueryRecordsRequest_Expression_Condition
+	//	*QueryRecordsRequest_Expression_And
+	//	*QueryRecordsRequest_Expression_Or
+	//	*QueryRecordsRequest_Expression_Not
+	Node isQueryRecordsRequest_Expression_Node `protobuf_oneof:"node"`
+}
+
+func (m *QueryRecordsRequest_Expression) Reset()         { *m = QueryRecordsRequest_Expression{} }
+func (m *QueryRecordsRequest_Expression) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsRequest_Expression) ProtoMessage()    {}
+func (*QueryRecordsRequest_Expression) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{2, 5}
+}
+func (m *QueryRecordsRequest_Expression) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecordsRequest_Expression) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecordsRequest_Expression.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecordsRequest_Expression) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsRequest_Expression.Merge(m, src)
+}
+func (m *QueryRecordsRequest_Expression) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecordsRequest_Expression) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsRequest_Expression.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecordsRequest_Expression proto.InternalMessageInfo
+
+type isQueryRecordsRequest_Expression_Node interface {
+	isQueryRecordsRequest_Expression_Node()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type QueryRecordsRequest_Expression_Condition struct {
+	Condition *QueryRecordsRequest_KeyValueInput `protobuf:"bytes,1,opt,name=condition,proto3,oneof" json:"condition,omitempty"`
+}
+type QueryRecordsRequest_Expression_And struct {
+	And *QueryRecordsRequest_ExpressionList `protobuf:"bytes,2,opt,name=and,proto3,oneof" json:"and,omitempty"`
+}
+type QueryRecordsRequest_Expression_Or struct {
+	Or *QueryRecordsRequest_ExpressionList `protobuf:"bytes,3,opt,name=or,proto3,oneof" json:"or,omitempty"`
+}
+type QueryRecordsRequest_Expression_Not struct {
+	Not *QueryRecordsRequest_Expression `protobuf:"bytes,4,opt,name=not,proto3,oneof" json:"not,omitempty"`
+}
+
+func (*QueryRecordsRequest_Expression_Condition) isQueryRecordsRequest_Expression_Node() {}
+func (*QueryRecordsRequest_Expression_And) isQueryRecordsRequest_Expression_Node()       {}
+func (*QueryRecordsRequest_Expression_Or) isQueryRecordsRequest_Expression_Node()        {}
+func (*QueryRecordsRequest_Expression_Not) isQueryRecordsRequest_Expression_Node()       {}
+
+func (m *QueryRecordsRequest_Expression) GetNode() isQueryRecordsRequest_Expression_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *QueryRecordsRequest_Expression) GetCondition() *QueryRecordsRequest_KeyValueInput {
+	if x, ok := m.GetNode().(*QueryRecordsRequest_Expression_Condition); ok {
+		return x.Condition
+	}
+	return nil
+}
+
+func (m *QueryRecordsRequest_Expression) GetAnd() *QueryRecordsRequest_ExpressionList {
+	if x, ok := m.GetNode().(*QueryRecordsRequest_Expression_And); ok {
+		return x.And
+	}
+	return nil
+}
+
+func (m *QueryRecordsRequest_Expression) GetOr() *QueryRecordsRequest_ExpressionList {
+	if x, ok := m.GetNode().(*QueryRecordsRequest_Expression_Or); ok {
+		return x.Or
+	}
+	return nil
+}
+
+func (m *QueryRecordsRequest_Expression) GetNot() *QueryRecordsRequest_Expression {
+	if x, ok := m.GetNode().(*QueryRecordsRequest_Expression_Not); ok {
+		return x.Not
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueryRecordsRequest_Expression) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueryRecordsRequest_Expression_Condition)(nil),
+		(*QueryRecordsRequest_Expression_And)(nil),
+		(*QueryRecordsRequest_Expression_Or)(nil),
+		(*QueryRecordsRequest_Expression_Not)(nil),
+	}
+}
+
 // QueryRecordsResponse is response type for registry records list
 type QueryRecordsResponse struct {
 	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
@@ -617,25 +842,23 @@ func (m *QueryGetRecordResponse) GetRecord() Record {
 	return Record{}
 }
 
-// QueryGetRecordsByBondIdRequest is request type for get the records by bond-id
-type QueryGetRecordsByBondIdRequest struct {
+// QueryGetRecordHistoryRequest is request type for record version history
+type QueryGetRecordHistoryRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRecordsByBondIdRequest) Reset()         { *m = QueryGetRecordsByBondIdRequest{} }
-func (m *QueryGetRecordsByBondIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecordsByBondIdRequest) ProtoMessage()    {}
-func (*QueryGetRecordsByBondIdRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetRecordHistoryRequest) Reset()         { *m = QueryGetRecordHistoryRequest{} }
+func (m *QueryGetRecordHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordHistoryRequest) ProtoMessage()    {}
+func (*QueryGetRecordHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{6}
 }
-func (m *QueryGetRecordsByBondIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecordsByBondIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecordsByBondIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -645,51 +868,42 @@ func (m *QueryGetRecordsByBondIdRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecordsByBondIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecordsByBondIdRequest.Merge(m, src)
+func (m *QueryGetRecordHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordHistoryRequest.Merge(m, src)
 }
-func (m *QueryGetRecordsByBondIdRequest) XXX_Size() int {
+func (m *QueryGetRecordHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecordsByBondIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecordsByBondIdRequest.DiscardUnknown(m)
+func (m *QueryGetRecordHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecordsByBondIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryGetRecordsByBondIdRequest) GetId() string {
+func (m *QueryGetRecordHistoryRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *QueryGetRecordsByBondIdRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryGetRecordsByBondIdResponse is response type for records list by bond-id
-type QueryGetRecordsByBondIdResponse struct {
+// QueryGetRecordHistoryResponse is response type for record version history
+type QueryGetRecordHistoryResponse struct {
 	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetRecordsByBondIdResponse) Reset()         { *m = QueryGetRecordsByBondIdResponse{} }
-func (m *QueryGetRecordsByBondIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRecordsByBondIdResponse) ProtoMessage()    {}
-func (*QueryGetRecordsByBondIdResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetRecordHistoryResponse) Reset()         { *m = QueryGetRecordHistoryResponse{} }
+func (m *QueryGetRecordHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordHistoryResponse) ProtoMessage()    {}
+func (*QueryGetRecordHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{7}
 }
-func (m *QueryGetRecordsByBondIdResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRecordsByBondIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRecordsByBondIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -699,50 +913,45 @@ func (m *QueryGetRecordsByBondIdResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRecordsByBondIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRecordsByBondIdResponse.Merge(m, src)
+func (m *QueryGetRecordHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordHistoryResponse.Merge(m, src)
 }
-func (m *QueryGetRecordsByBondIdResponse) XXX_Size() int {
+func (m *QueryGetRecordHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRecordsByBondIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRecordsByBondIdResponse.DiscardUnknown(m)
+func (m *QueryGetRecordHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRecordsByBondIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryGetRecordsByBondIdResponse) GetRecords() []Record {
+func (m *QueryGetRecordHistoryResponse) GetRecords() []Record {
 	if m != nil {
 		return m.Records
 	}
 	return nil
 }
 
-func (m *QueryGetRecordsByBondIdResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryNameRecordsRequest is request type for registry names records
-type QueryNameRecordsRequest struct {
+// QueryGetRecordsByOwnerRequest is request type for records by owner address
+type QueryGetRecordsByOwnerRequest struct {
+	// Account (bech32) or record owner (hex) address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryNameRecordsRequest) Reset()         { *m = QueryNameRecordsRequest{} }
-func (m *QueryNameRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryNameRecordsRequest) ProtoMessage()    {}
-func (*QueryNameRecordsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetRecordsByOwnerRequest) Reset()         { *m = QueryGetRecordsByOwnerRequest{} }
+func (m *QueryGetRecordsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByOwnerRequest) ProtoMessage()    {}
+func (*QueryGetRecordsByOwnerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{8}
 }
-func (m *QueryNameRecordsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordsByOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNameRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordsByOwnerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -752,44 +961,51 @@ func (m *QueryNameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryNameRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNameRecordsRequest.Merge(m, src)
+func (m *QueryGetRecordsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByOwnerRequest.Merge(m, src)
 }
-func (m *QueryNameRecordsRequest) XXX_Size() int {
+func (m *QueryGetRecordsByOwnerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNameRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNameRecordsRequest.DiscardUnknown(m)
+func (m *QueryGetRecordsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByOwnerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNameRecordsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordsByOwnerRequest proto.InternalMessageInfo
 
-func (m *QueryNameRecordsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetRecordsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryGetRecordsByOwnerRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryNameRecordsResponse is response type for registry names records
-type QueryNameRecordsResponse struct {
-	Names []NameEntry `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+// QueryGetRecordsByOwnerResponse is response type for records by owner address
+type QueryGetRecordsByOwnerResponse struct {
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryNameRecordsResponse) Reset()         { *m = QueryNameRecordsResponse{} }
-func (m *QueryNameRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryNameRecordsResponse) ProtoMessage()    {}
-func (*QueryNameRecordsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetRecordsByOwnerResponse) Reset()         { *m = QueryGetRecordsByOwnerResponse{} }
+func (m *QueryGetRecordsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByOwnerResponse) ProtoMessage()    {}
+func (*QueryGetRecordsByOwnerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{9}
 }
-func (m *QueryNameRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordsByOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryNameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryNameRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordsByOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -799,49 +1015,52 @@ func (m *QueryNameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryNameRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryNameRecordsResponse.Merge(m, src)
+func (m *QueryGetRecordsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByOwnerResponse.Merge(m, src)
 }
-func (m *QueryNameRecordsResponse) XXX_Size() int {
+func (m *QueryGetRecordsByOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryNameRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryNameRecordsResponse.DiscardUnknown(m)
+func (m *QueryGetRecordsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByOwnerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryNameRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordsByOwnerResponse proto.InternalMessageInfo
 
-func (m *QueryNameRecordsResponse) GetNames() []NameEntry {
+func (m *QueryGetRecordsByOwnerResponse) GetRecords() []Record {
 	if m != nil {
-		return m.Names
+		return m.Records
 	}
 	return nil
 }
 
-func (m *QueryNameRecordsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetRecordsByOwnerResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryWhoisRequest is request type for Get NameAuthority
-type QueryWhoisRequest struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// QueryGetReferencingRecordsRequest is request type for records linking to a
+// record
+type QueryGetReferencingRecordsRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWhoisRequest) Reset()         { *m = QueryWhoisRequest{} }
-func (m *QueryWhoisRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWhoisRequest) ProtoMessage()    {}
-func (*QueryWhoisRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetReferencingRecordsRequest) Reset()         { *m = QueryGetReferencingRecordsRequest{} }
+func (m *QueryGetReferencingRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReferencingRecordsRequest) ProtoMessage()    {}
+func (*QueryGetReferencingRecordsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{10}
 }
-func (m *QueryWhoisRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetReferencingRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhoisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetReferencingRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhoisRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetReferencingRecordsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -851,42 +1070,52 @@ func (m *QueryWhoisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryWhoisRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhoisRequest.Merge(m, src)
+func (m *QueryGetReferencingRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReferencingRecordsRequest.Merge(m, src)
 }
-func (m *QueryWhoisRequest) XXX_Size() int {
+func (m *QueryGetReferencingRecordsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhoisRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhoisRequest.DiscardUnknown(m)
+func (m *QueryGetReferencingRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReferencingRecordsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhoisRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetReferencingRecordsRequest proto.InternalMessageInfo
 
-func (m *QueryWhoisRequest) GetName() string {
+func (m *QueryGetReferencingRecordsRequest) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-// QueryWhoisResponse is response type for whois request
-type QueryWhoisResponse struct {
-	NameAuthority NameAuthority `protobuf:"bytes,1,opt,name=name_authority,json=nameAuthority,proto3" json:"name_authority" json:"name_authority" yaml:"name_authority"`
+func (m *QueryGetReferencingRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryWhoisResponse) Reset()         { *m = QueryWhoisResponse{} }
-func (m *QueryWhoisResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWhoisResponse) ProtoMessage()    {}
-func (*QueryWhoisResponse) Descriptor() ([]byte, []int) {
+// QueryGetReferencingRecordsResponse is response type for records linking to a
+// record
+type QueryGetReferencingRecordsResponse struct {
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetReferencingRecordsResponse) Reset()         { *m = QueryGetReferencingRecordsResponse{} }
+func (m *QueryGetReferencingRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetReferencingRecordsResponse) ProtoMessage()    {}
+func (*QueryGetReferencingRecordsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{11}
 }
-func (m *QueryWhoisResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetReferencingRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhoisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetReferencingRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhoisResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetReferencingRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -896,42 +1125,54 @@ func (m *QueryWhoisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryWhoisResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhoisResponse.Merge(m, src)
+func (m *QueryGetReferencingRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetReferencingRecordsResponse.Merge(m, src)
 }
-func (m *QueryWhoisResponse) XXX_Size() int {
+func (m *QueryGetReferencingRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhoisResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhoisResponse.DiscardUnknown(m)
+func (m *QueryGetReferencingRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetReferencingRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhoisResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetReferencingRecordsResponse proto.InternalMessageInfo
 
-func (m *QueryWhoisResponse) GetNameAuthority() NameAuthority {
+func (m *QueryGetReferencingRecordsResponse) GetRecords() []Record {
 	if m != nil {
-		return m.NameAuthority
+		return m.Records
 	}
-	return NameAuthority{}
+	return nil
 }
 
-// QueryAuthoritiesRequest is request type to get all authorities
-type QueryAuthoritiesRequest struct {
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-}
+func (m *QueryGetReferencingRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-func (m *QueryAuthoritiesRequest) Reset()         { *m = QueryAuthoritiesRequest{} }
-func (m *QueryAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAuthoritiesRequest) ProtoMessage()    {}
-func (*QueryAuthoritiesRequest) Descriptor() ([]byte, []int) {
+// QueryQuoteRecordRentRequest is request type for record rent quotes
+type QueryQuoteRecordRentRequest struct {
+	// Size of the (DAG-CBOR encoded) record attributes in bytes, for a new record
+	AttributesSize uint64 `protobuf:"varint,1,opt,name=attributes_size,json=attributesSize,proto3" json:"attributes_size,omitempty"`
+	// Id of an existing record, overrides the attributes size
+	RecordId string `protobuf:"bytes,2,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	// Number of rent periods, defaults to 1
+	Periods uint64 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *QueryQuoteRecordRentRequest) Reset()         { *m = QueryQuoteRecordRentRequest{} }
+func (m *QueryQuoteRecordRentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteRecordRentRequest) ProtoMessage()    {}
+func (*QueryQuoteRecordRentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{12}
 }
-func (m *QueryAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryQuoteRecordRentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryQuoteRecordRentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAuthoritiesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryQuoteRecordRentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -941,44 +1182,60 @@ func (m *QueryAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryAuthoritiesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAuthoritiesRequest.Merge(m, src)
+func (m *QueryQuoteRecordRentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteRecordRentRequest.Merge(m, src)
 }
-func (m *QueryAuthoritiesRequest) XXX_Size() int {
+func (m *QueryQuoteRecordRentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAuthoritiesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAuthoritiesRequest.DiscardUnknown(m)
+func (m *QueryQuoteRecordRentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteRecordRentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAuthoritiesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryQuoteRecordRentRequest proto.InternalMessageInfo
 
-func (m *QueryAuthoritiesRequest) GetOwner() string {
+func (m *QueryQuoteRecordRentRequest) GetAttributesSize() uint64 {
 	if m != nil {
-		return m.Owner
+		return m.AttributesSize
+	}
+	return 0
+}
+
+func (m *QueryQuoteRecordRentRequest) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
 	return ""
 }
 
-// QueryAuthoritiesResponse is response type for authorities request
-type QueryAuthoritiesResponse struct {
-	Authorities []AuthorityEntry `protobuf:"bytes,1,rep,name=authorities,proto3" json:"authorities"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryQuoteRecordRentRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
 }
 
-func (m *QueryAuthoritiesResponse) Reset()         { *m = QueryAuthoritiesResponse{} }
-func (m *QueryAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAuthoritiesResponse) ProtoMessage()    {}
-func (*QueryAuthoritiesResponse) Descriptor() ([]byte, []int) {
+// QueryQuoteRecordRentResponse is response type for record rent quotes
+type QueryQuoteRecordRentResponse struct {
+	AttributesSize uint64 `protobuf:"varint,1,opt,name=attributes_size,json=attributesSize,proto3" json:"attributes_size,omitempty"`
+	// Rent for 1 time period
+	RentPerPeriod types.Coin `protobuf:"bytes,2,opt,name=rent_per_period,json=rentPerPeriod,proto3" json:"rent_per_period"`
+	// Rent for the requested number of periods
+	Rent types.Coin `protobuf:"bytes,3,opt,name=rent,proto3" json:"rent"`
+}
+
+func (m *QueryQuoteRecordRentResponse) Reset()         { *m = QueryQuoteRecordRentResponse{} }
+func (m *QueryQuoteRecordRentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteRecordRentResponse) ProtoMessage()    {}
+func (*QueryQuoteRecordRentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{13}
 }
-func (m *QueryAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryQuoteRecordRentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryQuoteRecordRentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAuthoritiesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryQuoteRecordRentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -988,49 +1245,58 @@ func (m *QueryAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryAuthoritiesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAuthoritiesResponse.Merge(m, src)
+func (m *QueryQuoteRecordRentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteRecordRentResponse.Merge(m, src)
 }
-func (m *QueryAuthoritiesResponse) XXX_Size() int {
+func (m *QueryQuoteRecordRentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAuthoritiesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAuthoritiesResponse.DiscardUnknown(m)
+func (m *QueryQuoteRecordRentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteRecordRentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAuthoritiesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryQuoteRecordRentResponse proto.InternalMessageInfo
 
-func (m *QueryAuthoritiesResponse) GetAuthorities() []AuthorityEntry {
+func (m *QueryQuoteRecordRentResponse) GetAttributesSize() uint64 {
 	if m != nil {
-		return m.Authorities
+		return m.AttributesSize
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryAuthoritiesResponse) GetPagination() *query.PageResponse {
+func (m *QueryQuoteRecordRentResponse) GetRentPerPeriod() types.Coin {
 	if m != nil {
-		return m.Pagination
+		return m.RentPerPeriod
 	}
-	return nil
+	return types.Coin{}
 }
 
-// QueryLookupLrnRequest is request type for LookupLrn
-type QueryLookupLrnRequest struct {
-	Lrn string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+func (m *QueryQuoteRecordRentResponse) GetRent() types.Coin {
+	if m != nil {
+		return m.Rent
+	}
+	return types.Coin{}
 }
 
-func (m *QueryLookupLrnRequest) Reset()         { *m = QueryLookupLrnRequest{} }
-func (m *QueryLookupLrnRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLookupLrnRequest) ProtoMessage()    {}
-func (*QueryLookupLrnRequest) Descriptor() ([]byte, []int) {
+// QueryGetRecordsByBondIdRequest is request type for get the records by bond-id
+type QueryGetRecordsByBondIdRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetRecordsByBondIdRequest) Reset()         { *m = QueryGetRecordsByBondIdRequest{} }
+func (m *QueryGetRecordsByBondIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByBondIdRequest) ProtoMessage()    {}
+func (*QueryGetRecordsByBondIdRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{14}
 }
-func (m *QueryLookupLrnRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordsByBondIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLookupLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordsByBondIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLookupLrnRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordsByBondIdRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1040,42 +1306,51 @@ func (m *QueryLookupLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryLookupLrnRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLookupLrnRequest.Merge(m, src)
+func (m *QueryGetRecordsByBondIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByBondIdRequest.Merge(m, src)
 }
-func (m *QueryLookupLrnRequest) XXX_Size() int {
+func (m *QueryGetRecordsByBondIdRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLookupLrnRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLookupLrnRequest.DiscardUnknown(m)
+func (m *QueryGetRecordsByBondIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByBondIdRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLookupLrnRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordsByBondIdRequest proto.InternalMessageInfo
 
-func (m *QueryLookupLrnRequest) GetLrn() string {
+func (m *QueryGetRecordsByBondIdRequest) GetId() string {
 	if m != nil {
-		return m.Lrn
+		return m.Id
 	}
 	return ""
 }
 
-// QueryLookupLrnResponse is response type for QueryLookupLrnRequest
-type QueryLookupLrnResponse struct {
-	Name *NameRecord `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *QueryGetRecordsByBondIdRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryLookupLrnResponse) Reset()         { *m = QueryLookupLrnResponse{} }
-func (m *QueryLookupLrnResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLookupLrnResponse) ProtoMessage()    {}
-func (*QueryLookupLrnResponse) Descriptor() ([]byte, []int) {
+// QueryGetRecordsByBondIdResponse is response type for records list by bond-id
+type QueryGetRecordsByBondIdResponse struct {
+	Records []Record `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetRecordsByBondIdResponse) Reset()         { *m = QueryGetRecordsByBondIdResponse{} }
+func (m *QueryGetRecordsByBondIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRecordsByBondIdResponse) ProtoMessage()    {}
+func (*QueryGetRecordsByBondIdResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{15}
 }
-func (m *QueryLookupLrnResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetRecordsByBondIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLookupLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetRecordsByBondIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLookupLrnResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetRecordsByBondIdResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1085,42 +1360,50 @@ func (m *QueryLookupLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryLookupLrnResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLookupLrnResponse.Merge(m, src)
+func (m *QueryGetRecordsByBondIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRecordsByBondIdResponse.Merge(m, src)
 }
-func (m *QueryLookupLrnResponse) XXX_Size() int {
+func (m *QueryGetRecordsByBondIdResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLookupLrnResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLookupLrnResponse.DiscardUnknown(m)
+func (m *QueryGetRecordsByBondIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRecordsByBondIdResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLookupLrnResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetRecordsByBondIdResponse proto.InternalMessageInfo
 
-func (m *QueryLookupLrnResponse) GetName() *NameRecord {
+func (m *QueryGetRecordsByBondIdResponse) GetRecords() []Record {
 	if m != nil {
-		return m.Name
+		return m.Records
 	}
 	return nil
 }
 
-// QueryResolveLrnRequest is request type for ResolveLrn
-type QueryResolveLrnRequest struct {
-	Lrn string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+func (m *QueryGetRecordsByBondIdResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryResolveLrnRequest) Reset()         { *m = QueryResolveLrnRequest{} }
-func (m *QueryResolveLrnRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryResolveLrnRequest) ProtoMessage()    {}
-func (*QueryResolveLrnRequest) Descriptor() ([]byte, []int) {
+// QueryNameRecordsRequest is request type for registry names records
+type QueryNameRecordsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameRecordsRequest) Reset()         { *m = QueryNameRecordsRequest{} }
+func (m *QueryNameRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameRecordsRequest) ProtoMessage()    {}
+func (*QueryNameRecordsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{16}
 }
-func (m *QueryResolveLrnRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNameRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryResolveLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryResolveLrnRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNameRecordsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1130,42 +1413,44 @@ func (m *QueryResolveLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryResolveLrnRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResolveLrnRequest.Merge(m, src)
+func (m *QueryNameRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameRecordsRequest.Merge(m, src)
 }
-func (m *QueryResolveLrnRequest) XXX_Size() int {
+func (m *QueryNameRecordsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryResolveLrnRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResolveLrnRequest.DiscardUnknown(m)
+func (m *QueryNameRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameRecordsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResolveLrnRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNameRecordsRequest proto.InternalMessageInfo
 
-func (m *QueryResolveLrnRequest) GetLrn() string {
+func (m *QueryNameRecordsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Lrn
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// QueryResolveLrnResponse is response type for QueryResolveLrnRequest
-type QueryResolveLrnResponse struct {
-	Record *Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+// QueryNameRecordsResponse is response type for registry names records
+type QueryNameRecordsResponse struct {
+	Names []NameEntry `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryResolveLrnResponse) Reset()         { *m = QueryResolveLrnResponse{} }
-func (m *QueryResolveLrnResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryResolveLrnResponse) ProtoMessage()    {}
-func (*QueryResolveLrnResponse) Descriptor() ([]byte, []int) {
+func (m *QueryNameRecordsResponse) Reset()         { *m = QueryNameRecordsResponse{} }
+func (m *QueryNameRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameRecordsResponse) ProtoMessage()    {}
+func (*QueryNameRecordsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{17}
 }
-func (m *QueryResolveLrnResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNameRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryResolveLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryResolveLrnResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNameRecordsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1175,42 +1460,55 @@ func (m *QueryResolveLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryResolveLrnResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResolveLrnResponse.Merge(m, src)
+func (m *QueryNameRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameRecordsResponse.Merge(m, src)
 }
-func (m *QueryResolveLrnResponse) XXX_Size() int {
+func (m *QueryNameRecordsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryResolveLrnResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResolveLrnResponse.DiscardUnknown(m)
+func (m *QueryNameRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameRecordsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResolveLrnResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNameRecordsResponse proto.InternalMessageInfo
 
-func (m *QueryResolveLrnResponse) GetRecord() *Record {
+func (m *QueryNameRecordsResponse) GetNames() []NameEntry {
 	if m != nil {
-		return m.Record
+		return m.Names
 	}
 	return nil
 }
 
-// QueryGetRegistryModuleBalanceRequest is request type for registry module
-// accounts balance
-type QueryGetRegistryModuleBalanceRequest struct {
+func (m *QueryNameRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetRegistryModuleBalanceRequest) Reset()         { *m = QueryGetRegistryModuleBalanceRequest{} }
-func (m *QueryGetRegistryModuleBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRegistryModuleBalanceRequest) ProtoMessage()    {}
-func (*QueryGetRegistryModuleBalanceRequest) Descriptor() ([]byte, []int) {
+// QueryNamesByPrefixRequest is request type for the name records under an LRN
+// prefix
+type QueryNamesByPrefixRequest struct {
+	// LRN prefix of the names, e.g. lrn://cerc-io/ (or lrn://cerc-io) for all
+	// the names of the cerc-io authority, or lrn://cerc-io/apps/ for the names
+	// under a path
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamesByPrefixRequest) Reset()         { *m = QueryNamesByPrefixRequest{} }
+func (m *QueryNamesByPrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesByPrefixRequest) ProtoMessage()    {}
+func (*QueryNamesByPrefixRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{18}
 }
-func (m *QueryGetRegistryModuleBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryNamesByPrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRegistryModuleBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNamesByPrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNamesByPrefixRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1220,36 +1518,52 @@ func (m *QueryGetRegistryModuleBalanceRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRegistryModuleBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.Merge(m, src)
+func (m *QueryNamesByPrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesByPrefixRequest.Merge(m, src)
 }
-func (m *QueryGetRegistryModuleBalanceRequest) XXX_Size() int {
+func (m *QueryNamesByPrefixRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRegistryModuleBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.DiscardUnknown(m)
+func (m *QueryNamesByPrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesByPrefixRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRegistryModuleBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryNamesByPrefixRequest proto.InternalMessageInfo
 
-// QueryGetRegistryModuleBalanceResponse is response type for registry module
-// accounts balance
-type QueryGetRegistryModuleBalanceResponse struct {
-	Balances []*AccountBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+func (m *QueryNamesByPrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
 }
 
-func (m *QueryGetRegistryModuleBalanceResponse) Reset()         { *m = QueryGetRegistryModuleBalanceResponse{} }
-func (m *QueryGetRegistryModuleBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetRegistryModuleBalanceResponse) ProtoMessage()    {}
-func (*QueryGetRegistryModuleBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryNamesByPrefixRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNamesByPrefixResponse is response type for the name records under an
+// LRN prefix
+type QueryNamesByPrefixResponse struct {
+	Names []NamePrefixEntry `protobuf:"bytes,1,rep,name=names,proto3" json:"names"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNamesByPrefixResponse) Reset()         { *m = QueryNamesByPrefixResponse{} }
+func (m *QueryNamesByPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNamesByPrefixResponse) ProtoMessage()    {}
+func (*QueryNamesByPrefixResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{19}
 }
-func (m *QueryGetRegistryModuleBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryNamesByPrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetRegistryModuleBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryNamesByPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryNamesByPrefixResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1259,43 +1573,56 @@ func (m *QueryGetRegistryModuleBalanceResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryGetRegistryModuleBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.Merge(m, src)
+func (m *QueryNamesByPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNamesByPrefixResponse.Merge(m, src)
 }
-func (m *QueryGetRegistryModuleBalanceResponse) XXX_Size() int {
+func (m *QueryNamesByPrefixResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetRegistryModuleBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.DiscardUnknown(m)
+func (m *QueryNamesByPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNamesByPrefixResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetRegistryModuleBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryNamesByPrefixResponse proto.InternalMessageInfo
 
-func (m *QueryGetRegistryModuleBalanceResponse) GetBalances() []*AccountBalance {
+func (m *QueryNamesByPrefixResponse) GetNames() []NamePrefixEntry {
 	if m != nil {
-		return m.Balances
+		return m.Names
 	}
 	return nil
 }
 
-// AccountBalance is registry module account balance
-type AccountBalance struct {
-	AccountName string                                   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty" json:"account_name" yaml:"account_name"`
-	Balance     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance" json:"balance" yaml:"balance"`
+func (m *QueryNamesByPrefixResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
-func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
-func (*AccountBalance) ProtoMessage()    {}
-func (*AccountBalance) Descriptor() ([]byte, []int) {
+// NamePrefixEntry is a name record under an LRN prefix
+type NamePrefixEntry struct {
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entry *NameRecord `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Whether the name is stale, i.e. its authority is missing or expired, or
+	// the name was set before its authority was last registered or transferred
+	// with its names invalidated, so it doesn't resolve
+	Stale bool `protobuf:"varint,3,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Whether the name has been deleted, i.e. the latest entry has no record
+	Deleted bool `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *NamePrefixEntry) Reset()         { *m = NamePrefixEntry{} }
+func (m *NamePrefixEntry) String() string { return proto.CompactTextString(m) }
+func (*NamePrefixEntry) ProtoMessage()    {}
+func (*NamePrefixEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c642b96b6da07a30, []int{20}
 }
-func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
+func (m *NamePrefixEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamePrefixEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NamePrefixEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,1549 +1632,1830 @@ func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *AccountBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountBalance.Merge(m, src)
+func (m *NamePrefixEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamePrefixEntry.Merge(m, src)
 }
-func (m *AccountBalance) XXX_Size() int {
+func (m *NamePrefixEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
+func (m *NamePrefixEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamePrefixEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
+var xxx_messageInfo_NamePrefixEntry proto.InternalMessageInfo
 
-func (m *AccountBalance) GetAccountName() string {
+func (m *NamePrefixEntry) GetName() string {
 	if m != nil {
-		return m.AccountName
+		return m.Name
 	}
 	return ""
 }
 
-func (m *AccountBalance) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *NamePrefixEntry) GetEntry() *NameRecord {
 	if m != nil {
-		return m.Balance
+		return m.Entry
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cerc.registry.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cerc.registry.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryRecordsRequest)(nil), "cerc.registry.v1.QueryRecordsRequest")
-	proto.RegisterType((*QueryRecordsRequest_ArrayInput)(nil), "cerc.registry.v1.QueryRecordsRequest.ArrayInput")
-	proto.RegisterType((*QueryRecordsRequest_MapInput)(nil), "cerc.registry.v1.QueryRecordsRequest.MapInput")
-	proto.RegisterMapType((map[string]*QueryRecordsRequest_ValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.MapInput.ValuesEntry")
-	proto.RegisterType((*QueryRecordsRequest_ValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.ValueInput")
-	proto.RegisterType((*QueryRecordsRequest_KeyValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.KeyValueInput")
-	proto.RegisterType((*QueryRecordsResponse)(nil), "cerc.registry.v1.QueryRecordsResponse")
-	proto.RegisterType((*QueryGetRecordRequest)(nil), "cerc.registry.v1.QueryGetRecordRequest")
-	proto.RegisterType((*QueryGetRecordResponse)(nil), "cerc.registry.v1.QueryGetRecordResponse")
-	proto.RegisterType((*QueryGetRecordsByBondIdRequest)(nil), "cerc.registry.v1.QueryGetRecordsByBondIdRequest")
-	proto.RegisterType((*QueryGetRecordsByBondIdResponse)(nil), "cerc.registry.v1.QueryGetRecordsByBondIdResponse")
-	proto.RegisterType((*QueryNameRecordsRequest)(nil), "cerc.registry.v1.QueryNameRecordsRequest")
-	proto.RegisterType((*QueryNameRecordsResponse)(nil), "cerc.registry.v1.QueryNameRecordsResponse")
-	proto.RegisterType((*QueryWhoisRequest)(nil), "cerc.registry.v1.QueryWhoisRequest")
-	proto.RegisterType((*QueryWhoisResponse)(nil), "cerc.registry.v1.QueryWhoisResponse")
-	proto.RegisterType((*QueryAuthoritiesRequest)(nil), "cerc.registry.v1.QueryAuthoritiesRequest")
-	proto.RegisterType((*QueryAuthoritiesResponse)(nil), "cerc.registry.v1.QueryAuthoritiesResponse")
-	proto.RegisterType((*QueryLookupLrnRequest)(nil), "cerc.registry.v1.QueryLookupLrnRequest")
-	proto.RegisterType((*QueryLookupLrnResponse)(nil), "cerc.registry.v1.QueryLookupLrnResponse")
-	proto.RegisterType((*QueryResolveLrnRequest)(nil), "cerc.registry.v1.QueryResolveLrnRequest")
-	proto.RegisterType((*QueryResolveLrnResponse)(nil), "cerc.registry.v1.QueryResolveLrnResponse")
-	proto.RegisterType((*QueryGetRegistryModuleBalanceRequest)(nil), "cerc.registry.v1.QueryGetRegistryModuleBalanceRequest")
-	proto.RegisterType((*QueryGetRegistryModuleBalanceResponse)(nil), "cerc.registry.v1.QueryGetRegistryModuleBalanceResponse")
-	proto.RegisterType((*AccountBalance)(nil), "cerc.registry.v1.AccountBalance")
+func (m *NamePrefixEntry) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("cerc/registry/v1/query.proto", fileDescriptor_c642b96b6da07a30) }
+func (m *NamePrefixEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
 
-var fileDescriptor_c642b96b6da07a30 = []byte{
-	// 1366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x8f, 0xd3, 0xc6,
-	0x17, 0x8f, 0x93, 0x4d, 0x16, 0x5e, 0xbe, 0x20, 0xbe, 0xd3, 0x2d, 0x18, 0x03, 0xc9, 0xd6, 0x65,
-	0x77, 0xb3, 0xa0, 0xb5, 0xc9, 0x22, 0x01, 0x42, 0x3d, 0x94, 0x54, 0x85, 0x50, 0x7e, 0x08, 0x5c,
-	0x89, 0x4a, 0xbd, 0xa0, 0x49, 0x32, 0x0d, 0xee, 0x3a, 0x33, 0xc1, 0x76, 0x02, 0xe9, 0x0a, 0xa9,
-	0x42, 0x2d, 0x97, 0x5e, 0x5a, 0xf5, 0x54, 0xb5, 0xc7, 0x9e, 0xaa, 0xb6, 0x7f, 0x07, 0x52, 0x2f,
-	0x48, 0xbd, 0xf4, 0x44, 0x2b, 0xe8, 0xa5, 0x57, 0xfa, 0x0f, 0x54, 0x9e, 0x19, 0x3b, 0x76, 0x1c,
-	0x6f, 0xb2, 0x88, 0x4a, 0x3d, 0xad, 0x3d, 0xfe, 0xbc, 0xf7, 0xf9, 0xbc, 0x1f, 0xf3, 0x66, 0x36,
-	0x70, 0xb4, 0x4d, 0xdc, 0xb6, 0xe9, 0x92, 0xae, 0xed, 0xf9, 0xee, 0xc8, 0x1c, 0xd6, 0xcd, 0xbb,
-	0x03, 0xe2, 0x8e, 0x8c, 0xbe, 0xcb, 0x7c, 0x86, 0x0e, 0x04, 0x5f, 0x8d, 0xf0, 0xab, 0x31, 0xac,
-	0x6b, 0x47, 0xbb, 0x8c, 0x75, 0x1d, 0x62, 0xe2, 0xbe, 0x6d, 0x62, 0x4a, 0x99, 0x8f, 0x7d, 0x9b,
-	0x51, 0x4f, 0xe0, 0xb5, 0x13, 0x6d, 0xe6, 0xf5, 0x98, 0x67, 0xb6, 0xb0, 0x47, 0x84, 0x23, 0x73,
-	0x58, 0x6f, 0x11, 0x1f, 0xd7, 0xcd, 0x3e, 0xee, 0xda, 0x94, 0x83, 0x25, 0x76, 0xa9, 0xcb, 0xba,
-	0x8c, 0x3f, 0x9a, 0xc1, 0x93, 0x5c, 0xad, 0xc4, 0x3d, 0x84, 0xb6, 0x6d, 0x66, 0x87, 0x56, 0xd5,
-	0x94, 0xde, 0x48, 0x1d, 0x07, 0xe8, 0x4b, 0x80, 0x6e, 0x06, 0xc4, 0x37, 0xb0, 0x8b, 0x7b, 0x9e,
-	0x45, 0xee, 0x0e, 0x88, 0xe7, 0xeb, 0x97, 0xe0, 0xb5, 0xc4, 0xaa, 0xd7, 0x67, 0xd4, 0x23, 0xe8,
-	0x14, 0x94, 0xfa, 0x7c, 0x45, 0x55, 0x96, 0x95, 0x5a, 0x79, 0x53, 0x35, 0x26, 0x03, 0x36, 0xa4,
-	0x85, 0xc4, 0xe9, 0x7f, 0x97, 0xa4, 0x27, 0x8b, 0xb4, 0x99, 0xdb, 0x09, 0x09, 0xd0, 0xfb, 0x00,
-	0xd8, 0xf7, 0x5d, 0xbb, 0x35, 0xf0, 0x49, 0xe0, 0xad, 0x50, 0x2b, 0x6f, 0x9e, 0x4e, 0x7b, 0x9b,
-	0x62, 0x6a, 0x5c, 0x21, 0xa3, 0x5b, 0xd8, 0x19, 0x90, 0xcb, 0xb4, 0x3f, 0xf0, 0xad, 0x98, 0x1b,
-	0x74, 0x00, 0x0a, 0xd8, 0x71, 0xd4, 0xfc, 0xb2, 0x52, 0xdb, 0x63, 0x05, 0x8f, 0xe8, 0x22, 0xc0,
-	0x38, 0x91, 0x6a, 0x81, 0x8b, 0x5e, 0x35, 0x44, 0xce, 0x8c, 0x20, 0x67, 0x86, 0x28, 0x9f, 0xcc,
-	0x9c, 0x71, 0x03, 0x77, 0x89, 0xe4, 0xb1, 0x62, 0x96, 0xda, 0x2d, 0x80, 0x0b, 0xae, 0x8b, 0x47,
-	0x9c, 0x13, 0x35, 0xa1, 0x34, 0x0c, 0x14, 0x84, 0xc2, 0x4f, 0xcd, 0x27, 0x3c, 0xa6, 0x5a, 0xda,
-	0x6b, 0xbf, 0x28, 0xb0, 0xe7, 0x1a, 0xee, 0x0b, 0xb7, 0xd6, 0x84, 0xdb, 0xf3, 0xf3, 0xb9, 0x0d,
-	0xed, 0x85, 0x7f, 0xef, 0x5d, 0xea, 0xbb, 0xa3, 0x88, 0x60, 0x0b, 0xca, 0xb1, 0xe5, 0x20, 0x43,
-	0x5b, 0x64, 0xc4, 0xab, 0xb7, 0xd7, 0x0a, 0x1e, 0xd1, 0x45, 0x28, 0x72, 0x28, 0xcf, 0xda, 0xcb,
-	0x84, 0x22, 0xcc, 0xcf, 0xe7, 0xcf, 0x29, 0xda, 0xb7, 0x79, 0x80, 0xf1, 0x17, 0xa4, 0x42, 0xc9,
-	0xf3, 0x5d, 0x9b, 0x76, 0x05, 0x5f, 0x33, 0x67, 0xc9, 0x77, 0x84, 0xa0, 0x60, 0x53, 0x9f, 0x53,
-	0x16, 0x9a, 0x39, 0x2b, 0x78, 0x41, 0x07, 0xa1, 0xf8, 0x91, 0xc3, 0xb0, 0xcf, 0xab, 0xa4, 0x34,
-	0x73, 0x96, 0x78, 0x45, 0x1a, 0x2c, 0xb6, 0x18, 0x73, 0x08, 0xa6, 0xea, 0x42, 0x50, 0xd8, 0x66,
-	0xce, 0x0a, 0x17, 0xd0, 0x12, 0x2c, 0x38, 0x36, 0xdd, 0x52, 0x8b, 0xd2, 0x3f, 0x7f, 0x43, 0x4d,
-	0x28, 0xe2, 0xa0, 0x58, 0x6a, 0x69, 0x37, 0x21, 0x8d, 0xeb, 0x1b, 0x70, 0x73, 0x07, 0xa8, 0x01,
-	0x85, 0x1e, 0xee, 0xab, 0x8b, 0xdc, 0x8f, 0xb1, 0xbb, 0x72, 0x04, 0x71, 0xf5, 0x70, 0xbf, 0xb1,
-	0x28, 0x13, 0xac, 0xd9, 0xb0, 0x2f, 0xd1, 0xba, 0xff, 0x5e, 0x31, 0xf4, 0x6f, 0x14, 0x58, 0x4a,
-	0x22, 0xe5, 0x06, 0x3e, 0x07, 0x8b, 0xae, 0x58, 0x92, 0x3d, 0x36, 0x65, 0x07, 0x0b, 0x9b, 0xc6,
-	0xc2, 0xe3, 0xa7, 0xd5, 0x9c, 0x15, 0xc2, 0xd1, 0xa5, 0xc4, 0x4e, 0x12, 0xfa, 0xd6, 0x66, 0xee,
-	0x24, 0x41, 0x1b, 0xdf, 0x4a, 0xfa, 0x1a, 0xbc, 0xce, 0xa5, 0x5d, 0x22, 0xbe, 0x60, 0x0a, 0x47,
-	0xc2, 0x7e, 0xc8, 0xdb, 0x1d, 0x99, 0x8d, 0xbc, 0xdd, 0xd1, 0x6f, 0xc0, 0xc1, 0x49, 0xa0, 0x8c,
-	0xe2, 0x0c, 0x94, 0x84, 0xac, 0xec, 0x31, 0x94, 0x08, 0x42, 0xa2, 0xf5, 0xfb, 0x50, 0x49, 0x7a,
-	0xf4, 0x1a, 0xa3, 0x06, 0xa3, 0x9d, 0xcb, 0x59, 0x1a, 0x26, 0xe6, 0x47, 0xfe, 0x65, 0xe7, 0x87,
-	0xfe, 0xbd, 0x02, 0xd5, 0x4c, 0xea, 0xff, 0x4e, 0x6d, 0x30, 0x1c, 0xe2, 0x2a, 0xaf, 0xe3, 0x1e,
-	0x99, 0x18, 0xd8, 0xc9, 0x4c, 0x28, 0x2f, 0x9d, 0x89, 0xef, 0x14, 0x50, 0xd3, 0x1c, 0x32, 0x05,
-	0x67, 0xa1, 0x48, 0x71, 0x2f, 0x1a, 0x80, 0x47, 0xd2, 0x09, 0x08, 0xac, 0xf8, 0x28, 0x93, 0x39,
-	0x10, 0xf8, 0x57, 0xd9, 0x9d, 0xff, 0xe7, 0xea, 0x3e, 0xb8, 0xc3, 0xec, 0x28, 0x76, 0x04, 0x0b,
-	0x01, 0x8d, 0xec, 0x0b, 0xfe, 0xac, 0x7f, 0xa5, 0xc8, 0x83, 0x53, 0x22, 0x65, 0x04, 0xdb, 0xb0,
-	0x3f, 0xf8, 0x7c, 0x1b, 0x0f, 0xfc, 0x3b, 0xcc, 0xb5, 0xfd, 0x91, 0x4c, 0x55, 0x75, 0x7a, 0x28,
-	0x17, 0x42, 0x58, 0xe3, 0x74, 0x10, 0xce, 0x8b, 0xa7, 0xd5, 0x93, 0x1f, 0x7b, 0x8c, 0x9e, 0xd7,
-	0x93, 0x4e, 0xf4, 0xe5, 0x11, 0xee, 0x39, 0xa9, 0x55, 0x6b, 0x1f, 0x8d, 0xfb, 0xd0, 0x4d, 0x59,
-	0xbe, 0x70, 0xc5, 0x26, 0x51, 0x08, 0x4b, 0x50, 0x64, 0xf7, 0x28, 0x71, 0x65, 0x0c, 0xe2, 0x45,
-	0xff, 0x29, 0x2c, 0x46, 0xc2, 0x42, 0x86, 0xd2, 0x84, 0x32, 0x1e, 0x2f, 0xcb, 0x92, 0x2c, 0xa7,
-	0xe3, 0x88, 0xf8, 0xe3, 0x75, 0x89, 0x9b, 0xbe, 0xba, 0xea, 0xac, 0xcb, 0xd9, 0x71, 0x95, 0xb1,
-	0xad, 0x41, 0xff, 0xaa, 0x4b, 0xc3, 0xf0, 0x0e, 0x40, 0xc1, 0x71, 0x69, 0x38, 0x4a, 0x1d, 0x97,
-	0xea, 0xef, 0xc9, 0xe9, 0x11, 0x83, 0x46, 0x97, 0x98, 0x71, 0x35, 0xcb, 0x9b, 0x47, 0xa7, 0x17,
-	0x46, 0x4e, 0x1c, 0x51, 0xeb, 0x13, 0xd2, 0x97, 0x45, 0x3c, 0xe6, 0x0c, 0xc9, 0x8e, 0xbc, 0x57,
-	0x64, 0x0d, 0xe2, 0xd8, 0xf1, 0xed, 0x69, 0xbe, 0xb1, 0x15, 0x0d, 0xac, 0x55, 0x38, 0x3e, 0x9e,
-	0x1a, 0x02, 0x75, 0x8d, 0x75, 0x06, 0x0e, 0x69, 0x60, 0x07, 0xd3, 0x76, 0xb8, 0xc1, 0x74, 0x02,
-	0x2b, 0x33, 0x70, 0x52, 0xc2, 0x5b, 0xb0, 0xa7, 0x25, 0x96, 0x76, 0x2a, 0x68, 0xbb, 0xcd, 0x06,
-	0xd4, 0x0f, 0x6d, 0x23, 0x0b, 0xfd, 0x2f, 0x05, 0xf6, 0x27, 0x3f, 0xa2, 0xeb, 0xf0, 0x3f, 0x2c,
-	0x56, 0x6e, 0x8f, 0xb7, 0x48, 0xe3, 0xe4, 0x8b, 0xa7, 0xd5, 0x35, 0xd1, 0xc8, 0xf1, 0xaf, 0x61,
-	0x1b, 0x27, 0xd6, 0xac, 0xb2, 0x7c, 0x0d, 0xd2, 0x8e, 0x1e, 0x29, 0xb0, 0x28, 0xf9, 0xd4, 0x02,
-	0x17, 0x78, 0x38, 0xd1, 0x28, 0x61, 0x8b, 0xbc, 0xc3, 0x6c, 0xda, 0xb8, 0x29, 0xf7, 0xcc, 0x31,
-	0x41, 0x25, 0xed, 0x42, 0x96, 0xf0, 0xf5, 0x87, 0xdf, 0xab, 0xb5, 0xae, 0xed, 0xdf, 0x19, 0xb4,
-	0x8c, 0x36, 0xeb, 0x99, 0xf2, 0xc2, 0x2c, 0xfe, 0x6c, 0x78, 0x9d, 0x2d, 0xd3, 0x1f, 0xf5, 0x89,
-	0xc7, 0x3d, 0x7a, 0x56, 0x48, 0xbe, 0xf9, 0xb0, 0x0c, 0x45, 0x9e, 0x53, 0x74, 0x0f, 0x4a, 0xe2,
-	0x52, 0x8b, 0x8e, 0x67, 0x9c, 0xc7, 0x89, 0xbb, 0xb3, 0xb6, 0x32, 0x03, 0x25, 0x4a, 0xa1, 0x2f,
-	0x3f, 0xfc, 0xf5, 0xcf, 0xaf, 0xf3, 0x1a, 0x52, 0xcd, 0xd4, 0x15, 0x5d, 0xdc, 0x9d, 0xd1, 0x36,
-	0x2c, 0xca, 0x01, 0x89, 0x56, 0xe6, 0xba, 0x09, 0x68, 0xab, 0xb3, 0x60, 0x92, 0xfb, 0x0d, 0xce,
-	0x7d, 0x04, 0x1d, 0x36, 0xa7, 0xfc, 0x7b, 0x20, 0x18, 0x1f, 0x29, 0xb0, 0x37, 0x3a, 0xac, 0xd0,
-	0x5a, 0x86, 0xe3, 0xc9, 0x43, 0x5c, 0xab, 0xcd, 0x06, 0x4a, 0x0d, 0xab, 0x5c, 0xc3, 0x32, 0xaa,
-	0x64, 0x6a, 0x30, 0xb7, 0xed, 0xce, 0x03, 0xf4, 0xa3, 0x02, 0x28, 0x7d, 0x6a, 0xa2, 0x53, 0xb3,
-	0x88, 0x26, 0xcf, 0x76, 0xad, 0xbe, 0x0b, 0x0b, 0xa9, 0xb1, 0xce, 0x35, 0x9e, 0x44, 0xeb, 0x99,
-	0x1a, 0x37, 0x5a, 0xa3, 0x8d, 0x16, 0xa3, 0x9d, 0x0d, 0xbb, 0x23, 0xe4, 0x7e, 0xae, 0x40, 0x39,
-	0x76, 0xb4, 0xa1, 0xf5, 0x0c, 0xd6, 0xf4, 0x11, 0xab, 0x9d, 0x98, 0x07, 0x2a, 0x95, 0x55, 0xb9,
-	0xb2, 0xc3, 0xe8, 0x50, 0x5a, 0x99, 0x38, 0x11, 0x3f, 0x81, 0x22, 0x3f, 0x99, 0xd0, 0x9b, 0x19,
-	0x5e, 0xe3, 0x27, 0x9c, 0x76, 0x7c, 0x67, 0xd0, 0xec, 0x92, 0xdd, 0x0b, 0x80, 0xe6, 0x76, 0xc0,
-	0xfd, 0x00, 0x7d, 0xaa, 0xc0, 0xde, 0x68, 0xee, 0x66, 0xf6, 0xce, 0xe4, 0x10, 0xcf, 0xec, 0x9d,
-	0xd4, 0x08, 0xdf, 0x69, 0xef, 0x38, 0x1c, 0x8c, 0x3e, 0x53, 0x00, 0xc6, 0x23, 0x18, 0xd5, 0x32,
-	0x37, 0xc6, 0xc4, 0x44, 0xd7, 0xd6, 0xe7, 0x40, 0xce, 0xb3, 0x8b, 0x38, 0x1a, 0xfd, 0xac, 0x80,
-	0x9a, 0x35, 0x94, 0xd1, 0x99, 0x9d, 0x1a, 0x32, 0x7b, 0xda, 0x6b, 0x67, 0x77, 0x6d, 0x37, 0x5b,
-	0xb0, 0x1c, 0x7b, 0xe8, 0x0b, 0x05, 0xca, 0xb1, 0xcb, 0x40, 0x66, 0xfb, 0xa6, 0xaf, 0x18, 0x99,
-	0xed, 0x3b, 0xe5, 0x6e, 0xa1, 0xaf, 0x70, 0x25, 0x55, 0x74, 0x2c, 0xad, 0x24, 0x76, 0x71, 0x68,
-	0xbc, 0xfd, 0xf8, 0x59, 0x45, 0x79, 0xf2, 0xac, 0xa2, 0xfc, 0xf1, 0xac, 0xa2, 0x7c, 0xf9, 0xbc,
-	0x92, 0x7b, 0xf2, 0xbc, 0x92, 0xfb, 0xed, 0x79, 0x25, 0xf7, 0xe1, 0x6a, 0xd7, 0xf6, 0x8d, 0x61,
-	0xa7, 0x65, 0xf8, 0x8c, 0xbb, 0xd8, 0xb0, 0x99, 0xe9, 0xe0, 0x36, 0xa3, 0x76, 0xbb, 0x63, 0xde,
-	0x8f, 0x1c, 0xb6, 0x4a, 0xfc, 0x57, 0x8e, 0xd3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x7a,
-	0x2b, 0x91, 0xb8, 0x11, 0x00, 0x00,
+// QueryWhoisRequest is request type for Get NameAuthority
+type QueryWhoisRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryWhoisRequest) Reset()         { *m = QueryWhoisRequest{} }
+func (m *QueryWhoisRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhoisRequest) ProtoMessage()    {}
+func (*QueryWhoisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{21}
+}
+func (m *QueryWhoisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhoisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhoisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhoisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhoisRequest.Merge(m, src)
+}
+func (m *QueryWhoisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhoisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhoisRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryWhoisRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the registry module params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Records queries all records
-	Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error)
-	// Get record by id
-	GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error)
-	// Get records by bond id
-	GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error)
-	// NameRecords queries all name records
-	NameRecords(ctx context.Context, in *QueryNameRecordsRequest, opts ...grpc.CallOption) (*QueryNameRecordsResponse, error)
-	// Whois method retrieve the name authority info
-	Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error)
-	// LookupLrn
-	LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error)
-	// ResolveLrn
-	ResolveLrn(ctx context.Context, in *QueryResolveLrnRequest, opts ...grpc.CallOption) (*QueryResolveLrnResponse, error)
-	// Get registry module balance
-	GetRegistryModuleBalance(ctx context.Context, in *QueryGetRegistryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryGetRegistryModuleBalanceResponse, error)
-	// Authorities queries all authorities
-	Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error)
+func (m *QueryWhoisRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryWhoisResponse is response type for whois request
+type QueryWhoisResponse struct {
+	NameAuthority NameAuthority `protobuf:"bytes,1,opt,name=name_authority,json=nameAuthority,proto3" json:"name_authority" json:"name_authority" yaml:"name_authority"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryWhoisResponse) Reset()         { *m = QueryWhoisResponse{} }
+func (m *QueryWhoisResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhoisResponse) ProtoMessage()    {}
+func (*QueryWhoisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{22}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWhoisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhoisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhoisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWhoisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhoisResponse.Merge(m, src)
+}
+func (m *QueryWhoisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhoisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhoisResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error) {
-	out := new(QueryRecordsResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Records", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWhoisResponse proto.InternalMessageInfo
+
+func (m *QueryWhoisResponse) GetNameAuthority() NameAuthority {
+	if m != nil {
+		return m.NameAuthority
 	}
-	return out, nil
+	return NameAuthority{}
 }
 
-func (c *queryClient) GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error) {
-	out := new(QueryGetRecordResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryNameAccessGrantsRequest is request type for the name access grants of
+// an authority
+type QueryNameAccessGrantsRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only the grants to this address, if set
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryNameAccessGrantsRequest) Reset()         { *m = QueryNameAccessGrantsRequest{} }
+func (m *QueryNameAccessGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameAccessGrantsRequest) ProtoMessage()    {}
+func (*QueryNameAccessGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{23}
+}
+func (m *QueryNameAccessGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameAccessGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameAccessGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNameAccessGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameAccessGrantsRequest.Merge(m, src)
+}
+func (m *QueryNameAccessGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameAccessGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameAccessGrantsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error) {
-	out := new(QueryGetRecordsByBondIdResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecordsByBondId", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNameAccessGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryNameAccessGrantsRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) NameRecords(ctx context.Context, in *QueryNameRecordsRequest, opts ...grpc.CallOption) (*QueryNameRecordsResponse, error) {
-	out := new(QueryNameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/NameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNameAccessGrantsRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error) {
-	out := new(QueryWhoisResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Whois", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryNameAccessGrantsResponse is response type for name access grants
+// request
+type QueryNameAccessGrantsResponse struct {
+	Grants []NameAccessGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (c *queryClient) LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error) {
-	out := new(QueryLookupLrnResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/LookupLrn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNameAccessGrantsResponse) Reset()         { *m = QueryNameAccessGrantsResponse{} }
+func (m *QueryNameAccessGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameAccessGrantsResponse) ProtoMessage()    {}
+func (*QueryNameAccessGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{24}
+}
+func (m *QueryNameAccessGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameAccessGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameAccessGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNameAccessGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameAccessGrantsResponse.Merge(m, src)
+}
+func (m *QueryNameAccessGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameAccessGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameAccessGrantsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ResolveLrn(ctx context.Context, in *QueryResolveLrnRequest, opts ...grpc.CallOption) (*QueryResolveLrnResponse, error) {
-	out := new(QueryResolveLrnResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/ResolveLrn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNameAccessGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryNameAccessGrantsResponse) GetGrants() []NameAccessGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetRegistryModuleBalance(ctx context.Context, in *QueryGetRegistryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryGetRegistryModuleBalanceResponse, error) {
-	out := new(QueryGetRegistryModuleBalanceResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRegistryModuleBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryAuthoritiesRequest is request type to get all authorities
+type QueryAuthoritiesRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error) {
-	out := new(QueryAuthoritiesResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Authorities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuthoritiesRequest) Reset()         { *m = QueryAuthoritiesRequest{} }
+func (m *QueryAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthoritiesRequest) ProtoMessage()    {}
+func (*QueryAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{25}
+}
+func (m *QueryAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthoritiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the registry module params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Records queries all records
-	Records(context.Context, *QueryRecordsRequest) (*QueryRecordsResponse, error)
-	// Get record by id
-	GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error)
-	// Get records by bond id
-	GetRecordsByBondId(context.Context, *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error)
-	// NameRecords queries all name records
-	NameRecords(context.Context, *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error)
-	// Whois method retrieve the name authority info
-	Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error)
-	// LookupLrn
-	LookupLrn(context.Context, *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error)
-	// ResolveLrn
-	ResolveLrn(context.Context, *QueryResolveLrnRequest) (*QueryResolveLrnResponse, error)
-	// Get registry module balance
-	GetRegistryModuleBalance(context.Context, *QueryGetRegistryModuleBalanceRequest) (*QueryGetRegistryModuleBalanceResponse, error)
-	// Authorities queries all authorities
-	Authorities(context.Context, *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error)
+func (m *QueryAuthoritiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthoritiesRequest.Merge(m, src)
+}
+func (m *QueryAuthoritiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthoritiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthoritiesRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryAuthoritiesRequest proto.InternalMessageInfo
+
+func (m *QueryAuthoritiesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAuthoritiesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Records(ctx context.Context, req *QueryRecordsRequest) (*QueryRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
+
+// QueryAuthoritiesResponse is response type for authorities request
+type QueryAuthoritiesResponse struct {
+	Authorities []AuthorityEntry `protobuf:"bytes,1,rep,name=authorities,proto3" json:"authorities"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) GetRecord(ctx context.Context, req *QueryGetRecordRequest) (*QueryGetRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
+
+func (m *QueryAuthoritiesResponse) Reset()         { *m = QueryAuthoritiesResponse{} }
+func (m *QueryAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthoritiesResponse) ProtoMessage()    {}
+func (*QueryAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{26}
 }
-func (*UnimplementedQueryServer) GetRecordsByBondId(ctx context.Context, req *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByBondId not implemented")
+func (m *QueryAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NameRecords(ctx context.Context, req *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NameRecords not implemented")
+func (m *QueryAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Whois(ctx context.Context, req *QueryWhoisRequest) (*QueryWhoisResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Whois not implemented")
+func (m *QueryAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthoritiesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LookupLrn(ctx context.Context, req *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LookupLrn not implemented")
+func (m *QueryAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ResolveLrn(ctx context.Context, req *QueryResolveLrnRequest) (*QueryResolveLrnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResolveLrn not implemented")
+func (m *QueryAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthoritiesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetRegistryModuleBalance(ctx context.Context, req *QueryGetRegistryModuleBalanceRequest) (*QueryGetRegistryModuleBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRegistryModuleBalance not implemented")
+
+var xxx_messageInfo_QueryAuthoritiesResponse proto.InternalMessageInfo
+
+func (m *QueryAuthoritiesResponse) GetAuthorities() []AuthorityEntry {
+	if m != nil {
+		return m.Authorities
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Authorities(ctx context.Context, req *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authorities not implemented")
+
+func (m *QueryAuthoritiesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryLookupLrnRequest is request type for LookupLrn
+type QueryLookupLrnRequest struct {
+	// LRN, optionally with a version suffix (e.g. lrn://cerc-io/app@v3, see
+	// version)
+	Lrn string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+	// Look up the name as of this block height, if set
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Look up this version of the name (1 for the first one set), if set
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryLookupLrnRequest) Reset()         { *m = QueryLookupLrnRequest{} }
+func (m *QueryLookupLrnRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLookupLrnRequest) ProtoMessage()    {}
+func (*QueryLookupLrnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{27}
+}
+func (m *QueryLookupLrnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLookupLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLookupLrnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLookupLrnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLookupLrnRequest.Merge(m, src)
+}
+func (m *QueryLookupLrnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLookupLrnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLookupLrnRequest.DiscardUnknown(m)
 }
 
-func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Records(ctx, in)
+var xxx_messageInfo_QueryLookupLrnRequest proto.InternalMessageInfo
+
+func (m *QueryLookupLrnRequest) GetLrn() string {
+	if m != nil {
+		return m.Lrn
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/Records",
+	return ""
+}
+
+func (m *QueryLookupLrnRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Records(ctx, req.(*QueryRecordsRequest))
+	return 0
+}
+
+func (m *QueryLookupLrnRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/GetRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecord(ctx, req.(*QueryGetRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLookupLrnResponse is response type for QueryLookupLrnRequest
+type QueryLookupLrnResponse struct {
+	// Name record with the looked up version as latest, and the versions before
+	// it as history
+	Name *NameRecord `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Query_GetRecordsByBondId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRecordsByBondIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRecordsByBondId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/GetRecordsByBondId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRecordsByBondId(ctx, req.(*QueryGetRecordsByBondIdRequest))
+func (m *QueryLookupLrnResponse) Reset()         { *m = QueryLookupLrnResponse{} }
+func (m *QueryLookupLrnResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLookupLrnResponse) ProtoMessage()    {}
+func (*QueryLookupLrnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{28}
+}
+func (m *QueryLookupLrnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLookupLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLookupLrnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryLookupLrnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLookupLrnResponse.Merge(m, src)
+}
+func (m *QueryLookupLrnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLookupLrnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLookupLrnResponse.DiscardUnknown(m)
 }
 
-func _Query_NameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNameRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NameRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/NameRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NameRecords(ctx, req.(*QueryNameRecordsRequest))
+var xxx_messageInfo_QueryLookupLrnResponse proto.InternalMessageInfo
+
+func (m *QueryLookupLrnResponse) GetName() *NameRecord {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Whois_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhoisRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Whois(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/Whois",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Whois(ctx, req.(*QueryWhoisRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryResolveLrnRequest is request type for ResolveLrn
+type QueryResolveLrnRequest struct {
+	// LRN, optionally with a version suffix (e.g. lrn://cerc-io/app@v3, see
+	// version)
+	Lrn string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+	// Resolve the name as of this block height, if set
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Resolve this version of the name (1 for the first one set), if set.
+	// Names resolved at a height or version resolve to the record they were set
+	// to, rather than to its latest version
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func _Query_LookupLrn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLookupLrnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LookupLrn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/LookupLrn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LookupLrn(ctx, req.(*QueryLookupLrnRequest))
+func (m *QueryResolveLrnRequest) Reset()         { *m = QueryResolveLrnRequest{} }
+func (m *QueryResolveLrnRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveLrnRequest) ProtoMessage()    {}
+func (*QueryResolveLrnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{29}
+}
+func (m *QueryResolveLrnRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveLrnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveLrnRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryResolveLrnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveLrnRequest.Merge(m, src)
+}
+func (m *QueryResolveLrnRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveLrnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveLrnRequest.DiscardUnknown(m)
 }
 
-func _Query_ResolveLrn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryResolveLrnRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ResolveLrn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/ResolveLrn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ResolveLrn(ctx, req.(*QueryResolveLrnRequest))
+var xxx_messageInfo_QueryResolveLrnRequest proto.InternalMessageInfo
+
+func (m *QueryResolveLrnRequest) GetLrn() string {
+	if m != nil {
+		return m.Lrn
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRegistryModuleBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetRegistryModuleBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRegistryModuleBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/GetRegistryModuleBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRegistryModuleBalance(ctx, req.(*QueryGetRegistryModuleBalanceRequest))
+func (m *QueryResolveLrnRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Authorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuthoritiesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Authorities(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Query/Authorities",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Authorities(ctx, req.(*QueryAuthoritiesRequest))
+func (m *QueryResolveLrnRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cerc.registry.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Records",
-			Handler:    _Query_Records_Handler,
-		},
-		{
-			MethodName: "GetRecord",
-			Handler:    _Query_GetRecord_Handler,
-		},
-		{
-			MethodName: "GetRecordsByBondId",
-			Handler:    _Query_GetRecordsByBondId_Handler,
-		},
-		{
-			MethodName: "NameRecords",
-			Handler:    _Query_NameRecords_Handler,
-		},
-		{
-			MethodName: "Whois",
-			Handler:    _Query_Whois_Handler,
-		},
-		{
-			MethodName: "LookupLrn",
-			Handler:    _Query_LookupLrn_Handler,
-		},
-		{
-			MethodName: "ResolveLrn",
-			Handler:    _Query_ResolveLrn_Handler,
-		},
-		{
-			MethodName: "GetRegistryModuleBalance",
-			Handler:    _Query_GetRegistryModuleBalance_Handler,
-		},
-		{
-			MethodName: "Authorities",
-			Handler:    _Query_Authorities_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cerc/registry/v1/query.proto",
+// QueryResolveLrnResponse is response type for QueryResolveLrnRequest
+type QueryResolveLrnResponse struct {
+	Record *Record `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolveLrnResponse) Reset()         { *m = QueryResolveLrnResponse{} }
+func (m *QueryResolveLrnResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveLrnResponse) ProtoMessage()    {}
+func (*QueryResolveLrnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{30}
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryResolveLrnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveLrnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveLrnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryResolveLrnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveLrnResponse.Merge(m, src)
+}
+func (m *QueryResolveLrnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveLrnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveLrnResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolveLrnResponse proto.InternalMessageInfo
+
+func (m *QueryResolveLrnResponse) GetRecord() *Record {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetRegistryModuleBalanceRequest is request type for registry module
+// accounts balance
+type QueryGetRegistryModuleBalanceRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetRegistryModuleBalanceRequest) Reset()         { *m = QueryGetRegistryModuleBalanceRequest{} }
+func (m *QueryGetRegistryModuleBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegistryModuleBalanceRequest) ProtoMessage()    {}
+func (*QueryGetRegistryModuleBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{31}
+}
+func (m *QueryGetRegistryModuleBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegistryModuleBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetRegistryModuleBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.Merge(m, src)
+}
+func (m *QueryGetRegistryModuleBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegistryModuleBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegistryModuleBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetRegistryModuleBalanceRequest proto.InternalMessageInfo
+
+// QueryGetRegistryModuleBalanceResponse is response type for registry module
+// accounts balance
+type QueryGetRegistryModuleBalanceResponse struct {
+	Balances []*AccountBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
 }
 
-func (m *QueryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.All {
-		i--
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Attributes) > 0 {
-		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetRegistryModuleBalanceResponse) Reset()         { *m = QueryGetRegistryModuleBalanceResponse{} }
+func (m *QueryGetRegistryModuleBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRegistryModuleBalanceResponse) ProtoMessage()    {}
+func (*QueryGetRegistryModuleBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{32}
+}
+func (m *QueryGetRegistryModuleBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRegistryModuleBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetRegistryModuleBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.Merge(m, src)
+}
+func (m *QueryGetRegistryModuleBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRegistryModuleBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRegistryModuleBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsRequest_ArrayInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRegistryModuleBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryGetRegistryModuleBalanceResponse) GetBalances() []*AccountBalance {
+	if m != nil {
+		return m.Balances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRecordsRequest_ArrayInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountBalance is registry module account balance
+type AccountBalance struct {
+	AccountName string                                   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty" json:"account_name" yaml:"account_name"`
+	Balance     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance" json:"balance" yaml:"balance"`
 }
 
-func (m *QueryRecordsRequest_ArrayInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *AccountBalance) Reset()         { *m = AccountBalance{} }
+func (m *AccountBalance) String() string { return proto.CompactTextString(m) }
+func (*AccountBalance) ProtoMessage()    {}
+func (*AccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{33}
+}
+func (m *AccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRecordsRequest_MapInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *AccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountBalance.Merge(m, src)
 }
-
-func (m *QueryRecordsRequest_MapInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountBalance) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryRecordsRequest_MapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for k := range m.Values {
-			v := m.Values[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *AccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountBalance.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsRequest_ValueInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_AccountBalance proto.InternalMessageInfo
 
-func (m *QueryRecordsRequest_ValueInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountBalance) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
 }
 
-func (m *QueryRecordsRequest_ValueInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size := m.Value.Size()
-			i -= size
-			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *AccountBalance) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRecordsRequest_ValueInput_String_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySchemasRequest is request type for record type schemas list
+type QuerySchemasRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRecordsRequest_ValueInput_String_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.String_)
-	copy(dAtA[i:], m.String_)
-	i = encodeVarintQuery(dAtA, i, uint64(len(m.String_)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QuerySchemasRequest) Reset()         { *m = QuerySchemasRequest{} }
+func (m *QuerySchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemasRequest) ProtoMessage()    {}
+func (*QuerySchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{34}
 }
-func (m *QueryRecordsRequest_ValueInput_Int) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryRecordsRequest_ValueInput_Int) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintQuery(dAtA, i, uint64(m.Int))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *QuerySchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (m *QueryRecordsRequest_ValueInput_Float) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemasRequest.Merge(m, src)
 }
-
-func (m *QueryRecordsRequest_ValueInput_Float) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Float))))
-	i--
-	dAtA[i] = 0x19
-	return len(dAtA) - i, nil
+func (m *QuerySchemasRequest) XXX_Size() int {
+	return m.Size()
 }
-func (m *QueryRecordsRequest_ValueInput_Boolean) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemasRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsRequest_ValueInput_Boolean) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.Boolean {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+var xxx_messageInfo_QuerySchemasRequest proto.InternalMessageInfo
+
+func (m *QuerySchemasRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	i--
-	dAtA[i] = 0x20
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *QueryRecordsRequest_ValueInput_Link) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// QuerySchemasResponse is response type for record type schemas list
+type QuerySchemasResponse struct {
+	Schemas []Schema `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRecordsRequest_ValueInput_Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Link)
-	copy(dAtA[i:], m.Link)
-	i = encodeVarintQuery(dAtA, i, uint64(len(m.Link)))
-	i--
-	dAtA[i] = 0x2a
-	return len(dAtA) - i, nil
+func (m *QuerySchemasResponse) Reset()         { *m = QuerySchemasResponse{} }
+func (m *QuerySchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySchemasResponse) ProtoMessage()    {}
+func (*QuerySchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{35}
 }
-func (m *QueryRecordsRequest_ValueInput_Array) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryRecordsRequest_ValueInput_Array) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Array != nil {
-		{
-			size, err := m.Array.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *QueryRecordsRequest_ValueInput_Map) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySchemasResponse.Merge(m, src)
+}
+func (m *QuerySchemasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySchemasResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsRequest_ValueInput_Map) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Map != nil {
-		{
-			size, err := m.Map.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+var xxx_messageInfo_QuerySchemasResponse proto.InternalMessageInfo
+
+func (m *QuerySchemasResponse) GetSchemas() []Schema {
+	if m != nil {
+		return m.Schemas
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *QueryRecordsRequest_KeyValueInput) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *QuerySchemasResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRecordsRequest_KeyValueInput) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetSchemaRequest is request type for schema by record type
+type QueryGetSchemaRequest struct {
+	RecordType string `protobuf:"bytes,1,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	// Schema version, the latest version if not set
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *QueryRecordsRequest_KeyValueInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetSchemaRequest) Reset()         { *m = QueryGetSchemaRequest{} }
+func (m *QueryGetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSchemaRequest) ProtoMessage()    {}
+func (*QueryGetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{36}
+}
+func (m *QueryGetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSchemaRequest.Merge(m, src)
+}
+func (m *QueryGetSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSchemaRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetSchemaRequest proto.InternalMessageInfo
+
+func (m *QueryGetSchemaRequest) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetSchemaRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *QueryRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryGetSchemaResponse is response type for schema by record type
+type QueryGetSchemaResponse struct {
+	Schema Schema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+}
+
+func (m *QueryGetSchemaResponse) Reset()         { *m = QueryGetSchemaResponse{} }
+func (m *QueryGetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSchemaResponse) ProtoMessage()    {}
+func (*QueryGetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c642b96b6da07a30, []int{37}
+}
+func (m *QueryGetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryGetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSchemaResponse.Merge(m, src)
+}
+func (m *QueryGetSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSchemaResponse proto.InternalMessageInfo
+
+func (m *QueryGetSchemaResponse) GetSchema() Schema {
+	if m != nil {
+		return m.Schema
 	}
-	return len(dAtA) - i, nil
+	return Schema{}
 }
 
-func (m *QueryGetRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("cerc.registry.v1.QueryRecordsRequest_Operator", QueryRecordsRequest_Operator_name, QueryRecordsRequest_Operator_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "cerc.registry.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cerc.registry.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRecordsRequest)(nil), "cerc.registry.v1.QueryRecordsRequest")
+	proto.RegisterType((*QueryRecordsRequest_ArrayInput)(nil), "cerc.registry.v1.QueryRecordsRequest.ArrayInput")
+	proto.RegisterType((*QueryRecordsRequest_MapInput)(nil), "cerc.registry.v1.QueryRecordsRequest.MapInput")
+	proto.RegisterMapType((map[string]*QueryRecordsRequest_ValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.MapInput.ValuesEntry")
+	proto.RegisterType((*QueryRecordsRequest_ValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.ValueInput")
+	proto.RegisterType((*QueryRecordsRequest_KeyValueInput)(nil), "cerc.registry.v1.QueryRecordsRequest.KeyValueInput")
+	proto.RegisterType((*QueryRecordsRequest_ExpressionList)(nil), "cerc.registry.v1.QueryRecordsRequest.ExpressionList")
+	proto.RegisterType((*QueryRecordsRequest_Expression)(nil), "cerc.registry.v1.QueryRecordsRequest.Expression")
+	proto.RegisterType((*QueryRecordsResponse)(nil), "cerc.registry.v1.QueryRecordsResponse")
+	proto.RegisterType((*QueryGetRecordRequest)(nil), "cerc.registry.v1.QueryGetRecordRequest")
+	proto.RegisterType((*QueryGetRecordResponse)(nil), "cerc.registry.v1.QueryGetRecordResponse")
+	proto.RegisterType((*QueryGetRecordHistoryRequest)(nil), "cerc.registry.v1.QueryGetRecordHistoryRequest")
+	proto.RegisterType((*QueryGetRecordHistoryResponse)(nil), "cerc.registry.v1.QueryGetRecordHistoryResponse")
+	proto.RegisterType((*QueryGetRecordsByOwnerRequest)(nil), "cerc.registry.v1.QueryGetRecordsByOwnerRequest")
+	proto.RegisterType((*QueryGetRecordsByOwnerResponse)(nil), "cerc.registry.v1.QueryGetRecordsByOwnerResponse")
+	proto.RegisterType((*QueryGetReferencingRecordsRequest)(nil), "cerc.registry.v1.QueryGetReferencingRecordsRequest")
+	proto.RegisterType((*QueryGetReferencingRecordsResponse)(nil), "cerc.registry.v1.QueryGetReferencingRecordsResponse")
+	proto.RegisterType((*QueryQuoteRecordRentRequest)(nil), "cerc.registry.v1.QueryQuoteRecordRentRequest")
+	proto.RegisterType((*QueryQuoteRecordRentResponse)(nil), "cerc.registry.v1.QueryQuoteRecordRentResponse")
+	proto.RegisterType((*QueryGetRecordsByBondIdRequest)(nil), "cerc.registry.v1.QueryGetRecordsByBondIdRequest")
+	proto.RegisterType((*QueryGetRecordsByBondIdResponse)(nil), "cerc.registry.v1.QueryGetRecordsByBondIdResponse")
+	proto.RegisterType((*QueryNameRecordsRequest)(nil), "cerc.registry.v1.QueryNameRecordsRequest")
+	proto.RegisterType((*QueryNameRecordsResponse)(nil), "cerc.registry.v1.QueryNameRecordsResponse")
+	proto.RegisterType((*QueryNamesByPrefixRequest)(nil), "cerc.registry.v1.QueryNamesByPrefixRequest")
+	proto.RegisterType((*QueryNamesByPrefixResponse)(nil), "cerc.registry.v1.QueryNamesByPrefixResponse")
+	proto.RegisterType((*NamePrefixEntry)(nil), "cerc.registry.v1.NamePrefixEntry")
+	proto.RegisterType((*QueryWhoisRequest)(nil), "cerc.registry.v1.QueryWhoisRequest")
+	proto.RegisterType((*QueryWhoisResponse)(nil), "cerc.registry.v1.QueryWhoisResponse")
+	proto.RegisterType((*QueryNameAccessGrantsRequest)(nil), "cerc.registry.v1.QueryNameAccessGrantsRequest")
+	proto.RegisterType((*QueryNameAccessGrantsResponse)(nil), "cerc.registry.v1.QueryNameAccessGrantsResponse")
+	proto.RegisterType((*QueryAuthoritiesRequest)(nil), "cerc.registry.v1.QueryAuthoritiesRequest")
+	proto.RegisterType((*QueryAuthoritiesResponse)(nil), "cerc.registry.v1.QueryAuthoritiesResponse")
+	proto.RegisterType((*QueryLookupLrnRequest)(nil), "cerc.registry.v1.QueryLookupLrnRequest")
+	proto.RegisterType((*QueryLookupLrnResponse)(nil), "cerc.registry.v1.QueryLookupLrnResponse")
+	proto.RegisterType((*QueryResolveLrnRequest)(nil), "cerc.registry.v1.QueryResolveLrnRequest")
+	proto.RegisterType((*QueryResolveLrnResponse)(nil), "cerc.registry.v1.QueryResolveLrnResponse")
+	proto.RegisterType((*QueryGetRegistryModuleBalanceRequest)(nil), "cerc.registry.v1.QueryGetRegistryModuleBalanceRequest")
+	proto.RegisterType((*QueryGetRegistryModuleBalanceResponse)(nil), "cerc.registry.v1.QueryGetRegistryModuleBalanceResponse")
+	proto.RegisterType((*AccountBalance)(nil), "cerc.registry.v1.AccountBalance")
+	proto.RegisterType((*QuerySchemasRequest)(nil), "cerc.registry.v1.QuerySchemasRequest")
+	proto.RegisterType((*QuerySchemasResponse)(nil), "cerc.registry.v1.QuerySchemasResponse")
+	proto.RegisterType((*QueryGetSchemaRequest)(nil), "cerc.registry.v1.QueryGetSchemaRequest")
+	proto.RegisterType((*QueryGetSchemaResponse)(nil), "cerc.registry.v1.QueryGetSchemaResponse")
+}
+
+func init() { proto.RegisterFile("cerc/registry/v1/query.proto", fileDescriptor_c642b96b6da07a30) }
+
+var fileDescriptor_c642b96b6da07a30 = []byte{
+	// 2244 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xe7, 0xf2, 0x4b, 0xd2, 0xa3, 0x2d, 0x31, 0x13, 0xc5, 0xa6, 0xd7, 0x32, 0x29, 0x6f, 0x6c,
+	0x7d, 0xd8, 0x16, 0xd7, 0x92, 0x82, 0x24, 0x30, 0x5a, 0xb4, 0x66, 0x22, 0x89, 0x4a, 0x14, 0x5b,
+	0x5e, 0xa9, 0xe9, 0x37, 0xd4, 0x25, 0x77, 0x4c, 0x6d, 0x45, 0xed, 0x30, 0xbb, 0x4b, 0xd9, 0xb4,
+	0x20, 0xb4, 0x28, 0xda, 0x00, 0x45, 0x0f, 0x6d, 0x51, 0xb4, 0x40, 0xd1, 0x5e, 0x8a, 0x14, 0x28,
+	0x10, 0xb4, 0xbd, 0xa5, 0xf7, 0x1e, 0x0a, 0x04, 0xe8, 0x25, 0x40, 0x2f, 0x3d, 0xb9, 0x85, 0xdd,
+	0x4b, 0xaf, 0xfe, 0x0b, 0x8a, 0x9d, 0x0f, 0x72, 0x97, 0xe4, 0x92, 0x2b, 0x55, 0x0e, 0x7c, 0x12,
+	0xe7, 0xed, 0xfb, 0xf8, 0xcd, 0x6f, 0xe6, 0xcd, 0xbc, 0x37, 0x36, 0x4c, 0x55, 0xb1, 0x5d, 0x55,
+	0x6d, 0x5c, 0x33, 0x1d, 0xd7, 0x6e, 0xa9, 0x07, 0x8b, 0xea, 0x07, 0x4d, 0x6c, 0xb7, 0x8a, 0x0d,
+	0x9b, 0xb8, 0x04, 0x65, 0xbd, 0xaf, 0x45, 0xf1, 0xb5, 0x78, 0xb0, 0x28, 0x4f, 0xd5, 0x08, 0xa9,
+	0xd5, 0xb1, 0xaa, 0x37, 0x4c, 0x55, 0xb7, 0x2c, 0xe2, 0xea, 0xae, 0x49, 0x2c, 0x87, 0xe9, 0xcb,
+	0xd7, 0xaa, 0xc4, 0xd9, 0x27, 0x8e, 0x5a, 0xd1, 0x1d, 0xcc, 0x1c, 0xa9, 0x07, 0x8b, 0x15, 0xec,
+	0xea, 0x8b, 0x6a, 0x43, 0xaf, 0x99, 0x16, 0x55, 0xe6, 0xba, 0x93, 0x35, 0x52, 0x23, 0xf4, 0xa7,
+	0xea, 0xfd, 0xe2, 0xd2, 0xbc, 0xdf, 0x83, 0xb0, 0xad, 0x12, 0x53, 0x58, 0x15, 0x7a, 0xf0, 0x8a,
+	0xdf, 0x4c, 0x41, 0x99, 0x04, 0x74, 0xcf, 0x0b, 0xbc, 0xa9, 0xdb, 0xfa, 0xbe, 0xa3, 0xe1, 0x0f,
+	0x9a, 0xd8, 0x71, 0x95, 0x35, 0x78, 0x39, 0x20, 0x75, 0x1a, 0xc4, 0x72, 0x30, 0xba, 0x09, 0xe9,
+	0x06, 0x95, 0xe4, 0xa4, 0x69, 0x69, 0x2e, 0xb3, 0x94, 0x2b, 0x76, 0x4f, 0xb8, 0xc8, 0x2d, 0xb8,
+	0x9e, 0xf2, 0xc9, 0x19, 0xee, 0x49, 0xc3, 0x55, 0x62, 0x1b, 0x22, 0x00, 0xda, 0x02, 0xd0, 0x5d,
+	0xd7, 0x36, 0x2b, 0x4d, 0x17, 0x7b, 0xde, 0x12, 0x73, 0x99, 0xa5, 0xe5, 0x5e, 0x6f, 0x7d, 0x4c,
+	0x8b, 0xef, 0xe2, 0xd6, 0xfb, 0x7a, 0xbd, 0x89, 0xd7, 0xad, 0x46, 0xd3, 0xd5, 0x7c, 0x6e, 0x50,
+	0x16, 0x12, 0x7a, 0xbd, 0x9e, 0x8b, 0x4f, 0x4b, 0x73, 0xa3, 0x9a, 0xf7, 0x13, 0xad, 0x02, 0x74,
+	0x88, 0xcc, 0x25, 0x28, 0xe8, 0x99, 0x22, 0xe3, 0xac, 0xe8, 0x71, 0x56, 0x64, 0xcb, 0xc7, 0x99,
+	0x2b, 0x6e, 0xea, 0x35, 0xcc, 0xe3, 0x68, 0x3e, 0x4b, 0x54, 0x86, 0xf4, 0x7d, 0xb3, 0xee, 0x62,
+	0x3b, 0x97, 0xa4, 0x3e, 0x6e, 0x46, 0x83, 0xba, 0xf2, 0xb0, 0x61, 0x63, 0xc7, 0x31, 0x89, 0xa5,
+	0x71, 0x7b, 0xf9, 0x7d, 0x80, 0xdb, 0xb6, 0xad, 0xb7, 0x28, 0x7a, 0xcf, 0xef, 0x81, 0x37, 0x17,
+	0x41, 0x41, 0x44, 0xbf, 0xbe, 0xf9, 0x73, 0x7b, 0xf9, 0xef, 0x12, 0x8c, 0xbe, 0xa7, 0x37, 0x98,
+	0x5b, 0xad, 0xcb, 0xed, 0xad, 0x68, 0x6e, 0x85, 0x3d, 0xf3, 0xef, 0xac, 0x58, 0xae, 0xdd, 0x6a,
+	0x07, 0xd8, 0x83, 0x8c, 0x4f, 0xec, 0x71, 0xbd, 0x87, 0x5b, 0x74, 0x1f, 0x8c, 0x69, 0xde, 0x4f,
+	0xb4, 0x0a, 0x29, 0xaa, 0x4a, 0xf9, 0x3f, 0xc9, 0x54, 0x98, 0xf9, 0xad, 0xf8, 0x9b, 0x92, 0xfc,
+	0x9b, 0x38, 0x40, 0xe7, 0x0b, 0xca, 0x41, 0xda, 0x71, 0x6d, 0xd3, 0xaa, 0xb1, 0x78, 0xe5, 0x98,
+	0xc6, 0xc7, 0x08, 0x41, 0xc2, 0xb4, 0x5c, 0x1a, 0x32, 0x51, 0x8e, 0x69, 0xde, 0x00, 0x9d, 0x83,
+	0xd4, 0xfd, 0x3a, 0xd1, 0x5d, 0xba, 0xde, 0x52, 0x39, 0xa6, 0xb1, 0x21, 0x92, 0x61, 0xa4, 0x42,
+	0x48, 0x1d, 0xeb, 0x16, 0x5d, 0xc5, 0xd1, 0x72, 0x4c, 0x13, 0x02, 0x34, 0x09, 0xc9, 0xba, 0x69,
+	0xed, 0xe5, 0x52, 0xdc, 0x3f, 0x1d, 0xa1, 0x32, 0xa4, 0x74, 0x6f, 0xb1, 0x72, 0xe9, 0xe3, 0x4c,
+	0xa9, 0xb3, 0xbe, 0x5e, 0x6c, 0xea, 0x00, 0x95, 0x20, 0xb1, 0xaf, 0x37, 0x72, 0x23, 0xd4, 0x4f,
+	0xf1, 0x78, 0xcb, 0xe1, 0xcd, 0x6b, 0x5f, 0x6f, 0x94, 0x46, 0x38, 0xc1, 0xf2, 0x27, 0x12, 0x9c,
+	0x0d, 0x64, 0xc1, 0xf3, 0x5b, 0x0d, 0xf4, 0x0e, 0x8c, 0x92, 0x06, 0xb6, 0x75, 0x97, 0xd8, 0x94,
+	0xcf, 0xf1, 0xa8, 0xe8, 0xef, 0x72, 0x2b, 0xad, 0x6d, 0x2f, 0x1b, 0x30, 0xde, 0xc9, 0x88, 0x0d,
+	0xd3, 0xf1, 0x36, 0x6a, 0x06, 0xb7, 0x25, 0xc7, 0x4c, 0x02, 0x5f, 0x72, 0xf9, 0x9d, 0xc8, 0x7f,
+	0x8d, 0x03, 0x74, 0xbe, 0xa1, 0x2d, 0x18, 0xab, 0x12, 0xcb, 0x30, 0xe9, 0x09, 0xc0, 0x8e, 0xad,
+	0x93, 0x1c, 0x34, 0xe5, 0x98, 0xd6, 0xf1, 0x83, 0xca, 0x90, 0xd0, 0x2d, 0x83, 0x73, 0xfb, 0xda,
+	0x71, 0xf1, 0x7a, 0x53, 0xf7, 0x16, 0x55, 0xb7, 0x0c, 0xb4, 0x0a, 0x71, 0xce, 0xec, 0xc9, 0x1d,
+	0xc5, 0x89, 0x8d, 0xde, 0x86, 0x84, 0x45, 0xdc, 0x93, 0x1e, 0x4f, 0x1e, 0x1a, 0x8b, 0xb8, 0xa5,
+	0x34, 0x24, 0x2d, 0x62, 0x60, 0xe5, 0x7b, 0x30, 0x2a, 0xd6, 0x0f, 0xe5, 0x60, 0xf2, 0xee, 0xe6,
+	0x8a, 0x76, 0x7b, 0xfb, 0xae, 0xb6, 0xf3, 0x95, 0x3b, 0x5b, 0x9b, 0x2b, 0x6f, 0xad, 0xaf, 0xae,
+	0xaf, 0xbc, 0x9d, 0x8d, 0xa1, 0x09, 0xc8, 0xb4, 0xbf, 0xac, 0x6d, 0x67, 0x25, 0x94, 0x85, 0x33,
+	0x3e, 0xc1, 0x4a, 0x36, 0x1e, 0x50, 0xd9, 0xd8, 0xce, 0x26, 0x02, 0x2a, 0x1b, 0xdb, 0x2b, 0xd9,
+	0x24, 0x7a, 0x19, 0x26, 0xda, 0x92, 0x4d, 0x6d, 0x65, 0x75, 0xfd, 0x6b, 0xd9, 0x94, 0xf2, 0x6b,
+	0x09, 0x26, 0x83, 0x90, 0xf9, 0x15, 0xf4, 0x26, 0x8c, 0xd8, 0x4c, 0xc4, 0x77, 0x4b, 0x9f, 0x3b,
+	0x88, 0xd9, 0x94, 0x92, 0x9f, 0x3e, 0x2e, 0xc4, 0x34, 0xa1, 0x8e, 0xd6, 0x02, 0x77, 0x01, 0x5b,
+	0xba, 0xd9, 0xa1, 0x77, 0x01, 0x0b, 0xeb, 0xbf, 0x0c, 0x94, 0x59, 0x78, 0x85, 0x42, 0x5b, 0xc3,
+	0x2e, 0x8b, 0x24, 0x2e, 0xb5, 0x71, 0x88, 0x9b, 0x06, 0x4f, 0xc2, 0xb8, 0x69, 0x28, 0x9b, 0x70,
+	0xae, 0x5b, 0x91, 0xcf, 0xe2, 0x75, 0x48, 0x33, 0x58, 0xe1, 0x17, 0x69, 0x60, 0x12, 0x5c, 0x5b,
+	0x29, 0xc2, 0x54, 0xd0, 0x63, 0xd9, 0x74, 0x5c, 0x62, 0xb7, 0xc2, 0x10, 0x7c, 0x1d, 0x2e, 0x85,
+	0xe8, 0xff, 0xbf, 0x74, 0x2a, 0x47, 0xdd, 0xae, 0x9d, 0x52, 0xeb, 0xee, 0x03, 0x0b, 0xdb, 0x02,
+	0xcb, 0x24, 0xa4, 0x88, 0x37, 0xe6, 0x70, 0xd8, 0x00, 0xad, 0xf6, 0x59, 0x85, 0x13, 0xdc, 0xc8,
+	0xca, 0x47, 0x12, 0xe4, 0xc3, 0xe2, 0xbf, 0x38, 0x5b, 0xe5, 0x10, 0x2e, 0x77, 0x40, 0xde, 0xc7,
+	0x36, 0xb6, 0xaa, 0xa6, 0x55, 0xeb, 0xaa, 0x85, 0xba, 0x16, 0xed, 0xd4, 0x28, 0xfa, 0x83, 0x04,
+	0xca, 0xa0, 0xe8, 0x2f, 0x0e, 0x4d, 0x47, 0x70, 0x91, 0x02, 0xbd, 0xd7, 0x24, 0x2e, 0x16, 0xa9,
+	0x62, 0xb9, 0x82, 0xa0, 0x59, 0x98, 0xe8, 0x54, 0x79, 0x3b, 0x8e, 0xf9, 0x08, 0x53, 0xb6, 0x92,
+	0xda, 0x78, 0x47, 0xbc, 0x65, 0x3e, 0xc2, 0xe8, 0x22, 0x8c, 0x31, 0x6c, 0x3b, 0x26, 0x3b, 0x9c,
+	0xc7, 0xb4, 0x51, 0x26, 0x58, 0x37, 0x50, 0x0e, 0x46, 0x1a, 0xd8, 0x36, 0x89, 0xe1, 0xd0, 0xe3,
+	0x36, 0xa9, 0x89, 0xa1, 0xf2, 0x37, 0x09, 0xa6, 0xfa, 0xc7, 0xe7, 0x14, 0x45, 0x06, 0xb0, 0x06,
+	0x13, 0x36, 0xb6, 0xdc, 0x9d, 0x06, 0xb6, 0x77, 0x98, 0x77, 0x4e, 0xcb, 0x85, 0x00, 0x2d, 0x82,
+	0x90, 0xb7, 0x88, 0x69, 0x71, 0x52, 0xcf, 0x7a, 0x76, 0x9b, 0xd8, 0xde, 0xa4, 0x56, 0x68, 0x19,
+	0x92, 0x9e, 0x20, 0x97, 0x88, 0x66, 0x4d, 0x95, 0x95, 0x87, 0x7d, 0x52, 0xa2, 0x44, 0x2c, 0x63,
+	0xdd, 0x78, 0xde, 0x5b, 0xed, 0xf7, 0x12, 0x14, 0x42, 0x43, 0xbf, 0x38, 0xfb, 0x4c, 0x87, 0xf3,
+	0x14, 0xe5, 0x1d, 0x7d, 0x1f, 0x77, 0x25, 0x61, 0x90, 0x09, 0xe9, 0xc4, 0x4c, 0xfc, 0x56, 0x82,
+	0x5c, 0x6f, 0x0c, 0x4e, 0xc1, 0x1b, 0x90, 0xb2, 0xf4, 0xfd, 0x76, 0x59, 0x7e, 0xb1, 0x97, 0x00,
+	0xcf, 0x8a, 0x16, 0xd8, 0x9c, 0x03, 0xa6, 0x7f, 0x9a, 0x07, 0xd2, 0x85, 0x36, 0x3a, 0xa7, 0xd4,
+	0xda, 0xb4, 0xf1, 0x7d, 0xf3, 0xa1, 0xe0, 0xe0, 0x1c, 0xa4, 0x1b, 0x54, 0xc0, 0x77, 0x08, 0x1f,
+	0x9d, 0xe6, 0x2e, 0x91, 0xfb, 0x45, 0xe7, 0xec, 0x7c, 0x31, 0xc8, 0xce, 0xe5, 0xfe, 0xec, 0x30,
+	0xa3, 0xe7, 0xc9, 0xd1, 0x8f, 0x25, 0x98, 0xe8, 0x8a, 0x84, 0x10, 0x24, 0xbd, 0x28, 0x9c, 0x18,
+	0xfa, 0x1b, 0x2d, 0x41, 0x0a, 0x7b, 0x1f, 0x79, 0xac, 0xa9, 0xfe, 0x78, 0xf9, 0x71, 0xc2, 0x54,
+	0xbd, 0x4b, 0xd1, 0x71, 0xf5, 0x3a, 0xa6, 0x89, 0x3d, 0xaa, 0xb1, 0x81, 0x77, 0x34, 0x19, 0xb8,
+	0x8e, 0x5d, 0x6c, 0xb0, 0xce, 0x44, 0x13, 0x43, 0x65, 0x16, 0x5e, 0xa2, 0x8c, 0x7d, 0x75, 0x97,
+	0x98, 0xed, 0xbd, 0xda, 0x07, 0x8c, 0xf2, 0x73, 0x09, 0x90, 0x5f, 0x93, 0x73, 0x7a, 0x08, 0xe3,
+	0xde, 0xe7, 0x1d, 0xbd, 0xe9, 0xee, 0x12, 0xdb, 0x74, 0x5b, 0x7c, 0x6b, 0x17, 0xfa, 0x83, 0xbd,
+	0x2d, 0xd4, 0x4a, 0xcb, 0x1e, 0xb5, 0xcf, 0x1e, 0x17, 0xae, 0x7f, 0xd7, 0x21, 0xd6, 0x2d, 0x25,
+	0xe8, 0x44, 0x99, 0x6e, 0xe9, 0xfb, 0xf5, 0x1e, 0xa9, 0x76, 0xd6, 0xf2, 0xfb, 0x50, 0x36, 0xf8,
+	0xb1, 0x4a, 0x3d, 0x57, 0xab, 0xd8, 0x71, 0xd6, 0x6c, 0xdd, 0x72, 0x07, 0xcd, 0xc3, 0xa3, 0xa2,
+	0xe6, 0x29, 0x61, 0xcc, 0x0f, 0x70, 0x31, 0x54, 0xbe, 0x03, 0x97, 0x42, 0xbc, 0xf1, 0xb9, 0x7e,
+	0x09, 0xd2, 0x54, 0x77, 0xc8, 0x06, 0xf2, 0xd9, 0x8a, 0xea, 0x8a, 0x99, 0x29, 0x0f, 0xf8, 0xf1,
+	0x20, 0x66, 0x60, 0x62, 0xe7, 0xf3, 0x29, 0x66, 0xfe, 0x24, 0x0e, 0x8d, 0x40, 0x64, 0x3e, 0xad,
+	0x32, 0x64, 0xf4, 0x8e, 0x98, 0xcf, 0x6d, 0xba, 0x77, 0x6e, 0x6d, 0xde, 0xfd, 0xb9, 0xe1, 0x37,
+	0x3d, 0xbd, 0x0c, 0xf9, 0x26, 0xaf, 0x80, 0x37, 0x08, 0xd9, 0x6b, 0x36, 0x36, 0x6c, 0x4b, 0xd0,
+	0x94, 0x85, 0x44, 0xdd, 0xb6, 0x44, 0x1f, 0x5a, 0xb7, 0x2d, 0xef, 0x4c, 0xd9, 0xc5, 0x66, 0x6d,
+	0x97, 0xf5, 0xe8, 0x49, 0x8d, 0x8f, 0xbc, 0x75, 0x3e, 0xc0, 0xb6, 0x23, 0x9e, 0x65, 0x92, 0x9a,
+	0x18, 0x2a, 0xef, 0xc0, 0xb9, 0x6e, 0xe7, 0xed, 0xe7, 0xa7, 0xce, 0x7e, 0x19, 0x96, 0x6f, 0x2c,
+	0x2b, 0xbe, 0xc5, 0x7d, 0x69, 0xd8, 0x21, 0xf5, 0x03, 0x7c, 0xca, 0x48, 0xdf, 0x85, 0xf3, 0x3d,
+	0xde, 0x3b, 0x2f, 0x65, 0xd1, 0x0a, 0xfc, 0x76, 0x69, 0x3f, 0x03, 0x57, 0x3a, 0x37, 0x28, 0xd3,
+	0x7a, 0x8f, 0x18, 0xcd, 0x3a, 0x2e, 0xe9, 0x75, 0xdd, 0xaa, 0x8a, 0x7d, 0xa3, 0x60, 0xb8, 0x3a,
+	0x44, 0x8f, 0x43, 0xf8, 0x02, 0x8c, 0x56, 0x98, 0x68, 0xd0, 0xa6, 0xa9, 0x56, 0x49, 0xd3, 0x72,
+	0x85, 0x6d, 0xdb, 0x42, 0xf9, 0xaf, 0x04, 0xe3, 0xc1, 0x8f, 0xe8, 0x0e, 0x9c, 0xd1, 0x99, 0x64,
+	0xa7, 0x93, 0xb6, 0xa5, 0xeb, 0xcf, 0x1e, 0x17, 0x66, 0xd9, 0x21, 0xe1, 0xff, 0x2a, 0x8e, 0x88,
+	0x80, 0x4c, 0xcb, 0xf0, 0xa1, 0xb7, 0x50, 0xe8, 0x43, 0x09, 0x46, 0x78, 0xbc, 0x5c, 0x62, 0x3a,
+	0x31, 0xb8, 0xce, 0xb9, 0xc7, 0xcf, 0xa3, 0x4b, 0x2c, 0x14, 0xb7, 0x13, 0x51, 0xc4, 0xf0, 0xe3,
+	0x7f, 0x15, 0xe6, 0x6a, 0xa6, 0xbb, 0xdb, 0xac, 0x14, 0xab, 0x64, 0x5f, 0x65, 0xde, 0xf8, 0x9f,
+	0x05, 0xc7, 0xd8, 0x53, 0xdd, 0x56, 0x03, 0x3b, 0xd4, 0xa3, 0xa3, 0x89, 0xe0, 0xca, 0xb7, 0xf9,
+	0x1b, 0xe5, 0x56, 0x75, 0x17, 0xef, 0xeb, 0xa7, 0x5e, 0x12, 0xb4, 0x7b, 0xd9, 0xb6, 0xff, 0x4e,
+	0x45, 0xe4, 0x30, 0x51, 0x78, 0x45, 0xc4, 0x6c, 0x44, 0x45, 0xc4, 0xd5, 0x4f, 0x2f, 0x93, 0xb5,
+	0x4e, 0x2f, 0xcb, 0x22, 0x89, 0xc9, 0x17, 0x20, 0xc3, 0x4b, 0x69, 0x8f, 0x32, 0x9e, 0x27, 0xc0,
+	0x44, 0xdb, 0xad, 0x06, 0xf6, 0xa7, 0x45, 0x3c, 0x98, 0x16, 0xbe, 0xb6, 0x57, 0xf8, 0xec, 0xb4,
+	0xbd, 0x6c, 0x06, 0xe1, 0x59, 0x11, 0x98, 0x2f, 0xd7, 0x5e, 0x7a, 0x36, 0x09, 0x29, 0xea, 0x12,
+	0x3d, 0x80, 0x34, 0x7b, 0x61, 0x46, 0x57, 0x42, 0xde, 0x38, 0x02, 0x0f, 0xd9, 0xf2, 0xd5, 0x21,
+	0x5a, 0x0c, 0x98, 0x32, 0xfd, 0x83, 0x7f, 0xfc, 0xe7, 0x17, 0x71, 0x19, 0xe5, 0xd4, 0x9e, 0xf7,
+	0x72, 0xf6, 0x90, 0x8d, 0x0e, 0x61, 0x84, 0x57, 0x73, 0xe8, 0x6a, 0xa4, 0xd7, 0x15, 0x79, 0x66,
+	0x98, 0x1a, 0x8f, 0x7d, 0x99, 0xc6, 0xbe, 0x88, 0x2e, 0xf4, 0xc6, 0x16, 0x05, 0xf0, 0x87, 0x12,
+	0x8c, 0xb5, 0x2b, 0x6b, 0x34, 0x1b, 0xe2, 0xb8, 0xfb, 0x3d, 0x42, 0x9e, 0x1b, 0xae, 0xc8, 0x31,
+	0xcc, 0x50, 0x0c, 0xd3, 0x28, 0x1f, 0x8a, 0x41, 0x3d, 0x34, 0x8d, 0x23, 0xf4, 0x91, 0x04, 0xd9,
+	0xee, 0xb7, 0x04, 0x54, 0x1c, 0x16, 0x26, 0xf8, 0x48, 0x21, 0xab, 0x91, 0xf5, 0x39, 0xba, 0x22,
+	0x45, 0x37, 0x87, 0x66, 0x06, 0xa3, 0x53, 0x77, 0x39, 0xa0, 0x8f, 0x25, 0x78, 0xa9, 0xe7, 0x59,
+	0x00, 0x0d, 0x0d, 0xdb, 0xf5, 0x80, 0x21, 0xdf, 0x8c, 0x6e, 0xc0, 0x81, 0x2e, 0x51, 0xa0, 0x37,
+	0xd0, 0xb5, 0x50, 0xa0, 0x0b, 0x95, 0xd6, 0x02, 0xad, 0x1d, 0xd4, 0x43, 0xfa, 0xe7, 0x08, 0xfd,
+	0x45, 0x82, 0x57, 0xfa, 0x36, 0xe8, 0x68, 0x79, 0x50, 0xfc, 0x90, 0xc7, 0x04, 0xf9, 0xb5, 0xe3,
+	0x19, 0x45, 0x06, 0xce, 0x18, 0xb6, 0x3b, 0x2e, 0xd0, 0xaf, 0x24, 0x98, 0xe8, 0x6a, 0x98, 0xd1,
+	0x42, 0x48, 0xf4, 0xfe, 0x8d, 0xbd, 0x5c, 0x8c, 0xaa, 0xce, 0x61, 0x5e, 0xa1, 0x30, 0xf3, 0x68,
+	0x4a, 0xed, 0xf3, 0xcf, 0x70, 0xc4, 0xc5, 0x0b, 0xb6, 0x07, 0xe2, 0x8f, 0x12, 0xa0, 0xde, 0x3e,
+	0x14, 0x45, 0x59, 0xce, 0x40, 0xb7, 0x2c, 0x2f, 0x1e, 0xc3, 0x82, 0x23, 0x5c, 0xa4, 0x08, 0xaf,
+	0xa3, 0xf9, 0x81, 0x3b, 0xa0, 0x42, 0x2c, 0x63, 0xc1, 0x34, 0x58, 0x4e, 0xfd, 0x48, 0x82, 0x8c,
+	0xaf, 0x59, 0x44, 0xf3, 0x21, 0x51, 0x7b, 0x9b, 0x56, 0xf9, 0x5a, 0x14, 0x55, 0x8e, 0xac, 0x40,
+	0x91, 0x5d, 0x40, 0xe7, 0x7b, 0x91, 0xb1, 0xfe, 0xe9, 0x97, 0x12, 0x9c, 0x0d, 0x34, 0x66, 0xe8,
+	0xfa, 0x00, 0xf7, 0xdd, 0xcd, 0xa3, 0x7c, 0x23, 0x9a, 0x32, 0x47, 0x33, 0x4f, 0xd1, 0xbc, 0x8a,
+	0x2e, 0x87, 0xa0, 0xf1, 0x58, 0xe2, 0xdd, 0xe7, 0x23, 0x48, 0xd1, 0x9e, 0x06, 0xbd, 0x1a, 0x12,
+	0xc1, 0xdf, 0x1b, 0xc9, 0x57, 0x06, 0x2b, 0x0d, 0x3f, 0xef, 0x1e, 0x78, 0x8a, 0xea, 0xa1, 0x87,
+	0xe2, 0x08, 0xfd, 0x4e, 0x82, 0x6c, 0x77, 0xbf, 0x11, 0x7a, 0xde, 0x85, 0xb4, 0x39, 0xb2, 0x1a,
+	0x59, 0x9f, 0xa3, 0xbb, 0x41, 0xd1, 0xcd, 0xa0, 0x2b, 0xfd, 0xc9, 0x59, 0xd0, 0xa9, 0x91, 0xc0,
+	0xf8, 0x7d, 0x09, 0xc6, 0xda, 0xb5, 0x72, 0xe8, 0xe5, 0xd0, 0x5d, 0xaa, 0xcb, 0x73, 0xc3, 0x15,
+	0x87, 0x5f, 0x8e, 0x75, 0xaa, 0x8c, 0x7e, 0x28, 0x01, 0x74, 0x8a, 0x60, 0x34, 0x17, 0x7a, 0xf3,
+	0x75, 0x55, 0xe1, 0xf2, 0x7c, 0x04, 0xcd, 0x28, 0xd7, 0x24, 0xd5, 0x46, 0x7f, 0x96, 0x20, 0x17,
+	0x56, 0x16, 0xa3, 0xd7, 0x07, 0x25, 0x73, 0x78, 0xbd, 0x2d, 0xbf, 0x71, 0x6c, 0xbb, 0xe1, 0x80,
+	0x79, 0xe1, 0x89, 0x7e, 0x22, 0x41, 0xc6, 0xd7, 0xf2, 0x85, 0xa6, 0x7e, 0x6f, 0x43, 0x2a, 0x5f,
+	0x8b, 0xa2, 0xca, 0x91, 0x5c, 0xa5, 0x48, 0x0a, 0xe8, 0x52, 0x2f, 0x12, 0x7f, 0x7b, 0x78, 0x08,
+	0x23, 0xbc, 0x42, 0x0d, 0x2d, 0x71, 0x82, 0x15, 0xb2, 0x3c, 0x33, 0x4c, 0x6d, 0x38, 0x15, 0xa2,
+	0xa2, 0xfd, 0x29, 0x2b, 0x71, 0x98, 0xe5, 0xa0, 0x12, 0x27, 0x50, 0xa6, 0xca, 0x73, 0xc3, 0x15,
+	0x39, 0x06, 0x95, 0x62, 0x98, 0x47, 0xb3, 0xa1, 0x18, 0xd4, 0x43, 0x5f, 0xc5, 0x7b, 0x54, 0xfa,
+	0xf2, 0xa7, 0x4f, 0xf2, 0xd2, 0x67, 0x4f, 0xf2, 0xd2, 0xbf, 0x9f, 0xe4, 0xa5, 0x9f, 0x3d, 0xcd,
+	0xc7, 0x3e, 0x7b, 0x9a, 0x8f, 0xfd, 0xf3, 0x69, 0x3e, 0xf6, 0x8d, 0x99, 0x9a, 0xe9, 0x16, 0x0f,
+	0x8c, 0x4a, 0xd1, 0x25, 0xd4, 0xd9, 0x82, 0x49, 0xd4, 0xba, 0x5e, 0x25, 0x96, 0x59, 0x35, 0xd4,
+	0x87, 0x6d, 0xd7, 0x95, 0x34, 0xfd, 0x2f, 0x16, 0xcb, 0xff, 0x1b, 0x00, 0x0d, 0x7c, 0x5c, 0x73,
+	0x35, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the registry module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Records queries all records
+	Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error)
+	// Get record by id
+	GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error)
+	// Get all versions of a record, latest first
+	GetRecordHistory(ctx context.Context, in *QueryGetRecordHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecordHistoryResponse, error)
+	// Get records by owner address
+	GetRecordsByOwner(ctx context.Context, in *QueryGetRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryGetRecordsByOwnerResponse, error)
+	// Get records with a link to a record
+	GetReferencingRecords(ctx context.Context, in *QueryGetReferencingRecordsRequest, opts ...grpc.CallOption) (*QueryGetReferencingRecordsResponse, error)
+	// Get the rent of a new or existing record
+	QuoteRecordRent(ctx context.Context, in *QueryQuoteRecordRentRequest, opts ...grpc.CallOption) (*QueryQuoteRecordRentResponse, error)
+	// Get records by bond id
+	GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error)
+	// NameRecords queries all name records
+	NameRecords(ctx context.Context, in *QueryNameRecordsRequest, opts ...grpc.CallOption) (*QueryNameRecordsResponse, error)
+	// NamesByPrefix queries the name records under an LRN prefix
+	NamesByPrefix(ctx context.Context, in *QueryNamesByPrefixRequest, opts ...grpc.CallOption) (*QueryNamesByPrefixResponse, error)
+	// Whois method retrieve the name authority info
+	Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error)
+	// NameAccessGrants queries the name access grants of a name authority
+	NameAccessGrants(ctx context.Context, in *QueryNameAccessGrantsRequest, opts ...grpc.CallOption) (*QueryNameAccessGrantsResponse, error)
+	// LookupLrn
+	LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error)
+	// ResolveLrn
+	ResolveLrn(ctx context.Context, in *QueryResolveLrnRequest, opts ...grpc.CallOption) (*QueryResolveLrnResponse, error)
+	// Get registry module balance
+	GetRegistryModuleBalance(ctx context.Context, in *QueryGetRegistryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryGetRegistryModuleBalanceResponse, error)
+	// Authorities queries all authorities
+	Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error)
+	// Schemas queries all record type schemas
+	Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error)
+	// Get schema by record type
+	GetSchema(ctx context.Context, in *QueryGetSchemaRequest, opts ...grpc.CallOption) (*QueryGetSchemaResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Records(ctx context.Context, in *QueryRecordsRequest, opts ...grpc.CallOption) (*QueryRecordsResponse, error) {
+	out := new(QueryRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Records", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetRecord(ctx context.Context, in *QueryGetRecordRequest, opts ...grpc.CallOption) (*QueryGetRecordResponse, error) {
+	out := new(QueryGetRecordResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordHistory(ctx context.Context, in *QueryGetRecordHistoryRequest, opts ...grpc.CallOption) (*QueryGetRecordHistoryResponse, error) {
+	out := new(QueryGetRecordHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecordHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordsByOwner(ctx context.Context, in *QueryGetRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryGetRecordsByOwnerResponse, error) {
+	out := new(QueryGetRecordsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecordsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetReferencingRecords(ctx context.Context, in *QueryGetReferencingRecordsRequest, opts ...grpc.CallOption) (*QueryGetReferencingRecordsResponse, error) {
+	out := new(QueryGetReferencingRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetReferencingRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetRecordsByBondIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuoteRecordRent(ctx context.Context, in *QueryQuoteRecordRentRequest, opts ...grpc.CallOption) (*QueryQuoteRecordRentResponse, error) {
+	out := new(QueryQuoteRecordRentResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/QuoteRecordRent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetRecordsByBondIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRecordsByBondId(ctx context.Context, in *QueryGetRecordsByBondIdRequest, opts ...grpc.CallOption) (*QueryGetRecordsByBondIdResponse, error) {
+	out := new(QueryGetRecordsByBondIdResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRecordsByBondId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetRecordsByBondIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) NameRecords(ctx context.Context, in *QueryNameRecordsRequest, opts ...grpc.CallOption) (*QueryNameRecordsResponse, error) {
+	out := new(QueryNameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/NameRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) NamesByPrefix(ctx context.Context, in *QueryNamesByPrefixRequest, opts ...grpc.CallOption) (*QueryNamesByPrefixResponse, error) {
+	out := new(QueryNamesByPrefixResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/NamesByPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetRecordsByBondIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Whois(ctx context.Context, in *QueryWhoisRequest, opts ...grpc.CallOption) (*QueryWhoisResponse, error) {
+	out := new(QueryWhoisResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Whois", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetRecordsByBondIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetRecordsByBondIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryNameRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NameAccessGrants(ctx context.Context, in *QueryNameAccessGrantsRequest, opts ...grpc.CallOption) (*QueryNameAccessGrantsResponse, error) {
+	out := new(QueryNameAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/NameAccessGrants", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LookupLrn(ctx context.Context, in *QueryLookupLrnRequest, opts ...grpc.CallOption) (*QueryLookupLrnResponse, error) {
+	out := new(QueryLookupLrnResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/LookupLrn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNameRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ResolveLrn(ctx context.Context, in *QueryResolveLrnRequest, opts ...grpc.CallOption) (*QueryResolveLrnResponse, error) {
+	out := new(QueryResolveLrnResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/ResolveLrn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Names) > 0 {
-		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetRegistryModuleBalance(ctx context.Context, in *QueryGetRegistryModuleBalanceRequest, opts ...grpc.CallOption) (*QueryGetRegistryModuleBalanceResponse, error) {
+	out := new(QueryGetRegistryModuleBalanceResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetRegistryModuleBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWhoisRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Authorities(ctx context.Context, in *QueryAuthoritiesRequest, opts ...grpc.CallOption) (*QueryAuthoritiesResponse, error) {
+	out := new(QueryAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Authorities", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryWhoisRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryWhoisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Schemas(ctx context.Context, in *QuerySchemasRequest, opts ...grpc.CallOption) (*QuerySchemasResponse, error) {
+	out := new(QuerySchemasResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/Schemas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryWhoisResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetSchema(ctx context.Context, in *QueryGetSchemaRequest, opts ...grpc.CallOption) (*QueryGetSchemaResponse, error) {
+	out := new(QueryGetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Query/GetSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryWhoisResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the registry module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Records queries all records
+	Records(context.Context, *QueryRecordsRequest) (*QueryRecordsResponse, error)
+	// Get record by id
+	GetRecord(context.Context, *QueryGetRecordRequest) (*QueryGetRecordResponse, error)
+	// Get all versions of a record, latest first
+	GetRecordHistory(context.Context, *QueryGetRecordHistoryRequest) (*QueryGetRecordHistoryResponse, error)
+	// Get records by owner address
+	GetRecordsByOwner(context.Context, *QueryGetRecordsByOwnerRequest) (*QueryGetRecordsByOwnerResponse, error)
+	// Get records with a link to a record
+	GetReferencingRecords(context.Context, *QueryGetReferencingRecordsRequest) (*QueryGetReferencingRecordsResponse, error)
+	// Get the rent of a new or existing record
+	QuoteRecordRent(context.Context, *QueryQuoteRecordRentRequest) (*QueryQuoteRecordRentResponse, error)
+	// Get records by bond id
+	GetRecordsByBondId(context.Context, *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error)
+	// NameRecords queries all name records
+	NameRecords(context.Context, *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error)
+	// NamesByPrefix queries the name records under an LRN prefix
+	NamesByPrefix(context.Context, *QueryNamesByPrefixRequest) (*QueryNamesByPrefixResponse, error)
+	// Whois method retrieve the name authority info
+	Whois(context.Context, *QueryWhoisRequest) (*QueryWhoisResponse, error)
+	// NameAccessGrants queries the name access grants of a name authority
+	NameAccessGrants(context.Context, *QueryNameAccessGrantsRequest) (*QueryNameAccessGrantsResponse, error)
+	// LookupLrn
+	LookupLrn(context.Context, *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error)
+	// ResolveLrn
+	ResolveLrn(context.Context, *QueryResolveLrnRequest) (*QueryResolveLrnResponse, error)
+	// Get registry module balance
+	GetRegistryModuleBalance(context.Context, *QueryGetRegistryModuleBalanceRequest) (*QueryGetRegistryModuleBalanceResponse, error)
+	// Authorities queries all authorities
+	Authorities(context.Context, *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error)
+	// Schemas queries all record type schemas
+	Schemas(context.Context, *QuerySchemasRequest) (*QuerySchemasResponse, error)
+	// Get schema by record type
+	GetSchema(context.Context, *QueryGetSchemaRequest) (*QueryGetSchemaResponse, error)
 }
 
-func (m *QueryWhoisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NameAuthority.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Records(ctx context.Context, req *QueryRecordsRequest) (*QueryRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Records not implemented")
 }
-
-func (m *QueryAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetRecord(ctx context.Context, req *QueryGetRecordRequest) (*QueryGetRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecord not implemented")
 }
-
-func (m *QueryAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetRecordHistory(ctx context.Context, req *QueryGetRecordHistoryRequest) (*QueryGetRecordHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordHistory not implemented")
 }
-
-func (m *QueryAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetRecordsByOwner(ctx context.Context, req *QueryGetRecordsByOwnerRequest) (*QueryGetRecordsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByOwner not implemented")
 }
-
-func (m *QueryAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authorities) > 0 {
-		for iNdEx := len(m.Authorities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Authorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetReferencingRecords(ctx context.Context, req *QueryGetReferencingRecordsRequest) (*QueryGetReferencingRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferencingRecords not implemented")
 }
-
-func (m *QueryLookupLrnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QuoteRecordRent(ctx context.Context, req *QueryQuoteRecordRentRequest) (*QueryQuoteRecordRentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRecordRent not implemented")
 }
-
-func (m *QueryLookupLrnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetRecordsByBondId(ctx context.Context, req *QueryGetRecordsByBondIdRequest) (*QueryGetRecordsByBondIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordsByBondId not implemented")
 }
-
-func (m *QueryLookupLrnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lrn) > 0 {
-		i -= len(m.Lrn)
-		copy(dAtA[i:], m.Lrn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lrn)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) NameRecords(ctx context.Context, req *QueryNameRecordsRequest) (*QueryNameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameRecords not implemented")
 }
-
-func (m *QueryLookupLrnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) NamesByPrefix(ctx context.Context, req *QueryNamesByPrefixRequest) (*QueryNamesByPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NamesByPrefix not implemented")
+}
+func (*UnimplementedQueryServer) Whois(ctx context.Context, req *QueryWhoisRequest) (*QueryWhoisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Whois not implemented")
+}
+func (*UnimplementedQueryServer) NameAccessGrants(ctx context.Context, req *QueryNameAccessGrantsRequest) (*QueryNameAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameAccessGrants not implemented")
+}
+func (*UnimplementedQueryServer) LookupLrn(ctx context.Context, req *QueryLookupLrnRequest) (*QueryLookupLrnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupLrn not implemented")
+}
+func (*UnimplementedQueryServer) ResolveLrn(ctx context.Context, req *QueryResolveLrnRequest) (*QueryResolveLrnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveLrn not implemented")
+}
+func (*UnimplementedQueryServer) GetRegistryModuleBalance(ctx context.Context, req *QueryGetRegistryModuleBalanceRequest) (*QueryGetRegistryModuleBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistryModuleBalance not implemented")
+}
+func (*UnimplementedQueryServer) Authorities(ctx context.Context, req *QueryAuthoritiesRequest) (*QueryAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorities not implemented")
+}
+func (*UnimplementedQueryServer) Schemas(ctx context.Context, req *QuerySchemasRequest) (*QuerySchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schemas not implemented")
+}
+func (*UnimplementedQueryServer) GetSchema(ctx context.Context, req *QueryGetSchemaRequest) (*QueryGetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
 
-func (m *QueryLookupLrnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryLookupLrnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Name != nil {
-		{
-			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Records_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Records(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/Records",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Records(ctx, req.(*QueryRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecord(ctx, req.(*QueryGetRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lrn) > 0 {
-		i -= len(m.Lrn)
-		copy(dAtA[i:], m.Lrn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lrn)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetRecordHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetRecordHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordHistory(ctx, req.(*QueryGetRecordHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRecordsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordsByOwnerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetRecordsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByOwner(ctx, req.(*QueryGetRecordsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetReferencingRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetReferencingRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetReferencingRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetReferencingRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetReferencingRecords(ctx, req.(*QueryGetReferencingRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryResolveLrnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QuoteRecordRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRecordRentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteRecordRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/QuoteRecordRent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteRecordRent(ctx, req.(*QueryQuoteRecordRentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRegistryModuleBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRecordsByBondId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRecordsByBondIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRecordsByBondId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetRecordsByBondId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRecordsByBondId(ctx, req.(*QueryGetRecordsByBondIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRegistryModuleBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_NameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/NameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameRecords(ctx, req.(*QueryNameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRegistryModuleBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_NamesByPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNamesByPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NamesByPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/NamesByPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NamesByPrefix(ctx, req.(*QueryNamesByPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetRegistryModuleBalanceResponse) Marshal() (dAtA []byte, err error) {
+func _Query_Whois_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhoisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Whois(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/Whois",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Whois(ctx, req.(*QueryWhoisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NameAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameAccessGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameAccessGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/NameAccessGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameAccessGrants(ctx, req.(*QueryNameAccessGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LookupLrn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLookupLrnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LookupLrn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/LookupLrn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LookupLrn(ctx, req.(*QueryLookupLrnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveLrn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveLrnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveLrn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/ResolveLrn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveLrn(ctx, req.(*QueryResolveLrnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRegistryModuleBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRegistryModuleBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRegistryModuleBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetRegistryModuleBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRegistryModuleBalance(ctx, req.(*QueryGetRegistryModuleBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/Authorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authorities(ctx, req.(*QueryAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/Schemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schemas(ctx, req.(*QuerySchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Query/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSchema(ctx, req.(*QueryGetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cerc.registry.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Records",
+			Handler:    _Query_Records_Handler,
+		},
+		{
+			MethodName: "GetRecord",
+			Handler:    _Query_GetRecord_Handler,
+		},
+		{
+			MethodName: "GetRecordHistory",
+			Handler:    _Query_GetRecordHistory_Handler,
+		},
+		{
+			MethodName: "GetRecordsByOwner",
+			Handler:    _Query_GetRecordsByOwner_Handler,
+		},
+		{
+			MethodName: "GetReferencingRecords",
+			Handler:    _Query_GetReferencingRecords_Handler,
+		},
+		{
+			MethodName: "QuoteRecordRent",
+			Handler:    _Query_QuoteRecordRent_Handler,
+		},
+		{
+			MethodName: "GetRecordsByBondId",
+			Handler:    _Query_GetRecordsByBondId_Handler,
+		},
+		{
+			MethodName: "NameRecords",
+			Handler:    _Query_NameRecords_Handler,
+		},
+		{
+			MethodName: "NamesByPrefix",
+			Handler:    _Query_NamesByPrefix_Handler,
+		},
+		{
+			MethodName: "Whois",
+			Handler:    _Query_Whois_Handler,
+		},
+		{
+			MethodName: "NameAccessGrants",
+			Handler:    _Query_NameAccessGrants_Handler,
+		},
+		{
+			MethodName: "LookupLrn",
+			Handler:    _Query_LookupLrn_Handler,
+		},
+		{
+			MethodName: "ResolveLrn",
+			Handler:    _Query_ResolveLrn_Handler,
+		},
+		{
+			MethodName: "GetRegistryModuleBalance",
+			Handler:    _Query_GetRegistryModuleBalance_Handler,
+		},
+		{
+			MethodName: "Authorities",
+			Handler:    _Query_Authorities_Handler,
+		},
+		{
+			MethodName: "Schemas",
+			Handler:    _Query_Schemas_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Query_GetSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cerc/registry/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2857,34 +3465,20 @@ func (m *QueryGetRegistryModuleBalanceResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetRegistryModuleBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRegistryModuleBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2894,529 +3488,5169 @@ func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountBalance) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.AccountName) > 0 {
-		i -= len(m.AccountName)
-		copy(dAtA[i:], m.AccountName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Attributes) > 0 {
-		for _, e := range m.Attributes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.All {
-		n += 2
+		i--
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordsRequest_ArrayInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_ArrayInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest_ArrayInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ArrayInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordsRequest_MapInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_MapInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest_MapInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_MapInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Values) > 0 {
-		for k, v := range m.Values {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.Values {
+			v := m.Values[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordsRequest_ValueInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_ValueInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest_ValueInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Value != nil {
-		n += m.Value.Size()
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordsRequest_ValueInput_String_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.String_)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-func (m *QueryRecordsRequest_ValueInput_Int) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovQuery(uint64(m.Int))
-	return n
+func (m *QueryRecordsRequest_ValueInput_String_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryRecordsRequest_ValueInput_Float) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 9
-	return n
+
+func (m *QueryRecordsRequest_ValueInput_String_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.String_)
+	copy(dAtA[i:], m.String_)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.String_)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *QueryRecordsRequest_ValueInput_Boolean) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2
-	return n
+func (m *QueryRecordsRequest_ValueInput_Int) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryRecordsRequest_ValueInput_Link) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Link)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+
+func (m *QueryRecordsRequest_ValueInput_Int) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintQuery(dAtA, i, uint64(m.Int))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
 }
-func (m *QueryRecordsRequest_ValueInput_Array) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_ValueInput_Float) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput_Float) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Float))))
+	i--
+	dAtA[i] = 0x19
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_ValueInput_Boolean) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput_Boolean) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.Boolean {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_ValueInput_Link) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput_Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Link)
+	copy(dAtA[i:], m.Link)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.Link)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_ValueInput_Array) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput_Array) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Array != nil {
-		l = m.Array.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Array.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *QueryRecordsRequest_ValueInput_Map) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryRecordsRequest_ValueInput_Map) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ValueInput_Map) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.Map != nil {
-		l = m.Map.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Map.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *QueryRecordsRequest_KeyValueInput) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_KeyValueInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest_KeyValueInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_KeyValueInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Operator != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_ExpressionList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsRequest_ExpressionList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_ExpressionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Expressions) > 0 {
+		for iNdEx := len(m.Expressions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expressions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRecordsRequest_Expression) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Record.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRecordsRequest_Expression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetRecordsByBondIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecordsRequest_Expression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Node != nil {
+		{
+			size := m.Node.Size()
+			i -= size
+			if _, err := m.Node.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRecordsByBondIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_Expression_Condition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_Expression_Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_Expression_And) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_Expression_And) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.And != nil {
+		{
+			size, err := m.And.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_Expression_Or) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsRequest_Expression_Or) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Or != nil {
+		{
+			size, err := m.Or.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsRequest_Expression_Not) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNameRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecordsRequest_Expression_Not) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Not != nil {
+		{
+			size, err := m.Not.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *QueryRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNameRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Names) > 0 {
-		for _, e := range m.Names {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhoisRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWhoisResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NameAuthority.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthoritiesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthoritiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Authorities) > 0 {
-		for _, e := range m.Authorities {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLookupLrnRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Lrn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetRecordHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLookupLrnResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetRecordHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = m.Name.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveLrnRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRecordHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Lrn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveLrnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRecordsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRegistryModuleBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetRecordsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRegistryModuleBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetReferencingRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReferencingRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReferencingRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AccountBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetReferencingRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetReferencingRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetReferencingRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryQuoteRecordRentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryQuoteRecordRentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryQuoteRecordRentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AttributesSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttributesSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteRecordRentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteRecordRentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteRecordRentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RentPerPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AttributesSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttributesSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecordsByBondIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordsByBondIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordsByBondIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRecordsByBondIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRecordsByBondIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRecordsByBondIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesByPrefixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesByPrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesByPrefixRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNamesByPrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNamesByPrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNamesByPrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Names[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamePrefixEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamePrefixEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamePrefixEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhoisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhoisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhoisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhoisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhoisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhoisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NameAuthority.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameAccessGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameAccessGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameAccessGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameAccessGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameAccessGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameAccessGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authorities) > 0 {
+		for iNdEx := len(m.Authorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLookupLrnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLookupLrnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLookupLrnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lrn) > 0 {
+		i -= len(m.Lrn)
+		copy(dAtA[i:], m.Lrn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lrn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLookupLrnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLookupLrnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLookupLrnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Name != nil {
+		{
+			size, err := m.Name.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveLrnRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveLrnRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveLrnRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Lrn) > 0 {
+		i -= len(m.Lrn)
+		copy(dAtA[i:], m.Lrn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lrn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveLrnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveLrnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveLrnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegistryModuleBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegistryModuleBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegistryModuleBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRegistryModuleBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRegistryModuleBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRegistryModuleBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AccountName) > 0 {
+		i -= len(m.AccountName)
+		copy(dAtA[i:], m.AccountName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.All {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_ArrayInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_MapInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_ValueInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_ValueInput_String_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.String_)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Int) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovQuery(uint64(m.Int))
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Float) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Boolean) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Link) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Link)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Array) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Array != nil {
+		l = m.Array.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsRequest_ValueInput_Map) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Map != nil {
+		l = m.Map.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsRequest_KeyValueInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovQuery(uint64(m.Operator))
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_ExpressionList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Expressions) > 0 {
+		for _, e := range m.Expressions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_Expression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		n += m.Node.Size()
+	}
+	return n
+}
+
+func (m *QueryRecordsRequest_Expression_Condition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsRequest_Expression_And) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.And != nil {
+		l = m.And.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsRequest_Expression_Or) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Or != nil {
+		l = m.Or.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsRequest_Expression_Not) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Not != nil {
+		l = m.Not.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+func (m *QueryRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRecordHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetRecordsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReferencingRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetReferencingRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteRecordRentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttributesSize != 0 {
+		n += 1 + sovQuery(uint64(m.AttributesSize))
+	}
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *QueryQuoteRecordRentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttributesSize != 0 {
+		n += 1 + sovQuery(uint64(m.AttributesSize))
+	}
+	l = m.RentPerPeriod.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Rent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRecordsByBondIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRecordsByBondIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesByPrefixRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNamesByPrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, e := range m.Names {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *NamePrefixEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWhoisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhoisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NameAuthority.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNameAccessGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameAccessGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuthoritiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorities) > 0 {
+		for _, e := range m.Authorities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLookupLrnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lrn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryLookupLrnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = m.Name.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveLrnRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lrn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryResolveLrnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRegistryModuleBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetRegistryModuleBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryGetSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &QueryRecordsRequest_KeyValueInput{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &QueryRecordsRequest_Expression{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_ArrayInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArrayInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArrayInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &QueryRecordsRequest_ValueInput{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_MapInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MapInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MapInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Values == nil {
+				m.Values = make(map[string]*QueryRecordsRequest_ValueInput)
+			}
+			var mapkey string
+			var mapvalue *QueryRecordsRequest_ValueInput
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &QueryRecordsRequest_ValueInput{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &QueryRecordsRequest_ValueInput_String_{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &QueryRecordsRequest_ValueInput_Int{v}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = &QueryRecordsRequest_ValueInput_Float{float64(math.Float64frombits(v))}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boolean", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Value = &QueryRecordsRequest_ValueInput_Boolean{b}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &QueryRecordsRequest_ValueInput_Link{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_ArrayInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &QueryRecordsRequest_ValueInput_Array{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_MapInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &QueryRecordsRequest_ValueInput_Map{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValueInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &QueryRecordsRequest_ValueInput{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= QueryRecordsRequest_Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_ExpressionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpressionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpressionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expressions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expressions = append(m.Expressions, &QueryRecordsRequest_Expression{})
+			if err := m.Expressions[len(m.Expressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsRequest_Expression) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Expression: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Expression: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_KeyValueInput{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Node = &QueryRecordsRequest_Expression_Condition{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field And", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_ExpressionList{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Node = &QueryRecordsRequest_Expression_And{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Or", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_ExpressionList{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Node = &QueryRecordsRequest_Expression_Or{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Not", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryRecordsRequest_Expression{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Node = &QueryRecordsRequest_Expression_Not{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRecordsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRecordsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRecordsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReferencingRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReferencingRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReferencingRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetReferencingRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetReferencingRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetReferencingRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteRecordRentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteRecordRentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteRecordRentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributesSize", wireType)
+			}
+			m.AttributesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQuoteRecordRentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQuoteRecordRentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQuoteRecordRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributesSize", wireType)
+			}
+			m.AttributesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if iNdEx >= l {
+			if err := m.RentPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Rent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3438,7 +8672,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3461,15 +8695,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecordsByBondIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecordsByBondIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3496,10 +8762,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3524,7 +8790,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRecordsByBondIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3547,15 +8813,15 @@ func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRecordsByBondIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRecordsByBondIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3582,32 +8848,12 @@ func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, &QueryRecordsRequest_KeyValueInput{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, Record{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.All = bool(v != 0)
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3637,7 +8883,7 @@ func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3664,7 +8910,7 @@ func (m *QueryRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsRequest_ArrayInput) Unmarshal(dAtA []byte) error {
+func (m *QueryNameRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3687,15 +8933,15 @@ func (m *QueryRecordsRequest_ArrayInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArrayInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArrayInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3722,8 +8968,10 @@ func (m *QueryRecordsRequest_ArrayInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &QueryRecordsRequest_ValueInput{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3748,7 +8996,7 @@ func (m *QueryRecordsRequest_ArrayInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsRequest_MapInput) Unmarshal(dAtA []byte) error {
+func (m *QueryNameRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3771,15 +9019,15 @@ func (m *QueryRecordsRequest_MapInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3806,105 +9054,46 @@ func (m *QueryRecordsRequest_MapInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]*QueryRecordsRequest_ValueInput)
+			m.Names = append(m.Names, NameEntry{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *QueryRecordsRequest_ValueInput
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &QueryRecordsRequest_ValueInput{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Values[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3927,7 +9116,7 @@ func (m *QueryRecordsRequest_MapInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
+func (m *QueryNamesByPrefixRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3950,15 +9139,15 @@ func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNamesByPrefixRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNamesByPrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3986,95 +9175,11 @@ func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = &QueryRecordsRequest_ValueInput_String_{string(dAtA[iNdEx:postIndex])}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Int", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Value = &QueryRecordsRequest_ValueInput_Int{v}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = &QueryRecordsRequest_ValueInput_Float{float64(math.Float64frombits(v))}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Boolean", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Value = &QueryRecordsRequest_ValueInput_Boolean{b}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = &QueryRecordsRequest_ValueInput_Link{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4101,46 +9206,12 @@ func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &QueryRecordsRequest_ArrayInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Value = &QueryRecordsRequest_ValueInput_Array{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Map", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			v := &QueryRecordsRequest_MapInput{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Value = &QueryRecordsRequest_ValueInput_Map{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4163,7 +9234,7 @@ func (m *QueryRecordsRequest_ValueInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
+func (m *QueryNamesByPrefixResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4186,17 +9257,17 @@ func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyValueInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNamesByPrefixResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyValueInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNamesByPrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4206,27 +9277,29 @@ func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Names = append(m.Names, NamePrefixEntry{})
+			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4253,10 +9326,10 @@ func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &QueryRecordsRequest_ValueInput{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4281,7 +9354,7 @@ func (m *QueryRecordsRequest_KeyValueInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *NamePrefixEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4304,17 +9377,17 @@ func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamePrefixEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamePrefixEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4324,29 +9397,27 @@ func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4373,13 +9444,53 @@ func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Entry == nil {
+				m.Entry = &NameRecord{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4401,7 +9512,7 @@ func (m *QueryRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4424,15 +9535,15 @@ func (m *QueryGetRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhoisRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhoisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4460,7 +9571,7 @@ func (m *QueryGetRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4483,7 +9594,7 @@ func (m *QueryGetRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4506,15 +9617,15 @@ func (m *QueryGetRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhoisResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhoisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NameAuthority", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4541,7 +9652,7 @@ func (m *QueryGetRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NameAuthority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4566,7 +9677,7 @@ func (m *QueryGetRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNameAccessGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4589,15 +9700,15 @@ func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecordsByBondIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameAccessGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecordsByBondIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameAccessGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4625,13 +9736,13 @@ func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4641,27 +9752,23 @@ func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4684,7 +9791,7 @@ func (m *QueryGetRecordsByBondIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRecordsByBondIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNameAccessGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4707,49 +9814,15 @@ func (m *QueryGetRecordsByBondIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRecordsByBondIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameAccessGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRecordsByBondIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameAccessGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, Record{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4776,10 +9849,8 @@ func (m *QueryGetRecordsByBondIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, NameAccessGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4804,7 +9875,7 @@ func (m *QueryGetRecordsByBondIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNameRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4827,13 +9898,45 @@ func (m *QueryNameRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNameRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthoritiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNameRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4890,7 +9993,7 @@ func (m *QueryNameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4913,15 +10016,15 @@ func (m *QueryNameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthoritiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4948,8 +10051,8 @@ func (m *QueryNameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, NameEntry{})
-			if err := m.Names[len(m.Names)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authorities = append(m.Authorities, AuthorityEntry{})
+			if err := m.Authorities[len(m.Authorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5010,7 +10113,7 @@ func (m *QueryNameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupLrnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5033,15 +10136,15 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhoisRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupLrnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhoisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupLrnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5069,8 +10172,46 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Lrn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5092,7 +10233,7 @@ func (m *QueryWhoisRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLookupLrnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5115,15 +10256,15 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhoisResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLookupLrnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhoisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLookupLrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5150,7 +10291,10 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NameAuthority.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Name == nil {
+				m.Name = &NameRecord{}
+			}
+			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5175,7 +10319,7 @@ func (m *QueryWhoisResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveLrnRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5198,15 +10342,15 @@ func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthoritiesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveLrnRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveLrnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5234,8 +10378,46 @@ func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Lrn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5257,7 +10439,7 @@ func (m *QueryAuthoritiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryResolveLrnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5280,49 +10462,15 @@ func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthoritiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveLrnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveLrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authorities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authorities = append(m.Authorities, AuthorityEntry{})
-			if err := m.Authorities[len(m.Authorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5349,10 +10497,10 @@ func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Record == nil {
+				m.Record = &Record{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5377,7 +10525,7 @@ func (m *QueryAuthoritiesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLookupLrnRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRegistryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5400,44 +10548,12 @@ func (m *QueryLookupLrnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLookupLrnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLookupLrnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lrn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5459,7 +10575,7 @@ func (m *QueryLookupLrnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLookupLrnResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRegistryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5482,15 +10598,15 @@ func (m *QueryLookupLrnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLookupLrnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLookupLrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5517,10 +10633,8 @@ func (m *QueryLookupLrnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Name == nil {
-				m.Name = &NameRecord{}
-			}
-			if err := m.Name.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, &AccountBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5545,7 +10659,7 @@ func (m *QueryLookupLrnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveLrnRequest) Unmarshal(dAtA []byte) error {
+func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5568,15 +10682,15 @@ func (m *QueryResolveLrnRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveLrnRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveLrnRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5604,7 +10718,41 @@ func (m *QueryResolveLrnRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lrn = string(dAtA[iNdEx:postIndex])
+			m.AccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5627,7 +10775,7 @@ func (m *QueryResolveLrnRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveLrnResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5650,15 +10798,15 @@ func (m *QueryResolveLrnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveLrnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveLrnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5685,10 +10833,10 @@ func (m *QueryResolveLrnResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5713,7 +10861,7 @@ func (m *QueryResolveLrnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRegistryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5736,12 +10884,82 @@ func (m *QueryGetRegistryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, Schema{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5763,7 +10981,7 @@ func (m *QueryGetRegistryModuleBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetRegistryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5786,17 +11004,17 @@ func (m *QueryGetRegistryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetRegistryModuleBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5806,26 +11024,43 @@ func (m *QueryGetRegistryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &AccountBalance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5847,7 +11082,7 @@ func (m *QueryGetRegistryModuleBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountBalance) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5870,47 +11105,15 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5937,8 +11140,7 @@ func (m *AccountBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
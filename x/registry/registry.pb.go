@@ -42,6 +42,21 @@ type Params struct {
 	AuthorityAuctionCommitFee       types.Coin    `protobuf:"bytes,9,opt,name=authority_auction_commit_fee,json=authorityAuctionCommitFee,proto3" json:"authority_auction_commit_fee" json:"authority_auction_commit_fee" yaml:"authority_auction_commit_fee"`
 	AuthorityAuctionRevealFee       types.Coin    `protobuf:"bytes,10,opt,name=authority_auction_reveal_fee,json=authorityAuctionRevealFee,proto3" json:"authority_auction_reveal_fee" json:"authority_auction_reveal_fee" yaml:"authority_auction_reveal_fee"`
 	AuthorityAuctionMinimumBid      types.Coin    `protobuf:"bytes,11,opt,name=authority_auction_minimum_bid,json=authorityAuctionMinimumBid,proto3" json:"authority_auction_minimum_bid" json:"authority_auction_minimum_bid" yaml:"authority_auction_minimum_bid"`
+	// Maximum time a record can be paid for in advance (see MsgSetRecord
+	// lifetime and MsgRenewRecord periods)
+	MaxRecordLifetime time.Duration `protobuf:"bytes,12,opt,name=max_record_lifetime,json=maxRecordLifetime,proto3,stdduration" json:"max_record_lifetime" json:"max_record_lifetime" yaml:"max_record_lifetime"`
+	// Rent per byte of record attributes for 1 time period, charged in addition
+	// to the record rent
+	RecordRentPerByte types.Coin `protobuf:"bytes,13,opt,name=record_rent_per_byte,json=recordRentPerByte,proto3" json:"record_rent_per_byte" json:"record_rent_per_byte" yaml:"record_rent_per_byte"`
+	// Maximum size of record attributes in bytes
+	MaxRecordAttributesSize uint64 `protobuf:"varint,14,opt,name=max_record_attributes_size,json=maxRecordAttributesSize,proto3" json:"max_record_attributes_size,omitempty" json:"max_record_attributes_size" yaml:"max_record_attributes_size"`
+	// Maximum nesting depth of record attributes (maps and lists)
+	MaxRecordAttributesDepth uint64 `protobuf:"varint,15,opt,name=max_record_attributes_depth,json=maxRecordAttributesDepth,proto3" json:"max_record_attributes_depth,omitempty" json:"max_record_attributes_depth" yaml:"max_record_attributes_depth"`
+	// Maximum number of attribute index entries of a record (one per leaf value
+	// and list, see record queries)
+	MaxRecordIndexedAttributes uint64 `protobuf:"varint,16,opt,name=max_record_indexed_attributes,json=maxRecordIndexedAttributes,proto3" json:"max_record_indexed_attributes,omitempty" json:"max_record_indexed_attributes" yaml:"max_record_indexed_attributes"`
+	// Maximum size of record type schema definitions, in bytes
+	MaxSchemaSize uint64 `protobuf:"varint,17,opt,name=max_schema_size,json=maxSchemaSize,proto3" json:"max_schema_size,omitempty" json:"max_schema_size" yaml:"max_schema_size"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -154,6 +169,48 @@ func (m *Params) GetAuthorityAuctionMinimumBid() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMaxRecordLifetime() time.Duration {
+	if m != nil {
+		return m.MaxRecordLifetime
+	}
+	return 0
+}
+
+func (m *Params) GetRecordRentPerByte() types.Coin {
+	if m != nil {
+		return m.RecordRentPerByte
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxRecordAttributesSize() uint64 {
+	if m != nil {
+		return m.MaxRecordAttributesSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordAttributesDepth() uint64 {
+	if m != nil {
+		return m.MaxRecordAttributesDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordIndexedAttributes() uint64 {
+	if m != nil {
+		return m.MaxRecordIndexedAttributes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSchemaSize() uint64 {
+	if m != nil {
+		return m.MaxSchemaSize
+	}
+	return 0
+}
+
 // Record defines a registry record
 type Record struct {
 	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" json:"id" yaml:"id"`
@@ -162,9 +219,23 @@ type Record struct {
 	ExpiryTime string   `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty" json:"expiry_time" yaml:"expiry_time"`
 	Deleted    bool     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	Owners     []string `protobuf:"bytes,6,rep,name=owners,proto3" json:"owners,omitempty" json:"owners" yaml:"owners"`
+	// Record attributes: canonical DAG-CBOR (the block addressed by the record
+	// id) for stored records, JSON in record payloads
 	Attributes []byte   `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty" json:"attributes" yaml:"attributes"`
 	Names      []string `protobuf:"bytes,8,rep,name=names,proto3" json:"names,omitempty" json:"names" yaml:"names"`
 	Type       string   `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty" json:"types" yaml:"types"`
+	// id of the record version this record replaces (see MsgUpdateRecord).
+	PreviousId string `protobuf:"bytes,10,opt,name=previous_id,json=previousId,proto3" json:"previous_id,omitempty" json:"previous_id" yaml:"previous_id"`
+	// number of owner approvals required for record-scoped messages (at least 1)
+	OwnerThreshold uint32 `protobuf:"varint,11,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty" json:"owner_threshold" yaml:"owner_threshold"`
+	// version of the record type schema the record was validated against, if
+	// any
+	SchemaVersion uint64 `protobuf:"varint,12,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty" json:"schema_version" yaml:"schema_version"`
+	// rent paid for the current (and prepaid) rent periods of the record, if any
+	Rent *RecordRent `protobuf:"bytes,13,opt,name=rent,proto3" json:"rent,omitempty" json:"rent" yaml:"rent"`
+	// number of record operations approved so far, signed by the record owners
+	// with each operation so that their approvals can't be replayed
+	Nonce uint64 `protobuf:"varint,14,opt,name=nonce,proto3" json:"nonce,omitempty" json:"nonce" yaml:"nonce"`
 }
 
 func (m *Record) Reset()         { *m = Record{} }
@@ -263,6 +334,111 @@ func (m *Record) GetType() string {
 	return ""
 }
 
+func (m *Record) GetPreviousId() string {
+	if m != nil {
+		return m.PreviousId
+	}
+	return ""
+}
+
+func (m *Record) GetOwnerThreshold() uint32 {
+	if m != nil {
+		return m.OwnerThreshold
+	}
+	return 0
+}
+
+func (m *Record) GetSchemaVersion() uint64 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *Record) GetRent() *RecordRent {
+	if m != nil {
+		return m.Rent
+	}
+	return nil
+}
+
+func (m *Record) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// RecordRent is the rent paid by a bond for a record, for the time from
+// start_time to end_time (the record expiry time)
+type RecordRent struct {
+	Amount    types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount" json:"amount" yaml:"amount"`
+	BondId    string     `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
+	StartTime string     `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty" json:"start_time" yaml:"start_time"`
+	EndTime   string     `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty" json:"end_time" yaml:"end_time"`
+}
+
+func (m *RecordRent) Reset()         { *m = RecordRent{} }
+func (m *RecordRent) String() string { return proto.CompactTextString(m) }
+func (*RecordRent) ProtoMessage()    {}
+func (*RecordRent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d792f2373089b5b9, []int{2}
+}
+func (m *RecordRent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordRent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordRent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordRent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordRent.Merge(m, src)
+}
+func (m *RecordRent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordRent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordRent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordRent proto.InternalMessageInfo
+
+func (m *RecordRent) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RecordRent) GetBondId() string {
+	if m != nil {
+		return m.BondId
+	}
+	return ""
+}
+
+func (m *RecordRent) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *RecordRent) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
 // AuthorityEntry defines a registry authority
 type AuthorityEntry struct {
 	Name  string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -273,7 +449,7 @@ func (m *AuthorityEntry) Reset()         { *m = AuthorityEntry{} }
 func (m *AuthorityEntry) String() string { return proto.CompactTextString(m) }
 func (*AuthorityEntry) ProtoMessage()    {}
 func (*AuthorityEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{2}
+	return fileDescriptor_d792f2373089b5b9, []int{3}
 }
 func (m *AuthorityEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,18 +499,22 @@ type NameAuthority struct {
 	// Owner address.
 	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty" json:"owner_address" yaml:"owner_address"`
 	// height at which name/authority was created.
-	Height     uint64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Status: active, grace (rent unpaid, see AuthorityGracePeriod), auction or
+	// expired.
 	Status     string    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	AuctionId  string    `protobuf:"bytes,5,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" json:"auction_id" yaml:"auction_id"`
 	BondId     string    `protobuf:"bytes,6,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
 	ExpiryTime time.Time `protobuf:"bytes,7,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time" json:"expiry_time" yaml:"expiry_time"`
+	// Transfer to a new owner, pending acceptance (see MsgTransferAuthority)
+	PendingTransfer *AuthorityTransfer `protobuf:"bytes,8,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty" json:"pending_transfer" yaml:"pending_transfer"`
 }
 
 func (m *NameAuthority) Reset()         { *m = NameAuthority{} }
 func (m *NameAuthority) String() string { return proto.CompactTextString(m) }
 func (*NameAuthority) ProtoMessage()    {}
 func (*NameAuthority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{3}
+	return fileDescriptor_d792f2373089b5b9, []int{4}
 }
 func (m *NameAuthority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,6 +592,149 @@ func (m *NameAuthority) GetExpiryTime() time.Time {
 	return time.Time{}
 }
 
+func (m *NameAuthority) GetPendingTransfer() *AuthorityTransfer {
+	if m != nil {
+		return m.PendingTransfer
+	}
+	return nil
+}
+
+// AuthorityTransfer defines a name authority transfer, pending acceptance by
+// the new owner
+type AuthorityTransfer struct {
+	NewOwner        string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" json:"new_owner" yaml:"new_owner"`
+	KeepBond        bool   `protobuf:"varint,2,opt,name=keep_bond,json=keepBond,proto3" json:"keep_bond,omitempty" json:"keep_bond" yaml:"keep_bond"`
+	InvalidateNames bool   `protobuf:"varint,3,opt,name=invalidate_names,json=invalidateNames,proto3" json:"invalidate_names,omitempty" json:"invalidate_names" yaml:"invalidate_names"`
+}
+
+func (m *AuthorityTransfer) Reset()         { *m = AuthorityTransfer{} }
+func (m *AuthorityTransfer) String() string { return proto.CompactTextString(m) }
+func (*AuthorityTransfer) ProtoMessage()    {}
+func (*AuthorityTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d792f2373089b5b9, []int{5}
+}
+func (m *AuthorityTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorityTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorityTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorityTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorityTransfer.Merge(m, src)
+}
+func (m *AuthorityTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorityTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorityTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorityTransfer proto.InternalMessageInfo
+
+func (m *AuthorityTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *AuthorityTransfer) GetKeepBond() bool {
+	if m != nil {
+		return m.KeepBond
+	}
+	return false
+}
+
+func (m *AuthorityTransfer) GetInvalidateNames() bool {
+	if m != nil {
+		return m.InvalidateNames
+	}
+	return false
+}
+
+// NameAccessGrant defines the right of a grantee to set and delete the names
+// matching an LRN prefix, granted by the owner of the name authority
+type NameAccessGrant struct {
+	// LRN, or LRN path prefix ending with "/*" (e.g. lrn://cerc-io/apps/*)
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty" json:"lrn_prefix" yaml:"lrn_prefix"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Authority owner who granted access; the grant lapses if the authority
+	// changes owner
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Optional time after which the grant no longer applies
+	ExpiryTime *time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" json:"expiry_time" yaml:"expiry_time"`
+}
+
+func (m *NameAccessGrant) Reset()         { *m = NameAccessGrant{} }
+func (m *NameAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*NameAccessGrant) ProtoMessage()    {}
+func (*NameAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d792f2373089b5b9, []int{6}
+}
+func (m *NameAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameAccessGrant.Merge(m, src)
+}
+func (m *NameAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameAccessGrant proto.InternalMessageInfo
+
+func (m *NameAccessGrant) GetLrnPrefix() string {
+	if m != nil {
+		return m.LrnPrefix
+	}
+	return ""
+}
+
+func (m *NameAccessGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *NameAccessGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *NameAccessGrant) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 // NameEntry
 type NameEntry struct {
 	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -422,7 +745,7 @@ func (m *NameEntry) Reset()         { *m = NameEntry{} }
 func (m *NameEntry) String() string { return proto.CompactTextString(m) }
 func (*NameEntry) ProtoMessage()    {}
 func (*NameEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{4}
+	return fileDescriptor_d792f2373089b5b9, []int{7}
 }
 func (m *NameEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +798,7 @@ func (m *NameRecord) Reset()         { *m = NameRecord{} }
 func (m *NameRecord) String() string { return proto.CompactTextString(m) }
 func (*NameRecord) ProtoMessage()    {}
 func (*NameRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{5}
+	return fileDescriptor_d792f2373089b5b9, []int{8}
 }
 func (m *NameRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +851,7 @@ func (m *NameRecordEntry) Reset()         { *m = NameRecordEntry{} }
 func (m *NameRecordEntry) String() string { return proto.CompactTextString(m) }
 func (*NameRecordEntry) ProtoMessage()    {}
 func (*NameRecordEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{6}
+	return fileDescriptor_d792f2373089b5b9, []int{9}
 }
 func (m *NameRecordEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,13 +898,17 @@ func (m *NameRecordEntry) GetHeight() uint64 {
 type Signature struct {
 	Sig    string `protobuf:"bytes,1,opt,name=sig,proto3" json:"sig,omitempty" json:"sig" yaml:"sig"`
 	PubKey string `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" json:"pub_key" yaml:"pub_key"`
+	// Signature scheme: cosmos (default, amino encoded pub_key), eip191 (personal_sign)
+	// or eip712 (typed data); Ethereum signatures are hex encoded and pub_key is the
+	// (optional) expected signer address
+	Scheme string `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty" json:"scheme,omitempty" yaml:"scheme,omitempty"`
 }
 
 func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{7}
+	return fileDescriptor_d792f2373089b5b9, []int{10}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,6 +951,13 @@ func (m *Signature) GetPubKey() string {
 	return ""
 }
 
+func (m *Signature) GetScheme() string {
+	if m != nil {
+		return m.Scheme
+	}
+	return ""
+}
+
 // ExpiryQueue: record / authority expiry queue type
 // id:    expiry time
 // value: array of ids (record cids / authority names)
@@ -636,7 +970,7 @@ func (m *ExpiryQueue) Reset()         { *m = ExpiryQueue{} }
 func (m *ExpiryQueue) String() string { return proto.CompactTextString(m) }
 func (*ExpiryQueue) ProtoMessage()    {}
 func (*ExpiryQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{8}
+	return fileDescriptor_d792f2373089b5b9, []int{11}
 }
 func (m *ExpiryQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +1023,7 @@ func (m *RecordsList) Reset()         { *m = RecordsList{} }
 func (m *RecordsList) String() string { return proto.CompactTextString(m) }
 func (*RecordsList) ProtoMessage()    {}
 func (*RecordsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d792f2373089b5b9, []int{9}
+	return fileDescriptor_d792f2373089b5b9, []int{12}
 }
 func (m *RecordsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -725,101 +1059,243 @@ func (m *RecordsList) GetValue() []string {
 	return nil
 }
 
+// Schema defines a record type schema owned by a name authority
+type Schema struct {
+	// Record type name, matched against the `type` attribute of records.
+	RecordType string `protobuf:"bytes,1,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty" json:"record_type" yaml:"record_type"`
+	// Name authority that owns the schema.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Schema format (json or ipld).
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Schema definition (JSON Schema document or IPLD schema DSL).
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	// height at which the schema version was registered.
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// Schema version, starting at 1. Registered versions are immutable,
+	// registering the schema again adds a new version.
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *Schema) Reset()         { *m = Schema{} }
+func (m *Schema) String() string { return proto.CompactTextString(m) }
+func (*Schema) ProtoMessage()    {}
+func (*Schema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d792f2373089b5b9, []int{13}
+}
+func (m *Schema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schema.Merge(m, src)
+}
+func (m *Schema) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schema) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schema proto.InternalMessageInfo
+
+func (m *Schema) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
+	}
+	return ""
+}
+
+func (m *Schema) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *Schema) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Schema) GetDefinition() string {
+	if m != nil {
+		return m.Definition
+	}
+	return ""
+}
+
+func (m *Schema) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Schema) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cerc.registry.v1.Params")
 	proto.RegisterType((*Record)(nil), "cerc.registry.v1.Record")
+	proto.RegisterType((*RecordRent)(nil), "cerc.registry.v1.RecordRent")
 	proto.RegisterType((*AuthorityEntry)(nil), "cerc.registry.v1.AuthorityEntry")
 	proto.RegisterType((*NameAuthority)(nil), "cerc.registry.v1.NameAuthority")
+	proto.RegisterType((*AuthorityTransfer)(nil), "cerc.registry.v1.AuthorityTransfer")
+	proto.RegisterType((*NameAccessGrant)(nil), "cerc.registry.v1.NameAccessGrant")
 	proto.RegisterType((*NameEntry)(nil), "cerc.registry.v1.NameEntry")
 	proto.RegisterType((*NameRecord)(nil), "cerc.registry.v1.NameRecord")
 	proto.RegisterType((*NameRecordEntry)(nil), "cerc.registry.v1.NameRecordEntry")
 	proto.RegisterType((*Signature)(nil), "cerc.registry.v1.Signature")
 	proto.RegisterType((*ExpiryQueue)(nil), "cerc.registry.v1.ExpiryQueue")
 	proto.RegisterType((*RecordsList)(nil), "cerc.registry.v1.RecordsList")
+	proto.RegisterType((*Schema)(nil), "cerc.registry.v1.Schema")
 }
 
 func init() { proto.RegisterFile("cerc/registry/v1/registry.proto", fileDescriptor_d792f2373089b5b9) }
 
 var fileDescriptor_d792f2373089b5b9 = []byte{
-	// 1235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x36, 0x6d, 0x59, 0xb6, 0x8e, 0x62, 0xff, 0xc1, 0xfc, 0x4e, 0x22, 0xbb, 0x89, 0xe8, 0x28,
-	0x68, 0x93, 0x20, 0x30, 0x09, 0xc5, 0x28, 0x82, 0x24, 0x28, 0x50, 0xcb, 0x75, 0x0c, 0x37, 0xbd,
-	0x38, 0xe3, 0xac, 0x5a, 0x14, 0x02, 0x2f, 0x13, 0x79, 0x5a, 0x91, 0x14, 0xc8, 0xa1, 0x1a, 0x2d,
-	0x0b, 0x74, 0xd3, 0x9d, 0x97, 0x59, 0xf4, 0x0d, 0xba, 0xe8, 0x63, 0x34, 0xcb, 0x2c, 0xbb, 0x29,
-	0x5b, 0xd8, 0x40, 0x1f, 0x40, 0x4f, 0x50, 0x70, 0x66, 0x78, 0x95, 0x14, 0xb5, 0xc8, 0x8e, 0xe7,
-	0xf6, 0xcd, 0x77, 0xce, 0x9c, 0x33, 0x33, 0x04, 0xd5, 0x22, 0xbe, 0xa5, 0xfb, 0xa4, 0x47, 0x03,
-	0xe6, 0x8f, 0xf4, 0x61, 0x3b, 0xfd, 0xd6, 0x06, 0xbe, 0xc7, 0x3c, 0x74, 0x39, 0x76, 0xd0, 0x52,
-	0xe5, 0xb0, 0xbd, 0xd5, 0xec, 0x79, 0x5e, 0xaf, 0x4f, 0x74, 0x6e, 0x37, 0xc3, 0x17, 0xba, 0x1d,
-	0xfa, 0x06, 0xa3, 0x9e, 0x2b, 0x22, 0xb6, 0xd4, 0xb2, 0x9d, 0x51, 0x87, 0x04, 0xcc, 0x70, 0x06,
-	0xd2, 0x61, 0xa3, 0xe7, 0xf5, 0x3c, 0xfe, 0xa9, 0xc7, 0x5f, 0x52, 0xdb, 0xb4, 0xbc, 0xc0, 0xf1,
-	0x02, 0xdd, 0x34, 0x02, 0xa2, 0x0f, 0xdb, 0x26, 0x61, 0x46, 0x5b, 0xb7, 0x3c, 0x2a, 0x61, 0x5b,
-	0x7f, 0xac, 0x41, 0xf5, 0xd8, 0xf0, 0x0d, 0x27, 0x40, 0x14, 0xea, 0x3e, 0xb1, 0x3c, 0xdf, 0xee,
-	0xfa, 0xc4, 0x65, 0x0d, 0x65, 0x5b, 0xb9, 0x53, 0xbf, 0xbf, 0xa9, 0x09, 0x00, 0x2d, 0x06, 0xd0,
-	0x24, 0x80, 0xb6, 0xef, 0x51, 0xb7, 0xb3, 0xf3, 0x3a, 0x52, 0x17, 0xc6, 0x91, 0xfa, 0xfe, 0xb7,
-	0x81, 0xe7, 0x3e, 0x6a, 0xe5, 0x62, 0x5b, 0xdb, 0x23, 0xc3, 0xe9, 0x17, 0x55, 0x18, 0x84, 0x84,
-	0x89, 0xcb, 0xd0, 0x99, 0x02, 0x1b, 0x39, 0x63, 0x37, 0xc9, 0xb5, 0xb1, 0x28, 0x17, 0x15, 0xc9,
-	0x6a, 0x49, 0xb2, 0xda, 0x27, 0xd2, 0xa1, 0xb3, 0x2f, 0x17, 0x7d, 0x30, 0xb1, 0x68, 0x0a, 0x32,
-	0x65, 0xf5, 0xcc, 0xf6, 0xea, 0x4f, 0x55, 0xc1, 0x28, 0xa3, 0x92, 0x00, 0xa3, 0x10, 0xd6, 0x8d,
-	0x90, 0x9d, 0x7a, 0x3e, 0x65, 0x23, 0x51, 0x80, 0xa5, 0x79, 0x05, 0xd8, 0x95, 0x5c, 0xee, 0x09,
-	0x2e, 0xc5, 0xf0, 0x84, 0x45, 0x49, 0x8b, 0xd7, 0x52, 0x05, 0xaf, 0xc4, 0xcf, 0x0a, 0x5c, 0x2b,
-	0xba, 0x64, 0xc5, 0xa8, 0xcc, 0x2b, 0xc6, 0x91, 0x24, 0xf0, 0xd1, 0x34, 0x02, 0x13, 0xf5, 0x98,
-	0x65, 0xe6, 0x25, 0xb9, 0x52, 0xa0, 0x95, 0x56, 0xe5, 0x95, 0x02, 0x57, 0xb3, 0xb8, 0x9e, 0x6f,
-	0x58, 0xa4, 0x3b, 0x20, 0x3e, 0xf5, 0xec, 0xc6, 0xf2, 0x3c, 0x76, 0x87, 0x92, 0xdd, 0xe3, 0x32,
-	0xbb, 0x3c, 0xcc, 0x24, 0xb9, 0x82, 0x95, 0x73, 0xdb, 0x48, 0x8d, 0x87, 0xb1, 0xed, 0x98, 0x9b,
-	0xd0, 0x0f, 0x0a, 0x6c, 0x66, 0x51, 0x46, 0x68, 0xc5, 0x8b, 0x76, 0x89, 0x6b, 0x98, 0x7d, 0x62,
-	0x37, 0xaa, 0xdb, 0xca, 0x9d, 0xd5, 0xce, 0xc1, 0x38, 0x52, 0xf7, 0xca, 0xcb, 0x97, 0x5c, 0x27,
-	0x19, 0x94, 0x1d, 0x70, 0xb6, 0x43, 0x7b, 0xc2, 0x74, 0x20, 0x2c, 0xe8, 0x37, 0x05, 0xa6, 0xc4,
-	0x59, 0x9e, 0xe3, 0x50, 0x16, 0x64, 0x1b, 0xb9, 0x32, 0xaf, 0x54, 0x5d, 0x59, 0xaa, 0x93, 0x59,
-	0x5c, 0xcb, 0x90, 0xb3, 0x49, 0x4f, 0x78, 0xf2, 0x12, 0xaa, 0xe5, 0x0c, 0xf6, 0x85, 0x5b, 0xba,
-	0xd1, 0xd3, 0x33, 0xf1, 0xc9, 0x90, 0x18, 0xfd, 0x5c, 0x26, 0xab, 0xef, 0x9c, 0x49, 0x19, 0x72,
-	0x76, 0x26, 0x13, 0x9e, 0xd3, 0x33, 0xc1, 0xc2, 0x2d, 0xcd, 0xe4, 0x17, 0x05, 0xae, 0xcf, 0x2a,
-	0x4b, 0xf7, 0x05, 0x21, 0x8d, 0xda, 0xbc, 0xb9, 0xfe, 0x52, 0xe6, 0x70, 0xf8, 0xf6, 0xdd, 0x88,
-	0xc1, 0xe6, 0xed, 0x03, 0xf7, 0xc1, 0x9b, 0xd3, 0xab, 0xff, 0x84, 0x90, 0x19, 0x6c, 0x45, 0xea,
-	0x9c, 0x2d, 0xbc, 0x33, 0xdb, 0x0c, 0x6c, 0x5e, 0xad, 0x67, 0xb0, 0x15, 0x15, 0x8e, 0xd9, 0xfe,
-	0xaa, 0xc0, 0x8d, 0xc9, 0x60, 0x87, 0xba, 0xd4, 0x09, 0x9d, 0xae, 0x49, 0xed, 0x46, 0x7d, 0x1e,
-	0xdd, 0x67, 0x92, 0xee, 0xd1, 0x2c, 0xba, 0x39, 0xb4, 0xd9, 0x7c, 0xf3, 0x4e, 0x78, 0xab, 0x4c,
-	0xf8, 0x73, 0x61, 0xed, 0x50, 0xbb, 0xf5, 0x53, 0x05, 0xaa, 0x98, 0x9f, 0xf6, 0xe8, 0x36, 0x2c,
-	0x52, 0x9b, 0x5f, 0x6b, 0xb5, 0xce, 0xb5, 0x71, 0xa4, 0xfe, 0x5f, 0x30, 0xc8, 0x96, 0x89, 0xb1,
-	0x16, 0xa9, 0x8d, 0x1e, 0xc1, 0x8a, 0xe9, 0xb9, 0x76, 0x97, 0xda, 0xfc, 0x3e, 0xaa, 0x75, 0x6e,
-	0x8e, 0x23, 0xf5, 0x86, 0xf0, 0x96, 0x86, 0x24, 0x24, 0x11, 0x71, 0x35, 0xfe, 0x3a, 0xb2, 0xd1,
-	0xa7, 0x50, 0xb7, 0x7c, 0x62, 0x30, 0xd2, 0x8d, 0xef, 0x67, 0x7e, 0x87, 0xd4, 0x3a, 0x77, 0xb3,
-	0x5b, 0x32, 0x67, 0x4c, 0x30, 0xf2, 0x2a, 0x0c, 0x42, 0x7a, 0x4e, 0x1d, 0x12, 0x63, 0x91, 0x97,
-	0x03, 0xea, 0x8f, 0x04, 0x56, 0xa5, 0x8c, 0x95, 0x33, 0x26, 0x58, 0x79, 0x15, 0x06, 0x21, 0x71,
-	0xac, 0x06, 0xac, 0xd8, 0xa4, 0x4f, 0x18, 0x11, 0x07, 0xf7, 0x2a, 0x4e, 0x44, 0xf4, 0x00, 0xaa,
-	0xde, 0xf7, 0x2e, 0xf1, 0x83, 0x46, 0x75, 0x7b, 0xe9, 0x4e, 0xad, 0xa3, 0x8e, 0x23, 0xf5, 0x3d,
-	0xb1, 0x80, 0xd0, 0x27, 0xd8, 0x52, 0xc2, 0xd2, 0x1d, 0x1d, 0x02, 0x18, 0x8c, 0xf9, 0xd4, 0x0c,
-	0x19, 0x09, 0xf8, 0x19, 0x77, 0xa9, 0x73, 0x7b, 0x1c, 0xa9, 0xb7, 0xe4, 0xce, 0xa6, 0xb6, 0x74,
-	0x1b, 0x33, 0x0d, 0xce, 0x85, 0xa2, 0x5d, 0x58, 0x76, 0x0d, 0x87, 0x04, 0x8d, 0x55, 0x4e, 0xe0,
-	0xc6, 0x38, 0x52, 0x37, 0x05, 0x06, 0x57, 0x27, 0xe1, 0x42, 0xc0, 0xc2, 0x17, 0xb5, 0xa1, 0xc2,
-	0x46, 0x03, 0x31, 0xcd, 0x85, 0x98, 0x58, 0x9b, 0xc6, 0x08, 0x01, 0x73, 0xd7, 0xd6, 0xd7, 0xb0,
-	0xbe, 0x97, 0x74, 0xca, 0x81, 0xcb, 0xfc, 0x11, 0x42, 0x50, 0x89, 0xd1, 0x44, 0x53, 0x60, 0xfe,
-	0x8d, 0x3e, 0x84, 0x65, 0x12, 0x1b, 0xe5, 0x5b, 0x44, 0xd5, 0xca, 0x4f, 0x35, 0xed, 0x0b, 0xc3,
-	0x21, 0x29, 0x10, 0x16, 0xde, 0xad, 0xbf, 0x97, 0x60, 0xad, 0x60, 0x40, 0xdf, 0xc0, 0x65, 0x5e,
-	0xa9, 0xee, 0x20, 0x34, 0xfb, 0xd4, 0xea, 0x7e, 0x47, 0x46, 0xb2, 0xfb, 0x76, 0xc7, 0x91, 0xaa,
-	0xe7, 0x4a, 0x9c, 0xf3, 0x28, 0x14, 0x3b, 0xaf, 0xc7, 0xeb, 0x5c, 0x75, 0xcc, 0x35, 0x4f, 0xc9,
-	0x08, 0x61, 0x58, 0x13, 0x4e, 0x86, 0x6d, 0xfb, 0x24, 0x08, 0x64, 0xaf, 0xee, 0x8c, 0x23, 0xf5,
-	0x6e, 0x1e, 0x5b, 0x9a, 0x8b, 0xc0, 0x89, 0x12, 0x5f, 0xe2, 0xf2, 0x9e, 0x10, 0xd1, 0x55, 0xa8,
-	0x9e, 0x12, 0xda, 0x3b, 0x15, 0x8f, 0x9f, 0x0a, 0x96, 0x52, 0xac, 0x0f, 0x98, 0xc1, 0xc2, 0x40,
-	0x34, 0x21, 0x96, 0x12, 0x7a, 0x02, 0x90, 0x4c, 0x24, 0x15, 0x8d, 0x55, 0x2b, 0xb4, 0x40, 0x6a,
-	0xcb, 0x26, 0x39, 0xd5, 0xe0, 0x9a, 0x14, 0x8e, 0x0a, 0x13, 0x57, 0xfd, 0xaf, 0x13, 0xe7, 0x16,
-	0xa7, 0x44, 0xdc, 0xb5, 0x5b, 0x13, 0x37, 0xd4, 0xf3, 0xe4, 0xb9, 0xdc, 0x69, 0x17, 0xdf, 0xad,
-	0x73, 0xa6, 0xe8, 0x2c, 0xbe, 0x74, 0x72, 0x93, 0xd4, 0x3a, 0x81, 0x5a, 0xbc, 0xcf, 0xb3, 0x1b,
-	0xe8, 0x7e, 0xb1, 0x81, 0xae, 0x4f, 0x6f, 0x20, 0x71, 0x28, 0x25, 0xdd, 0xf3, 0xa3, 0x02, 0x90,
-	0x69, 0xd1, 0x43, 0xa8, 0xf6, 0x0d, 0x46, 0x82, 0xe4, 0x15, 0x7e, 0xf3, 0x6d, 0x18, 0x9c, 0x09,
-	0x96, 0x01, 0xe8, 0x31, 0xac, 0x9c, 0xd2, 0x80, 0x79, 0x7c, 0xfd, 0xa5, 0x7f, 0x17, 0x9b, 0x44,
-	0xb4, 0x1e, 0xc2, 0xff, 0x4a, 0x36, 0xb4, 0x9e, 0x9d, 0x9a, 0xfc, 0x70, 0xcc, 0x5a, 0x64, 0x31,
-	0xdf, 0x22, 0x2d, 0x06, 0xb5, 0x13, 0xda, 0x73, 0x0d, 0x16, 0xfa, 0x04, 0xdd, 0x83, 0xa5, 0x80,
-	0xf6, 0x64, 0xb7, 0x6f, 0x8e, 0x23, 0xf5, 0x8a, 0xa8, 0x75, 0x40, 0x7b, 0x49, 0x8d, 0xe3, 0x4f,
-	0x1c, 0x7b, 0xc5, 0x9b, 0x3f, 0x08, 0x4d, 0x3e, 0x1e, 0x13, 0xc7, 0xad, 0x34, 0x24, 0x41, 0x89,
-	0x88, 0xab, 0x83, 0xd0, 0x7c, 0x4a, 0x46, 0xad, 0x5d, 0xa8, 0x1f, 0xf0, 0xad, 0x79, 0x16, 0x92,
-	0x90, 0x4c, 0x90, 0xdd, 0x80, 0xe5, 0xa1, 0xd1, 0x0f, 0x09, 0x2f, 0x45, 0x0d, 0x0b, 0xa1, 0x75,
-	0x0b, 0xea, 0x22, 0xc3, 0xe0, 0x33, 0x1a, 0xb0, 0xcc, 0x49, 0xc9, 0x39, 0x75, 0x3e, 0x7e, 0x7d,
-	0xde, 0x54, 0xde, 0x9c, 0x37, 0x95, 0xbf, 0xce, 0x9b, 0xca, 0xd9, 0x45, 0x73, 0xe1, 0xcd, 0x45,
-	0x73, 0xe1, 0xf7, 0x8b, 0xe6, 0xc2, 0x57, 0x1f, 0xf4, 0x28, 0xd3, 0x86, 0xb6, 0xa9, 0x31, 0x4f,
-	0x8f, 0x4b, 0xbb, 0x43, 0x3d, 0xbd, 0x6f, 0x58, 0x9e, 0x4b, 0x2d, 0x5b, 0x7f, 0x99, 0xfe, 0xe9,
-	0x99, 0x55, 0xde, 0x7b, 0xbb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x50, 0xe6, 0xc7, 0xe7, 0x0d,
-	0x0e, 0x00, 0x00,
+	// 1975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0xea, 0x83, 0x12, 0x9f, 0xac, 0x0f, 0x8f, 0x9d, 0x78, 0x25, 0xdb, 0x5c, 0x99, 0x42,
+	0x1a, 0x07, 0xa9, 0x48, 0x28, 0x42, 0x10, 0x24, 0x41, 0x3f, 0x44, 0x47, 0x11, 0x94, 0xc4, 0x89,
+	0x32, 0x12, 0x0c, 0x34, 0x45, 0xbb, 0x58, 0x72, 0x47, 0xd4, 0x24, 0xdc, 0x5d, 0x62, 0x77, 0x48,
+	0x8b, 0xb9, 0x15, 0xcd, 0xa1, 0x40, 0x5b, 0xc0, 0xc7, 0x1c, 0xfa, 0x07, 0x14, 0xe8, 0xa1, 0x7f,
+	0x43, 0x4f, 0xcd, 0x31, 0xc7, 0x1e, 0x0a, 0xb6, 0xb0, 0xaf, 0x3d, 0xf1, 0xd6, 0xf6, 0x52, 0xcc,
+	0xd7, 0xee, 0xec, 0x92, 0x14, 0xd3, 0xf8, 0xb6, 0xef, 0x73, 0x7e, 0xf3, 0xe6, 0xcd, 0x7b, 0x6f,
+	0x16, 0x9c, 0x16, 0x89, 0x5b, 0xf5, 0x98, 0xb4, 0x69, 0xc2, 0xe2, 0x41, 0xbd, 0xbf, 0x97, 0x7e,
+	0xd7, 0xba, 0x71, 0xc4, 0x22, 0xb4, 0xc1, 0x15, 0x6a, 0x29, 0xb3, 0xbf, 0xb7, 0x55, 0x69, 0x47,
+	0x51, 0xbb, 0x43, 0xea, 0x42, 0xde, 0xec, 0x9d, 0xd7, 0xfd, 0x5e, 0xec, 0x31, 0x1a, 0x85, 0xd2,
+	0x62, 0xcb, 0x29, 0xca, 0x19, 0x0d, 0x48, 0xc2, 0xbc, 0xa0, 0xab, 0x14, 0x6e, 0xb5, 0xa3, 0x76,
+	0x24, 0x3e, 0xeb, 0xfc, 0x4b, 0x71, 0x2b, 0xad, 0x28, 0x09, 0xa2, 0xa4, 0xde, 0xf4, 0x12, 0x52,
+	0xef, 0xef, 0x35, 0x09, 0xf3, 0xf6, 0xea, 0xad, 0x88, 0x2a, 0xb7, 0xd5, 0xff, 0xdc, 0x84, 0xd2,
+	0x89, 0x17, 0x7b, 0x41, 0x82, 0x28, 0xac, 0xc4, 0xa4, 0x15, 0xc5, 0xbe, 0x1b, 0x93, 0x90, 0xd9,
+	0xd6, 0xb6, 0xf5, 0x60, 0xe5, 0x8d, 0xcd, 0x9a, 0x74, 0x50, 0xe3, 0x0e, 0x6a, 0xca, 0x41, 0xed,
+	0x61, 0x44, 0xc3, 0xc6, 0xee, 0x37, 0x43, 0xe7, 0xda, 0x68, 0xe8, 0xbc, 0xf2, 0x79, 0x12, 0x85,
+	0xef, 0x54, 0x0d, 0xdb, 0xea, 0xf6, 0xc0, 0x0b, 0x3a, 0x79, 0x16, 0x06, 0x49, 0x61, 0x12, 0x32,
+	0xf4, 0xd4, 0x82, 0x5b, 0x86, 0xd0, 0xd5, 0x7b, 0xb5, 0xe7, 0xd4, 0xa2, 0x72, 0xb3, 0x35, 0xbd,
+	0xd9, 0xda, 0x7b, 0x4a, 0xa1, 0xf1, 0x50, 0x2d, 0xfa, 0xd6, 0xd8, 0xa2, 0xa9, 0x93, 0x09, 0xab,
+	0x67, 0xb2, 0xaf, 0xff, 0xe1, 0x58, 0x18, 0x65, 0x50, 0xb4, 0x63, 0xd4, 0x83, 0x35, 0xaf, 0xc7,
+	0x2e, 0xa2, 0x98, 0xb2, 0x81, 0x0c, 0xc0, 0xfc, 0xac, 0x00, 0xec, 0x2b, 0x2c, 0xaf, 0x4b, 0x2c,
+	0x79, 0x73, 0x8d, 0xa2, 0xc0, 0xc5, 0xab, 0x29, 0x43, 0x44, 0xe2, 0x0f, 0x16, 0xdc, 0xce, 0xab,
+	0x64, 0xc1, 0x58, 0x98, 0x15, 0x8c, 0x63, 0x05, 0xe0, 0x47, 0x93, 0x00, 0x8c, 0xc5, 0x63, 0x9a,
+	0x58, 0x84, 0xe4, 0xa5, 0x1c, 0xac, 0x34, 0x2a, 0x5f, 0x5b, 0xf0, 0x72, 0x66, 0xd7, 0x8e, 0xbd,
+	0x16, 0x71, 0xbb, 0x24, 0xa6, 0x91, 0x6f, 0x2f, 0xce, 0x42, 0x77, 0xa4, 0xd0, 0xbd, 0x5b, 0x44,
+	0x67, 0xba, 0x19, 0x07, 0x97, 0x93, 0x0a, 0x6c, 0xb7, 0x52, 0xe1, 0x11, 0x97, 0x9d, 0x08, 0x11,
+	0xfa, 0x95, 0x05, 0x9b, 0x99, 0x95, 0xd7, 0x6b, 0xf1, 0x45, 0x5d, 0x12, 0x7a, 0xcd, 0x0e, 0xf1,
+	0xed, 0xd2, 0xb6, 0xf5, 0x60, 0xb9, 0x71, 0x38, 0x1a, 0x3a, 0x07, 0xc5, 0xe5, 0x0b, 0xaa, 0xe3,
+	0x08, 0x8a, 0x0a, 0x38, 0x3b, 0xa1, 0x03, 0x29, 0x3a, 0x94, 0x12, 0xf4, 0x57, 0x0b, 0x26, 0xd8,
+	0xb5, 0xa2, 0x20, 0xa0, 0x2c, 0xc9, 0x0e, 0x72, 0x69, 0x56, 0xa8, 0x5c, 0x15, 0xaa, 0xd3, 0x69,
+	0x58, 0x8b, 0x2e, 0xa7, 0x83, 0x1e, 0xd3, 0x14, 0x21, 0x74, 0x8a, 0x3b, 0x78, 0x28, 0xd5, 0xd2,
+	0x83, 0x9e, 0xbc, 0x93, 0x98, 0xf4, 0x89, 0xd7, 0x31, 0x76, 0xb2, 0xfc, 0xc2, 0x3b, 0x29, 0xba,
+	0x9c, 0xbe, 0x93, 0x31, 0xcd, 0xc9, 0x3b, 0xc1, 0x52, 0x2d, 0xdd, 0xc9, 0x9f, 0x2c, 0xb8, 0x3b,
+	0x2d, 0x2c, 0xee, 0x39, 0x21, 0x76, 0x79, 0xd6, 0xbd, 0xfe, 0x44, 0xed, 0xe1, 0xe8, 0xea, 0xd3,
+	0xe0, 0xce, 0x66, 0x9d, 0x83, 0xd0, 0xc1, 0x9b, 0x93, 0xa3, 0xff, 0x3e, 0x21, 0x53, 0xd0, 0xca,
+	0xad, 0x0b, 0xb4, 0xf0, 0xc2, 0x68, 0x33, 0x67, 0xb3, 0x62, 0x3d, 0x05, 0xad, 0x8c, 0x30, 0x47,
+	0xfb, 0x67, 0x0b, 0xee, 0x8d, 0x1b, 0x07, 0x34, 0xa4, 0x41, 0x2f, 0x70, 0x9b, 0xd4, 0xb7, 0x57,
+	0x66, 0xc1, 0xfd, 0x54, 0xc1, 0x3d, 0x9e, 0x06, 0xd7, 0xf0, 0x36, 0x1d, 0xaf, 0xa9, 0x84, 0xb7,
+	0x8a, 0x80, 0x1f, 0x49, 0x69, 0x83, 0xfa, 0xe8, 0x77, 0x16, 0xdc, 0x0c, 0xbc, 0x4b, 0x57, 0x35,
+	0x83, 0x0e, 0x3d, 0x27, 0xbc, 0x71, 0xda, 0xd7, 0x67, 0x25, 0xf2, 0x81, 0xc2, 0xf9, 0xa6, 0xc4,
+	0x39, 0xc1, 0x87, 0x46, 0x37, 0x49, 0x24, 0x52, 0xf5, 0x46, 0xe0, 0x5d, 0x62, 0x21, 0xf8, 0x48,
+	0xf1, 0xd1, 0x6f, 0x0b, 0x8d, 0xaf, 0x4b, 0x62, 0xb7, 0x39, 0x60, 0xc4, 0x5e, 0x9d, 0x15, 0xb7,
+	0x9f, 0x4c, 0x6f, 0x7c, 0xda, 0xc9, 0xa4, 0xc6, 0x97, 0xca, 0xf0, 0x8d, 0xac, 0xe9, 0x9d, 0x90,
+	0xb8, 0x31, 0x60, 0x04, 0x7d, 0x65, 0xc1, 0x96, 0x81, 0xde, 0x63, 0x2c, 0xa6, 0xcd, 0x1e, 0x23,
+	0x89, 0x9b, 0xd0, 0x2f, 0x89, 0xbd, 0xb6, 0x6d, 0x3d, 0x58, 0x68, 0x1c, 0x8d, 0x86, 0xce, 0xc3,
+	0xb1, 0x20, 0x14, 0x74, 0x27, 0xc4, 0xa2, 0xa8, 0x81, 0x6f, 0xa7, 0xe1, 0x38, 0x48, 0x45, 0xa7,
+	0xf4, 0x4b, 0x82, 0x7e, 0x63, 0xc1, 0x9d, 0xc9, 0x86, 0x3e, 0xe9, 0xb2, 0x0b, 0x7b, 0x5d, 0xe0,
+	0x38, 0x1e, 0x0d, 0x9d, 0xc3, 0xab, 0x70, 0x08, 0xe5, 0xab, 0x81, 0x48, 0x15, 0x6c, 0x4f, 0x40,
+	0xf2, 0x1e, 0x17, 0xf1, 0xc1, 0xe4, 0x9e, 0x61, 0x4a, 0x43, 0x9f, 0x5c, 0x12, 0xd3, 0x85, 0xbd,
+	0x21, 0xc0, 0x3c, 0xca, 0x32, 0xf8, 0x4a, 0xf5, 0x09, 0x70, 0x26, 0x28, 0xe1, 0xad, 0x14, 0xd0,
+	0xb1, 0x94, 0x66, 0xb8, 0xd0, 0xcf, 0x60, 0x9d, 0x5b, 0x27, 0xad, 0x0b, 0x12, 0x78, 0xf2, 0x60,
+	0x6e, 0x08, 0x0c, 0x7b, 0xa3, 0xa1, 0xb3, 0x9b, 0x61, 0x30, 0x14, 0xcc, 0x55, 0x4d, 0x36, 0x5e,
+	0x0d, 0xbc, 0xcb, 0x53, 0xc1, 0xe0, 0x81, 0xaf, 0xfe, 0x7e, 0x09, 0x4a, 0x72, 0x59, 0xf4, 0x2a,
+	0xcc, 0x51, 0x5f, 0xcc, 0x7c, 0xe5, 0xc6, 0xed, 0xd1, 0xd0, 0xb9, 0x29, 0x1d, 0x67, 0x77, 0x90,
+	0x5f, 0xb4, 0x39, 0xea, 0xa3, 0x77, 0x60, 0xa9, 0x19, 0x85, 0xbe, 0x4b, 0x7d, 0x31, 0xac, 0x95,
+	0x1b, 0xf7, 0x47, 0x43, 0xe7, 0x9e, 0xd4, 0x56, 0x02, 0x6d, 0xa2, 0x49, 0x5c, 0xe2, 0x5f, 0xc7,
+	0x3e, 0xfa, 0x00, 0x56, 0x5a, 0x31, 0xf1, 0x18, 0x71, 0xc5, 0x1d, 0x9c, 0x17, 0xf6, 0xaf, 0x65,
+	0x23, 0xa4, 0x21, 0xd4, 0x3e, 0x4c, 0x16, 0x06, 0x49, 0x9d, 0xf1, 0x9b, 0xf4, 0x01, 0xac, 0x90,
+	0xcb, 0x2e, 0x8d, 0x07, 0xd2, 0xd7, 0x42, 0xd1, 0x97, 0x21, 0xd4, 0xbe, 0x4c, 0x16, 0x06, 0x49,
+	0x09, 0x5f, 0x36, 0x2c, 0xf9, 0xa4, 0x43, 0x18, 0x91, 0x53, 0xcd, 0x32, 0xd6, 0x24, 0x7a, 0x0b,
+	0x4a, 0xd1, 0x93, 0x90, 0xc4, 0x89, 0x5d, 0xda, 0x9e, 0x7f, 0x50, 0x6e, 0x38, 0xa3, 0xa1, 0x73,
+	0x47, 0x2e, 0x20, 0xf9, 0xda, 0xb7, 0xa2, 0xb0, 0x52, 0x47, 0x47, 0x00, 0x46, 0xd2, 0xf0, 0x01,
+	0xe0, 0x7a, 0xe3, 0xd5, 0xd1, 0xd0, 0xd9, 0x91, 0xc6, 0xe3, 0x19, 0x62, 0xa6, 0x83, 0x61, 0x8a,
+	0xf6, 0x61, 0x31, 0xf4, 0x02, 0x92, 0xd8, 0xcb, 0x02, 0xc0, 0xbd, 0xd1, 0xd0, 0xd9, 0x94, 0x3e,
+	0x04, 0x5b, 0x9b, 0x4b, 0x02, 0x4b, 0x5d, 0xb4, 0x07, 0x0b, 0x6c, 0xd0, 0x95, 0xad, 0x2e, 0x67,
+	0xc3, 0xb9, 0xa9, 0x8d, 0x24, 0xb0, 0x50, 0xe5, 0xf1, 0xec, 0xc6, 0xa4, 0x4f, 0xa3, 0x5e, 0xc2,
+	0xcf, 0x16, 0x8a, 0xf1, 0x34, 0x84, 0xda, 0xde, 0x64, 0x61, 0xd0, 0xd4, 0xb1, 0x8f, 0x3e, 0x83,
+	0x75, 0x11, 0x06, 0x97, 0x5d, 0xc4, 0x24, 0xb9, 0x88, 0x3a, 0xb2, 0x2f, 0xac, 0x9a, 0x29, 0x5b,
+	0x50, 0xc8, 0xc5, 0xd1, 0x60, 0xe3, 0x35, 0xc1, 0x39, 0xd3, 0x0c, 0xf4, 0x18, 0xd6, 0x54, 0x4a,
+	0xf7, 0x49, 0x9c, 0xf0, 0x99, 0xe4, 0xba, 0xb8, 0x0d, 0xf5, 0x6c, 0x10, 0xcf, 0xcb, 0xb5, 0xe7,
+	0x02, 0x17, 0xaf, 0x4a, 0xc6, 0x63, 0x49, 0xa3, 0x47, 0xb0, 0x20, 0xa6, 0x7e, 0x59, 0x88, 0xef,
+	0xd6, 0x8a, 0x0f, 0xb4, 0x1a, 0x4e, 0xcb, 0x67, 0xe3, 0xce, 0x68, 0xe8, 0xdc, 0xd6, 0x75, 0xd8,
+	0x7c, 0xee, 0xf0, 0x01, 0x5f, 0xb8, 0x11, 0xc7, 0x16, 0x85, 0x2d, 0x5d, 0x44, 0xcd, 0x63, 0xe3,
+	0xec, 0xf4, 0xd8, 0x04, 0x81, 0xa5, 0x6e, 0xf5, 0x8f, 0x73, 0x00, 0xd9, 0x32, 0xe8, 0x31, 0x94,
+	0xbc, 0x20, 0xea, 0x7d, 0x97, 0xb7, 0xd8, 0x8e, 0xea, 0x0e, 0x2a, 0x37, 0xa5, 0x59, 0x9a, 0x5a,
+	0x92, 0xc2, 0xca, 0xdb, 0x0b, 0x5d, 0xe1, 0xf7, 0x01, 0x12, 0xe6, 0xc5, 0xcc, 0xbc, 0xc1, 0x46,
+	0x5e, 0x67, 0xb2, 0x34, 0xec, 0x19, 0x07, 0x97, 0x05, 0x21, 0xae, 0xdc, 0x8f, 0x61, 0x99, 0x84,
+	0xbe, 0x79, 0x77, 0x77, 0x46, 0x43, 0xc7, 0x51, 0x77, 0x37, 0xf4, 0x73, 0x3e, 0x52, 0x1a, 0x2f,
+	0x91, 0xd0, 0xe7, 0xf6, 0xd5, 0x9f, 0xc3, 0xda, 0x81, 0xee, 0xfa, 0x87, 0x21, 0x8b, 0x07, 0x08,
+	0xc1, 0x02, 0x4f, 0x7e, 0x59, 0xc3, 0xb0, 0xf8, 0x46, 0x6f, 0xc2, 0x22, 0xe1, 0x42, 0xf5, 0xae,
+	0x74, 0xc6, 0x4f, 0xf5, 0x63, 0x2f, 0x20, 0xa9, 0x23, 0x2c, 0xb5, 0xab, 0xff, 0x5a, 0x80, 0xd5,
+	0x9c, 0x00, 0xfd, 0x02, 0x36, 0x64, 0x66, 0x76, 0x7b, 0xcd, 0x0e, 0x6d, 0xb9, 0x5f, 0x90, 0x81,
+	0x2a, 0x96, 0xfb, 0xa3, 0xa1, 0x53, 0x37, 0x53, 0x3a, 0xd3, 0xc8, 0xe7, 0xb4, 0xc1, 0x57, 0x49,
+	0x7d, 0x22, 0x38, 0x1f, 0x92, 0x01, 0xc2, 0xb0, 0x2a, 0x95, 0x3c, 0xdf, 0x8f, 0x49, 0x92, 0xa8,
+	0x73, 0xd9, 0x1d, 0x0d, 0x9d, 0xd7, 0x4c, 0xdf, 0x4a, 0x9c, 0x77, 0xac, 0x99, 0xf8, 0xba, 0xa0,
+	0x0f, 0x24, 0x89, 0x5e, 0x86, 0xd2, 0x05, 0xa1, 0xed, 0x0b, 0xf9, 0x90, 0x5d, 0xc0, 0x8a, 0xe2,
+	0xfc, 0x84, 0x79, 0xac, 0x97, 0xc8, 0xb8, 0x63, 0x45, 0xf1, 0x93, 0xd5, 0xd3, 0x15, 0x95, 0x75,
+	0x30, 0x77, 0xb2, 0x99, 0x2c, 0x9b, 0xca, 0x52, 0x0e, 0x2e, 0x2b, 0xe2, 0x38, 0xd7, 0x20, 0x4a,
+	0xff, 0x6f, 0x76, 0x85, 0xf9, 0xa2, 0x2e, 0xdf, 0x4d, 0x5b, 0x63, 0x43, 0xda, 0x99, 0xfe, 0xf5,
+	0xd1, 0xd8, 0xcb, 0xff, 0x83, 0x98, 0x51, 0xf4, 0x9f, 0xf2, 0xa9, 0xcc, 0x2c, 0xfc, 0xbf, 0xb6,
+	0x60, 0xa3, 0x4b, 0x42, 0x9f, 0x86, 0x6d, 0x97, 0xc5, 0x5e, 0x98, 0x9c, 0x93, 0x58, 0xbd, 0x71,
+	0x76, 0xc6, 0x73, 0x25, 0x4d, 0x87, 0x33, 0xa5, 0x6a, 0x1e, 0x7e, 0xd1, 0x4d, 0x5a, 0x24, 0x8b,
+	0x7c, 0xbc, 0xae, 0x58, 0xda, 0x4b, 0xf5, 0xbf, 0x16, 0xdc, 0x18, 0xf3, 0x8d, 0x1a, 0x50, 0x0e,
+	0xc9, 0x13, 0x57, 0x9c, 0xa9, 0xca, 0xb5, 0x57, 0x46, 0x43, 0xe7, 0xbe, 0xaa, 0x22, 0x5a, 0x94,
+	0x56, 0x92, 0x94, 0x81, 0x97, 0x43, 0xf2, 0xe4, 0x93, 0x27, 0xa1, 0xf4, 0xf1, 0x05, 0x21, 0x5d,
+	0x97, 0x87, 0x57, 0xe4, 0xd4, 0xb2, 0xe9, 0x23, 0x15, 0x69, 0x1f, 0x19, 0x03, 0x2f, 0xf3, 0xef,
+	0x46, 0x14, 0xfa, 0xe8, 0x97, 0xb0, 0x41, 0xc3, 0xbe, 0xd7, 0xa1, 0x3e, 0x6f, 0xc4, 0xb2, 0x17,
+	0xcd, 0x0b, 0x57, 0xc6, 0xee, 0x8b, 0x1a, 0xda, 0xe3, 0x18, 0x1f, 0xaf, 0x67, 0xac, 0x8f, 0x05,
+	0xe7, 0xdf, 0x16, 0xac, 0x8b, 0xcb, 0xd6, 0x6a, 0x91, 0x24, 0x39, 0x8a, 0xbd, 0x90, 0xf1, 0x5c,
+	0xec, 0xc4, 0xa1, 0xdb, 0x8d, 0xc9, 0x39, 0xbd, 0xb4, 0xad, 0x62, 0x2e, 0x66, 0x32, 0xbd, 0x8e,
+	0xc1, 0xc1, 0xe5, 0x4e, 0x1c, 0x9e, 0x88, 0x6f, 0xde, 0xd8, 0xdb, 0xdc, 0x21, 0x21, 0xf2, 0x46,
+	0x61, 0x4d, 0x66, 0x92, 0xd8, 0x9e, 0x37, 0x25, 0x31, 0xfa, 0x7c, 0x7c, 0xb0, 0xb8, 0x3a, 0x07,
+	0x77, 0xbf, 0x77, 0xfe, 0x55, 0x4f, 0xa1, 0xcc, 0xb7, 0x3e, 0xbd, 0x80, 0xbd, 0x91, 0x2f, 0x60,
+	0x77, 0x27, 0x17, 0x30, 0xd5, 0x33, 0xa4, 0x6a, 0xf5, 0x2b, 0x0b, 0x20, 0xe3, 0xa2, 0xb7, 0xa1,
+	0xd4, 0xf1, 0x18, 0x49, 0x74, 0x17, 0xb9, 0x7f, 0x95, 0x0f, 0x81, 0x04, 0x2b, 0x03, 0xf4, 0x2e,
+	0x2c, 0x5d, 0xd0, 0x84, 0x45, 0x62, 0xfd, 0xf9, 0xef, 0x66, 0xab, 0x2d, 0xaa, 0x6f, 0xc3, 0x7a,
+	0x41, 0x86, 0xd6, 0xb2, 0x21, 0x53, 0xcc, 0x92, 0x59, 0x89, 0x9a, 0x33, 0x4b, 0x54, 0xf5, 0x2f,
+	0x16, 0x94, 0x4f, 0x69, 0x3b, 0xf4, 0x58, 0x2f, 0x26, 0xe8, 0x75, 0x98, 0x4f, 0x68, 0x5b, 0x65,
+	0xc1, 0xe6, 0x68, 0xe8, 0xbc, 0xa4, 0x7a, 0x0d, 0x6d, 0xa7, 0x4d, 0x86, 0xb6, 0xab, 0x98, 0x6b,
+	0xf1, 0xea, 0xd3, 0xed, 0x35, 0x45, 0x7d, 0x1e, 0xeb, 0x6d, 0x4a, 0x90, 0xde, 0x4c, 0x45, 0xe2,
+	0x52, 0xb7, 0xd7, 0xe4, 0x55, 0xf8, 0x43, 0x28, 0x89, 0x99, 0x40, 0xf7, 0x35, 0x23, 0xbf, 0x25,
+	0xff, 0x87, 0x51, 0x40, 0x19, 0x09, 0xba, 0x6c, 0x90, 0x1b, 0x2a, 0x4c, 0x3e, 0x56, 0x2e, 0xaa,
+	0xfb, 0xb0, 0x72, 0x28, 0x0e, 0xfa, 0xd3, 0x1e, 0xe9, 0x91, 0xb1, 0xad, 0xdf, 0x82, 0xc5, 0xbe,
+	0xd7, 0xe9, 0x11, 0x11, 0xd8, 0x32, 0x96, 0x44, 0x75, 0x07, 0x56, 0x64, 0xbc, 0x92, 0x8f, 0x68,
+	0xc2, 0x32, 0x25, 0xcb, 0x54, 0xfa, 0xbb, 0x05, 0x25, 0x39, 0xc4, 0xf3, 0xa1, 0x4d, 0xbd, 0x2a,
+	0xc4, 0xb8, 0x67, 0x15, 0x87, 0x36, 0x43, 0x58, 0x78, 0x1c, 0x0a, 0x96, 0xfe, 0x27, 0x7b, 0xc6,
+	0x07, 0xc0, 0xbb, 0x50, 0x4e, 0xdf, 0xd1, 0xea, 0xb6, 0x64, 0x0c, 0x7e, 0x54, 0xe7, 0x51, 0x1c,
+	0x78, 0x4c, 0x5d, 0x17, 0x45, 0xa1, 0x0a, 0x80, 0x4f, 0xce, 0x69, 0x48, 0xd3, 0x3f, 0x96, 0x65,
+	0x6c, 0x70, 0x8c, 0x23, 0x5e, 0xcc, 0x75, 0x21, 0x1b, 0x96, 0xf4, 0xfc, 0x56, 0x12, 0x02, 0x4d,
+	0x36, 0x7e, 0xfa, 0xcd, 0xb3, 0x8a, 0xf5, 0xed, 0xb3, 0x8a, 0xf5, 0xcf, 0x67, 0x15, 0xeb, 0xe9,
+	0xf3, 0xca, 0xb5, 0x6f, 0x9f, 0x57, 0xae, 0xfd, 0xed, 0x79, 0xe5, 0xda, 0x67, 0x3f, 0x68, 0x53,
+	0x56, 0xeb, 0xfb, 0xcd, 0x1a, 0x8b, 0xea, 0x3c, 0x0f, 0x77, 0x69, 0x54, 0xef, 0x78, 0xad, 0x28,
+	0xa4, 0x2d, 0xbf, 0x7e, 0x99, 0xfe, 0x62, 0x6f, 0x96, 0xc4, 0x25, 0xdd, 0xff, 0xdf, 0x00, 0xa2,
+	0xb6, 0x83, 0xf2, 0x86, 0x17, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -842,12 +1318,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AuthorityAuctionMinimumBid.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if m.MaxSchemaSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.MaxSchemaSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxRecordIndexedAttributes != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.MaxRecordIndexedAttributes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxRecordAttributesDepth != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.MaxRecordAttributesDepth))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxRecordAttributesSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.MaxRecordAttributesSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.RecordRentPerByte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxRecordLifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRecordLifetime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRegistry(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.AuthorityAuctionMinimumBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 		i = encodeVarintRegistry(dAtA, i, uint64(size))
 	}
 	i--
@@ -872,20 +1390,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x4a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityAuctionRevealsDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityAuctionRevealsDuration):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityAuctionRevealsDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityAuctionRevealsDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintRegistry(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintRegistry(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityAuctionCommitsDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityAuctionCommitsDuration):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityAuctionCommitsDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityAuctionCommitsDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintRegistry(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintRegistry(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x3a
 	if m.AuthorityAuctionEnabled {
@@ -898,20 +1416,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityGracePeriod):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityGracePeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintRegistry(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintRegistry(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x2a
-	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityRentDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityRentDuration):])
-	if err7 != nil {
-		return 0, err7
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorityRentDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorityRentDuration):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n7
-	i = encodeVarintRegistry(dAtA, i, uint64(n7))
+	i -= n9
+	i = encodeVarintRegistry(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x22
 	{
@@ -924,12 +1442,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordRentDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordRentDuration):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordRentDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordRentDuration):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintRegistry(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintRegistry(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -965,6 +1483,40 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Rent != nil {
+		{
+			size, err := m.Rent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRegistry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.SchemaVersion != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OwnerThreshold != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.OwnerThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PreviousId) > 0 {
+		i -= len(m.PreviousId)
+		copy(dAtA[i:], m.PreviousId)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.PreviousId)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.Type) > 0 {
 		i -= len(m.Type)
 		copy(dAtA[i:], m.Type)
@@ -1038,6 +1590,60 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordRent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordRent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordRent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndTime) > 0 {
+		i -= len(m.EndTime)
+		copy(dAtA[i:], m.EndTime)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.EndTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartTime) > 0 {
+		i -= len(m.StartTime)
+		copy(dAtA[i:], m.StartTime)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.StartTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BondId) > 0 {
+		i -= len(m.BondId)
+		copy(dAtA[i:], m.BondId)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.BondId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRegistry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AuthorityEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1100,12 +1706,24 @@ func (m *NameAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
-	if err12 != nil {
-		return 0, err12
+	if m.PendingTransfer != nil {
+		{
+			size, err := m.PendingTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRegistry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n12
-	i = encodeVarintRegistry(dAtA, i, uint64(n12))
+	i -= n17
+	i = encodeVarintRegistry(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BondId) > 0 {
@@ -1151,6 +1769,110 @@ func (m *NameAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuthorityTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorityTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorityTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidateNames {
+		i--
+		if m.InvalidateNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.KeepBond {
+		i--
+		if m.KeepBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameAccessGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameAccessGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTime != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintRegistry(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LrnPrefix) > 0 {
+		i -= len(m.LrnPrefix)
+		copy(dAtA[i:], m.LrnPrefix)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.LrnPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NameEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1297,6 +2019,13 @@ func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Scheme) > 0 {
+		i -= len(m.Scheme)
+		copy(dAtA[i:], m.Scheme)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Scheme)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PubKey) > 0 {
 		i -= len(m.PubKey)
 		copy(dAtA[i:], m.PubKey)
@@ -1385,6 +2114,67 @@ func (m *RecordsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Schema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRegistry(v)
 	base := offset
@@ -1425,6 +2215,22 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovRegistry(uint64(l))
 	l = m.AuthorityAuctionMinimumBid.Size()
 	n += 1 + l + sovRegistry(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRecordLifetime)
+	n += 1 + l + sovRegistry(uint64(l))
+	l = m.RecordRentPerByte.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	if m.MaxRecordAttributesSize != 0 {
+		n += 1 + sovRegistry(uint64(m.MaxRecordAttributesSize))
+	}
+	if m.MaxRecordAttributesDepth != 0 {
+		n += 1 + sovRegistry(uint64(m.MaxRecordAttributesDepth))
+	}
+	if m.MaxRecordIndexedAttributes != 0 {
+		n += 2 + sovRegistry(uint64(m.MaxRecordIndexedAttributes))
+	}
+	if m.MaxSchemaSize != 0 {
+		n += 2 + sovRegistry(uint64(m.MaxSchemaSize))
+	}
 	return n
 }
 
@@ -1473,33 +2279,73 @@ func (m *Record) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
-	return n
-}
-
-func (m *AuthorityEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
+	l = len(m.PreviousId)
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
-	if m.Entry != nil {
-		l = m.Entry.Size()
+	if m.OwnerThreshold != 0 {
+		n += 1 + sovRegistry(uint64(m.OwnerThreshold))
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovRegistry(uint64(m.SchemaVersion))
+	}
+	if m.Rent != nil {
+		l = m.Rent.Size()
 		n += 1 + l + sovRegistry(uint64(l))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovRegistry(uint64(m.Nonce))
+	}
 	return n
 }
 
-func (m *NameAuthority) Size() (n int) {
+func (m *RecordRent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.OwnerPublicKey)
+	l = m.Amount.Size()
+	n += 1 + l + sovRegistry(uint64(l))
+	l = len(m.BondId)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.StartTime)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.EndTime)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorityEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *NameAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerPublicKey)
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
@@ -1524,6 +2370,54 @@ func (m *NameAuthority) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
 	n += 1 + l + sovRegistry(uint64(l))
+	if m.PendingTransfer != nil {
+		l = m.PendingTransfer.Size()
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorityTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.KeepBond {
+		n += 2
+	}
+	if m.InvalidateNames {
+		n += 2
+	}
+	return n
+}
+
+func (m *NameAccessGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LrnPrefix)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovRegistry(uint64(l))
+	}
 	return n
 }
 
@@ -1593,6 +2487,10 @@ func (m *Signature) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegistry(uint64(l))
 	}
+	l = len(m.Scheme)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
 	return n
 }
 
@@ -1630,6 +2528,37 @@ func (m *RecordsList) Size() (n int) {
 	return n
 }
 
+func (m *Schema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRegistry(uint64(m.Height))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRegistry(uint64(m.Version))
+	}
+	return n
+}
+
 func sovRegistry(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2015,6 +2944,148 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxRecordLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRentPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordRentPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordAttributesSize", wireType)
+			}
+			m.MaxRecordAttributesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordAttributesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordAttributesDepth", wireType)
+			}
+			m.MaxRecordAttributesDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordAttributesDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordIndexedAttributes", wireType)
+			}
+			m.MaxRecordIndexedAttributes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordIndexedAttributes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchemaSize", wireType)
+			}
+			m.MaxSchemaSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchemaSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -2343,28 +3414,153 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRegistry(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			m.PreviousId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+			}
+			m.OwnerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rent == nil {
+				m.Rent = &RecordRent{}
+			}
+			if err := m.Rent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
+func (m *RecordRent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2387,15 +3583,48 @@ func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AuthorityEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordRent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AuthorityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordRent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2423,13 +3652,13 @@ func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BondId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -2439,27 +3668,55 @@ func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Entry == nil {
-				m.Entry = &NameAuthority{}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2482,7 +3739,7 @@ func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameAuthority) Unmarshal(dAtA []byte) error {
+func (m *AuthorityEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2505,15 +3762,15 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameAuthority: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuthorityEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuthorityEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2541,13 +3798,13 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerPublicKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRegistry
@@ -2557,46 +3814,501 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRegistry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRegistry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			if m.Entry == nil {
+				m.Entry = &NameAuthority{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRegistry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingTransfer == nil {
+				m.PendingTransfer = &AuthorityTransfer{}
+			}
+			if err := m.PendingTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorityTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorityTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorityTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepBond = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidateNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidateNames = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameAccessGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameAccessGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2624,11 +4336,11 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.LrnPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2656,11 +4368,11 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuctionId = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2688,9 +4400,9 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondId = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
@@ -2719,7 +4431,10 @@ func (m *NameAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3176,6 +4891,38 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			}
 			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheme", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheme = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegistry(dAtA[iNdEx:])
@@ -3393,6 +5140,222 @@ func (m *RecordsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Schema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRegistry(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
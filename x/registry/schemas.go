@@ -0,0 +1,20 @@
+package registry
+
+import (
+	"errors"
+	"strings"
+)
+
+// RecordTypeSeparator separates the authority a schema record type is namespaced by from the type name,
+// e.g. `cerc-io/WebsiteRegistrationRecord`, so that authorities can't claim each other's record types.
+const RecordTypeSeparator = "/"
+
+// ParseRecordType splits a schema record type into the name of its authority and the type name.
+func ParseRecordType(recordType string) (string, string, error) {
+	authority, name, ok := strings.Cut(recordType, RecordTypeSeparator)
+	if !ok || authority == "" || name == "" || strings.Contains(name, RecordTypeSeparator) {
+		return "", "", errors.New("record type must be namespaced by its authority (<authority>/<Type>)")
+	}
+
+	return authority, name, nil
+}
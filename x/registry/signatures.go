@@ -0,0 +1,75 @@
+package registry
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"git.vdb.to/cerc-io/laconicd/utils"
+	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
+)
+
+// VerifySignature verifies a signature over a document and returns the address of the signer:
+// the (hex) address of the pubKey for Cosmos signatures, or the checksummed 0x address for Ethereum ones.
+// Cosmos signatures are over the sign bytes (hash) of the document, and Ethereum ones over the document itself.
+func VerifySignature(signBytes []byte, signDoc []byte, sig Signature) (string, error) {
+	switch sig.Scheme {
+	case "", SignatureSchemeCosmos:
+		pubKey, err := legacy.PubKeyFromBytes(helpers.BytesFromBase64(sig.PubKey))
+		if err != nil {
+			return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Error decoding pubKey from bytes: ", err))
+		}
+
+		if !pubKey.VerifySignature(signBytes, helpers.BytesFromBase64(sig.Sig)) {
+			return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Signature mismatch: ", sig.PubKey))
+		}
+
+		return pubKey.Address().String(), nil
+	case SignatureSchemeEIP191, SignatureSchemeEIP712:
+		var address string
+		var err error
+		if sig.Scheme == SignatureSchemeEIP191 {
+			address, err = utils.DecodeEthereumAddress(signDoc, sig.Sig)
+		} else {
+			address, err = utils.DecodeEIP712Address(EIP712TypedData(signDoc), sig.Sig)
+		}
+		if err != nil {
+			return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Error recovering signer address: ", err))
+		}
+
+		// The expected signer address is optional, as it is recovered from the signature.
+		if sig.PubKey != "" && !strings.EqualFold(sig.PubKey, address) {
+			return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprint("Signature mismatch: ", sig.PubKey))
+		}
+
+		return address, nil
+	default:
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprint("Unsupported signature scheme: ", sig.Scheme))
+	}
+}
+
+// VerifyPayload verifies the signatures of a record payload, returning the record CID and (sorted) owners.
+func (payloadObj *ReadablePayload) VerifyPayload() (string, []string, error) {
+	record := ReadableRecord{Attributes: payloadObj.RecordAttributes}
+	cid, err := record.GetCid()
+	if err != nil {
+		return "", nil, err
+	}
+
+	signBytes, signDoc := record.GetSignBytes()
+	owners := make([]string, 0, len(payloadObj.Signatures))
+	for _, sig := range payloadObj.Signatures {
+		owner, err := VerifySignature(signBytes, signDoc, sig)
+		if err != nil {
+			return "", nil, err
+		}
+		owners = append(owners, owner)
+	}
+	slices.Sort(owners)
+
+	return cid, owners, nil
+}
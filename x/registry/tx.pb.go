@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +40,12 @@ type MsgSetRecord struct {
 	BondId  string  `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
 	Signer  string  `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 	Payload Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload"`
+	// Initial lifetime of the record, paid for upfront; defaults to the record
+	// rent duration if not set
+	Lifetime time.Duration `protobuf:"bytes,4,opt,name=lifetime,proto3,stdduration" json:"lifetime" json:"lifetime" yaml:"lifetime"`
+	// Number of owner approvals required for record-scoped messages; defaults
+	// to 1
+	OwnerThreshold uint32 `protobuf:"varint,5,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty" json:"owner_threshold" yaml:"owner_threshold"`
 }
 
 func (m *MsgSetRecord) Reset()         { *m = MsgSetRecord{} }
@@ -91,6 +102,20 @@ func (m *MsgSetRecord) GetPayload() Payload {
 	return Payload{}
 }
 
+func (m *MsgSetRecord) GetLifetime() time.Duration {
+	if m != nil {
+		return m.Lifetime
+	}
+	return 0
+}
+
+func (m *MsgSetRecord) GetOwnerThreshold() uint32 {
+	if m != nil {
+		return m.OwnerThreshold
+	}
+	return 0
+}
+
 // MsgSetRecordResponse
 type MsgSetRecordResponse struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -136,6 +161,121 @@ func (m *MsgSetRecordResponse) GetId() string {
 	return ""
 }
 
+// MsgUpdateRecord
+type MsgUpdateRecord struct {
+	RecordId string  `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	Signer   string  `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Payload  Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
+}
+
+func (m *MsgUpdateRecord) Reset()         { *m = MsgUpdateRecord{} }
+func (m *MsgUpdateRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecord) ProtoMessage()    {}
+func (*MsgUpdateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{2}
+}
+func (m *MsgUpdateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecord.Merge(m, src)
+}
+func (m *MsgUpdateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRecord proto.InternalMessageInfo
+
+func (m *MsgUpdateRecord) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *MsgUpdateRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdateRecord) GetPayload() Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return Payload{}
+}
+
+func (m *MsgUpdateRecord) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgUpdateRecordResponse
+type MsgUpdateRecordResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgUpdateRecordResponse) Reset()         { *m = MsgUpdateRecordResponse{} }
+func (m *MsgUpdateRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRecordResponse) ProtoMessage()    {}
+func (*MsgUpdateRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{3}
+}
+func (m *MsgUpdateRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRecordResponse.Merge(m, src)
+}
+func (m *MsgUpdateRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRecordResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateRecordResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 // Payload
 type Payload struct {
 	Record     *Record     `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
@@ -146,7 +286,7 @@ func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{2}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{4}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,7 +340,7 @@ func (m *MsgSetName) Reset()         { *m = MsgSetName{} }
 func (m *MsgSetName) String() string { return proto.CompactTextString(m) }
 func (*MsgSetName) ProtoMessage()    {}
 func (*MsgSetName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{3}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{5}
 }
 func (m *MsgSetName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +398,7 @@ func (m *MsgSetNameResponse) Reset()         { *m = MsgSetNameResponse{} }
 func (m *MsgSetNameResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetNameResponse) ProtoMessage()    {}
 func (*MsgSetNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{4}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{6}
 }
 func (m *MsgSetNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +439,7 @@ func (m *MsgReserveAuthority) Reset()         { *m = MsgReserveAuthority{} }
 func (m *MsgReserveAuthority) String() string { return proto.CompactTextString(m) }
 func (*MsgReserveAuthority) ProtoMessage()    {}
 func (*MsgReserveAuthority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{5}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{7}
 }
 func (m *MsgReserveAuthority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +497,7 @@ func (m *MsgReserveAuthorityResponse) Reset()         { *m = MsgReserveAuthority
 func (m *MsgReserveAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReserveAuthorityResponse) ProtoMessage()    {}
 func (*MsgReserveAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{6}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{8}
 }
 func (m *MsgReserveAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +537,7 @@ func (m *MsgSetAuthorityBond) Reset()         { *m = MsgSetAuthorityBond{} }
 func (m *MsgSetAuthorityBond) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAuthorityBond) ProtoMessage()    {}
 func (*MsgSetAuthorityBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{7}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{9}
 }
 func (m *MsgSetAuthorityBond) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,7 +595,7 @@ func (m *MsgSetAuthorityBondResponse) Reset()         { *m = MsgSetAuthorityBond
 func (m *MsgSetAuthorityBondResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAuthorityBondResponse) ProtoMessage()    {}
 func (*MsgSetAuthorityBondResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{8}
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{10}
 }
 func (m *MsgSetAuthorityBondResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,24 +624,36 @@ func (m *MsgSetAuthorityBondResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetAuthorityBondResponse proto.InternalMessageInfo
 
-// MsgDeleteName
-type MsgDeleteName struct {
-	Lrn    string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
-	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
-}
-
-func (m *MsgDeleteName) Reset()         { *m = MsgDeleteName{} }
-func (m *MsgDeleteName) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteName) ProtoMessage()    {}
-func (*MsgDeleteName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{9}
+// MsgTransferAuthority
+type MsgTransferAuthority struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// New owner address; transferring to the current owner cancels a pending
+	// transfer
+	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty" json:"new_owner" yaml:"new_owner"`
+	// Whether the new owner needs to accept the transfer (see
+	// MsgAcceptAuthority)
+	RequireAccept bool `protobuf:"varint,3,opt,name=require_accept,json=requireAccept,proto3" json:"require_accept,omitempty" json:"require_accept" yaml:"require_accept"`
+	// Whether the authority keeps its bond; otherwise the new owner needs to
+	// set one (see MsgSetAuthorityBond)
+	KeepBond bool `protobuf:"varint,4,opt,name=keep_bond,json=keepBond,proto3" json:"keep_bond,omitempty" json:"keep_bond" yaml:"keep_bond"`
+	// Whether the authority height is bumped, so that existing names no longer
+	// resolve
+	InvalidateNames bool   `protobuf:"varint,5,opt,name=invalidate_names,json=invalidateNames,proto3" json:"invalidate_names,omitempty" json:"invalidate_names" yaml:"invalidate_names"`
+	Signer          string `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgTransferAuthority) Reset()         { *m = MsgTransferAuthority{} }
+func (m *MsgTransferAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAuthority) ProtoMessage()    {}
+func (*MsgTransferAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{11}
 }
-func (m *MsgDeleteName) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferAuthority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteName.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferAuthority.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -511,48 +663,76 @@ func (m *MsgDeleteName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteName) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteName.Merge(m, src)
+func (m *MsgTransferAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAuthority.Merge(m, src)
 }
-func (m *MsgDeleteName) XXX_Size() int {
+func (m *MsgTransferAuthority) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteName) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteName.DiscardUnknown(m)
+func (m *MsgTransferAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAuthority.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteName proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferAuthority proto.InternalMessageInfo
 
-func (m *MsgDeleteName) GetLrn() string {
+func (m *MsgTransferAuthority) GetName() string {
 	if m != nil {
-		return m.Lrn
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgDeleteName) GetSigner() string {
+func (m *MsgTransferAuthority) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *MsgTransferAuthority) GetRequireAccept() bool {
+	if m != nil {
+		return m.RequireAccept
+	}
+	return false
+}
+
+func (m *MsgTransferAuthority) GetKeepBond() bool {
+	if m != nil {
+		return m.KeepBond
+	}
+	return false
+}
+
+func (m *MsgTransferAuthority) GetInvalidateNames() bool {
+	if m != nil {
+		return m.InvalidateNames
+	}
+	return false
+}
+
+func (m *MsgTransferAuthority) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgDeleteNameResponse
-type MsgDeleteNameResponse struct {
+// MsgTransferAuthorityResponse
+type MsgTransferAuthorityResponse struct {
 }
 
-func (m *MsgDeleteNameResponse) Reset()         { *m = MsgDeleteNameResponse{} }
-func (m *MsgDeleteNameResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteNameResponse) ProtoMessage()    {}
-func (*MsgDeleteNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{10}
+func (m *MsgTransferAuthorityResponse) Reset()         { *m = MsgTransferAuthorityResponse{} }
+func (m *MsgTransferAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAuthorityResponse) ProtoMessage()    {}
+func (*MsgTransferAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{12}
 }
-func (m *MsgDeleteNameResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransferAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransferAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteNameResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransferAuthorityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,36 +742,36 @@ func (m *MsgDeleteNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteNameResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteNameResponse.Merge(m, src)
+func (m *MsgTransferAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAuthorityResponse.Merge(m, src)
 }
-func (m *MsgDeleteNameResponse) XXX_Size() int {
+func (m *MsgTransferAuthorityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteNameResponse.DiscardUnknown(m)
+func (m *MsgTransferAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAuthorityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransferAuthorityResponse proto.InternalMessageInfo
 
-// MsgRenewRecord
-type MsgRenewRecord struct {
-	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
-	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgAcceptAuthority
+type MsgAcceptAuthority struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgRenewRecord) Reset()         { *m = MsgRenewRecord{} }
-func (m *MsgRenewRecord) String() string { return proto.CompactTextString(m) }
-func (*MsgRenewRecord) ProtoMessage()    {}
-func (*MsgRenewRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{11}
+func (m *MsgAcceptAuthority) Reset()         { *m = MsgAcceptAuthority{} }
+func (m *MsgAcceptAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAuthority) ProtoMessage()    {}
+func (*MsgAcceptAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{13}
 }
-func (m *MsgRenewRecord) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptAuthority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRenewRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRenewRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptAuthority.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -601,48 +781,48 @@ func (m *MsgRenewRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgRenewRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRenewRecord.Merge(m, src)
+func (m *MsgAcceptAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAuthority.Merge(m, src)
 }
-func (m *MsgRenewRecord) XXX_Size() int {
+func (m *MsgAcceptAuthority) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRenewRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRenewRecord.DiscardUnknown(m)
+func (m *MsgAcceptAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAuthority.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRenewRecord proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptAuthority proto.InternalMessageInfo
 
-func (m *MsgRenewRecord) GetRecordId() string {
+func (m *MsgAcceptAuthority) GetName() string {
 	if m != nil {
-		return m.RecordId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgRenewRecord) GetSigner() string {
+func (m *MsgAcceptAuthority) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgRenewRecordResponse
-type MsgRenewRecordResponse struct {
+// MsgAcceptAuthorityResponse
+type MsgAcceptAuthorityResponse struct {
 }
 
-func (m *MsgRenewRecordResponse) Reset()         { *m = MsgRenewRecordResponse{} }
-func (m *MsgRenewRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRenewRecordResponse) ProtoMessage()    {}
-func (*MsgRenewRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{12}
+func (m *MsgAcceptAuthorityResponse) Reset()         { *m = MsgAcceptAuthorityResponse{} }
+func (m *MsgAcceptAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAuthorityResponse) ProtoMessage()    {}
+func (*MsgAcceptAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{14}
 }
-func (m *MsgRenewRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRenewRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRenewRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptAuthorityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -652,37 +832,39 @@ func (m *MsgRenewRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgRenewRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRenewRecordResponse.Merge(m, src)
+func (m *MsgAcceptAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAuthorityResponse.Merge(m, src)
 }
-func (m *MsgRenewRecordResponse) XXX_Size() int {
+func (m *MsgAcceptAuthorityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRenewRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRenewRecordResponse.DiscardUnknown(m)
+func (m *MsgAcceptAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAuthorityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRenewRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptAuthorityResponse proto.InternalMessageInfo
 
-// MsgAssociateBond
-type MsgAssociateBond struct {
-	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
-	BondId   string `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
-	Signer   string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgRenewAuthority
+type MsgRenewAuthority struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of rent periods to pay for upfront from the authority bond,
+	// extending the authority expiry time
+	Periods uint64 `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"`
+	Signer  string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgAssociateBond) Reset()         { *m = MsgAssociateBond{} }
-func (m *MsgAssociateBond) String() string { return proto.CompactTextString(m) }
-func (*MsgAssociateBond) ProtoMessage()    {}
-func (*MsgAssociateBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{13}
+func (m *MsgRenewAuthority) Reset()         { *m = MsgRenewAuthority{} }
+func (m *MsgRenewAuthority) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewAuthority) ProtoMessage()    {}
+func (*MsgRenewAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{15}
 }
-func (m *MsgAssociateBond) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewAuthority) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAssociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAssociateBond.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewAuthority.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,55 +874,55 @@ func (m *MsgAssociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgAssociateBond) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAssociateBond.Merge(m, src)
+func (m *MsgRenewAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewAuthority.Merge(m, src)
 }
-func (m *MsgAssociateBond) XXX_Size() int {
+func (m *MsgRenewAuthority) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAssociateBond) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAssociateBond.DiscardUnknown(m)
+func (m *MsgRenewAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewAuthority.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAssociateBond proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewAuthority proto.InternalMessageInfo
 
-func (m *MsgAssociateBond) GetRecordId() string {
+func (m *MsgRenewAuthority) GetName() string {
 	if m != nil {
-		return m.RecordId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgAssociateBond) GetBondId() string {
+func (m *MsgRenewAuthority) GetPeriods() uint64 {
 	if m != nil {
-		return m.BondId
+		return m.Periods
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgAssociateBond) GetSigner() string {
+func (m *MsgRenewAuthority) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgAssociateBondResponse
-type MsgAssociateBondResponse struct {
+// MsgRenewAuthorityResponse
+type MsgRenewAuthorityResponse struct {
 }
 
-func (m *MsgAssociateBondResponse) Reset()         { *m = MsgAssociateBondResponse{} }
-func (m *MsgAssociateBondResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAssociateBondResponse) ProtoMessage()    {}
-func (*MsgAssociateBondResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{14}
+func (m *MsgRenewAuthorityResponse) Reset()         { *m = MsgRenewAuthorityResponse{} }
+func (m *MsgRenewAuthorityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewAuthorityResponse) ProtoMessage()    {}
+func (*MsgRenewAuthorityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{16}
 }
-func (m *MsgAssociateBondResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAssociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewAuthorityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAssociateBondResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewAuthorityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -750,36 +932,40 @@ func (m *MsgAssociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgAssociateBondResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAssociateBondResponse.Merge(m, src)
+func (m *MsgRenewAuthorityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewAuthorityResponse.Merge(m, src)
 }
-func (m *MsgAssociateBondResponse) XXX_Size() int {
+func (m *MsgRenewAuthorityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAssociateBondResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAssociateBondResponse.DiscardUnknown(m)
+func (m *MsgRenewAuthorityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewAuthorityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAssociateBondResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewAuthorityResponse proto.InternalMessageInfo
 
-// MsgDissociateBond
-type MsgDissociateBond struct {
-	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
-	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgGrantNameAccess
+type MsgGrantNameAccess struct {
+	// LRN, or LRN path prefix ending with "/*" (e.g. lrn://cerc-io/apps/*)
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty" json:"lrn_prefix" yaml:"lrn_prefix"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Optional time after which the grant no longer applies
+	ExpiryTime *time.Time `protobuf:"bytes,3,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty" json:"expiry_time" yaml:"expiry_time"`
+	Signer     string     `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgDissociateBond) Reset()         { *m = MsgDissociateBond{} }
-func (m *MsgDissociateBond) String() string { return proto.CompactTextString(m) }
-func (*MsgDissociateBond) ProtoMessage()    {}
-func (*MsgDissociateBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{15}
+func (m *MsgGrantNameAccess) Reset()         { *m = MsgGrantNameAccess{} }
+func (m *MsgGrantNameAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantNameAccess) ProtoMessage()    {}
+func (*MsgGrantNameAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{17}
 }
-func (m *MsgDissociateBond) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantNameAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDissociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantNameAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDissociateBond.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantNameAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -789,48 +975,62 @@ func (m *MsgDissociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgDissociateBond) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDissociateBond.Merge(m, src)
+func (m *MsgGrantNameAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantNameAccess.Merge(m, src)
 }
-func (m *MsgDissociateBond) XXX_Size() int {
+func (m *MsgGrantNameAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDissociateBond) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDissociateBond.DiscardUnknown(m)
+func (m *MsgGrantNameAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantNameAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDissociateBond proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantNameAccess proto.InternalMessageInfo
 
-func (m *MsgDissociateBond) GetRecordId() string {
+func (m *MsgGrantNameAccess) GetLrnPrefix() string {
 	if m != nil {
-		return m.RecordId
+		return m.LrnPrefix
 	}
 	return ""
 }
 
-func (m *MsgDissociateBond) GetSigner() string {
+func (m *MsgGrantNameAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgGrantNameAccess) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *MsgGrantNameAccess) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgDissociateBondResponse
-type MsgDissociateBondResponse struct {
+// MsgGrantNameAccessResponse
+type MsgGrantNameAccessResponse struct {
 }
 
-func (m *MsgDissociateBondResponse) Reset()         { *m = MsgDissociateBondResponse{} }
-func (m *MsgDissociateBondResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDissociateBondResponse) ProtoMessage()    {}
-func (*MsgDissociateBondResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{16}
+func (m *MsgGrantNameAccessResponse) Reset()         { *m = MsgGrantNameAccessResponse{} }
+func (m *MsgGrantNameAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantNameAccessResponse) ProtoMessage()    {}
+func (*MsgGrantNameAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{18}
 }
-func (m *MsgDissociateBondResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantNameAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDissociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantNameAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDissociateBondResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantNameAccessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -840,36 +1040,37 @@ func (m *MsgDissociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgDissociateBondResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDissociateBondResponse.Merge(m, src)
+func (m *MsgGrantNameAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantNameAccessResponse.Merge(m, src)
 }
-func (m *MsgDissociateBondResponse) XXX_Size() int {
+func (m *MsgGrantNameAccessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDissociateBondResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDissociateBondResponse.DiscardUnknown(m)
+func (m *MsgGrantNameAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantNameAccessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDissociateBondResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantNameAccessResponse proto.InternalMessageInfo
 
-// MsgDissociateRecords
-type MsgDissociateRecords struct {
-	BondId string `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
-	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgRevokeNameAccess
+type MsgRevokeNameAccess struct {
+	LrnPrefix string `protobuf:"bytes,1,opt,name=lrn_prefix,json=lrnPrefix,proto3" json:"lrn_prefix,omitempty" json:"lrn_prefix" yaml:"lrn_prefix"`
+	Grantee   string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgDissociateRecords) Reset()         { *m = MsgDissociateRecords{} }
-func (m *MsgDissociateRecords) String() string { return proto.CompactTextString(m) }
-func (*MsgDissociateRecords) ProtoMessage()    {}
-func (*MsgDissociateRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{17}
+func (m *MsgRevokeNameAccess) Reset()         { *m = MsgRevokeNameAccess{} }
+func (m *MsgRevokeNameAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNameAccess) ProtoMessage()    {}
+func (*MsgRevokeNameAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{19}
 }
-func (m *MsgDissociateRecords) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeNameAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDissociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeNameAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDissociateRecords.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeNameAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -879,48 +1080,55 @@ func (m *MsgDissociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgDissociateRecords) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDissociateRecords.Merge(m, src)
+func (m *MsgRevokeNameAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNameAccess.Merge(m, src)
 }
-func (m *MsgDissociateRecords) XXX_Size() int {
+func (m *MsgRevokeNameAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDissociateRecords) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDissociateRecords.DiscardUnknown(m)
+func (m *MsgRevokeNameAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNameAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDissociateRecords proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeNameAccess proto.InternalMessageInfo
 
-func (m *MsgDissociateRecords) GetBondId() string {
+func (m *MsgRevokeNameAccess) GetLrnPrefix() string {
 	if m != nil {
-		return m.BondId
+		return m.LrnPrefix
 	}
 	return ""
 }
 
-func (m *MsgDissociateRecords) GetSigner() string {
+func (m *MsgRevokeNameAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *MsgRevokeNameAccess) GetSigner() string {
 	if m != nil {
 		return m.Signer
 	}
 	return ""
 }
 
-// MsgDissociateRecordsResponse
-type MsgDissociateRecordsResponse struct {
+// MsgRevokeNameAccessResponse
+type MsgRevokeNameAccessResponse struct {
 }
 
-func (m *MsgDissociateRecordsResponse) Reset()         { *m = MsgDissociateRecordsResponse{} }
-func (m *MsgDissociateRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDissociateRecordsResponse) ProtoMessage()    {}
-func (*MsgDissociateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{18}
+func (m *MsgRevokeNameAccessResponse) Reset()         { *m = MsgRevokeNameAccessResponse{} }
+func (m *MsgRevokeNameAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNameAccessResponse) ProtoMessage()    {}
+func (*MsgRevokeNameAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{20}
 }
-func (m *MsgDissociateRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeNameAccessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDissociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeNameAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDissociateRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeNameAccessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -930,37 +1138,36 @@ func (m *MsgDissociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgDissociateRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDissociateRecordsResponse.Merge(m, src)
+func (m *MsgRevokeNameAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNameAccessResponse.Merge(m, src)
 }
-func (m *MsgDissociateRecordsResponse) XXX_Size() int {
+func (m *MsgRevokeNameAccessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDissociateRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDissociateRecordsResponse.DiscardUnknown(m)
+func (m *MsgRevokeNameAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNameAccessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDissociateRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeNameAccessResponse proto.InternalMessageInfo
 
-// MsgReassociateRecords
-type MsgReassociateRecords struct {
-	NewBondId string `protobuf:"bytes,1,opt,name=new_bond_id,json=newBondId,proto3" json:"new_bond_id,omitempty" json:"new_bond_id" yaml:"new_bond_id"`
-	OldBondId string `protobuf:"bytes,2,opt,name=old_bond_id,json=oldBondId,proto3" json:"old_bond_id,omitempty" json:"old_bond_id" yaml:"old_bond_id"`
-	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+// MsgDeleteName
+type MsgDeleteName struct {
+	Lrn    string `protobuf:"bytes,1,opt,name=lrn,proto3" json:"lrn,omitempty"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgReassociateRecords) Reset()         { *m = MsgReassociateRecords{} }
-func (m *MsgReassociateRecords) String() string { return proto.CompactTextString(m) }
-func (*MsgReassociateRecords) ProtoMessage()    {}
-func (*MsgReassociateRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{19}
+func (m *MsgDeleteName) Reset()         { *m = MsgDeleteName{} }
+func (m *MsgDeleteName) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteName) ProtoMessage()    {}
+func (*MsgDeleteName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{21}
 }
-func (m *MsgReassociateRecords) XXX_Unmarshal(b []byte) error {
+func (m *MsgDeleteName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReassociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDeleteName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReassociateRecords.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDeleteName.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -970,55 +1177,93 @@ func (m *MsgReassociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgReassociateRecords) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReassociateRecords.Merge(m, src)
+func (m *MsgDeleteName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteName.Merge(m, src)
 }
-func (m *MsgReassociateRecords) XXX_Size() int {
+func (m *MsgDeleteName) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReassociateRecords) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReassociateRecords.DiscardUnknown(m)
+func (m *MsgDeleteName) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteName.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReassociateRecords proto.InternalMessageInfo
+var xxx_messageInfo_MsgDeleteName proto.InternalMessageInfo
 
-func (m *MsgReassociateRecords) GetNewBondId() string {
+func (m *MsgDeleteName) GetLrn() string {
 	if m != nil {
-		return m.NewBondId
+		return m.Lrn
 	}
 	return ""
 }
 
-func (m *MsgReassociateRecords) GetOldBondId() string {
+func (m *MsgDeleteName) GetSigner() string {
 	if m != nil {
-		return m.OldBondId
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgReassociateRecords) GetSigner() string {
-	if m != nil {
-		return m.Signer
+// MsgDeleteNameResponse
+type MsgDeleteNameResponse struct {
+}
+
+func (m *MsgDeleteNameResponse) Reset()         { *m = MsgDeleteNameResponse{} }
+func (m *MsgDeleteNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteNameResponse) ProtoMessage()    {}
+func (*MsgDeleteNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{22}
+}
+func (m *MsgDeleteNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgDeleteNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteNameResponse.Merge(m, src)
+}
+func (m *MsgDeleteNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteNameResponse.DiscardUnknown(m)
 }
 
-// MsgReassociateRecordsResponse
-type MsgReassociateRecordsResponse struct {
+var xxx_messageInfo_MsgDeleteNameResponse proto.InternalMessageInfo
+
+// MsgRenewRecord
+type MsgRenewRecord struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Number of rent periods to pay for upfront, extending the record expiry
+	// time; if not set, only an expired record is renewed for one period
+	Periods uint64 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	// Approvals of record owners other than the signer for prepaying rent, if
+	// the signer doesn't own the record bond (see RecordOperation)
+	Signatures []Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
 }
 
-func (m *MsgReassociateRecordsResponse) Reset()         { *m = MsgReassociateRecordsResponse{} }
-func (m *MsgReassociateRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgReassociateRecordsResponse) ProtoMessage()    {}
-func (*MsgReassociateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c6eb2e5a4d8fa03, []int{20}
+func (m *MsgRenewRecord) Reset()         { *m = MsgRenewRecord{} }
+func (m *MsgRenewRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewRecord) ProtoMessage()    {}
+func (*MsgRenewRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{23}
 }
-func (m *MsgReassociateRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgReassociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgReassociateRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1028,1561 +1273,5722 @@ func (m *MsgReassociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgReassociateRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgReassociateRecordsResponse.Merge(m, src)
+func (m *MsgRenewRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewRecord.Merge(m, src)
 }
-func (m *MsgReassociateRecordsResponse) XXX_Size() int {
+func (m *MsgRenewRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgReassociateRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgReassociateRecordsResponse.DiscardUnknown(m)
+func (m *MsgRenewRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgReassociateRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewRecord proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetRecord)(nil), "cerc.registry.v1.MsgSetRecord")
-	proto.RegisterType((*MsgSetRecordResponse)(nil), "cerc.registry.v1.MsgSetRecordResponse")
-	proto.RegisterType((*Payload)(nil), "cerc.registry.v1.Payload")
-	proto.RegisterType((*MsgSetName)(nil), "cerc.registry.v1.MsgSetName")
-	proto.RegisterType((*MsgSetNameResponse)(nil), "cerc.registry.v1.MsgSetNameResponse")
-	proto.RegisterType((*MsgReserveAuthority)(nil), "cerc.registry.v1.MsgReserveAuthority")
-	proto.RegisterType((*MsgReserveAuthorityResponse)(nil), "cerc.registry.v1.MsgReserveAuthorityResponse")
-	proto.RegisterType((*MsgSetAuthorityBond)(nil), "cerc.registry.v1.MsgSetAuthorityBond")
-	proto.RegisterType((*MsgSetAuthorityBondResponse)(nil), "cerc.registry.v1.MsgSetAuthorityBondResponse")
-	proto.RegisterType((*MsgDeleteName)(nil), "cerc.registry.v1.MsgDeleteName")
-	proto.RegisterType((*MsgDeleteNameResponse)(nil), "cerc.registry.v1.MsgDeleteNameResponse")
-	proto.RegisterType((*MsgRenewRecord)(nil), "cerc.registry.v1.MsgRenewRecord")
-	proto.RegisterType((*MsgRenewRecordResponse)(nil), "cerc.registry.v1.MsgRenewRecordResponse")
-	proto.RegisterType((*MsgAssociateBond)(nil), "cerc.registry.v1.MsgAssociateBond")
-	proto.RegisterType((*MsgAssociateBondResponse)(nil), "cerc.registry.v1.MsgAssociateBondResponse")
-	proto.RegisterType((*MsgDissociateBond)(nil), "cerc.registry.v1.MsgDissociateBond")
-	proto.RegisterType((*MsgDissociateBondResponse)(nil), "cerc.registry.v1.MsgDissociateBondResponse")
-	proto.RegisterType((*MsgDissociateRecords)(nil), "cerc.registry.v1.MsgDissociateRecords")
-	proto.RegisterType((*MsgDissociateRecordsResponse)(nil), "cerc.registry.v1.MsgDissociateRecordsResponse")
-	proto.RegisterType((*MsgReassociateRecords)(nil), "cerc.registry.v1.MsgReassociateRecords")
-	proto.RegisterType((*MsgReassociateRecordsResponse)(nil), "cerc.registry.v1.MsgReassociateRecordsResponse")
+func (m *MsgRenewRecord) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("cerc/registry/v1/tx.proto", fileDescriptor_3c6eb2e5a4d8fa03) }
+func (m *MsgRenewRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-var fileDescriptor_3c6eb2e5a4d8fa03 = []byte{
-	// 1036 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0x3b, 0xc9, 0x6e, 0x4b, 0x5e, 0xd8, 0x2a, 0x6b, 0xca, 0x6e, 0xea, 0xa6, 0x76, 0x3a,
-	0x6d, 0xd2, 0x6c, 0x4b, 0x63, 0xb6, 0x9c, 0xe8, 0x89, 0x8d, 0x10, 0x52, 0x0f, 0x41, 0xc8, 0x15,
-	0x17, 0x2e, 0x91, 0x1b, 0x8f, 0xbc, 0x46, 0x89, 0xa7, 0x78, 0xbc, 0xcd, 0x46, 0x02, 0x09, 0xf6,
-	0x80, 0x04, 0x12, 0x12, 0x12, 0x02, 0x89, 0x03, 0x47, 0x2e, 0x9c, 0xf6, 0xc6, 0x57, 0xd8, 0xe3,
-	0x0a, 0x2e, 0x9c, 0x22, 0xd4, 0x22, 0xed, 0xbd, 0x9f, 0x00, 0x79, 0x6c, 0x4f, 0xec, 0xd8, 0x4e,
-	0x03, 0xda, 0xbd, 0x4d, 0xe6, 0xfd, 0xfd, 0xfe, 0xbf, 0x79, 0x6f, 0xc6, 0x9e, 0xc0, 0x7a, 0x9f,
-	0xb8, 0x7d, 0xcd, 0x25, 0x96, 0xcd, 0x3c, 0x77, 0xac, 0x9d, 0xdf, 0xd7, 0xbc, 0xc7, 0xed, 0x33,
-	0x97, 0x7a, 0x54, 0xaa, 0xf8, 0xa1, 0x76, 0x14, 0x6a, 0x9f, 0xdf, 0x97, 0x6b, 0x16, 0xa5, 0xd6,
-	0x80, 0x68, 0xc6, 0x99, 0xad, 0x19, 0x8e, 0x43, 0x3d, 0xc3, 0xb3, 0xa9, 0xc3, 0x02, 0xbd, 0xbc,
-	0x66, 0x51, 0x8b, 0xf2, 0xa1, 0xe6, 0x8f, 0xc2, 0xd9, 0xbb, 0x7d, 0xca, 0x86, 0x94, 0x69, 0x43,
-	0x66, 0xf9, 0xd9, 0x87, 0xcc, 0x0a, 0x03, 0x6a, 0xca, 0x59, 0x58, 0x71, 0x01, 0xfe, 0x0d, 0xc1,
-	0xeb, 0x5d, 0x66, 0x9d, 0x10, 0x4f, 0x27, 0x7d, 0xea, 0x9a, 0xd2, 0x11, 0xac, 0x9c, 0x52, 0xc7,
-	0xec, 0xd9, 0x66, 0x15, 0xd5, 0x51, 0xab, 0xd4, 0xd9, 0xba, 0x9a, 0xa8, 0x9b, 0x9f, 0x32, 0xea,
-	0x1c, 0xe1, 0x30, 0x80, 0xeb, 0x63, 0x63, 0x38, 0x98, 0xfe, 0xd4, 0x97, 0xfd, 0xd1, 0xb1, 0x29,
-	0xdd, 0x81, 0x65, 0x66, 0x5b, 0x0e, 0x71, 0xab, 0x05, 0xff, 0x51, 0x3d, 0xfc, 0x25, 0xbd, 0x0b,
-	0x2b, 0x67, 0xc6, 0x78, 0x40, 0x0d, 0xb3, 0x5a, 0xac, 0xa3, 0x56, 0xf9, 0x70, 0xbd, 0x3d, 0xbb,
-	0xec, 0xf6, 0x47, 0x81, 0xa0, 0x73, 0xe3, 0xd9, 0x44, 0x5d, 0xd2, 0x23, 0xfd, 0x51, 0xf9, 0xc9,
-	0x8b, 0xa7, 0x7b, 0x61, 0x1e, 0xdc, 0x84, 0xb5, 0x38, 0xab, 0x4e, 0xd8, 0x19, 0x75, 0x18, 0x91,
-	0x56, 0xa1, 0x10, 0xe1, 0xea, 0x05, 0xdb, 0xc4, 0xbf, 0x22, 0x58, 0x09, 0xf3, 0x49, 0x6f, 0xc3,
-	0xb2, 0xcb, 0xd5, 0x3c, 0x5e, 0x3e, 0xac, 0xa6, 0xad, 0xc3, 0x6c, 0xa1, 0x4e, 0x7a, 0x08, 0xe0,
-	0xfb, 0x19, 0xde, 0x23, 0x97, 0xb0, 0x6a, 0xa1, 0x5e, 0x6c, 0x95, 0x0f, 0x37, 0xd2, 0x4f, 0x9d,
-	0x44, 0x9a, 0xce, 0xbe, 0x8f, 0x7c, 0x35, 0x51, 0xb7, 0x83, 0x2a, 0x4d, 0x1f, 0x8e, 0x0a, 0x15,
-	0x9b, 0xd1, 0x63, 0xb9, 0xf1, 0xc7, 0x00, 0xc1, 0x7a, 0x3e, 0x34, 0x86, 0x44, 0xaa, 0x40, 0x71,
-	0xe0, 0x3a, 0xe1, 0x32, 0xfc, 0xa1, 0x3f, 0xd3, 0xb7, 0xcd, 0xb0, 0x98, 0xfe, 0x30, 0x56, 0xe1,
-	0x62, 0xbc, 0xc2, 0xc9, 0x32, 0xad, 0x81, 0x34, 0x4d, 0x1b, 0x15, 0x09, 0x9b, 0xf0, 0x46, 0x97,
-	0x59, 0x3a, 0x61, 0xc4, 0x3d, 0x27, 0x0f, 0x1e, 0x79, 0x0f, 0xa9, 0x6b, 0x7b, 0x63, 0x49, 0x82,
-	0x1b, 0x8e, 0x31, 0x24, 0xa1, 0x2d, 0x1f, 0xe7, 0xf6, 0x71, 0x0d, 0x6e, 0xd2, 0xd1, 0xd4, 0x3c,
-	0xf8, 0x91, 0xf4, 0xde, 0x84, 0x8d, 0x0c, 0x17, 0x01, 0xf1, 0x2d, 0xe2, 0x14, 0x27, 0xc4, 0x13,
-	0xb1, 0x0e, 0x75, 0xcc, 0x4c, 0x8a, 0xd8, 0x4e, 0x2c, 0xfc, 0xff, 0x9d, 0x38, 0xa7, 0x4e, 0x01,
-	0xeb, 0x2c, 0x8b, 0x60, 0xfd, 0x00, 0x6e, 0x75, 0x99, 0xf5, 0x3e, 0x19, 0x10, 0x8f, 0xe4, 0x34,
-	0x28, 0xa7, 0x50, 0x49, 0x9b, 0xbb, 0xf0, 0x66, 0x22, 0x8f, 0x30, 0x18, 0xc3, 0x2a, 0xaf, 0x95,
-	0x43, 0x46, 0xe1, 0xe1, 0xeb, 0x40, 0x29, 0xd8, 0x84, 0xd3, 0xe3, 0xd7, 0xb8, 0x9a, 0xa8, 0x5b,
-	0xc1, 0xa2, 0x45, 0x28, 0x5a, 0xf6, 0x74, 0x42, 0x7f, 0x2d, 0x18, 0xe7, 0x1f, 0xc2, 0x24, 0x53,
-	0x15, 0xee, 0x24, 0xad, 0x05, 0xd4, 0xef, 0x08, 0x2a, 0x5d, 0x66, 0x3d, 0x60, 0x8c, 0xf6, 0x6d,
-	0xc3, 0x23, 0xbc, 0x3d, 0x2f, 0x83, 0xeb, 0x95, 0xb7, 0x53, 0x86, 0xea, 0x2c, 0xb8, 0x58, 0xd5,
-	0xe7, 0x70, 0xdb, 0xef, 0x81, 0xfd, 0xd2, 0x57, 0xb5, 0x50, 0xb5, 0x37, 0x60, 0x3d, 0xe5, 0x2e,
-	0xd0, 0x46, 0xfc, 0xa5, 0x36, 0x0d, 0x06, 0xfd, 0x60, 0xaf, 0xe2, 0x45, 0x9c, 0xa4, 0x52, 0xa0,
-	0x96, 0x65, 0x2c, 0xc0, 0xfe, 0x40, 0x7c, 0xe3, 0xea, 0xc4, 0x98, 0x45, 0x3b, 0x86, 0xb2, 0x43,
-	0x46, 0xbd, 0x24, 0xde, 0xbd, 0xab, 0x89, 0xda, 0x08, 0xf0, 0x62, 0xc1, 0x08, 0x31, 0x3e, 0xa5,
-	0x97, 0x1c, 0x32, 0xea, 0x04, 0xa4, 0xc7, 0x50, 0xa6, 0x03, 0xb3, 0x97, 0xdc, 0x19, 0xb1, 0x54,
-	0xb1, 0x60, 0x94, 0x2a, 0x3e, 0xa5, 0x97, 0xe8, 0xc0, 0xec, 0xfc, 0x87, 0x4d, 0xa2, 0xc2, 0x66,
-	0xe6, 0x9a, 0xa2, 0x55, 0x1f, 0x7e, 0x5d, 0x86, 0x62, 0x97, 0x59, 0xd2, 0x18, 0x4a, 0xd3, 0x8f,
-	0xa2, 0x92, 0x7e, 0xfd, 0xc7, 0x3f, 0x44, 0x72, 0x73, 0x7e, 0x5c, 0x94, 0x74, 0xe7, 0xc9, 0x9f,
-	0xff, 0xfc, 0x50, 0x50, 0x70, 0x4d, 0x4b, 0x7d, 0x97, 0x19, 0xf1, 0x7a, 0xe1, 0x07, 0xe8, 0x2b,
-	0x04, 0xe5, 0xf8, 0x5b, 0xa1, 0x9e, 0x99, 0x3d, 0xa6, 0x90, 0x5b, 0xd7, 0x29, 0x04, 0x41, 0x93,
-	0x13, 0xd4, 0xb1, 0xa2, 0x65, 0xdc, 0x0c, 0xfc, 0x26, 0x85, 0x0c, 0xdf, 0x20, 0xb8, 0x95, 0x7c,
-	0x07, 0xe0, 0x4c, 0x8f, 0x84, 0x46, 0xde, 0xbb, 0x5e, 0x23, 0x48, 0x5a, 0x9c, 0x04, 0xe3, 0x7a,
-	0x9a, 0x44, 0x34, 0x87, 0xf7, 0x59, 0xfa, 0x0e, 0xc1, 0xea, 0xcc, 0xd1, 0xdd, 0xce, 0x34, 0x4a,
-	0x8a, 0xe4, 0xfd, 0x05, 0x44, 0x02, 0xe7, 0x1e, 0xc7, 0xd9, 0xc6, 0x5b, 0x69, 0x1c, 0xd3, 0x4e,
-	0xf2, 0xfc, 0x8c, 0xe0, 0x76, 0xfa, 0xbc, 0x36, 0xaf, 0x71, 0x0b, 0x75, 0x72, 0x7b, 0x31, 0x9d,
-	0x00, 0x7b, 0x8b, 0x83, 0x35, 0xf1, 0xce, 0x5c, 0x30, 0x37, 0xa4, 0xf8, 0x05, 0x81, 0x94, 0x71,
-	0x62, 0x77, 0x73, 0x36, 0xc8, 0xac, 0x50, 0xd6, 0x16, 0x14, 0x0a, 0xbc, 0x03, 0x8e, 0xb7, 0x8b,
-	0x1b, 0x59, 0x1b, 0xca, 0x48, 0xf1, 0x7d, 0x06, 0x2b, 0xd1, 0x7d, 0xa7, 0x96, 0x77, 0x68, 0xfc,
-	0xa8, 0xbc, 0x33, 0x2f, 0x2a, 0xdc, 0x31, 0x77, 0xaf, 0x61, 0x39, 0xfb, 0x40, 0xf1, 0x7b, 0xc4,
-	0x17, 0x00, 0xb1, 0x8f, 0xb8, 0x9a, 0x5d, 0x7e, 0x21, 0x90, 0x77, 0xaf, 0x11, 0x08, 0xef, 0x06,
-	0xf7, 0x56, 0xf1, 0x66, 0x46, 0x63, 0xb8, 0x3a, 0xb0, 0xff, 0x11, 0x41, 0x25, 0x75, 0xeb, 0x6a,
-	0xe4, 0x94, 0x39, 0x29, 0x93, 0x0f, 0x16, 0x92, 0x09, 0xa2, 0x7d, 0x4e, 0xd4, 0xc0, 0xdb, 0x59,
-	0xbd, 0xe0, 0xcf, 0xf4, 0x0c, 0x81, 0xf0, 0x13, 0x82, 0x4a, 0xea, 0x1e, 0xd6, 0xc8, 0xab, 0x7a,
-	0x42, 0x96, 0xc3, 0x95, 0x7b, 0x93, 0x9a, 0xb3, 0x85, 0xfd, 0x2e, 0x09, 0x26, 0x7e, 0xbc, 0xe4,
-	0x9b, 0x5f, 0xbe, 0x78, 0xba, 0x87, 0x3a, 0xef, 0x3d, 0xbb, 0x50, 0xd0, 0xf3, 0x0b, 0x05, 0xfd,
-	0x7d, 0xa1, 0xa0, 0xef, 0x2f, 0x95, 0xa5, 0xe7, 0x97, 0xca, 0xd2, 0x5f, 0x97, 0xca, 0xd2, 0x27,
-	0x4d, 0xcb, 0xf6, 0xda, 0xe7, 0xe6, 0x69, 0xdb, 0xa3, 0x3c, 0xe1, 0x81, 0x4d, 0xb5, 0x81, 0xd1,
-	0xa7, 0x8e, 0xdd, 0x37, 0xb5, 0xc7, 0x22, 0xfd, 0xe9, 0x32, 0xff, 0x8b, 0xf3, 0xce, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x60, 0xd8, 0xf4, 0xdb, 0x7f, 0x0d, 0x00, 0x00,
+func (m *MsgRenewRecord) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRenewRecord) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgRenewRecordResponse
+type MsgRenewRecordResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetRecord records a new record with given payload and bond id
-	SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grpc.CallOption) (*MsgSetRecordResponse, error)
-	// Renew Record renews an expired record
-	RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error)
-	// AssociateBond
-	AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error)
-	// DissociateBond
-	DissociateBond(ctx context.Context, in *MsgDissociateBond, opts ...grpc.CallOption) (*MsgDissociateBondResponse, error)
-	// DissociateRecords
-	DissociateRecords(ctx context.Context, in *MsgDissociateRecords, opts ...grpc.CallOption) (*MsgDissociateRecordsResponse, error)
-	// ReassociateRecords
-	ReassociateRecords(ctx context.Context, in *MsgReassociateRecords, opts ...grpc.CallOption) (*MsgReassociateRecordsResponse, error)
-	// SetName will store the name with given lrn and name
-	SetName(ctx context.Context, in *MsgSetName, opts ...grpc.CallOption) (*MsgSetNameResponse, error)
-	// Delete Name method will remove authority name
-	DeleteName(ctx context.Context, in *MsgDeleteName, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
-	// Reserve authority name
-	ReserveAuthority(ctx context.Context, in *MsgReserveAuthority, opts ...grpc.CallOption) (*MsgReserveAuthorityResponse, error)
-	// SetAuthorityBond
-	SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBond, opts ...grpc.CallOption) (*MsgSetAuthorityBondResponse, error)
+func (m *MsgRenewRecordResponse) Reset()         { *m = MsgRenewRecordResponse{} }
+func (m *MsgRenewRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewRecordResponse) ProtoMessage()    {}
+func (*MsgRenewRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{24}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRenewRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewRecordResponse.Merge(m, src)
+}
+func (m *MsgRenewRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewRecordResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgRenewRecordResponse proto.InternalMessageInfo
+
+// MsgDeleteRecord
+type MsgDeleteRecord struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []Signature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
 }
 
-func (c *msgClient) SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grpc.CallOption) (*MsgSetRecordResponse, error) {
-	out := new(MsgSetRecordResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRecord) Reset()         { *m = MsgDeleteRecord{} }
+func (m *MsgDeleteRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecord) ProtoMessage()    {}
+func (*MsgDeleteRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{25}
+}
+func (m *MsgDeleteRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecord.Merge(m, src)
+}
+func (m *MsgDeleteRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecord.DiscardUnknown(m)
 }
 
-func (c *msgClient) RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error) {
-	out := new(MsgRenewRecordResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/RenewRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteRecord proto.InternalMessageInfo
+
+func (m *MsgDeleteRecord) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error) {
-	out := new(MsgAssociateBondResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/AssociateBond", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DissociateBond(ctx context.Context, in *MsgDissociateBond, opts ...grpc.CallOption) (*MsgDissociateBondResponse, error) {
-	out := new(MsgDissociateBondResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DissociateBond", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRecord) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DissociateRecords(ctx context.Context, in *MsgDissociateRecords, opts ...grpc.CallOption) (*MsgDissociateRecordsResponse, error) {
-	out := new(MsgDissociateRecordsResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DissociateRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDeleteRecordResponse
+type MsgDeleteRecordResponse struct {
 }
 
-func (c *msgClient) ReassociateRecords(ctx context.Context, in *MsgReassociateRecords, opts ...grpc.CallOption) (*MsgReassociateRecordsResponse, error) {
-	out := new(MsgReassociateRecordsResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/ReassociateRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRecordResponse) Reset()         { *m = MsgDeleteRecordResponse{} }
+func (m *MsgDeleteRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRecordResponse) ProtoMessage()    {}
+func (*MsgDeleteRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{26}
+}
+func (m *MsgDeleteRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRecordResponse.Merge(m, src)
+}
+func (m *MsgDeleteRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRecordResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetName(ctx context.Context, in *MsgSetName, opts ...grpc.CallOption) (*MsgSetNameResponse, error) {
-	out := new(MsgSetNameResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
+
+// MsgTransferRecordOwnership
+type MsgTransferRecordOwnership struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	// New owner addresses (account or hex)
+	Owners []string `protobuf:"bytes,2,rep,name=owners,proto3" json:"owners,omitempty" json:"owners" yaml:"owners"`
+	// New owner threshold; defaults to 1
+	OwnerThreshold uint32 `protobuf:"varint,3,opt,name=owner_threshold,json=ownerThreshold,proto3" json:"owner_threshold,omitempty" json:"owner_threshold" yaml:"owner_threshold"`
+	// Approvals of current record owners other than the signer (see
+	// RecordOperation)
+	Signatures []Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
+	Signer     string      `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgTransferRecordOwnership) Reset()         { *m = MsgTransferRecordOwnership{} }
+func (m *MsgTransferRecordOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRecordOwnership) ProtoMessage()    {}
+func (*MsgTransferRecordOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{27}
+}
+func (m *MsgTransferRecordOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferRecordOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferRecordOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferRecordOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRecordOwnership.Merge(m, src)
+}
+func (m *MsgTransferRecordOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferRecordOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRecordOwnership.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteName, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error) {
-	out := new(MsgDeleteNameResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DeleteName", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferRecordOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferRecordOwnership) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ReserveAuthority(ctx context.Context, in *MsgReserveAuthority, opts ...grpc.CallOption) (*MsgReserveAuthorityResponse, error) {
-	out := new(MsgReserveAuthorityResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/ReserveAuthority", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferRecordOwnership) GetOwners() []string {
+	if m != nil {
+		return m.Owners
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBond, opts ...grpc.CallOption) (*MsgSetAuthorityBondResponse, error) {
-	out := new(MsgSetAuthorityBondResponse)
-	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetAuthorityBond", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferRecordOwnership) GetOwnerThreshold() uint32 {
+	if m != nil {
+		return m.OwnerThreshold
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetRecord records a new record with given payload and bond id
-	SetRecord(context.Context, *MsgSetRecord) (*MsgSetRecordResponse, error)
-	// Renew Record renews an expired record
-	RenewRecord(context.Context, *MsgRenewRecord) (*MsgRenewRecordResponse, error)
-	// AssociateBond
-	AssociateBond(context.Context, *MsgAssociateBond) (*MsgAssociateBondResponse, error)
-	// DissociateBond
-	DissociateBond(context.Context, *MsgDissociateBond) (*MsgDissociateBondResponse, error)
-	// DissociateRecords
-	DissociateRecords(context.Context, *MsgDissociateRecords) (*MsgDissociateRecordsResponse, error)
-	// ReassociateRecords
-	ReassociateRecords(context.Context, *MsgReassociateRecords) (*MsgReassociateRecordsResponse, error)
-	// SetName will store the name with given lrn and name
-	SetName(context.Context, *MsgSetName) (*MsgSetNameResponse, error)
-	// Delete Name method will remove authority name
-	DeleteName(context.Context, *MsgDeleteName) (*MsgDeleteNameResponse, error)
-	// Reserve authority name
-	ReserveAuthority(context.Context, *MsgReserveAuthority) (*MsgReserveAuthorityResponse, error)
-	// SetAuthorityBond
-	SetAuthorityBond(context.Context, *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error)
+func (m *MsgTransferRecordOwnership) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgTransferRecordOwnership) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) SetRecord(ctx context.Context, req *MsgSetRecord) (*MsgSetRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRecord not implemented")
-}
-func (*UnimplementedMsgServer) RenewRecord(ctx context.Context, req *MsgRenewRecord) (*MsgRenewRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewRecord not implemented")
+// MsgTransferRecordOwnershipResponse
+type MsgTransferRecordOwnershipResponse struct {
 }
-func (*UnimplementedMsgServer) AssociateBond(ctx context.Context, req *MsgAssociateBond) (*MsgAssociateBondResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssociateBond not implemented")
-}
-func (*UnimplementedMsgServer) DissociateBond(ctx context.Context, req *MsgDissociateBond) (*MsgDissociateBondResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DissociateBond not implemented")
-}
-func (*UnimplementedMsgServer) DissociateRecords(ctx context.Context, req *MsgDissociateRecords) (*MsgDissociateRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DissociateRecords not implemented")
+
+func (m *MsgTransferRecordOwnershipResponse) Reset()         { *m = MsgTransferRecordOwnershipResponse{} }
+func (m *MsgTransferRecordOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferRecordOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferRecordOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{28}
 }
-func (*UnimplementedMsgServer) ReassociateRecords(ctx context.Context, req *MsgReassociateRecords) (*MsgReassociateRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReassociateRecords not implemented")
+func (m *MsgTransferRecordOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetName(ctx context.Context, req *MsgSetName) (*MsgSetNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetName not implemented")
+func (m *MsgTransferRecordOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferRecordOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteName) (*MsgDeleteNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
+func (m *MsgTransferRecordOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferRecordOwnershipResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ReserveAuthority(ctx context.Context, req *MsgReserveAuthority) (*MsgReserveAuthorityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReserveAuthority not implemented")
+func (m *MsgTransferRecordOwnershipResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetAuthorityBond(ctx context.Context, req *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAuthorityBond not implemented")
+func (m *MsgTransferRecordOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferRecordOwnershipResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgTransferRecordOwnershipResponse proto.InternalMessageInfo
+
+// MsgAssociateBond
+type MsgAssociateBond struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	BondId   string `protobuf:"bytes,2,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
+	Signer   string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
 }
 
-func _Msg_SetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/SetRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetRecord(ctx, req.(*MsgSetRecord))
+func (m *MsgAssociateBond) Reset()         { *m = MsgAssociateBond{} }
+func (m *MsgAssociateBond) String() string { return proto.CompactTextString(m) }
+func (*MsgAssociateBond) ProtoMessage()    {}
+func (*MsgAssociateBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{29}
+}
+func (m *MsgAssociateBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAssociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAssociateBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAssociateBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAssociateBond.Merge(m, src)
+}
+func (m *MsgAssociateBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAssociateBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAssociateBond.DiscardUnknown(m)
 }
 
-func _Msg_RenewRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRenewRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RenewRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/RenewRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RenewRecord(ctx, req.(*MsgRenewRecord))
+var xxx_messageInfo_MsgAssociateBond proto.InternalMessageInfo
+
+func (m *MsgAssociateBond) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AssociateBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAssociateBond)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AssociateBond(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/AssociateBond",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AssociateBond(ctx, req.(*MsgAssociateBond))
+func (m *MsgAssociateBond) GetBondId() string {
+	if m != nil {
+		return m.BondId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DissociateBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDissociateBond)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DissociateBond(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/DissociateBond",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DissociateBond(ctx, req.(*MsgDissociateBond))
+func (m *MsgAssociateBond) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DissociateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDissociateRecords)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DissociateRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/DissociateRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DissociateRecords(ctx, req.(*MsgDissociateRecords))
+func (m *MsgAssociateBond) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_ReassociateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReassociateRecords)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReassociateRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/ReassociateRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReassociateRecords(ctx, req.(*MsgReassociateRecords))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgAssociateBondResponse
+type MsgAssociateBondResponse struct {
 }
 
-func _Msg_SetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetName)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/SetName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetName(ctx, req.(*MsgSetName))
+func (m *MsgAssociateBondResponse) Reset()         { *m = MsgAssociateBondResponse{} }
+func (m *MsgAssociateBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAssociateBondResponse) ProtoMessage()    {}
+func (*MsgAssociateBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{30}
+}
+func (m *MsgAssociateBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAssociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAssociateBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAssociateBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAssociateBondResponse.Merge(m, src)
+}
+func (m *MsgAssociateBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAssociateBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAssociateBondResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteName)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteName(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/DeleteName",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteName(ctx, req.(*MsgDeleteName))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgAssociateBondResponse proto.InternalMessageInfo
+
+// MsgDissociateBond
+type MsgDissociateBond struct {
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty" json:"record_id" yaml:"record_id"`
+	Signer   string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Approvals of record owners other than the signer (see RecordOperation)
+	Signatures []Signature `protobuf:"bytes,3,rep,name=signatures,proto3" json:"signatures" json:"signatures" yaml:"signatures"`
 }
 
-func _Msg_ReserveAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReserveAuthority)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReserveAuthority(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/ReserveAuthority",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReserveAuthority(ctx, req.(*MsgReserveAuthority))
+func (m *MsgDissociateBond) Reset()         { *m = MsgDissociateBond{} }
+func (m *MsgDissociateBond) String() string { return proto.CompactTextString(m) }
+func (*MsgDissociateBond) ProtoMessage()    {}
+func (*MsgDissociateBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{31}
+}
+func (m *MsgDissociateBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDissociateBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDissociateBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDissociateBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDissociateBond.Merge(m, src)
+}
+func (m *MsgDissociateBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDissociateBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDissociateBond.DiscardUnknown(m)
 }
 
-func _Msg_SetAuthorityBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetAuthorityBond)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetAuthorityBond(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cerc.registry.v1.Msg/SetAuthorityBond",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetAuthorityBond(ctx, req.(*MsgSetAuthorityBond))
+var xxx_messageInfo_MsgDissociateBond proto.InternalMessageInfo
+
+func (m *MsgDissociateBond) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cerc.registry.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetRecord",
-			Handler:    _Msg_SetRecord_Handler,
-		},
-		{
-			MethodName: "RenewRecord",
-			Handler:    _Msg_RenewRecord_Handler,
-		},
-		{
-			MethodName: "AssociateBond",
-			Handler:    _Msg_AssociateBond_Handler,
-		},
-		{
-			MethodName: "DissociateBond",
-			Handler:    _Msg_DissociateBond_Handler,
-		},
-		{
-			MethodName: "DissociateRecords",
-			Handler:    _Msg_DissociateRecords_Handler,
-		},
-		{
-			MethodName: "ReassociateRecords",
-			Handler:    _Msg_ReassociateRecords_Handler,
-		},
-		{
-			MethodName: "SetName",
-			Handler:    _Msg_SetName_Handler,
-		},
-		{
-			MethodName: "DeleteName",
-			Handler:    _Msg_DeleteName_Handler,
-		},
-		{
-			MethodName: "ReserveAuthority",
-			Handler:    _Msg_ReserveAuthority_Handler,
-		},
-		{
-			MethodName: "SetAuthorityBond",
-			Handler:    _Msg_SetAuthorityBond_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cerc/registry/v1/tx.proto",
+func (m *MsgDissociateBond) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgSetRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDissociateBond) GetSignatures() []Signature {
+	if m != nil {
+		return m.Signatures
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDissociateBondResponse
+type MsgDissociateBondResponse struct {
 }
 
-func (m *MsgSetRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDissociateBondResponse) Reset()         { *m = MsgDissociateBondResponse{} }
+func (m *MsgDissociateBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDissociateBondResponse) ProtoMessage()    {}
+func (*MsgDissociateBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{32}
+}
+func (m *MsgDissociateBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDissociateBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDissociateBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BondId) > 0 {
-		i -= len(m.BondId)
-		copy(dAtA[i:], m.BondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSetRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDissociateBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDissociateBondResponse.Merge(m, src)
 }
-
-func (m *MsgSetRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDissociateBondResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgSetRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDissociateBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDissociateBondResponse.DiscardUnknown(m)
 }
 
-func (m *Payload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgDissociateBondResponse proto.InternalMessageInfo
 
-func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDissociateRecords
+type MsgDissociateRecords struct {
+	BondId string `protobuf:"bytes,1,opt,name=bond_id,json=bondId,proto3" json:"bond_id,omitempty" json:"bond_id" yaml:"bond_id"`
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgDissociateRecords) Reset()         { *m = MsgDissociateRecords{} }
+func (m *MsgDissociateRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgDissociateRecords) ProtoMessage()    {}
+func (*MsgDissociateRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{33}
+}
+func (m *MsgDissociateRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDissociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDissociateRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Record != nil {
-		{
-			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgDissociateRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDissociateRecords.Merge(m, src)
+}
+func (m *MsgDissociateRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDissociateRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDissociateRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDissociateRecords proto.InternalMessageInfo
+
+func (m *MsgDissociateRecords) GetBondId() string {
+	if m != nil {
+		return m.BondId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetName) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDissociateRecords) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetName) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDissociateRecordsResponse
+type MsgDissociateRecordsResponse struct {
 }
 
-func (m *MsgSetName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Lrn) > 0 {
-		i -= len(m.Lrn)
-		copy(dAtA[i:], m.Lrn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lrn)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgDissociateRecordsResponse) Reset()         { *m = MsgDissociateRecordsResponse{} }
+func (m *MsgDissociateRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDissociateRecordsResponse) ProtoMessage()    {}
+func (*MsgDissociateRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{34}
 }
-
-func (m *MsgSetNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDissociateRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDissociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDissociateRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSetNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDissociateRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDissociateRecordsResponse.Merge(m, src)
+}
+func (m *MsgDissociateRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDissociateRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDissociateRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgDissociateRecordsResponse proto.InternalMessageInfo
+
+// MsgReassociateRecords
+type MsgReassociateRecords struct {
+	NewBondId string `protobuf:"bytes,1,opt,name=new_bond_id,json=newBondId,proto3" json:"new_bond_id,omitempty" json:"new_bond_id" yaml:"new_bond_id"`
+	OldBondId string `protobuf:"bytes,2,opt,name=old_bond_id,json=oldBondId,proto3" json:"old_bond_id,omitempty" json:"old_bond_id" yaml:"old_bond_id"`
+	Signer    string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgReserveAuthority) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReassociateRecords) Reset()         { *m = MsgReassociateRecords{} }
+func (m *MsgReassociateRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgReassociateRecords) ProtoMessage()    {}
+func (*MsgReassociateRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{35}
+}
+func (m *MsgReassociateRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReassociateRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReassociateRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgReserveAuthority) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReassociateRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReassociateRecords.Merge(m, src)
+}
+func (m *MsgReassociateRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReassociateRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReassociateRecords.DiscardUnknown(m)
 }
 
-func (m *MsgReserveAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgReassociateRecords proto.InternalMessageInfo
+
+func (m *MsgReassociateRecords) GetNewBondId() string {
+	if m != nil {
+		return m.NewBondId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgReserveAuthorityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReassociateRecords) GetOldBondId() string {
+	if m != nil {
+		return m.OldBondId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgReserveAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReassociateRecords) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgReserveAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgReassociateRecordsResponse
+type MsgReassociateRecordsResponse struct {
 }
 
-func (m *MsgSetAuthorityBond) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReassociateRecordsResponse) Reset()         { *m = MsgReassociateRecordsResponse{} }
+func (m *MsgReassociateRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReassociateRecordsResponse) ProtoMessage()    {}
+func (*MsgReassociateRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{36}
+}
+func (m *MsgReassociateRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReassociateRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReassociateRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReassociateRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReassociateRecordsResponse.Merge(m, src)
+}
+func (m *MsgReassociateRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReassociateRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReassociateRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSetAuthorityBond) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReassociateRecordsResponse proto.InternalMessageInfo
+
+// MsgRegisterSchema
+type MsgRegisterSchema struct {
+	RecordType string `protobuf:"bytes,1,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty" json:"record_type" yaml:"record_type"`
+	Authority  string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	Format     string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Definition string `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	Signer     string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgSetAuthorityBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRegisterSchema) Reset()         { *m = MsgRegisterSchema{} }
+func (m *MsgRegisterSchema) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSchema) ProtoMessage()    {}
+func (*MsgRegisterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{37}
+}
+func (m *MsgRegisterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.BondId) > 0 {
-		i -= len(m.BondId)
-		copy(dAtA[i:], m.BondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRegisterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSchema.Merge(m, src)
+}
+func (m *MsgRegisterSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSchema proto.InternalMessageInfo
+
+func (m *MsgRegisterSchema) GetRecordType() string {
+	if m != nil {
+		return m.RecordType
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRegisterSchema) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetAuthorityBondResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterSchema) GetFormat() string {
+	if m != nil {
+		return m.Format
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSetAuthorityBondResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterSchema) GetDefinition() string {
+	if m != nil {
+		return m.Definition
+	}
+	return ""
 }
 
-func (m *MsgSetAuthorityBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterSchema) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgDeleteName) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgRegisterSchemaResponse
+type MsgRegisterSchemaResponse struct {
+}
+
+func (m *MsgRegisterSchemaResponse) Reset()         { *m = MsgRegisterSchemaResponse{} }
+func (m *MsgRegisterSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSchemaResponse) ProtoMessage()    {}
+func (*MsgRegisterSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c6eb2e5a4d8fa03, []int{38}
+}
+func (m *MsgRegisterSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSchemaResponse.Merge(m, src)
+}
+func (m *MsgRegisterSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteName) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterSchemaResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetRecord)(nil), "cerc.registry.v1.MsgSetRecord")
+	proto.RegisterType((*MsgSetRecordResponse)(nil), "cerc.registry.v1.MsgSetRecordResponse")
+	proto.RegisterType((*MsgUpdateRecord)(nil), "cerc.registry.v1.MsgUpdateRecord")
+	proto.RegisterType((*MsgUpdateRecordResponse)(nil), "cerc.registry.v1.MsgUpdateRecordResponse")
+	proto.RegisterType((*Payload)(nil), "cerc.registry.v1.Payload")
+	proto.RegisterType((*MsgSetName)(nil), "cerc.registry.v1.MsgSetName")
+	proto.RegisterType((*MsgSetNameResponse)(nil), "cerc.registry.v1.MsgSetNameResponse")
+	proto.RegisterType((*MsgReserveAuthority)(nil), "cerc.registry.v1.MsgReserveAuthority")
+	proto.RegisterType((*MsgReserveAuthorityResponse)(nil), "cerc.registry.v1.MsgReserveAuthorityResponse")
+	proto.RegisterType((*MsgSetAuthorityBond)(nil), "cerc.registry.v1.MsgSetAuthorityBond")
+	proto.RegisterType((*MsgSetAuthorityBondResponse)(nil), "cerc.registry.v1.MsgSetAuthorityBondResponse")
+	proto.RegisterType((*MsgTransferAuthority)(nil), "cerc.registry.v1.MsgTransferAuthority")
+	proto.RegisterType((*MsgTransferAuthorityResponse)(nil), "cerc.registry.v1.MsgTransferAuthorityResponse")
+	proto.RegisterType((*MsgAcceptAuthority)(nil), "cerc.registry.v1.MsgAcceptAuthority")
+	proto.RegisterType((*MsgAcceptAuthorityResponse)(nil), "cerc.registry.v1.MsgAcceptAuthorityResponse")
+	proto.RegisterType((*MsgRenewAuthority)(nil), "cerc.registry.v1.MsgRenewAuthority")
+	proto.RegisterType((*MsgRenewAuthorityResponse)(nil), "cerc.registry.v1.MsgRenewAuthorityResponse")
+	proto.RegisterType((*MsgGrantNameAccess)(nil), "cerc.registry.v1.MsgGrantNameAccess")
+	proto.RegisterType((*MsgGrantNameAccessResponse)(nil), "cerc.registry.v1.MsgGrantNameAccessResponse")
+	proto.RegisterType((*MsgRevokeNameAccess)(nil), "cerc.registry.v1.MsgRevokeNameAccess")
+	proto.RegisterType((*MsgRevokeNameAccessResponse)(nil), "cerc.registry.v1.MsgRevokeNameAccessResponse")
+	proto.RegisterType((*MsgDeleteName)(nil), "cerc.registry.v1.MsgDeleteName")
+	proto.RegisterType((*MsgDeleteNameResponse)(nil), "cerc.registry.v1.MsgDeleteNameResponse")
+	proto.RegisterType((*MsgRenewRecord)(nil), "cerc.registry.v1.MsgRenewRecord")
+	proto.RegisterType((*MsgRenewRecordResponse)(nil), "cerc.registry.v1.MsgRenewRecordResponse")
+	proto.RegisterType((*MsgDeleteRecord)(nil), "cerc.registry.v1.MsgDeleteRecord")
+	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "cerc.registry.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgTransferRecordOwnership)(nil), "cerc.registry.v1.MsgTransferRecordOwnership")
+	proto.RegisterType((*MsgTransferRecordOwnershipResponse)(nil), "cerc.registry.v1.MsgTransferRecordOwnershipResponse")
+	proto.RegisterType((*MsgAssociateBond)(nil), "cerc.registry.v1.MsgAssociateBond")
+	proto.RegisterType((*MsgAssociateBondResponse)(nil), "cerc.registry.v1.MsgAssociateBondResponse")
+	proto.RegisterType((*MsgDissociateBond)(nil), "cerc.registry.v1.MsgDissociateBond")
+	proto.RegisterType((*MsgDissociateBondResponse)(nil), "cerc.registry.v1.MsgDissociateBondResponse")
+	proto.RegisterType((*MsgDissociateRecords)(nil), "cerc.registry.v1.MsgDissociateRecords")
+	proto.RegisterType((*MsgDissociateRecordsResponse)(nil), "cerc.registry.v1.MsgDissociateRecordsResponse")
+	proto.RegisterType((*MsgReassociateRecords)(nil), "cerc.registry.v1.MsgReassociateRecords")
+	proto.RegisterType((*MsgReassociateRecordsResponse)(nil), "cerc.registry.v1.MsgReassociateRecordsResponse")
+	proto.RegisterType((*MsgRegisterSchema)(nil), "cerc.registry.v1.MsgRegisterSchema")
+	proto.RegisterType((*MsgRegisterSchemaResponse)(nil), "cerc.registry.v1.MsgRegisterSchemaResponse")
 }
 
-func (m *MsgDeleteName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Lrn) > 0 {
-		i -= len(m.Lrn)
-		copy(dAtA[i:], m.Lrn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Lrn)))
-		i--
-		dAtA[i] = 0xa
+func init() { proto.RegisterFile("cerc/registry/v1/tx.proto", fileDescriptor_3c6eb2e5a4d8fa03) }
+
+var fileDescriptor_3c6eb2e5a4d8fa03 = []byte{
+	// 1885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6f, 0x23, 0x49,
+	0x19, 0x4f, 0xdb, 0x79, 0xf9, 0xf3, 0xe6, 0xb1, 0x4d, 0x76, 0xe3, 0x74, 0x12, 0xb7, 0xd3, 0x79,
+	0x3f, 0x6c, 0x33, 0x19, 0x24, 0xc4, 0x9c, 0x58, 0x2b, 0x5a, 0x34, 0x48, 0x81, 0x55, 0x27, 0xcb,
+	0x61, 0x0f, 0x58, 0x1d, 0x77, 0xc5, 0xe9, 0x5d, 0xbb, 0xdb, 0xdb, 0xd5, 0x79, 0xf8, 0x80, 0x04,
+	0x23, 0x10, 0x02, 0x09, 0x31, 0x80, 0x40, 0x20, 0x81, 0x10, 0x07, 0xee, 0x23, 0xfe, 0x8a, 0xe1,
+	0x36, 0x82, 0x0b, 0xe2, 0x60, 0xd0, 0xcc, 0x88, 0x91, 0x38, 0xfa, 0x8e, 0x84, 0xaa, 0xba, 0xbb,
+	0xba, 0xfa, 0x61, 0xa7, 0x67, 0x94, 0x64, 0xf6, 0x56, 0x5d, 0xf5, 0xab, 0xfa, 0x7e, 0xf5, 0xfb,
+	0xbe, 0xaa, 0xfa, 0xfa, 0x83, 0x85, 0x06, 0xb2, 0x1b, 0x55, 0x1b, 0x35, 0x0d, 0xec, 0xd8, 0xdd,
+	0xea, 0xc5, 0xbd, 0xaa, 0x73, 0x55, 0xe9, 0xd8, 0x96, 0x63, 0x89, 0xb3, 0x64, 0xa8, 0xe2, 0x0f,
+	0x55, 0x2e, 0xee, 0x49, 0x4b, 0x4d, 0xcb, 0x6a, 0xb6, 0x50, 0x55, 0xeb, 0x18, 0x55, 0xcd, 0x34,
+	0x2d, 0x47, 0x73, 0x0c, 0xcb, 0xc4, 0x2e, 0x5e, 0x9a, 0x6b, 0x5a, 0x4d, 0x8b, 0x36, 0xab, 0xa4,
+	0xe5, 0xf5, 0x16, 0xbd, 0x39, 0xf4, 0xeb, 0xe4, 0xfc, 0xb4, 0xaa, 0x9f, 0xdb, 0x74, 0x9a, 0x37,
+	0x2e, 0x47, 0xc7, 0x1d, 0xa3, 0x8d, 0xb0, 0xa3, 0xb5, 0x3b, 0x1e, 0x60, 0xbe, 0x61, 0xe1, 0xb6,
+	0x85, 0xab, 0x6d, 0xdc, 0x24, 0xf4, 0xda, 0xb8, 0xe9, 0xcf, 0x8c, 0x51, 0x67, 0x5c, 0x29, 0x40,
+	0xf9, 0x6f, 0x06, 0xde, 0x39, 0xc4, 0xcd, 0x23, 0xe4, 0xa8, 0xa8, 0x61, 0xd9, 0xba, 0xf8, 0x00,
+	0x26, 0x4e, 0x2c, 0x53, 0xaf, 0x1b, 0x7a, 0x41, 0x28, 0x09, 0x5b, 0xb9, 0xda, 0x4a, 0xbf, 0x27,
+	0x2f, 0x7f, 0x8a, 0x2d, 0xf3, 0x81, 0xe2, 0x0d, 0x28, 0xa5, 0xae, 0xd6, 0x6e, 0x05, 0x9f, 0xea,
+	0x38, 0x69, 0x3d, 0xd4, 0xc5, 0xf7, 0x61, 0x1c, 0x1b, 0x4d, 0x13, 0xd9, 0x85, 0x0c, 0x99, 0xaa,
+	0x7a, 0x5f, 0xe2, 0xd7, 0x60, 0xa2, 0xa3, 0x75, 0x5b, 0x96, 0xa6, 0x17, 0xb2, 0x25, 0x61, 0x2b,
+	0xbf, 0xbf, 0x50, 0x89, 0xea, 0x56, 0xf9, 0xc8, 0x05, 0xd4, 0x46, 0x9f, 0xf6, 0xe4, 0x11, 0xd5,
+	0xc7, 0x8b, 0x27, 0x30, 0xd9, 0x32, 0x4e, 0x11, 0xd9, 0x70, 0x61, 0xd4, 0x9b, 0xeb, 0xaa, 0x51,
+	0xf1, 0xd5, 0xa8, 0x1c, 0x78, 0x6a, 0xd5, 0x76, 0xc9, 0xdc, 0x7e, 0x4f, 0x96, 0x5d, 0xba, 0xfe,
+	0x44, 0x9f, 0x2f, 0xfb, 0xfe, 0xed, 0xbf, 0x64, 0x41, 0x65, 0xeb, 0x8a, 0x9f, 0xc0, 0x8c, 0x75,
+	0x69, 0x22, 0xbb, 0xee, 0x9c, 0xd9, 0x08, 0x9f, 0x59, 0x2d, 0xbd, 0x30, 0x56, 0x12, 0xb6, 0xa6,
+	0x6a, 0xf7, 0xfa, 0x3d, 0xb9, 0xec, 0xae, 0x15, 0x01, 0xf8, 0x4b, 0x46, 0xbb, 0xd5, 0x69, 0xda,
+	0x73, 0xec, 0x77, 0x3c, 0xc8, 0x3f, 0x7a, 0xf5, 0x64, 0xc7, 0xd3, 0x41, 0xd9, 0x80, 0x39, 0x5e,
+	0x6b, 0x15, 0xe1, 0x8e, 0x65, 0x62, 0x24, 0x4e, 0x43, 0xc6, 0x97, 0x5b, 0xcd, 0x18, 0xba, 0xf2,
+	0xc7, 0x0c, 0xcc, 0x1c, 0xe2, 0xe6, 0xc7, 0x1d, 0x5d, 0x73, 0x90, 0xe7, 0x97, 0x1a, 0xe4, 0x6c,
+	0xda, 0x0a, 0x3c, 0xb3, 0xde, 0xef, 0xc9, 0x2b, 0x2e, 0x3d, 0x36, 0xe4, 0x13, 0x0b, 0x3a, 0xd4,
+	0x49, 0xb7, 0x7d, 0x3b, 0xfe, 0x39, 0x03, 0x20, 0x8b, 0x68, 0xce, 0xb9, 0x8d, 0x70, 0x61, 0xb4,
+	0x94, 0xdd, 0xca, 0xef, 0x2f, 0xc6, 0x67, 0x1f, 0xf9, 0x18, 0xe6, 0xa3, 0x55, 0x97, 0x78, 0x30,
+	0xd9, 0x67, 0xce, 0xf5, 0xa8, 0xdc, 0xda, 0x61, 0x25, 0xb7, 0x61, 0x3e, 0x22, 0xd0, 0x40, 0x31,
+	0xff, 0x2c, 0xc0, 0x84, 0x47, 0x5e, 0xfc, 0x32, 0x8c, 0xbb, 0x62, 0xd0, 0xf1, 0xfc, 0x7e, 0x21,
+	0xce, 0xd4, 0x5b, 0xcd, 0xc3, 0x45, 0xf6, 0x97, 0xb9, 0xbd, 0xfd, 0x29, 0x1f, 0x03, 0xb8, 0xc1,
+	0xf1, 0x2d, 0xad, 0x8d, 0xc4, 0x59, 0xc8, 0xb6, 0x6c, 0xd3, 0xdb, 0x06, 0x69, 0x92, 0x9e, 0x86,
+	0xa1, 0x7b, 0x9e, 0x23, 0x4d, 0xce, 0x9d, 0x59, 0xde, 0x9d, 0x61, 0xa5, 0xe6, 0x40, 0x0c, 0x96,
+	0xf5, 0x45, 0x52, 0x74, 0xf8, 0xd2, 0x21, 0x6e, 0xaa, 0x08, 0x23, 0xfb, 0x02, 0x7d, 0x70, 0xee,
+	0x9c, 0x59, 0xb6, 0xe1, 0x74, 0x45, 0x11, 0x46, 0x4d, 0xad, 0x8d, 0x3c, 0xb3, 0xb4, 0x3d, 0x30,
+	0x68, 0xe6, 0x60, 0xcc, 0xba, 0x0c, 0x8c, 0xbb, 0x1f, 0x61, 0xdb, 0xcb, 0xb0, 0x98, 0x60, 0x85,
+	0x91, 0xf8, 0xa9, 0x40, 0x59, 0x1c, 0x21, 0x87, 0x8d, 0xd5, 0x2c, 0x53, 0x4f, 0x64, 0xc1, 0x5d,
+	0x4b, 0x99, 0x37, 0xbf, 0x96, 0x86, 0xe8, 0xe4, 0x72, 0x8d, 0x72, 0x61, 0x5c, 0x7f, 0x9e, 0xa5,
+	0x67, 0xf7, 0xd8, 0xd6, 0x4c, 0x7c, 0x8a, 0xec, 0xe1, 0x92, 0xd5, 0x20, 0x67, 0xa2, 0xcb, 0xba,
+	0x2b, 0x4f, 0x26, 0x7a, 0x56, 0xd9, 0x90, 0x4f, 0x38, 0xe8, 0x50, 0x27, 0x4d, 0x74, 0xf9, 0x6d,
+	0xd2, 0x14, 0xbf, 0x03, 0xd3, 0x36, 0xfa, 0xfc, 0xdc, 0xb0, 0x51, 0x5d, 0x6b, 0x34, 0x50, 0xc7,
+	0xa1, 0xe4, 0x27, 0x6b, 0xd5, 0x7e, 0x4f, 0xde, 0xf5, 0x0f, 0x3d, 0x3f, 0x1e, 0x9c, 0xfc, 0x50,
+	0xaf, 0x3a, 0xe5, 0x75, 0x7c, 0x40, 0xbf, 0x09, 0xb7, 0xcf, 0x10, 0xea, 0xd4, 0x89, 0x36, 0xf4,
+	0x46, 0x9d, 0xe4, 0xb9, 0xb1, 0x21, 0x7f, 0xb5, 0xa0, 0x43, 0x9d, 0x24, 0x6d, 0xea, 0xa0, 0xef,
+	0xc2, 0xac, 0x61, 0x5e, 0x68, 0x2d, 0x83, 0x1c, 0xbf, 0x3a, 0xd9, 0x32, 0xa6, 0x37, 0xe6, 0x64,
+	0xed, 0x7e, 0xbf, 0x27, 0x57, 0xdd, 0xa5, 0xa2, 0x08, 0x7f, 0xc5, 0x58, 0xbf, 0x3a, 0x13, 0x74,
+	0x91, 0x20, 0xc5, 0x9c, 0xc3, 0xc6, 0x07, 0x3b, 0xac, 0x08, 0x4b, 0x49, 0x0e, 0x61, 0x1e, 0x3b,
+	0xa4, 0x81, 0xef, 0xee, 0xfa, 0x8d, 0x22, 0x3c, 0x6c, 0x6e, 0x09, 0xa4, 0xf8, 0x72, 0xcc, 0xd8,
+	0x29, 0xbc, 0x4b, 0x23, 0xdd, 0x44, 0x97, 0xc3, 0x6d, 0x15, 0x60, 0xa2, 0x83, 0x6c, 0xc3, 0xd2,
+	0x31, 0x35, 0x36, 0xaa, 0xfa, 0x9f, 0xe9, 0xa2, 0x74, 0x11, 0x16, 0x62, 0x76, 0x18, 0x89, 0x1f,
+	0x67, 0xe8, 0x96, 0xbf, 0x61, 0x6b, 0x26, 0x3d, 0xed, 0x84, 0x2c, 0xc6, 0xe2, 0x87, 0x00, 0x2d,
+	0xdb, 0xac, 0x77, 0x6c, 0x74, 0x6a, 0x5c, 0x79, 0x4f, 0xc7, 0x66, 0x70, 0x43, 0x05, 0x63, 0xec,
+	0x9d, 0x0c, 0x7a, 0xd4, 0x5c, 0xcb, 0x36, 0x3f, 0xa2, 0x6d, 0x42, 0xbd, 0x49, 0x96, 0x46, 0xc8,
+	0xd3, 0xc9, 0xff, 0x14, 0x3f, 0x85, 0x3c, 0xba, 0xea, 0x18, 0x76, 0xb7, 0x4e, 0xdf, 0x69, 0xf7,
+	0x0d, 0x91, 0x62, 0xef, 0xf4, 0xb1, 0x9f, 0xb5, 0xd4, 0xca, 0xfd, 0x9e, 0xbc, 0xee, 0x9a, 0xe7,
+	0x26, 0xfa, 0xf6, 0xf9, 0xae, 0xc7, 0xe4, 0xa9, 0x06, 0xb7, 0x87, 0xcc, 0xe7, 0x64, 0x1a, 0xbd,
+	0xce, 0x59, 0x11, 0x21, 0x98, 0x4e, 0xbf, 0x17, 0xbc, 0xdb, 0xef, 0xc2, 0xfa, 0x0c, 0xdd, 0xa9,
+	0x50, 0xaf, 0x71, 0x13, 0x45, 0xd9, 0x31, 0xf6, 0x1f, 0xc2, 0xd4, 0x21, 0x6e, 0x1e, 0xa0, 0x16,
+	0x72, 0x8f, 0x4b, 0xc2, 0x53, 0x91, 0x2a, 0xa0, 0xe7, 0xe1, 0xbd, 0xd0, 0x3a, 0xcc, 0xc0, 0xff,
+	0x04, 0x98, 0xf6, 0x83, 0xec, 0x0e, 0x92, 0x0f, 0xee, 0x44, 0x64, 0xc3, 0x27, 0xe2, 0x2d, 0xe5,
+	0x16, 0x05, 0x78, 0x3f, 0xbc, 0x7d, 0xa6, 0xcc, 0x4b, 0x01, 0x66, 0x98, 0x66, 0x77, 0x20, 0x4d,
+	0x58, 0x80, 0xec, 0x5d, 0x09, 0xb0, 0x00, 0xf3, 0x91, 0x5d, 0x32, 0x05, 0x7e, 0x98, 0x05, 0x89,
+	0xbb, 0x75, 0xdd, 0x51, 0xfa, 0x60, 0xe1, 0x33, 0xa3, 0x73, 0x23, 0x62, 0x7c, 0x15, 0xc6, 0xe9,
+	0x63, 0xe8, 0x66, 0x5b, 0xb9, 0x9a, 0xdc, 0xef, 0xc9, 0x8b, 0x5c, 0x12, 0x8e, 0x43, 0xb9, 0x37,
+	0x56, 0x54, 0x0f, 0x9e, 0x94, 0xc6, 0x67, 0x6f, 0x28, 0x8d, 0xbf, 0xbb, 0x50, 0xe4, 0x62, 0x61,
+	0x6c, 0xf0, 0xd9, 0x5d, 0x03, 0x65, 0xb0, 0x17, 0x98, 0xb3, 0xfe, 0x94, 0x81, 0x59, 0xf2, 0x66,
+	0x61, 0x6c, 0x35, 0x0c, 0xcd, 0x41, 0xf4, 0xed, 0xbe, 0x09, 0x17, 0xdd, 0x42, 0x32, 0xf6, 0xb6,
+	0x0e, 0xbb, 0x04, 0x85, 0xa8, 0x44, 0x4c, 0xbf, 0xff, 0x08, 0xf4, 0x55, 0x3f, 0x30, 0x6e, 0x5c,
+	0xc0, 0x2f, 0xd8, 0x81, 0x77, 0xb3, 0x8a, 0x03, 0x23, 0x51, 0x85, 0x4b, 0x98, 0x0b, 0x0d, 0xba,
+	0xd1, 0x86, 0x6f, 0xa3, 0x50, 0x90, 0x94, 0xe0, 0xc5, 0x0c, 0x33, 0x62, 0x7f, 0x13, 0xe8, 0x0b,
+	0xa6, 0x22, 0x2d, 0x4a, 0xed, 0x21, 0xe4, 0x49, 0x4e, 0x1d, 0xa6, 0xb7, 0x1d, 0xe4, 0x1c, 0xdc,
+	0x20, 0x9f, 0x83, 0x33, 0x9a, 0x24, 0x7b, 0xaf, 0xb9, 0x4c, 0x1f, 0x42, 0xde, 0x6a, 0xe9, 0xf5,
+	0x70, 0xb8, 0x73, 0x4b, 0x71, 0x83, 0xec, 0x32, 0xe1, 0xba, 0xd4, 0x9c, 0xd5, 0xd2, 0x6b, 0xaf,
+	0xf1, 0x1b, 0x22, 0xc3, 0x72, 0xe2, 0x9e, 0xd8, 0xae, 0xff, 0x29, 0x78, 0xa9, 0x26, 0x09, 0x07,
+	0x64, 0x1f, 0x35, 0xce, 0x50, 0x5b, 0x13, 0xbf, 0x09, 0x79, 0x2f, 0xd0, 0x9c, 0x6e, 0x07, 0xc5,
+	0x77, 0xcc, 0x0d, 0x46, 0x02, 0x93, 0x76, 0xa9, 0xe0, 0x7e, 0x1d, 0x77, 0x3b, 0x48, 0x5c, 0x82,
+	0x9c, 0xe6, 0xe7, 0x96, 0x9e, 0x7f, 0x82, 0x0e, 0xb2, 0x8b, 0x53, 0xcb, 0x6e, 0x6b, 0x8e, 0xbf,
+	0x0b, 0xf7, 0x4b, 0x2c, 0x02, 0xe8, 0xe8, 0xd4, 0x30, 0x0d, 0x52, 0x89, 0xf1, 0x72, 0x33, 0xae,
+	0x27, 0xdd, 0xbd, 0xe6, 0xa7, 0xb7, 0xfc, 0xde, 0xfc, 0x9d, 0xef, 0xff, 0xf5, 0x3d, 0xc8, 0x1e,
+	0xe2, 0xa6, 0xd8, 0x85, 0x5c, 0x50, 0xae, 0x2a, 0xc6, 0x4f, 0x07, 0x5f, 0x62, 0x91, 0x36, 0x86,
+	0x8f, 0x33, 0x59, 0xd7, 0x1e, 0xfd, 0xfd, 0xe5, 0xaf, 0x32, 0x45, 0x65, 0xa9, 0x1a, 0xab, 0x98,
+	0x61, 0xe4, 0xd4, 0xbd, 0x6a, 0xc0, 0x8f, 0x04, 0x78, 0x27, 0x54, 0x95, 0x59, 0x49, 0x5c, 0x9e,
+	0x87, 0x48, 0xdb, 0xd7, 0x42, 0x18, 0x89, 0x4d, 0x4a, 0x62, 0x45, 0x91, 0xe3, 0x24, 0xce, 0x29,
+	0xde, 0xe7, 0xf1, 0x03, 0x01, 0xf2, 0x7c, 0x7e, 0x56, 0x4a, 0xb4, 0xc1, 0x21, 0xa4, 0xad, 0xeb,
+	0x10, 0x8c, 0xc4, 0x06, 0x25, 0x51, 0x52, 0x8a, 0xd5, 0x84, 0xda, 0x21, 0x39, 0x26, 0x9c, 0x16,
+	0xa1, 0x4c, 0x28, 0x59, 0x0b, 0x1e, 0x22, 0x6d, 0x5f, 0x0b, 0x49, 0xa3, 0x85, 0x4e, 0xf1, 0x3e,
+	0x8f, 0xbf, 0x08, 0x30, 0x3f, 0x28, 0x1f, 0xd9, 0x4b, 0xb4, 0x37, 0x00, 0x2d, 0x7d, 0xe5, 0x75,
+	0xd0, 0x8c, 0xe8, 0x7d, 0x4a, 0xb4, 0xac, 0xec, 0xc6, 0x89, 0x3a, 0xde, 0x54, 0x8f, 0x6a, 0xdd,
+	0xf2, 0x27, 0x8b, 0x3f, 0x11, 0x60, 0x2a, 0xfc, 0x2e, 0x2b, 0x89, 0xc6, 0x43, 0x18, 0x69, 0xe7,
+	0x7a, 0x0c, 0xa3, 0xb5, 0x45, 0x69, 0x29, 0x4a, 0x29, 0x4e, 0x8b, 0xdd, 0x2d, 0xf4, 0x9a, 0x12,
+	0x7f, 0x26, 0xc0, 0x74, 0xe4, 0x8d, 0x5b, 0x4d, 0xf6, 0x53, 0x08, 0x24, 0xed, 0xa6, 0x00, 0x31,
+	0x3a, 0xdb, 0x94, 0xce, 0xaa, 0xb2, 0x92, 0xe0, 0x4e, 0x23, 0xcc, 0xe7, 0x77, 0x02, 0xbc, 0x1b,
+	0x7f, 0x6e, 0x36, 0xae, 0xb1, 0xe6, 0xe1, 0xa4, 0x4a, 0x3a, 0x1c, 0x23, 0xb6, 0x47, 0x89, 0x6d,
+	0x28, 0x6b, 0x43, 0x89, 0xd9, 0x1e, 0x8b, 0x3f, 0x08, 0x20, 0x26, 0x3c, 0x38, 0x9b, 0x03, 0x4e,
+	0x57, 0x14, 0x28, 0x55, 0x53, 0x02, 0x19, 0xbd, 0x32, 0xa5, 0xb7, 0xa9, 0xac, 0x27, 0x9d, 0x46,
+	0x2d, 0xc6, 0xef, 0x73, 0x98, 0xf0, 0x2b, 0x88, 0x4b, 0x83, 0x6e, 0x3e, 0x32, 0x2a, 0xad, 0x0d,
+	0x1b, 0x65, 0xd6, 0x15, 0x6a, 0x7d, 0x49, 0x91, 0x92, 0x6f, 0x45, 0x5a, 0xd1, 0xf8, 0x1e, 0x00,
+	0xf7, 0x33, 0x2a, 0x0f, 0x39, 0xe1, 0xd4, 0xf0, 0xe6, 0x35, 0x00, 0x66, 0x7b, 0x9d, 0xda, 0x96,
+	0x95, 0xe5, 0x81, 0x17, 0x00, 0x35, 0xff, 0x6b, 0x01, 0x66, 0x63, 0x75, 0xcc, 0xf5, 0x01, 0x32,
+	0x87, 0x61, 0x52, 0x39, 0x15, 0x8c, 0x31, 0xda, 0xa5, 0x8c, 0xd6, 0x95, 0xd5, 0x24, 0x5f, 0xd0,
+	0x39, 0xf5, 0xe0, 0x9d, 0xfc, 0x8d, 0x00, 0xb3, 0xb1, 0xca, 0xe6, 0xfa, 0x20, 0xd5, 0x43, 0x30,
+	0xa9, 0x9c, 0x0a, 0x96, 0x26, 0x84, 0x89, 0x97, 0x18, 0xa7, 0xe0, 0x78, 0xc5, 0xcb, 0x98, 0x1b,
+	0x43, 0xef, 0xbe, 0x40, 0xb2, 0x4a, 0x3a, 0x5c, 0x1a, 0x6e, 0xec, 0x76, 0x0c, 0x44, 0xfb, 0x85,
+	0x00, 0x33, 0xd1, 0x8a, 0x5d, 0x72, 0xa4, 0x46, 0x50, 0xd2, 0x5e, 0x1a, 0x14, 0x63, 0xb5, 0x43,
+	0x59, 0xad, 0x29, 0x4a, 0xc2, 0xe5, 0x48, 0xa7, 0x70, 0x9c, 0xc8, 0xf5, 0x18, 0x29, 0xec, 0xad,
+	0x0e, 0x7e, 0x4c, 0x03, 0x46, 0xbb, 0x29, 0x40, 0x69, 0xae, 0x47, 0xf7, 0xd1, 0x0d, 0xf8, 0xfc,
+	0x52, 0x80, 0x99, 0x68, 0x89, 0x2f, 0x59, 0xa3, 0x08, 0x4a, 0xda, 0x4b, 0x83, 0x4a, 0x13, 0xed,
+	0xb4, 0x9c, 0x45, 0x8f, 0x1f, 0xad, 0x32, 0x63, 0x4c, 0xa3, 0x3d, 0x56, 0x4f, 0x1b, 0x74, 0x0a,
+	0xc3, 0x30, 0xa9, 0x9c, 0x0a, 0x96, 0x26, 0xa2, 0x6c, 0x3a, 0x27, 0x44, 0xcc, 0xf5, 0x5e, 0x28,
+	0x57, 0x1e, 0xe4, 0x3d, 0x1e, 0x24, 0xed, 0xa6, 0x00, 0xa5, 0xf3, 0x9e, 0x3b, 0xa3, 0x8e, 0xdd,
+	0x75, 0xc7, 0xbe, 0xff, 0xea, 0xc9, 0x8e, 0x50, 0xfb, 0xfa, 0xd3, 0xe7, 0x45, 0xe1, 0xd9, 0xf3,
+	0xa2, 0xf0, 0xef, 0xe7, 0x45, 0xe1, 0xf1, 0x8b, 0xe2, 0xc8, 0xb3, 0x17, 0xc5, 0x91, 0x7f, 0xbc,
+	0x28, 0x8e, 0x7c, 0xb2, 0xd1, 0x34, 0x9c, 0xca, 0x85, 0x7e, 0x52, 0x71, 0x2c, 0xba, 0x5a, 0xd9,
+	0xb0, 0xaa, 0x2d, 0xad, 0x61, 0x99, 0x46, 0x43, 0xaf, 0x5e, 0xb1, 0xb5, 0x4f, 0xc6, 0x69, 0x59,
+	0xf5, 0xfe, 0xff, 0x07, 0x00, 0x63, 0xed, 0x5d, 0xa4, 0x9d, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetRecord records a new record with given payload and bond id
+	SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grpc.CallOption) (*MsgSetRecordResponse, error)
+	// UpdateRecord creates a new version of a record, linked to the previous one
+	UpdateRecord(ctx context.Context, in *MsgUpdateRecord, opts ...grpc.CallOption) (*MsgUpdateRecordResponse, error)
+	// Renew Record renews an expired record, or extends a record by prepaying
+	// rent periods
+	RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error)
+	// DeleteRecord deletes a record and refunds the unused rent to its bond
+	DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// TransferRecordOwnership replaces the owners of a record, approved by a
+	// threshold of its current owners
+	TransferRecordOwnership(ctx context.Context, in *MsgTransferRecordOwnership, opts ...grpc.CallOption) (*MsgTransferRecordOwnershipResponse, error)
+	// AssociateBond
+	AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error)
+	// DissociateBond
+	DissociateBond(ctx context.Context, in *MsgDissociateBond, opts ...grpc.CallOption) (*MsgDissociateBondResponse, error)
+	// DissociateRecords
+	DissociateRecords(ctx context.Context, in *MsgDissociateRecords, opts ...grpc.CallOption) (*MsgDissociateRecordsResponse, error)
+	// ReassociateRecords
+	ReassociateRecords(ctx context.Context, in *MsgReassociateRecords, opts ...grpc.CallOption) (*MsgReassociateRecordsResponse, error)
+	// SetName will store the name with given lrn and name
+	SetName(ctx context.Context, in *MsgSetName, opts ...grpc.CallOption) (*MsgSetNameResponse, error)
+	// Delete Name method will remove authority name
+	DeleteName(ctx context.Context, in *MsgDeleteName, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error)
+	// Reserve authority name
+	ReserveAuthority(ctx context.Context, in *MsgReserveAuthority, opts ...grpc.CallOption) (*MsgReserveAuthorityResponse, error)
+	// SetAuthorityBond
+	SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBond, opts ...grpc.CallOption) (*MsgSetAuthorityBondResponse, error)
+	// TransferAuthority hands a name authority over to a new owner, or proposes
+	// the transfer to be accepted by the new owner (see AcceptAuthority)
+	TransferAuthority(ctx context.Context, in *MsgTransferAuthority, opts ...grpc.CallOption) (*MsgTransferAuthorityResponse, error)
+	// AcceptAuthority completes a pending name authority transfer
+	AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error)
+	// RenewAuthority prepays the rent of a name authority for a number of rent
+	// periods, bringing it back to active if it's in its grace period
+	RenewAuthority(ctx context.Context, in *MsgRenewAuthority, opts ...grpc.CallOption) (*MsgRenewAuthorityResponse, error)
+	// GrantNameAccess lets another address set and delete names matching an LRN
+	// prefix within a name authority
+	GrantNameAccess(ctx context.Context, in *MsgGrantNameAccess, opts ...grpc.CallOption) (*MsgGrantNameAccessResponse, error)
+	// RevokeNameAccess revokes a name access grant
+	RevokeNameAccess(ctx context.Context, in *MsgRevokeNameAccess, opts ...grpc.CallOption) (*MsgRevokeNameAccessResponse, error)
+	// RegisterSchema registers or updates a record type schema
+	RegisterSchema(ctx context.Context, in *MsgRegisterSchema, opts ...grpc.CallOption) (*MsgRegisterSchemaResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetRecord(ctx context.Context, in *MsgSetRecord, opts ...grpc.CallOption) (*MsgSetRecordResponse, error) {
+	out := new(MsgSetRecordResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRecord(ctx context.Context, in *MsgUpdateRecord, opts ...grpc.CallOption) (*MsgUpdateRecordResponse, error) {
+	out := new(MsgUpdateRecordResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/UpdateRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RenewRecord(ctx context.Context, in *MsgRenewRecord, opts ...grpc.CallOption) (*MsgRenewRecordResponse, error) {
+	out := new(MsgRenewRecordResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/RenewRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecord, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error) {
+	out := new(MsgDeleteRecordResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DeleteRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRenewRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferRecordOwnership(ctx context.Context, in *MsgTransferRecordOwnership, opts ...grpc.CallOption) (*MsgTransferRecordOwnershipResponse, error) {
+	out := new(MsgTransferRecordOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/TransferRecordOwnership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRenewRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AssociateBond(ctx context.Context, in *MsgAssociateBond, opts ...grpc.CallOption) (*MsgAssociateBondResponse, error) {
+	out := new(MsgAssociateBondResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/AssociateBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRenewRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) DissociateBond(ctx context.Context, in *MsgDissociateBond, opts ...grpc.CallOption) (*MsgDissociateBondResponse, error) {
+	out := new(MsgDissociateBondResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DissociateBond", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RecordId) > 0 {
-		i -= len(m.RecordId)
-		copy(dAtA[i:], m.RecordId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) DissociateRecords(ctx context.Context, in *MsgDissociateRecords, opts ...grpc.CallOption) (*MsgDissociateRecordsResponse, error) {
+	out := new(MsgDissociateRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DissociateRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRenewRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReassociateRecords(ctx context.Context, in *MsgReassociateRecords, opts ...grpc.CallOption) (*MsgReassociateRecordsResponse, error) {
+	out := new(MsgReassociateRecordsResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/ReassociateRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRenewRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetName(ctx context.Context, in *MsgSetName, opts ...grpc.CallOption) (*MsgSetNameResponse, error) {
+	out := new(MsgSetNameResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRenewRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteName(ctx context.Context, in *MsgDeleteName, opts ...grpc.CallOption) (*MsgDeleteNameResponse, error) {
+	out := new(MsgDeleteNameResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/DeleteName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAssociateBond) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReserveAuthority(ctx context.Context, in *MsgReserveAuthority, opts ...grpc.CallOption) (*MsgReserveAuthorityResponse, error) {
+	out := new(MsgReserveAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/ReserveAuthority", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgAssociateBond) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAuthorityBond(ctx context.Context, in *MsgSetAuthorityBond, opts ...grpc.CallOption) (*MsgSetAuthorityBondResponse, error) {
+	out := new(MsgSetAuthorityBondResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/SetAuthorityBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgAssociateBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BondId) > 0 {
-		i -= len(m.BondId)
-		copy(dAtA[i:], m.BondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordId) > 0 {
-		i -= len(m.RecordId)
-		copy(dAtA[i:], m.RecordId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) TransferAuthority(ctx context.Context, in *MsgTransferAuthority, opts ...grpc.CallOption) (*MsgTransferAuthorityResponse, error) {
+	out := new(MsgTransferAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/TransferAuthority", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgAssociateBondResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptAuthority(ctx context.Context, in *MsgAcceptAuthority, opts ...grpc.CallOption) (*MsgAcceptAuthorityResponse, error) {
+	out := new(MsgAcceptAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/AcceptAuthority", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAssociateBondResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAssociateBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDissociateBond) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RenewAuthority(ctx context.Context, in *MsgRenewAuthority, opts ...grpc.CallOption) (*MsgRenewAuthorityResponse, error) {
+	out := new(MsgRenewAuthorityResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/RenewAuthority", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDissociateBond) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GrantNameAccess(ctx context.Context, in *MsgGrantNameAccess, opts ...grpc.CallOption) (*MsgGrantNameAccessResponse, error) {
+	out := new(MsgGrantNameAccessResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/GrantNameAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDissociateBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RecordId) > 0 {
-		i -= len(m.RecordId)
-		copy(dAtA[i:], m.RecordId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) RevokeNameAccess(ctx context.Context, in *MsgRevokeNameAccess, opts ...grpc.CallOption) (*MsgRevokeNameAccessResponse, error) {
+	out := new(MsgRevokeNameAccessResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/RevokeNameAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDissociateBondResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterSchema(ctx context.Context, in *MsgRegisterSchema, opts ...grpc.CallOption) (*MsgRegisterSchemaResponse, error) {
+	out := new(MsgRegisterSchemaResponse)
+	err := c.cc.Invoke(ctx, "/cerc.registry.v1.Msg/RegisterSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDissociateBondResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetRecord records a new record with given payload and bond id
+	SetRecord(context.Context, *MsgSetRecord) (*MsgSetRecordResponse, error)
+	// UpdateRecord creates a new version of a record, linked to the previous one
+	UpdateRecord(context.Context, *MsgUpdateRecord) (*MsgUpdateRecordResponse, error)
+	// Renew Record renews an expired record, or extends a record by prepaying
+	// rent periods
+	RenewRecord(context.Context, *MsgRenewRecord) (*MsgRenewRecordResponse, error)
+	// DeleteRecord deletes a record and refunds the unused rent to its bond
+	DeleteRecord(context.Context, *MsgDeleteRecord) (*MsgDeleteRecordResponse, error)
+	// TransferRecordOwnership replaces the owners of a record, approved by a
+	// threshold of its current owners
+	TransferRecordOwnership(context.Context, *MsgTransferRecordOwnership) (*MsgTransferRecordOwnershipResponse, error)
+	// AssociateBond
+	AssociateBond(context.Context, *MsgAssociateBond) (*MsgAssociateBondResponse, error)
+	// DissociateBond
+	DissociateBond(context.Context, *MsgDissociateBond) (*MsgDissociateBondResponse, error)
+	// DissociateRecords
+	DissociateRecords(context.Context, *MsgDissociateRecords) (*MsgDissociateRecordsResponse, error)
+	// ReassociateRecords
+	ReassociateRecords(context.Context, *MsgReassociateRecords) (*MsgReassociateRecordsResponse, error)
+	// SetName will store the name with given lrn and name
+	SetName(context.Context, *MsgSetName) (*MsgSetNameResponse, error)
+	// Delete Name method will remove authority name
+	DeleteName(context.Context, *MsgDeleteName) (*MsgDeleteNameResponse, error)
+	// Reserve authority name
+	ReserveAuthority(context.Context, *MsgReserveAuthority) (*MsgReserveAuthorityResponse, error)
+	// SetAuthorityBond
+	SetAuthorityBond(context.Context, *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error)
+	// TransferAuthority hands a name authority over to a new owner, or proposes
+	// the transfer to be accepted by the new owner (see AcceptAuthority)
+	TransferAuthority(context.Context, *MsgTransferAuthority) (*MsgTransferAuthorityResponse, error)
+	// AcceptAuthority completes a pending name authority transfer
+	AcceptAuthority(context.Context, *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error)
+	// RenewAuthority prepays the rent of a name authority for a number of rent
+	// periods, bringing it back to active if it's in its grace period
+	RenewAuthority(context.Context, *MsgRenewAuthority) (*MsgRenewAuthorityResponse, error)
+	// GrantNameAccess lets another address set and delete names matching an LRN
+	// prefix within a name authority
+	GrantNameAccess(context.Context, *MsgGrantNameAccess) (*MsgGrantNameAccessResponse, error)
+	// RevokeNameAccess revokes a name access grant
+	RevokeNameAccess(context.Context, *MsgRevokeNameAccess) (*MsgRevokeNameAccessResponse, error)
+	// RegisterSchema registers or updates a record type schema
+	RegisterSchema(context.Context, *MsgRegisterSchema) (*MsgRegisterSchemaResponse, error)
 }
 
-func (m *MsgDissociateBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgDissociateRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetRecord(ctx context.Context, req *MsgSetRecord) (*MsgSetRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecord not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRecord(ctx context.Context, req *MsgUpdateRecord) (*MsgUpdateRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecord not implemented")
+}
+func (*UnimplementedMsgServer) RenewRecord(ctx context.Context, req *MsgRenewRecord) (*MsgRenewRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewRecord not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecord) (*MsgDeleteRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
+}
+func (*UnimplementedMsgServer) TransferRecordOwnership(ctx context.Context, req *MsgTransferRecordOwnership) (*MsgTransferRecordOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRecordOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AssociateBond(ctx context.Context, req *MsgAssociateBond) (*MsgAssociateBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssociateBond not implemented")
+}
+func (*UnimplementedMsgServer) DissociateBond(ctx context.Context, req *MsgDissociateBond) (*MsgDissociateBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DissociateBond not implemented")
+}
+func (*UnimplementedMsgServer) DissociateRecords(ctx context.Context, req *MsgDissociateRecords) (*MsgDissociateRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DissociateRecords not implemented")
+}
+func (*UnimplementedMsgServer) ReassociateRecords(ctx context.Context, req *MsgReassociateRecords) (*MsgReassociateRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassociateRecords not implemented")
+}
+func (*UnimplementedMsgServer) SetName(ctx context.Context, req *MsgSetName) (*MsgSetNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetName not implemented")
+}
+func (*UnimplementedMsgServer) DeleteName(ctx context.Context, req *MsgDeleteName) (*MsgDeleteNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteName not implemented")
+}
+func (*UnimplementedMsgServer) ReserveAuthority(ctx context.Context, req *MsgReserveAuthority) (*MsgReserveAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveAuthority not implemented")
+}
+func (*UnimplementedMsgServer) SetAuthorityBond(ctx context.Context, req *MsgSetAuthorityBond) (*MsgSetAuthorityBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthorityBond not implemented")
+}
+func (*UnimplementedMsgServer) TransferAuthority(ctx context.Context, req *MsgTransferAuthority) (*MsgTransferAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAuthority not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAuthority(ctx context.Context, req *MsgAcceptAuthority) (*MsgAcceptAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAuthority not implemented")
+}
+func (*UnimplementedMsgServer) RenewAuthority(ctx context.Context, req *MsgRenewAuthority) (*MsgRenewAuthorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAuthority not implemented")
+}
+func (*UnimplementedMsgServer) GrantNameAccess(ctx context.Context, req *MsgGrantNameAccess) (*MsgGrantNameAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantNameAccess not implemented")
+}
+func (*UnimplementedMsgServer) RevokeNameAccess(ctx context.Context, req *MsgRevokeNameAccess) (*MsgRevokeNameAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeNameAccess not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSchema(ctx context.Context, req *MsgRegisterSchema) (*MsgRegisterSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSchema not implemented")
 }
 
-func (m *MsgDissociateRecords) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgDissociateRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_SetRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BondId) > 0 {
-		i -= len(m.BondId)
-		copy(dAtA[i:], m.BondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SetRecord(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/SetRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRecord(ctx, req.(*MsgSetRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDissociateRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRecord)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgDissociateRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgDissociateRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/UpdateRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRecord(ctx, req.(*MsgUpdateRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReassociateRecords) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RenewRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewRecord)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RenewRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/RenewRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewRecord(ctx, req.(*MsgRenewRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReassociateRecords) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgReassociateRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OldBondId) > 0 {
-		i -= len(m.OldBondId)
-		copy(dAtA[i:], m.OldBondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OldBondId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRecord(ctx, in)
 	}
-	if len(m.NewBondId) > 0 {
-		i -= len(m.NewBondId)
-		copy(dAtA[i:], m.NewBondId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBondId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/DeleteRecord",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRecord(ctx, req.(*MsgDeleteRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReassociateRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_TransferRecordOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferRecordOwnership)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).TransferRecordOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/TransferRecordOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferRecordOwnership(ctx, req.(*MsgTransferRecordOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReassociateRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AssociateBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssociateBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AssociateBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/AssociateBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssociateBond(ctx, req.(*MsgAssociateBond))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReassociateRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DissociateBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDissociateBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DissociateBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/DissociateBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DissociateBond(ctx, req.(*MsgDissociateBond))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_DissociateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDissociateRecords)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSetRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).DissociateRecords(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.BondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/DissociateRecords",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DissociateRecords(ctx, req.(*MsgDissociateRecords))
 	}
-	l = m.Payload.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReassociateRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReassociateRecords)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ReassociateRecords(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/ReassociateRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReassociateRecords(ctx, req.(*MsgReassociateRecords))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Payload) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetName)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Record != nil {
-		l = m.Record.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetName(ctx, in)
 	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/SetName",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetName(ctx, req.(*MsgSetName))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetName) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeleteName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteName)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Lrn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteName(ctx, in)
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/DeleteName",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteName(ctx, req.(*MsgDeleteName))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReserveAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReserveAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ReserveAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/ReserveAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReserveAuthority(ctx, req.(*MsgReserveAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReserveAuthority) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAuthorityBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthorityBond)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthorityBond(ctx, in)
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/SetAuthorityBond",
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthorityBond(ctx, req.(*MsgSetAuthorityBond))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReserveAuthorityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TransferAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAuthority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/TransferAuthority",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAuthority(ctx, req.(*MsgTransferAuthority))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAuthorityBond) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AcceptAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAuthority(ctx, in)
 	}
-	l = len(m.BondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/AcceptAuthority",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAuthority(ctx, req.(*MsgAcceptAuthority))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetAuthorityBondResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RenewAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewAuthority)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgDeleteName) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RenewAuthority(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Lrn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/RenewAuthority",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewAuthority(ctx, req.(*MsgRenewAuthority))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteNameResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_GrantNameAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantNameAccess)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRenewRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).GrantNameAccess(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.RecordId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/GrantNameAccess",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantNameAccess(ctx, req.(*MsgGrantNameAccess))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRenewRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevokeNameAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeNameAccess)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeNameAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/RevokeNameAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeNameAccess(ctx, req.(*MsgRevokeNameAccess))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssociateBond) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSchema)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RecordId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSchema(ctx, in)
 	}
-	l = len(m.BondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cerc.registry.v1.Msg/RegisterSchema",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSchema(ctx, req.(*MsgRegisterSchema))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAssociateBondResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cerc.registry.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetRecord",
+			Handler:    _Msg_SetRecord_Handler,
+		},
+		{
+			MethodName: "UpdateRecord",
+			Handler:    _Msg_UpdateRecord_Handler,
+		},
+		{
+			MethodName: "RenewRecord",
+			Handler:    _Msg_RenewRecord_Handler,
+		},
+		{
+			MethodName: "DeleteRecord",
+			Handler:    _Msg_DeleteRecord_Handler,
+		},
+		{
+			MethodName: "TransferRecordOwnership",
+			Handler:    _Msg_TransferRecordOwnership_Handler,
+		},
+		{
+			MethodName: "AssociateBond",
+			Handler:    _Msg_AssociateBond_Handler,
+		},
+		{
+			MethodName: "DissociateBond",
+			Handler:    _Msg_DissociateBond_Handler,
+		},
+		{
+			MethodName: "DissociateRecords",
+			Handler:    _Msg_DissociateRecords_Handler,
+		},
+		{
+			MethodName: "ReassociateRecords",
+			Handler:    _Msg_ReassociateRecords_Handler,
+		},
+		{
+			MethodName: "SetName",
+			Handler:    _Msg_SetName_Handler,
+		},
+		{
+			MethodName: "DeleteName",
+			Handler:    _Msg_DeleteName_Handler,
+		},
+		{
+			MethodName: "ReserveAuthority",
+			Handler:    _Msg_ReserveAuthority_Handler,
+		},
+		{
+			MethodName: "SetAuthorityBond",
+			Handler:    _Msg_SetAuthorityBond_Handler,
+		},
+		{
+			MethodName: "TransferAuthority",
+			Handler:    _Msg_TransferAuthority_Handler,
+		},
+		{
+			MethodName: "AcceptAuthority",
+			Handler:    _Msg_AcceptAuthority_Handler,
+		},
+		{
+			MethodName: "RenewAuthority",
+			Handler:    _Msg_RenewAuthority_Handler,
+		},
+		{
+			MethodName: "GrantNameAccess",
+			Handler:    _Msg_GrantNameAccess_Handler,
+		},
+		{
+			MethodName: "RevokeNameAccess",
+			Handler:    _Msg_RevokeNameAccess_Handler,
+		},
+		{
+			MethodName: "RegisterSchema",
+			Handler:    _Msg_RegisterSchema_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cerc/registry/v1/tx.proto",
 }
 
-func (m *MsgDissociateBond) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDissociateBondResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDissociateRecords) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.OwnerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OwnerThreshold))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Lifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lifetime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondId) > 0 {
+		i -= len(m.BondId)
+		copy(dAtA[i:], m.BondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lrn) > 0 {
+		i -= len(m.Lrn)
+		copy(dAtA[i:], m.Lrn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lrn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReserveAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReserveAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReserveAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthorityBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthorityBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthorityBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BondId) > 0 {
+		i -= len(m.BondId)
+		copy(dAtA[i:], m.BondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthorityBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthorityBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthorityBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InvalidateNames {
+		i--
+		if m.InvalidateNames {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeepBond {
+		i--
+		if m.KeepBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RequireAccept {
+		i--
+		if m.RequireAccept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewAuthority) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewAuthority) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewAuthority) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Periods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewAuthorityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewAuthorityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantNameAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantNameAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantNameAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpiryTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LrnPrefix) > 0 {
+		i -= len(m.LrnPrefix)
+		copy(dAtA[i:], m.LrnPrefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LrnPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantNameAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantNameAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantNameAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNameAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNameAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNameAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LrnPrefix) > 0 {
+		i -= len(m.LrnPrefix)
+		copy(dAtA[i:], m.LrnPrefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LrnPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeNameAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNameAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNameAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteName) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteName) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteName) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lrn) > 0 {
+		i -= len(m.Lrn)
+		copy(dAtA[i:], m.Lrn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Lrn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Periods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRecordOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRecordOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRecordOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OwnerThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OwnerThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferRecordOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferRecordOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferRecordOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAssociateBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssociateBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssociateBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BondId) > 0 {
+		i -= len(m.BondId)
+		copy(dAtA[i:], m.BondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAssociateBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAssociateBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAssociateBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDissociateBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDissociateBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDissociateBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordId) > 0 {
+		i -= len(m.RecordId)
+		copy(dAtA[i:], m.RecordId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDissociateBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDissociateBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDissociateBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDissociateRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDissociateRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDissociateRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondId) > 0 {
+		i -= len(m.BondId)
+		copy(dAtA[i:], m.BondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BondId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDissociateRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDissociateRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDissociateRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReassociateRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReassociateRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReassociateRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldBondId) > 0 {
+		i -= len(m.OldBondId)
+		copy(dAtA[i:], m.OldBondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldBondId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewBondId) > 0 {
+		i -= len(m.NewBondId)
+		copy(dAtA[i:], m.NewBondId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewBondId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReassociateRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReassociateRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReassociateRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordType) > 0 {
+		i -= len(m.RecordType)
+		copy(dAtA[i:], m.RecordType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payload.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Lifetime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.OwnerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.OwnerThreshold))
+	}
+	return n
+}
+
+func (m *MsgSetRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Payload.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Payload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lrn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReserveAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReserveAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAuthorityBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAuthorityBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireAccept {
+		n += 2
+	}
+	if m.KeepBond {
+		n += 2
+	}
+	if m.InvalidateNames {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovTx(uint64(m.Periods))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantNameAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LrnPrefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantNameAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeNameAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LrnPrefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeNameAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteName) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lrn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovTx(uint64(m.Periods))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRenewRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferRecordOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.OwnerThreshold != 0 {
+		n += 1 + sovTx(uint64(m.OwnerThreshold))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferRecordOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAssociateBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAssociateBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDissociateBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDissociateBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDissociateRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDissociateRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReassociateRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewBondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldBondId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReassociateRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Lifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+			}
+			m.OwnerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Record == nil {
+				m.Record = &Record{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lrn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReserveAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReserveAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReserveAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthorityBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthorityBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAuthorityBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAuthorityBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAuthorityBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireAccept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireAccept = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.KeepBond = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidateNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InvalidateNames = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDissociateRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgReassociateRecords) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewBondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OldBondId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRenewAuthorityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewAuthorityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgReassociateRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantNameAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2605,15 +7011,15 @@ func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantNameAccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantNameAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2641,11 +7047,11 @@ func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondId = string(dAtA[iNdEx:postIndex])
+			m.LrnPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2673,11 +7079,11 @@ func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2704,10 +7110,45 @@ func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2729,7 +7170,7 @@ func (m *MsgSetRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantNameAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2752,44 +7193,12 @@ func (m *MsgSetRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantNameAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantNameAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2811,7 +7220,7 @@ func (m *MsgSetRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payload) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeNameAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2834,17 +7243,17 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeNameAccess: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeNameAccess: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LrnPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2854,33 +7263,61 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Record == nil {
-				m.Record = &Record{}
+			m.LrnPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2890,25 +7327,23 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, Signature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2931,7 +7366,7 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetName) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeNameAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2954,47 +7389,65 @@ func (m *MsgSetName) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetName: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeNameAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetName: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeNameAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteName) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lrn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteName: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteName: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3022,9 +7475,9 @@ func (m *MsgSetName) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.Lrn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -3077,7 +7530,7 @@ func (m *MsgSetName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3100,10 +7553,10 @@ func (m *MsgSetNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3127,7 +7580,7 @@ func (m *MsgSetNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3150,15 +7603,15 @@ func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReserveAuthority: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReserveAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3186,7 +7639,7 @@ func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3221,10 +7674,29 @@ func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3234,23 +7706,25 @@ func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3273,7 +7747,7 @@ func (m *MsgReserveAuthority) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReserveAuthorityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRenewRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3296,10 +7770,10 @@ func (m *MsgReserveAuthorityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReserveAuthorityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRenewRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReserveAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRenewRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3323,7 +7797,7 @@ func (m *MsgReserveAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3346,15 +7820,15 @@ func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAuthorityBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAuthorityBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,11 +7856,11 @@ func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3414,13 +7888,13 @@ func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3430,23 +7904,25 @@ func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3469,7 +7945,7 @@ func (m *MsgSetAuthorityBond) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAuthorityBondResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,10 +7968,10 @@ func (m *MsgSetAuthorityBondResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAuthorityBondResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAuthorityBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3519,7 +7995,7 @@ func (m *MsgSetAuthorityBondResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteName) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferRecordOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3542,15 +8018,47 @@ func (m *MsgDeleteName) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteName: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferRecordOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteName: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferRecordOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lrn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3571,16 +8079,69 @@ func (m *MsgDeleteName) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerThreshold", wireType)
+			}
+			m.OwnerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lrn = string(dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -3633,7 +8194,7 @@ func (m *MsgDeleteName) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferRecordOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3656,10 +8217,10 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferRecordOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferRecordOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3683,7 +8244,7 @@ func (m *MsgDeleteNameResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3706,10 +8267,10 @@ func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssociateBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3745,6 +8306,38 @@ func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
 			m.RecordId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -3776,6 +8369,40 @@ func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3797,7 +8424,7 @@ func (m *MsgRenewRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRenewRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAssociateBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3820,10 +8447,10 @@ func (m *MsgRenewRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRenewRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssociateBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRenewRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3847,7 +8474,7 @@ func (m *MsgRenewRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
+func (m *MsgDissociateBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3870,10 +8497,10 @@ func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssociateBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDissociateBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDissociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3910,7 +8537,7 @@ func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3938,13 +8565,13 @@ func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3954,23 +8581,25 @@ func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3993,7 +8622,7 @@ func (m *MsgAssociateBond) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssociateBondResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDissociateBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4016,10 +8645,10 @@ func (m *MsgAssociateBondResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssociateBondResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDissociateBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDissociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4043,7 +8672,7 @@ func (m *MsgAssociateBondResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDissociateBond) Unmarshal(dAtA []byte) error {
+func (m *MsgDissociateRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4066,15 +8695,15 @@ func (m *MsgDissociateBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDissociateBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDissociateRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDissociateBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDissociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4102,7 +8731,7 @@ func (m *MsgDissociateBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordId = string(dAtA[iNdEx:postIndex])
+			m.BondId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4157,7 +8786,7 @@ func (m *MsgDissociateBond) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDissociateBondResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDissociateRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4180,10 +8809,10 @@ func (m *MsgDissociateBondResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDissociateBondResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDissociateRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDissociateBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDissociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4207,7 +8836,7 @@ func (m *MsgDissociateBondResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDissociateRecords) Unmarshal(dAtA []byte) error {
+func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4230,15 +8859,15 @@ func (m *MsgDissociateRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDissociateRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReassociateRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDissociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReassociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBondId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4266,9 +8895,41 @@ func (m *MsgDissociateRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondId = string(dAtA[iNdEx:postIndex])
+			m.NewBondId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldBondId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldBondId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4321,7 +8982,7 @@ func (m *MsgDissociateRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDissociateRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReassociateRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4344,10 +9005,10 @@ func (m *MsgDissociateRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDissociateRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReassociateRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDissociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReassociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4371,7 +9032,7 @@ func (m *MsgDissociateRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4394,15 +9055,15 @@ func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReassociateRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReassociateRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4430,11 +9091,11 @@ func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewBondId = string(dAtA[iNdEx:postIndex])
+			m.RecordType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldBondId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4462,9 +9123,73 @@ func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldBondId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4517,7 +9242,7 @@ func (m *MsgReassociateRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReassociateRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4540,10 +9265,10 @@ func (m *MsgReassociateRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReassociateRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReassociateRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
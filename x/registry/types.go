@@ -2,18 +2,80 @@ package registry
 
 import (
 	"crypto/sha256"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/gibson042/canonicaljson-go"
 
 	"git.vdb.to/cerc-io/laconicd/x/registry/helpers"
 )
 
 const (
-	AuthorityActive       = "active"
-	AuthorityExpired      = "expired"
-	AuthorityUnderAuction = "auction"
+	AuthorityActive        = "active"
+	AuthorityExpired       = "expired"
+	AuthorityUnderAuction  = "auction"
+	AuthorityInGracePeriod = "grace"
+
+	SchemaFormatJSON = "json"
+	SchemaFormatIPLD = "ipld"
+
+	// RecordTypeAttribute is the record attribute that names the record type.
+	RecordTypeAttribute = "type"
+
+	// AttributePathSeparator separates the keys of nested record attributes in attribute paths,
+	// e.g. `x500.common_name`.
+	AttributePathSeparator = "."
+
+	// AttributePathEscape escapes separators (and itself) within the keys of attribute paths,
+	// e.g. `a\.b` is the path of the top level key `a.b`, and `a.b` the path of `{"a": {"b": ...}}`.
+	AttributePathEscape = `\`
+
+	// Record payload signature schemes.
+	SignatureSchemeCosmos = "cosmos"
+	SignatureSchemeEIP191 = "eip191"
+	SignatureSchemeEIP712 = "eip712"
+
+	// EIP-712 domain of the documents (records and record operations) signed by owners.
+	EIP712DomainName    = "laconic-registry"
+	EIP712DomainVersion = "1"
 )
 
+var attributePathKeyEscaper = strings.NewReplacer(
+	AttributePathEscape, AttributePathEscape+AttributePathEscape,
+	AttributePathSeparator, AttributePathEscape+AttributePathSeparator,
+)
+
+// AttributePath returns the path of a nested attribute given the path of its parent.
+// Separators within the key are escaped, so that paths of distinct attributes don't collide.
+func AttributePath(prefix string, key string) string {
+	key = attributePathKeyEscaper.Replace(key)
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + AttributePathSeparator + key
+}
+
+// SplitAttributePath splits an attribute path into its (unescaped) keys.
+func SplitAttributePath(path string) []string {
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == AttributePathEscape[0] && i+1 < len(path):
+			i++
+			key.WriteByte(path[i])
+		case c == AttributePathSeparator[0]:
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(c)
+		}
+	}
+
+	return append(keys, key.String())
+}
+
 // TODO if schema records are to be more permissive than allowing a map of fields, this type will
 // become specific to content records. schema records will either occupy a new message or have new
 // more general purpose helper types.
@@ -35,7 +97,13 @@ type ReadableRecord struct {
 	ExpiryTime string       `json:"expiry_time,omitempty"`
 	Deleted    bool         `json:"deleted,omitempty"`
 	Owners     []string     `json:"owners,omitempty"`
+	Type       string       `json:"type,omitempty"`
+	PreviousId string       `json:"previous_id,omitempty"`
 	Attributes AttributeMap `json:"attributes,omitempty"`
+
+	OwnerThreshold uint32 `json:"owner_threshold,omitempty"`
+	SchemaVersion  uint64 `json:"schema_version,omitempty"`
+	Nonce          uint64 `json:"nonce,omitempty"`
 }
 
 // ToPayload converts PayloadEncodable to Payload object.
@@ -55,13 +123,17 @@ func (payloadObj *ReadablePayload) ToPayload() Payload {
 }
 
 // ToReadablePayload converts Payload to a serializable object
-func (payload Payload) ToReadablePayload() ReadablePayload {
+func (payload Payload) ToReadablePayload() (ReadablePayload, error) {
 	var encodable ReadablePayload
 
-	encodable.RecordAttributes = helpers.MustUnmarshalJSON[AttributeMap](payload.Record.Attributes)
+	attributes, err := DecodeJSONAttributes(payload.Record.Attributes)
+	if err != nil {
+		return ReadablePayload{}, err
+	}
+	encodable.RecordAttributes = attributes
 	encodable.Signatures = payload.Signatures
 
-	return encodable
+	return encodable, nil
 }
 
 // ToRecordObj converts Record to RecordObj.
@@ -75,13 +147,23 @@ func (r *ReadableRecord) ToRecordObj() (Record, error) {
 	resourceObj.ExpiryTime = r.ExpiryTime
 	resourceObj.Deleted = r.Deleted
 	resourceObj.Owners = r.Owners
-	resourceObj.Attributes = helpers.MustMarshalJSON(r.Attributes)
+	resourceObj.Type = r.Type
+	resourceObj.PreviousId = r.PreviousId
+	resourceObj.OwnerThreshold = r.OwnerThreshold
+	resourceObj.SchemaVersion = r.SchemaVersion
+	resourceObj.Nonce = r.Nonce
+
+	attributes, err := EncodeAttributes(r.Attributes)
+	if err != nil {
+		return Record{}, err
+	}
+	resourceObj.Attributes = attributes
 
 	return resourceObj, nil
 }
 
 // ToReadableRecord converts Record to a serializable object
-func (r *Record) ToReadableRecord() ReadableRecord {
+func (r *Record) ToReadableRecord() (ReadableRecord, error) {
 	var resourceObj ReadableRecord
 
 	resourceObj.Id = r.Id
@@ -91,9 +173,19 @@ func (r *Record) ToReadableRecord() ReadableRecord {
 	resourceObj.Deleted = r.Deleted
 	resourceObj.Owners = r.Owners
 	resourceObj.Names = r.Names
-	resourceObj.Attributes = helpers.MustUnmarshalJSON[AttributeMap](r.Attributes)
+	resourceObj.Type = r.Type
+	resourceObj.PreviousId = r.PreviousId
+	resourceObj.OwnerThreshold = r.OwnerThreshold
+	resourceObj.SchemaVersion = r.SchemaVersion
+	resourceObj.Nonce = r.Nonce
 
-	return resourceObj
+	attributes, err := DecodeAttributes(r.Attributes)
+	if err != nil {
+		return ReadableRecord{}, err
+	}
+	resourceObj.Attributes = attributes
+
+	return resourceObj, nil
 }
 
 // CanonicalJSON returns the canonical JSON representation of the record.
@@ -108,11 +200,36 @@ func (r *ReadableRecord) CanonicalJSON() []byte {
 
 // GetSignBytes generates a record hash to be signed.
 func (r *ReadableRecord) GetSignBytes() ([]byte, []byte) {
-	// Double SHA256 hash.
-
-	// Input to the first round of hashing.
 	bytes := r.CanonicalJSON()
 
+	return doubleSha256(bytes), bytes
+}
+
+// RecordOperation is the document record owners sign to approve a record-scoped message.
+// The action is the message type URL, the version id is the id of the new version for record updates,
+// and owners are the sorted hex addresses of new owners, if any.
+// The chain id and the record nonce bind an approval to a single operation on a single chain.
+type RecordOperation struct {
+	Action         string   `json:"action"`
+	ChainId        string   `json:"chain_id"`
+	RecordId       string   `json:"record_id"`
+	Nonce          uint64   `json:"nonce"`
+	VersionId      string   `json:"version_id,omitempty"`
+	Owners         []string `json:"owners,omitempty"`
+	OwnerThreshold uint32   `json:"owner_threshold,omitempty"`
+}
+
+// GetSignBytes generates a record operation hash to be signed, the same way as for records.
+func (op RecordOperation) GetSignBytes() ([]byte, []byte) {
+	bytes, err := canonicaljson.Marshal(op)
+	if err != nil {
+		panic("error marshaling record operation: " + err.Error())
+	}
+
+	return doubleSha256(bytes), bytes
+}
+
+func doubleSha256(bytes []byte) []byte {
 	// First round.
 	first := sha256.New()
 	first.Write(bytes)
@@ -121,9 +238,37 @@ func (r *ReadableRecord) GetSignBytes() ([]byte, []byte) {
 	// Second round of hashing takes as input the output of the first round.
 	second := sha256.New()
 	second.Write(firstHash)
-	secondHash := second.Sum(nil)
 
-	return secondHash, bytes
+	return second.Sum(nil)
+}
+
+// EIP712TypedData wraps a (canonical JSON) document to be signed by an Ethereum wallet as EIP-712 typed data.
+func EIP712TypedData(document []byte) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Document": {
+				{Name: "content", Type: "string"},
+			},
+		},
+		PrimaryType: "Document",
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712DomainName,
+			Version: EIP712DomainVersion,
+		},
+		Message: apitypes.TypedDataMessage{
+			"content": string(document),
+		},
+	}
+}
+
+// RecordType returns the value of the record's `type` attribute, if it is a string.
+func (r *ReadableRecord) RecordType() string {
+	recordType, _ := r.Attributes[RecordTypeAttribute].(string)
+	return recordType
 }
 
 // GetCid gets the record CID.